@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/format"
+	"github.com/open-edge-platform/cli/pkg/rest/alerting"
+	"github.com/spf13/cobra"
+)
+
+const listAlertExamples = `# List the alerts of a project, the most severe first
+orch-cli list alert --project some-project
+
+# List the critical and major alerts only
+orch-cli list alert --severity critical,major --project some-project
+
+# List the alerts about a host, suppressed alerts included
+orch-cli list alert --host 4c4c4544-2046-5310-8052-cac04f515233 --all --project some-project`
+
+const getAlertExamples = `# Get the details of an alert using its fingerprint
+orch-cli get alert 0a6e1b3c7f2d9e41 --project some-project`
+
+const (
+	DEFAULT_ALERT_FORMAT          = "table{{.Fingerprint}}\t{{.Severity}}\t{{.State}}\t{{.Name}}\t{{.Resource}}\t{{formatTime .StartsAt}}"
+	DEFAULT_ALERT_VERBOSE_FORMAT  = "table{{.Fingerprint}}\t{{.Severity}}\t{{.State}}\t{{.Name}}\t{{.Resource}}\t{{formatTime .StartsAt}}\t{{formatTime .UpdatedAt}}\t{{.Summary}}"
+	DEFAULT_ALERT_INSPECT_FORMAT  = "Fingerprint: \t{{.Fingerprint}}\nName: \t{{.Name}}\nSeverity: \t{{.Severity}}\nState: \t{{.State}}\nResource: \t{{.Resource}}\nDefinition ID: \t{{.DefinitionId}}\nStarted: \t{{formatTime .StartsAt}}\nUpdated: \t{{formatTime .UpdatedAt}}\nEnds: \t{{formatTime .EndsAt}}\nSummary: \t{{.Summary}}\nDescription: \t{{.Description}}\nLabels:\n{{range $k, $v := .Labels}}  {{$k}}: \t{{$v}}\n{{end}}"
+	ALERT_OUTPUT_TEMPLATE_ENVVAR  = "ORCH_CLI_ALERT_OUTPUT_TEMPLATE"
+	ALERT_INSPECT_TEMPLATE_ENVVAR = "ORCH_CLI_ALERT_INSPECT_TEMPLATE"
+)
+
+// Severities of the alerts, the most severe first; alerts of another severity are listed after them
+var alertSeverities = []string{"critical", "major", "minor", "warning", "info"}
+
+// Labels of an alert naming the resource the alert is about, and how the resource is shown
+var alertResourceLabels = []struct{ label, kind string }{
+	{"host_uuid", "host"},
+	{"cluster_name", "cluster"},
+	{"deployment_id", "deployment"},
+}
+
+// AlertRow is the view of an alert printed by the list and get alert commands
+type AlertRow struct {
+	Fingerprint  string            `json:"fingerprint"`
+	Name         string            `json:"name"`
+	Severity     string            `json:"severity"`
+	State        string            `json:"state"`
+	Resource     string            `json:"resource,omitempty"`
+	DefinitionId string            `json:"definitionId,omitempty"` //nolint:revive
+	Summary      string            `json:"summary,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	StartsAt     *time.Time        `json:"startsAt,omitempty"`
+	UpdatedAt    *time.Time        `json:"updatedAt,omitempty"`
+	EndsAt       *time.Time        `json:"endsAt,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+}
+
+func toAlertRow(alert alerting.Alert) AlertRow {
+	row := AlertRow{
+		Fingerprint:  alert.Fingerprint,
+		Name:         alert.Labels["alertname"],
+		Severity:     alert.Labels["severity"],
+		DefinitionId: alert.AlertDefinitionId,
+		Summary:      alert.Annotations["summary"],
+		Description:  alert.Annotations["description"],
+		StartsAt:     alert.StartsAt,
+		UpdatedAt:    alert.UpdatedAt,
+		EndsAt:       alert.EndsAt,
+		Labels:       alert.Labels,
+	}
+	if alert.Status != nil {
+		row.State = string(alert.Status.State)
+	}
+	for _, r := range alertResourceLabels {
+		if value := alert.Labels[r.label]; value != "" {
+			row.Resource = r.kind + " " + value
+			break
+		}
+	}
+	return row
+}
+
+// Rank of the severity of an alert, lower is more severe
+func alertSeverityRank(severity string) int {
+	if i := slices.Index(alertSeverities, strings.ToLower(severity)); i >= 0 {
+		return i
+	}
+	return len(alertSeverities)
+}
+
+// Sorts the alerts the most severe first, and the most recent first among alerts of the same severity
+func sortAlerts(alerts []alerting.Alert) {
+	sort.SliceStable(alerts, func(i, j int) bool {
+		ri, rj := alertSeverityRank(alerts[i].Labels["severity"]), alertSeverityRank(alerts[j].Labels["severity"])
+		if ri != rj {
+			return ri < rj
+		}
+		ti, tj := alerts[i].StartsAt, alerts[j].StartsAt
+		return ti != nil && (tj == nil || ti.After(*tj))
+	})
+}
+
+// Parses the comma-separated severities of --severity, compared without case
+func parseAlertSeverities(spec string) ([]string, error) {
+	var severities []string
+	for _, severity := range strings.Split(spec, ",") {
+		severity = strings.ToLower(strings.TrimSpace(severity))
+		if severity == "" {
+			continue
+		}
+		if !slices.Contains(alertSeverities, severity) {
+			return nil, e.WithCode(e.CodeInvalidArgument,
+				fmt.Errorf("invalid --severity %q: accepted values are %s", severity, strings.Join(alertSeverities, ", ")))
+		}
+		severities = append(severities, severity)
+	}
+	return severities, nil
+}
+
+// Keeps the alerts of one of the severities, all of them when no severity is given
+func filterAlertsBySeverity(alerts []alerting.Alert, severities []string) []alerting.Alert {
+	if len(severities) == 0 {
+		return alerts
+	}
+	var filtered []alerting.Alert
+	for _, alert := range alerts {
+		if slices.Contains(severities, strings.ToLower(alert.Labels["severity"])) {
+			filtered = append(filtered, alert)
+		}
+	}
+	return filtered
+}
+
+// Lists the alerts of the project matching the parameters
+func listAlerts(ctx context.Context, alertClient alerting.ClientWithResponsesInterface, projectName string, params *alerting.ListAlertsParams) ([]alerting.Alert, error) {
+	resp, err := alertClient.ListProjectAlertsWithResponse(ctx, projectName, params, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while listing alerts"); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+	return resp.JSON200.Alerts, nil
+}
+
+func getListAlertCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert [flags]",
+		Short:   "List the alerts of a project",
+		Example: listAlertExamples,
+		Args:    cobra.NoArgs,
+		Aliases: alertAliases,
+		RunE:    runListAlertCommand,
+	}
+	cmd.Flags().String("severity", "", fmt.Sprintf("Comma-separated severities of the alerts to list: %s", strings.Join(alertSeverities, ", ")))
+	cmd.Flags().String("host", "", "List the alerts about the host of that UUID")
+	cmd.Flags().String("cluster", "", "List the alerts about the cluster of that name")
+	cmd.Flags().Bool("all", false, "List the suppressed alerts as well as the active ones")
+	addStandardListOutputFlags(cmd)
+	return cmd
+}
+
+func getGetAlertCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert <fingerprint> [flags]",
+		Short:   "Get an alert",
+		Example: getAlertExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: alertAliases,
+		RunE:    runGetAlertCommand,
+	}
+	addStandardGetOutputFlags(cmd)
+	return cmd
+}
+
+// Lists the alerts of a project, the most severe first
+func runListAlertCommand(cmd *cobra.Command, _ []string) error {
+	writer, verbose := getOutputContext(cmd)
+	severitySpec, _ := cmd.Flags().GetString("severity")
+	severities, err := parseAlertSeverities(severitySpec)
+	if err != nil {
+		return err
+	}
+
+	ctx, alertClient, projectName, err := AlertingFactory(cmd)
+	if err != nil {
+		return err
+	}
+	params := &alerting.ListAlertsParams{
+		Host:    getNonEmptyFlag(cmd, "host"),
+		Cluster: getNonEmptyFlag(cmd, "cluster"),
+	}
+	if all, _ := cmd.Flags().GetBool("all"); !all {
+		active := true
+		params.Active = &active
+	}
+	alerts, err := listAlerts(ctx, alertClient, projectName, params)
+	if err != nil {
+		return err
+	}
+	alerts = filterAlertsBySeverity(alerts, severities)
+	sortAlerts(alerts)
+
+	outputFilter, _ := cmd.Flags().GetString("output-filter")
+	if err := printAlerts(cmd, writer, alerts, &outputFilter, verbose); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// Gets an alert given by its fingerprint; the alerting monitor only lists alerts, so the alert is found in the list
+func runGetAlertCommand(cmd *cobra.Command, args []string) error {
+	writer, _ := getOutputContext(cmd)
+	ctx, alertClient, projectName, err := AlertingFactory(cmd)
+	if err != nil {
+		return err
+	}
+	alerts, err := listAlerts(ctx, alertClient, projectName, nil)
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(alerts, func(alert alerting.Alert) bool { return alert.Fingerprint == args[0] })
+	if index < 0 {
+		return e.WithCode(e.CodeNotFound, fmt.Errorf("alert %s not found in project %s", args[0], projectName))
+	}
+	alert := alerts[index]
+
+	if selected, err := printSelectedOutput(cmd, alert); selected {
+		return err
+	}
+	if err := printAlert(cmd, writer, alert); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+func printAlerts(cmd *cobra.Command, writer io.Writer, alerts []alerting.Alert, outputFilter *string, verbose bool) error {
+	outputType, _ := cmd.Flags().GetString("output-type")
+	if outputType == "json" || outputType == "yaml" {
+		GenerateOutput(writer, &CommandResult{OutputAs: toOutputType(outputType), Data: alerts})
+		return nil
+	}
+
+	outputFormat, err := getAlertOutputFormat(cmd, verbose, true)
+	if err != nil {
+		return err
+	}
+	rows := make([]AlertRow, 0, len(alerts))
+	for _, alert := range alerts {
+		rows = append(rows, toAlertRow(alert))
+	}
+	result := CommandResult{
+		Format:    format.Format(outputFormat),
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      rows,
+	}
+	if outputFilter != nil {
+		result.Filter = *outputFilter
+	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
+	GenerateOutput(writer, &result)
+	return nil
+}
+
+// Prints the details of a single alert
+func printAlert(cmd *cobra.Command, writer io.Writer, alert alerting.Alert) error {
+	outputType, _ := cmd.Flags().GetString("output-type")
+	if outputType == "json" || outputType == "yaml" {
+		GenerateOutput(writer, &CommandResult{OutputAs: toOutputType(outputType), Data: alert})
+		return nil
+	}
+
+	outputFormat, err := getAlertOutputFormat(cmd, false, false)
+	if err != nil {
+		return err
+	}
+	GenerateOutput(writer, &CommandResult{
+		Format:    format.Format(outputFormat),
+		OutputAs:  toOutputType("table"),
+		NameLimit: -1,
+		Data:      toAlertRow(alert),
+	})
+	return nil
+}
+
+func getAlertOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (string, error) {
+	if verbose && forList {
+		return DEFAULT_ALERT_VERBOSE_FORMAT, nil
+	}
+	if !forList {
+		return resolveTableOutputTemplate(cmd, DEFAULT_ALERT_INSPECT_FORMAT, ALERT_INSPECT_TEMPLATE_ENVVAR)
+	}
+	return resolveTableOutputTemplate(cmd, DEFAULT_ALERT_FORMAT, ALERT_OUTPUT_TEMPLATE_ENVVAR)
+}
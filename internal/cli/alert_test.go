@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/alerting"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestListAlert() {
+	out, err := s.runCommand("list alert --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?s)0a6e1b3c7f2d9e41\s*\|critical\s*\|active\s*\|HostStatusError\s*\|host 4c4c4544-2046-5310-8052-cac04f5152…\s*\|2026-10-15T08:00:00.*`+
+		`5f2c8d1e9b7a3c60\s*\|warning\s*\|active\s*\|ClusterCPUUsageExceedsThreshold\s*\|cluster cluster-1`, out)
+	s.NotContains(out, "9d4b7e2a1c6f8035", "suppressed alerts are listed with --all only")
+
+	out, err = s.runCommand("list alert --all --severity Major,critical --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?s)0a6e1b3c7f2d9e41.*9d4b7e2a1c6f8035\s*\|major\s*\|suppressed\s*\|DeploymentDown\s*\|deployment deployment-1a2b3c4d`, out)
+	s.NotContains(out, "5f2c8d1e9b7a3c60")
+
+	_, err = s.runCommand("list alert --severity urgent --project " + project)
+	s.EqualError(err, `invalid --severity "urgent": accepted values are critical, major, minor, warning, info`)
+
+	_, err = s.runCommand("list alert --project invalid-project")
+	s.Error(err)
+}
+
+func (s *CLITestSuite) TestGetAlert() {
+	out, err := s.runCommand("get alert 0a6e1b3c7f2d9e41 --project " + project)
+	s.NoError(err)
+	s.Regexp(`Severity:\s*\|critical`, out)
+	s.Regexp(`Description:\s*\|The host reports an error status for more than 5 minutes`, out)
+	s.Regexp(`host_uuid:\s*\|4c4c4544-2046-5310-8052-cac04f515233`, out)
+
+	out, err = s.runCommand("get alert 9d4b7e2a1c6f8035 --jsonpath {.status.state} --project " + project)
+	s.NoError(err)
+	s.Equal("suppressed\n", out)
+
+	_, err = s.runCommand("get alert ffffffffffffffff --project " + project)
+	s.EqualError(err, "alert ffffffffffffffff not found in project "+project)
+}
+
+func TestSortAlerts(t *testing.T) {
+	alerts := []alerting.Alert{
+		{Fingerprint: "unknown", Labels: map[string]string{"severity": "page"}},
+		{Fingerprint: "minor", Labels: map[string]string{"severity": "minor"}},
+		{Fingerprint: "critical", Labels: map[string]string{"severity": "CRITICAL"}},
+	}
+	sortAlerts(alerts)
+	var order []string
+	for _, alert := range alerts {
+		order = append(order, alert.Fingerprint)
+	}
+	assert.Equal(t, []string{"critical", "minor", "unknown"}, order)
+}
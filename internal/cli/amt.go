@@ -256,6 +256,10 @@ func runGetAmtProfileCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, *resp.JSON200); selected {
+		return err
+	}
+
 	if err := printAmtProfile(cmd, writer, *resp.JSON200, verbose); err != nil {
 		return err
 	}
@@ -315,6 +319,9 @@ func printAmtProfiles(cmd *cobra.Command, writer io.Writer, amtprofiles *[]rps.D
 		NameLimit: -1,
 		Data:      data,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
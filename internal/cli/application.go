@@ -40,11 +40,20 @@ Default Profile: {{str .DefaultProfileName}}
 
 func getCreateApplicationCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "application <name> <version> [flags]",
+		Use:     "application {<name> <version> | --from-dir <dir>} [flags]",
 		Aliases: applicationAliases,
 		Short:   "Create an application",
-		Args:    cobra.ExactArgs(2),
-		Example: "orch-cli create application my-app 1.0.0 --chart-name my-chart --chart-version 1.0.0 --chart-registry my-registry --project some-project",
+		Long: "Creates an application from the chart given by the flags, or publishes the application described by the " +
+			applicationDirManifest + " of a directory given with --" + fromDirFlag + ": its registries and artifacts " +
+			"missing from the catalog are created, then the application with its profiles. The whole directory is " +
+			"checked before anything is created.",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if dir, _ := cmd.Flags().GetString(fromDirFlag); dir != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		Example: createApplicationExamples,
 		RunE:    runCreateApplicationCommand,
 	}
 	addEntityFlags(cmd, "application")
@@ -53,6 +62,10 @@ func getCreateApplicationCommand() *cobra.Command {
 	cmd.Flags().String("chart-registry", "", "Helm chart registry (required)")
 	cmd.Flags().String("image-registry", "", "image registry")
 	cmd.Flags().String("kind", "normal", "application kind: normal, addon, extension")
+	cmd.Flags().String(fromDirFlag, "", "directory holding the "+applicationDirManifest+" of the application, and the files it refers to, to publish")
+	for _, flag := range []string{"chart-name", "chart-version", "chart-registry", "image-registry", "kind", "display-name", "description"} {
+		cmd.MarkFlagsMutuallyExclusive(fromDirFlag, flag)
+	}
 	return cmd
 }
 
@@ -114,6 +127,9 @@ func getDeleteApplicationCommand() *cobra.Command {
 }
 
 func runCreateApplicationCommand(cmd *cobra.Command, args []string) error {
+	if dir, _ := cmd.Flags().GetString(fromDirFlag); dir != "" {
+		return runCreateApplicationFromDir(cmd, dir)
+	}
 	name := args[0]
 	version := args[1]
 
@@ -247,6 +263,9 @@ func printApplications(cmd *cobra.Command, writer io.Writer, appList *[]catapi.C
 		NameLimit: -1,
 		Data:      *appList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -482,6 +501,10 @@ func runGetApplicationCommand(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no versions of application %s found", name)
 		}
 	}
+	if selected, err := printSelectedOutput(cmd, appList); selected {
+		return err
+	}
+
 	if err := printApplications(cmd, writer, &appList, nil, nil, verbose); err != nil {
 		return err
 	}
@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"testing"
 
@@ -288,6 +290,97 @@ func (s *CLITestSuite) TestApplication() {
 	s.Contains(err.Error(), "unable to read")
 }
 
+const testApplicationDir = `name: my-app
+version: 1.0.0
+displayName: My App
+chart:
+  name: my-chart
+  version: 1.0.0
+  registry: registry-helm
+registries:
+  - name: registry-helm
+    type: helm
+    rootUrl: oci://registry.example.com/charts
+artifacts:
+  - name: artifact
+    file: icon.png
+profiles:
+  - name: default
+    parameterTemplates:
+      - env.HOST_IP=string:"IP address":""
+    deploymentRequirements:
+      - dependent-package:0.2.1:default-profile
+  - name: large
+    valuesFile: large-values.yaml
+`
+
+func writeTestApplicationDir(t *testing.T, manifest string) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"application.yaml":      manifest,
+		"icon.png":              "png",
+		"profiles/default.yaml": "replicas: 1\n",
+		"large-values.yaml":     "replicas: 3\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	return dir
+}
+
+func (s *CLITestSuite) TestCreateApplicationFromDir() {
+	dir := writeTestApplicationDir(s.T(), testApplicationDir)
+	out, err := s.runCommand("create application --from-dir " + dir + " --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Registry 'registry-helm' already exists, skipped")
+	s.Contains(out, "Artifact 'artifact' already exists, skipped")
+	s.Contains(out, "Application 'my-app:1.0.0' created successfully with 2 profile(s)")
+
+	_, err = s.runCommand("create application my-app 1.0.0 --from-dir " + dir + " --project " + project)
+	s.Error(err)
+	_, err = s.runCommand("create application --from-dir " + dir + " --chart-name my-chart --project " + project)
+	s.Error(err)
+
+	dir = writeTestApplicationDir(s.T(), testApplicationDir+"defaultProfile: missing\n")
+	_, err = s.runCommand("create application --from-dir " + dir + " --project " + project)
+	s.EqualError(err, "default profile missing is not one of the profiles of application.yaml")
+}
+
+func TestLoadApplicationDir(t *testing.T) {
+	pkg, err := loadApplicationDir(writeTestApplicationDir(t, testApplicationDir))
+	assert.NoError(t, err)
+	assert.Len(t, pkg.registries, 1)
+	assert.Equal(t, "HELM", pkg.registries[0].Type)
+	assert.Len(t, pkg.artifacts, 1)
+	assert.Equal(t, "image/png", pkg.artifacts[0].MimeType)
+	assert.Equal(t, []byte("png"), pkg.artifacts[0].Artifact)
+
+	app := pkg.application
+	assert.Equal(t, "my-app", app.Name)
+	assert.Equal(t, "default", *app.DefaultProfileName)
+	profiles := *app.Profiles
+	assert.Len(t, profiles, 2)
+	assert.Equal(t, "replicas: 1\n", *profiles[0].ChartValues)
+	assert.Equal(t, "env.HOST_IP", (*profiles[0].ParameterTemplates)[0].Name)
+	assert.Equal(t, "dependent-package", (*profiles[0].DeploymentRequirement)[0].Name)
+	assert.Equal(t, "replicas: 3\n", *profiles[1].ChartValues)
+
+	for manifest, expected := range map[string]string{
+		"version: 1.0.0\n":                  "application.yaml must give the name and version of the application",
+		testApplicationDir + "unknown: x\n": "invalid application.yaml",
+		"name: a\nversion: 1.0.0\nchart: {name: c, version: 1.0.0, registry: r}\nkind: other\n":                         "invalid application kind",
+		"name: a\nversion: 1.0.0\nchart: {name: c, version: 1.0.0, registry: r}\nartifacts: [{name: x, file: ../x}]\n":  "path traversal detected",
+		"name: a\nversion: 1.0.0\nchart: {name: c, version: 1.0.0, registry: r}\nregistries: [{name: x, rootUrl: u}]\n": "invalid type",
+	} {
+		_, err := loadApplicationDir(writeTestApplicationDir(t, manifest))
+		if assert.Error(t, err, manifest) {
+			assert.Contains(t, err.Error(), expected)
+		}
+	}
+}
+
 func TestPrintApplicationEvent(t *testing.T) {
 	kind := catapi.KINDNORMAL
 	app := catapi.CatalogV3Application{
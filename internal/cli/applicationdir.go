@@ -0,0 +1,382 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-edge-platform/cli/internal/validator"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	catapi "github.com/open-edge-platform/cli/pkg/rest/catalog"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	fromDirFlag = "from-dir"
+
+	// Metadata file of an application directory
+	applicationDirManifest = "application.yaml"
+	// Directory holding the values file of each profile, named <profile>.yaml
+	applicationDirProfiles = "profiles"
+)
+
+const createApplicationExamples = `# Create an application from a chart of a registry known to the catalog
+orch-cli create application my-app 1.0.0 --chart-name my-chart --chart-version 1.0.0 --chart-registry my-registry --project some-project
+
+# Publish the application described by ./myapp/application.yaml, with its registries, artifacts and profiles
+orch-cli create application --from-dir ./myapp --project some-project
+
+# Sample ./myapp/application.yaml; file paths are relative to the directory
+name: my-app
+version: 1.0.0
+displayName: My App
+description: An example application
+kind: normal
+chart:
+  name: my-chart
+  version: 1.0.0
+  registry: my-helm-registry
+imageRegistry: my-image-registry
+defaultProfile: default
+registries:
+  - name: my-helm-registry
+    type: helm
+    rootUrl: oci://registry.example.com/charts
+artifacts:
+  - name: my-app-icon
+    file: icon.png
+profiles:
+  - name: default
+    # values read from profiles/default.yaml when valuesFile is not given
+    parameterTemplates:
+      - env.HOST_IP=string:"IP address of the target Edge Node":""
+    deploymentRequirements:
+      - dependent-package:0.2.1:default-profile`
+
+// applicationDir is the application.yaml of a directory published with create application --from-dir
+type applicationDir struct {
+	Name           string `yaml:"name"`
+	Version        string `yaml:"version"`
+	DisplayName    string `yaml:"displayName"`
+	Description    string `yaml:"description"`
+	Kind           string `yaml:"kind"`
+	ImageRegistry  string `yaml:"imageRegistry"`
+	DefaultProfile string `yaml:"defaultProfile"`
+	Chart          struct {
+		Name     string `yaml:"name"`
+		Version  string `yaml:"version"`
+		Registry string `yaml:"registry"`
+	} `yaml:"chart"`
+	Registries []applicationDirRegistry `yaml:"registries"`
+	Artifacts  []applicationDirArtifact `yaml:"artifacts"`
+	Profiles   []applicationDirProfile  `yaml:"profiles"`
+}
+
+// applicationDirRegistry is a registry created when the catalog does not have it yet
+type applicationDirRegistry struct {
+	Name         string `yaml:"name"`
+	DisplayName  string `yaml:"displayName"`
+	Description  string `yaml:"description"`
+	Type         string `yaml:"type"`
+	RootURL      string `yaml:"rootUrl"`
+	InventoryURL string `yaml:"inventoryUrl"`
+	Username     string `yaml:"username"`
+	AuthToken    string `yaml:"authToken"`
+	CACerts      string `yaml:"caCerts"`
+	APIType      string `yaml:"apiType"`
+}
+
+// applicationDirArtifact is an artifact created from a file of the directory when the catalog does not have it yet
+type applicationDirArtifact struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"displayName"`
+	Description string `yaml:"description"`
+	MimeType    string `yaml:"mimeType"`
+	File        string `yaml:"file"`
+}
+
+// applicationDirProfile uses the flag formats of create profile for its parameter templates and deployment requirements
+type applicationDirProfile struct {
+	Name                   string   `yaml:"name"`
+	DisplayName            string   `yaml:"displayName"`
+	Description            string   `yaml:"description"`
+	ValuesFile             string   `yaml:"valuesFile"`
+	ParameterTemplates     []string `yaml:"parameterTemplates"`
+	DeploymentRequirements []string `yaml:"deploymentRequirements"`
+}
+
+// applicationPackage is an application directory checked and read, ready to be published
+type applicationPackage struct {
+	registries  []catapi.CatalogV3Registry
+	artifacts   []catapi.CatalogV3Artifact
+	application catapi.CatalogV3Application
+}
+
+// Reads and checks a whole application directory, so that nothing is published when any part of it is invalid
+func loadApplicationDir(dir string) (*applicationPackage, error) {
+	data, err := readApplicationDirFile(dir, applicationDirManifest)
+	if err != nil {
+		return nil, err
+	}
+	var manifest applicationDir
+	if err := yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", applicationDirManifest, err)
+	}
+
+	if manifest.Name == "" || manifest.Version == "" {
+		return nil, fmt.Errorf("%s must give the name and version of the application", applicationDirManifest)
+	}
+	if err := validator.ValidateVersion(manifest.Version); err != nil {
+		return nil, err
+	}
+	if manifest.Chart.Name == "" || manifest.Chart.Version == "" || manifest.Chart.Registry == "" {
+		return nil, fmt.Errorf("%s must give the name, version and registry of the chart", applicationDirManifest)
+	}
+	if err := validator.ValidateVersion(manifest.Chart.Version); err != nil {
+		return nil, fmt.Errorf("invalid chart version: %w", err)
+	}
+	kind := catapi.KINDNORMAL
+	switch manifest.Kind {
+	case "", "normal":
+	case "addon", "extension":
+		kind = string2ApplicationKind(manifest.Kind)
+	default:
+		return nil, fmt.Errorf("invalid application kind %q, must be one of: normal, addon, extension", manifest.Kind)
+	}
+
+	pkg := &applicationPackage{
+		application: catapi.CatalogV3Application{
+			Name:             manifest.Name,
+			Version:          manifest.Version,
+			Kind:             &kind,
+			DisplayName:      &manifest.DisplayName,
+			Description:      &manifest.Description,
+			ChartName:        manifest.Chart.Name,
+			ChartVersion:     manifest.Chart.Version,
+			HelmRegistryName: manifest.Chart.Registry,
+		},
+	}
+	if manifest.ImageRegistry != "" {
+		pkg.application.ImageRegistryName = &manifest.ImageRegistry
+	}
+
+	for _, registry := range manifest.Registries {
+		registryType := strings.ToUpper(registry.Type)
+		if registry.Name == "" || registry.RootURL == "" {
+			return nil, errors.New("every registry must give its name and rootUrl")
+		}
+		if registryType != "HELM" && registryType != "IMAGE" {
+			return nil, fmt.Errorf("invalid type %q of registry %s, must be helm or image", registry.Type, registry.Name)
+		}
+		pkg.registries = append(pkg.registries, catapi.CatalogV3Registry{
+			Name:         registry.Name,
+			DisplayName:  &registry.DisplayName,
+			Description:  &registry.Description,
+			Type:         registryType,
+			RootUrl:      registry.RootURL,
+			InventoryUrl: optionalString(registry.InventoryURL),
+			Username:     optionalString(registry.Username),
+			AuthToken:    optionalString(registry.AuthToken),
+			Cacerts:      optionalString(registry.CACerts),
+			ApiType:      optionalString(registry.APIType),
+		})
+	}
+
+	for _, artifact := range manifest.Artifacts {
+		if artifact.Name == "" || artifact.File == "" {
+			return nil, errors.New("every artifact must give its name and file")
+		}
+		content, err := readApplicationDirFile(dir, artifact.File)
+		if err != nil {
+			return nil, fmt.Errorf("error reading artifact %s: %w", artifact.Name, err)
+		}
+		mimeType := artifact.MimeType
+		if mimeType == "" {
+			if mimeType = mime.TypeByExtension(filepath.Ext(artifact.File)); mimeType == "" {
+				return nil, fmt.Errorf("mimeType of artifact %s must be given, it cannot be guessed from %s", artifact.Name, artifact.File)
+			}
+		}
+		pkg.artifacts = append(pkg.artifacts, catapi.CatalogV3Artifact{
+			Name:        artifact.Name,
+			DisplayName: &artifact.DisplayName,
+			Description: &artifact.Description,
+			MimeType:    mimeType,
+			Artifact:    content,
+		})
+	}
+
+	profiles := make([]catapi.CatalogV3Profile, 0, len(manifest.Profiles))
+	for _, profile := range manifest.Profiles {
+		p, err := loadApplicationDirProfile(dir, profile)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, *p)
+	}
+	pkg.application.Profiles = &profiles
+
+	defaultProfile := manifest.DefaultProfile
+	if defaultProfile == "" && len(profiles) > 0 {
+		defaultProfile = profiles[0].Name
+	}
+	if defaultProfile != "" {
+		found := false
+		for _, profile := range profiles {
+			found = found || profile.Name == defaultProfile
+		}
+		if !found {
+			return nil, fmt.Errorf("default profile %s is not one of the profiles of %s", defaultProfile, applicationDirManifest)
+		}
+		pkg.application.DefaultProfileName = &defaultProfile
+	}
+	return pkg, nil
+}
+
+func loadApplicationDirProfile(dir string, profile applicationDirProfile) (*catapi.CatalogV3Profile, error) {
+	if profile.Name == "" {
+		return nil, errors.New("every profile must give its name")
+	}
+	p := &catapi.CatalogV3Profile{
+		Name:               profile.Name,
+		DisplayName:        &profile.DisplayName,
+		Description:        &profile.Description,
+		ParameterTemplates: &[]catapi.CatalogV3ParameterTemplate{},
+	}
+
+	valuesFile := profile.ValuesFile
+	if valuesFile == "" {
+		valuesFile = filepath.Join(applicationDirProfiles, profile.Name+".yaml")
+		if _, err := os.Stat(filepath.Join(dir, valuesFile)); errors.Is(err, os.ErrNotExist) {
+			valuesFile = ""
+		}
+	}
+	if valuesFile != "" {
+		values, err := readApplicationDirFile(dir, valuesFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading values of profile %s: %w", profile.Name, err)
+		}
+		if len(values) > maxValuesYAMLSize {
+			return nil, fmt.Errorf("values of profile %s exceed %d bytes", profile.Name, maxValuesYAMLSize)
+		}
+		if err := validateValuesYAML(values); err != nil {
+			return nil, fmt.Errorf("invalid values of profile %s: %w", profile.Name, err)
+		}
+		chartValues := string(values)
+		p.ChartValues = &chartValues
+	}
+
+	for _, spec := range profile.ParameterTemplates {
+		template, err := parseParameterTemplate(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter template '%s' of profile %s: %w", spec, profile.Name, err)
+		}
+		*p.ParameterTemplates = append(*p.ParameterTemplates, *template)
+	}
+	if len(profile.DeploymentRequirements) > 0 {
+		requirements := make([]catapi.CatalogV3DeploymentRequirement, 0, len(profile.DeploymentRequirements))
+		for _, spec := range profile.DeploymentRequirements {
+			requirement, err := parseDeploymentRequirement(spec)
+			if err != nil {
+				return nil, err
+			}
+			requirements = append(requirements, *requirement)
+		}
+		p.DeploymentRequirement = &requirements
+	}
+	return p, nil
+}
+
+// Reads a file given relative to the application directory; it may not be outside of it
+func readApplicationDirFile(dir string, name string) ([]byte, error) {
+	if filepath.IsAbs(name) {
+		return nil, fmt.Errorf("%s must be relative to the application directory", name)
+	}
+	if err := isSafePath(name); err != nil {
+		return nil, err
+	}
+	return readInput(filepath.Join(dir, name))
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// Publishes an application directory: the registries and artifacts missing from the catalog are created,
+// then the application with its profiles
+func runCreateApplicationFromDir(cmd *cobra.Command, dir string) error {
+	pkg, err := loadApplicationDir(dir)
+	if err != nil {
+		return err
+	}
+	ctx, catalogClient, projectName, err := CatalogFactory(cmd)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+
+	for _, registry := range pkg.registries {
+		gresp, err := catalogClient.CatalogServiceGetRegistryWithResponse(ctx, projectName, registry.Name, nil, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if gresp.StatusCode() != http.StatusNotFound {
+			if err := checkResponse(gresp.HTTPResponse, gresp.Body, fmt.Sprintf("error getting registry %s", registry.Name)); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Registry '%s' already exists, skipped\n", registry.Name)
+			continue
+		}
+		resp, err := catalogClient.CatalogServiceCreateRegistryWithResponse(ctx, projectName, registry, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating registry %s", registry.Name)); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Registry '%s' created successfully\n", registry.Name)
+	}
+
+	for _, artifact := range pkg.artifacts {
+		gresp, err := catalogClient.CatalogServiceGetArtifactWithResponse(ctx, projectName, artifact.Name, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if gresp.StatusCode() != http.StatusNotFound {
+			if err := checkResponse(gresp.HTTPResponse, gresp.Body, fmt.Sprintf("error getting artifact %s", artifact.Name)); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Artifact '%s' already exists, skipped\n", artifact.Name)
+			continue
+		}
+		resp, err := catalogClient.CatalogServiceCreateArtifactWithResponse(ctx, projectName, artifact, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating artifact %s", artifact.Name)); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Artifact '%s' created successfully\n", artifact.Name)
+	}
+
+	app := pkg.application
+	resp, err := catalogClient.CatalogServiceCreateApplicationWithResponse(ctx, projectName, app, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating application %s", app.Name)); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Application '%s:%s' created successfully with %d profile(s)\n", app.Name, app.Version, len(*app.Profiles))
+	return nil
+}
@@ -129,6 +129,9 @@ func printArtifacts(cmd *cobra.Command, writer io.Writer, artifactList *[]catapi
 		NameLimit: -1,
 		Data:      *artifactList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -339,6 +342,10 @@ func runGetArtifactCommand(cmd *cobra.Command, args []string) error {
 		fmt.Sprintf("error getting artifact %s", name)); !proceed {
 		return err
 	}
+	if selected, err := printSelectedOutput(cmd, resp.JSON200.Artifact); selected {
+		return err
+	}
+
 	if err := printArtifacts(cmd, writer, &[]catapi.CatalogV3Artifact{resp.JSON200.Artifact}, nil, nil, verbose); err != nil {
 		return err
 	}
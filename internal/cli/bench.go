@@ -0,0 +1,377 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultBenchIterations = 20
+	maxBenchIterations     = 10000
+)
+
+const benchExamples = `# Measure the latency of listing and getting hosts 50 times
+orch-cli bench --endpoint hosts --iterations 50 --project some-project
+
+# Measure the latency of listing and getting sites, without a warm-up request
+orch-cli bench --endpoint sites --warmup 0 --project some-project
+
+# Sample output
+Endpoint: hosts  Iterations: 50  Server: https://api.example.com
+
+OPERATION   REQUESTS   ERRORS   MIN     P50     P90     P99     MAX     MEAN
+list        50         0        41ms    47ms    63ms    88ms    91ms    50.2ms
+get         50         0        18ms    21ms    27ms    35ms    36ms    22.4ms
+
+Median time per phase
+OPERATION   CONNECTION   SERVER   CLIENT
+list        0s           44.9ms   1.8ms
+get         0s           20.1ms   700µs
+`
+
+// benchTarget lists and gets one kind of infrastructure resource
+type benchTarget struct {
+	// list returns the HTTP response and the resource ID of the first listed resource, if any
+	list func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (*http.Response, []byte, string, error)
+	get  func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, id string) (*http.Response, []byte, error)
+}
+
+var benchTargets = map[string]benchTarget{
+	"hosts": {
+		list: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (*http.Response, []byte, string, error) {
+			resp, err := client.HostServiceListHostsWithResponse(ctx, projectName, &infra.HostServiceListHostsParams{}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			id := ""
+			if resp.JSON200 != nil && len(resp.JSON200.Hosts) > 0 {
+				id = derefString(resp.JSON200.Hosts[0].ResourceId)
+			}
+			return resp.HTTPResponse, resp.Body, id, nil
+		},
+		get: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, id string) (*http.Response, []byte, error) {
+			resp, err := client.HostServiceGetHostWithResponse(ctx, projectName, id, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		},
+	},
+	"sites": {
+		list: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (*http.Response, []byte, string, error) {
+			resp, err := client.SiteServiceListSitesWithResponse(ctx, projectName, queryRegion, &infra.SiteServiceListSitesParams{}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			id := ""
+			if resp.JSON200 != nil && len(resp.JSON200.Sites) > 0 {
+				id = derefString(resp.JSON200.Sites[0].ResourceId)
+			}
+			return resp.HTTPResponse, resp.Body, id, nil
+		},
+		get: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, id string) (*http.Response, []byte, error) {
+			resp, err := client.SiteServiceGetSiteWithResponse(ctx, projectName, "empty", id, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		},
+	},
+	"regions": {
+		list: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (*http.Response, []byte, string, error) {
+			resp, err := client.RegionServiceListRegionsWithResponse(ctx, projectName, &infra.RegionServiceListRegionsParams{}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			id := ""
+			if resp.JSON200 != nil && len(resp.JSON200.Regions) > 0 {
+				id = derefString(resp.JSON200.Regions[0].ResourceId)
+			}
+			return resp.HTTPResponse, resp.Body, id, nil
+		},
+		get: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, id string) (*http.Response, []byte, error) {
+			resp, err := client.RegionServiceGetRegionWithResponse(ctx, projectName, id, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		},
+	},
+	"osprofiles": {
+		list: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (*http.Response, []byte, string, error) {
+			resp, err := client.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
+				&infra.OperatingSystemServiceListOperatingSystemsParams{}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			id := ""
+			if resp.JSON200 != nil && len(resp.JSON200.OperatingSystemResources) > 0 {
+				id = derefString(resp.JSON200.OperatingSystemResources[0].ResourceId)
+			}
+			return resp.HTTPResponse, resp.Body, id, nil
+		},
+		get: func(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, id string) (*http.Response, []byte, error) {
+			resp, err := client.OperatingSystemServiceGetOperatingSystemWithResponse(ctx, projectName, id, auth.AddAuthHeader)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		},
+	},
+}
+
+// benchSample is the timing of a single request
+type benchSample struct {
+	Total      time.Duration
+	Connection time.Duration
+	Server     time.Duration
+	Err        error
+}
+
+// benchPhases records the phase boundaries of a request through an httptrace.ClientTrace
+type benchPhases struct {
+	dnsStart, connectStart, tlsStart time.Time
+	connection                       time.Duration
+	wroteRequest, firstByte          time.Time
+}
+
+func (p *benchPhases) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:     func(httptrace.DNSStartInfo) { p.dnsStart = time.Now() },
+		DNSDone:      func(httptrace.DNSDoneInfo) { p.connection += time.Since(p.dnsStart) },
+		ConnectStart: func(string, string) { p.connectStart = time.Now() },
+		ConnectDone:  func(string, string, error) { p.connection += time.Since(p.connectStart) },
+		TLSHandshakeStart: func() {
+			p.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) { p.connection += time.Since(p.tlsStart) },
+		WroteRequest:     func(httptrace.WroteRequestInfo) { p.wroteRequest = time.Now() },
+		GotFirstResponseByte: func() {
+			p.firstByte = time.Now()
+		},
+	}
+}
+
+// Times a single request and splits it into connection, server and client time
+func measureBenchRequest(ctx context.Context, request func(ctx context.Context) (*http.Response, []byte, error)) benchSample {
+	phases := &benchPhases{}
+	start := time.Now()
+	resp, body, err := request(httptrace.WithClientTrace(ctx, phases.trace()))
+	sample := benchSample{Total: time.Since(start), Connection: phases.connection}
+	if !phases.wroteRequest.IsZero() && phases.firstByte.After(phases.wroteRequest) {
+		sample.Server = phases.firstByte.Sub(phases.wroteRequest)
+	}
+	if err != nil {
+		sample.Err = processError(err)
+	} else {
+		sample.Err = checkResponse(resp, body, "benchmark request failed")
+	}
+	return sample
+}
+
+func getBenchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bench [flags]",
+		Short: "Measures the latency of Edge Orchestrator API requests",
+		Long: "Measures the latency of listing and getting resources against the configured Edge Orchestrator and " +
+			"reports percentiles. The median time of each request is split into CONNECTION, spent resolving the server name " +
+			"and opening connections, SERVER, spent waiting for the first byte of the response, and CLIENT, spent by the CLI " +
+			"sending the request and reading and decoding the response.",
+		Example: benchExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runBenchCommand,
+	}
+	cmd.Flags().StringP("endpoint", "e", "hosts", fmt.Sprintf("Resource to benchmark, one of: %s", strings.Join(benchEndpointNames(), ", ")))
+	cmd.Flags().IntP("iterations", "i", defaultBenchIterations, "Number of requests per operation")
+	cmd.Flags().Int("warmup", 1, "Number of requests per operation made before measuring, to open connections")
+	return cmd
+}
+
+func benchEndpointNames() []string {
+	names := make([]string, 0, len(benchTargets))
+	for name := range benchTargets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Runs the list and get requests of an endpoint repeatedly and prints a latency report
+func runBenchCommand(cmd *cobra.Command, _ []string) error {
+	endpoint, _ := cmd.Flags().GetString("endpoint")
+	iterations, _ := cmd.Flags().GetInt("iterations")
+	warmup, _ := cmd.Flags().GetInt("warmup")
+
+	target, ok := benchTargets[strings.ToLower(endpoint)]
+	if !ok {
+		return fmt.Errorf("invalid endpoint %q, must be one of: %s", endpoint, strings.Join(benchEndpointNames(), ", "))
+	}
+	if iterations < 1 || iterations > maxBenchIterations {
+		return fmt.Errorf("--iterations must be between 1 and %d", maxBenchIterations)
+	}
+	if warmup < 0 {
+		return fmt.Errorf("--warmup cannot be negative")
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	resourceID := ""
+	list := func(ctx context.Context) (*http.Response, []byte, error) {
+		resp, body, id, err := target.list(ctx, client, projectName)
+		if resourceID == "" {
+			resourceID = id
+		}
+		return resp, body, err
+	}
+	get := func(ctx context.Context) (*http.Response, []byte, error) {
+		return target.get(ctx, client, projectName, resourceID)
+	}
+
+	listSamples := runBenchRequests(ctx, list, warmup, iterations)
+	var getSamples []benchSample
+	if resourceID != "" {
+		getSamples = runBenchRequests(ctx, get, warmup, iterations)
+	}
+
+	serverAddress, _ := cmd.Flags().GetString(apiEndpoint)
+	printBenchReport(cmd, endpoint, iterations, serverAddress, listSamples, getSamples)
+
+	failed := 0
+	var firstErr error
+	for _, s := range append(listSamples, getSamples...) {
+		if s.Err != nil {
+			if firstErr == nil {
+				firstErr = s.Err
+			}
+			failed++
+		}
+	}
+	if firstErr != nil {
+		return fmt.Errorf("%d of %d requests failed, first error: %w", failed, len(listSamples)+len(getSamples), firstErr)
+	}
+	return nil
+}
+
+func runBenchRequests(ctx context.Context, request func(ctx context.Context) (*http.Response, []byte, error), warmup int, iterations int) []benchSample {
+	for i := 0; i < warmup; i++ {
+		_ = measureBenchRequest(ctx, request)
+	}
+	samples := make([]benchSample, iterations)
+	for i := range samples {
+		samples[i] = measureBenchRequest(ctx, request)
+	}
+	return samples
+}
+
+// Returns the p-th percentile of sorted durations using the nearest-rank method
+func benchPercentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Rounds a duration to a precision that keeps reports readable
+func formatBenchDuration(d time.Duration) string {
+	switch {
+	case d < time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	case d < time.Second:
+		return d.Round(100 * time.Microsecond).String()
+	default:
+		return d.Round(10 * time.Millisecond).String()
+	}
+}
+
+func sortedBenchDurations(samples []benchSample, phase func(benchSample) time.Duration) []time.Duration {
+	durations := make([]time.Duration, 0, len(samples))
+	for _, s := range samples {
+		if s.Err == nil {
+			durations = append(durations, phase(s))
+		}
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	return durations
+}
+
+func printBenchReport(cmd *cobra.Command, endpoint string, iterations int, serverAddress string, listSamples []benchSample, getSamples []benchSample) {
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "Endpoint: %s  Iterations: %d  Server: %s\n\n", endpoint, iterations, serverAddress)
+
+	operations := []struct {
+		name    string
+		samples []benchSample
+	}{
+		{"list", listSamples},
+		{"get", getSamples},
+	}
+
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "OPERATION\tREQUESTS\tERRORS\tMIN\tP50\tP90\tP99\tMAX\tMEAN\n")
+	for _, op := range operations {
+		if len(op.samples) == 0 {
+			fmt.Fprintf(writer, "%s\t0\t0\t-\t-\t-\t-\t-\t-\n", op.name)
+			continue
+		}
+		total := sortedBenchDurations(op.samples, func(s benchSample) time.Duration { return s.Total })
+		failed := len(op.samples) - len(total)
+		if len(total) == 0 {
+			fmt.Fprintf(writer, "%s\t%d\t%d\t-\t-\t-\t-\t-\t-\n", op.name, len(op.samples), failed)
+			continue
+		}
+		var sum time.Duration
+		for _, d := range total {
+			sum += d
+		}
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n", op.name, len(op.samples), failed,
+			formatBenchDuration(total[0]), formatBenchDuration(benchPercentile(total, 50)),
+			formatBenchDuration(benchPercentile(total, 90)), formatBenchDuration(benchPercentile(total, 99)),
+			formatBenchDuration(total[len(total)-1]), formatBenchDuration(sum/time.Duration(len(total))))
+	}
+	_ = writer.Flush()
+
+	fmt.Fprintf(w, "\nMedian time per phase\n")
+	writer = newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "OPERATION\tCONNECTION\tSERVER\tCLIENT\n")
+	for _, op := range operations {
+		total := sortedBenchDurations(op.samples, func(s benchSample) time.Duration { return s.Total })
+		if len(total) == 0 {
+			continue
+		}
+		connection := benchPercentile(sortedBenchDurations(op.samples, func(s benchSample) time.Duration { return s.Connection }), 50)
+		server := benchPercentile(sortedBenchDurations(op.samples, func(s benchSample) time.Duration { return s.Server }), 50)
+		client := benchPercentile(sortedBenchDurations(op.samples, func(s benchSample) time.Duration {
+			if rest := s.Total - s.Connection - s.Server; rest > 0 {
+				return rest
+			}
+			return 0
+		}), 50)
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", op.name, formatBenchDuration(connection),
+			formatBenchDuration(server), formatBenchDuration(client))
+	}
+	_ = writer.Flush()
+
+	if len(getSamples) == 0 {
+		fmt.Fprintf(w, "\nget was skipped, the project has no %s\n", endpoint)
+	}
+}
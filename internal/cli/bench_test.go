@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 0, 20)
+	for i := 1; i <= 20; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 1*time.Millisecond, benchPercentile(sorted, 0))
+	assert.Equal(t, 10*time.Millisecond, benchPercentile(sorted, 50))
+	assert.Equal(t, 18*time.Millisecond, benchPercentile(sorted, 90))
+	assert.Equal(t, 20*time.Millisecond, benchPercentile(sorted, 99))
+	assert.Equal(t, time.Duration(0), benchPercentile(nil, 50))
+}
+
+func TestFormatBenchDuration(t *testing.T) {
+	assert.Equal(t, "850µs", formatBenchDuration(850400*time.Nanosecond))
+	assert.Equal(t, "47.3ms", formatBenchDuration(47312*time.Microsecond))
+	assert.Equal(t, "1.23s", formatBenchDuration(1234*time.Millisecond))
+}
+
+func (s *CLITestSuite) TestBench() {
+	out, err := s.runCommand("bench --endpoint hosts --iterations 3 --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Endpoint: hosts  Iterations: 3")
+	s.Regexp(`(?m)^list\s+\|3\s+\|0\s`, out)
+	s.Regexp(`(?m)^get\s+\|3\s+\|0\s`, out)
+	s.Contains(out, "Median time per phase")
+
+	_, err = s.runCommand("bench --endpoint clusters --project " + project)
+	s.EqualError(err, `invalid endpoint "clusters", must be one of: hosts, osprofiles, regions, sites`)
+
+	_, err = s.runCommand("bench --iterations 0 --project " + project)
+	s.EqualError(err, "--iterations must be between 1 and 10000")
+
+	out, err = s.runCommand("bench --endpoint hosts --iterations 2 --warmup 0 --project invalid-project")
+	s.ErrorContains(err, "2 of 4 requests failed")
+	s.Regexp(`(?m)^get\s+\|2\s+\|2\s+\|-`, out)
+}
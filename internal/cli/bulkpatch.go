@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// Largest patch file that will be read
+	maxPatchFileSize = 1 << 20
+	// Time given to each revert of a failed bulk patch
+	bulkPatchRevertTimeout = 30 * time.Second
+)
+
+// Results of a bulk patch on one resource
+const (
+	patchResultPatched        = "patched"
+	patchResultFailed         = "failed"
+	patchResultRolledBack     = "rolled back"
+	patchResultRollbackFailed = "rollback failed"
+	patchResultNotApplied     = "not applied"
+)
+
+// bulkPatchTarget is the change a bulk patch makes to one resource, with the way to undo it. Patching a kind of
+// resource, e.g. hosts, sites or instances, is planning a target for each resource matched by the patch file
+type bulkPatchTarget struct {
+	name       string
+	resourceID string
+	// Description of the change, e.g. "site store-042, metadata rack=r12"
+	changes string
+	apply   func(ctx context.Context) error
+	revert  func(ctx context.Context) error
+}
+
+// bulkPatchResult is the outcome of a bulk patch on one resource
+type bulkPatchResult struct {
+	name       string
+	resourceID string
+	result     string
+	details    string
+}
+
+// Applies the targets one after the other as a single transaction: once a target fails, the next ones are not
+// applied and the ones already applied are reverted, the last first, so the resources are left as they were.
+// The results are in the order of the targets. The reverts still run once ctx is done, e.g. when --timeout expired
+// or the command was interrupted, each one with its own deadline.
+func applyBulkPatch(ctx context.Context, targets []bulkPatchTarget) []bulkPatchResult {
+	results := make([]bulkPatchResult, len(targets))
+	for i, t := range targets {
+		results[i] = bulkPatchResult{name: t.name, resourceID: t.resourceID, result: patchResultNotApplied, details: t.changes}
+	}
+	for i, t := range targets {
+		if err := t.apply(ctx); err != nil {
+			results[i].result = patchResultFailed
+			results[i].details = err.Error()
+			for j := i - 1; j >= 0; j-- {
+				if err := revertBulkPatchTarget(ctx, targets[j]); err != nil {
+					results[j].result = patchResultRollbackFailed
+					results[j].details = err.Error()
+				} else {
+					results[j].result = patchResultRolledBack
+				}
+			}
+			break
+		}
+		results[i].result = patchResultPatched
+	}
+	return results
+}
+
+func revertBulkPatchTarget(ctx context.Context, target bulkPatchTarget) error {
+	revertCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bulkPatchRevertTimeout)
+	defer cancel()
+	return target.revert(revertCtx)
+}
+
+// Prints a table of the results and their totals; an error is returned if the patch failed
+func printBulkPatchResults(cmd *cobra.Command, w io.Writer, kind string, results []bulkPatchResult) error {
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "NAME\tRESOURCE ID\tRESULT\tDETAILS\n")
+	counts := map[string]int{}
+	failed := ""
+	for _, r := range results {
+		counts[r.result]++
+		if r.result == patchResultFailed {
+			failed = r.resourceID
+		}
+		details := r.details
+		if details == "" {
+			details = "-"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", r.name, r.resourceID, r.result, details)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Done: %d patched, %d failed, %d rolled back, %d not rolled back, %d not applied\n",
+		counts[patchResultPatched], counts[patchResultFailed], counts[patchResultRolledBack],
+		counts[patchResultRollbackFailed], counts[patchResultNotApplied])
+	if failed == "" {
+		return nil
+	}
+	if counts[patchResultRollbackFailed] > 0 {
+		return fmt.Errorf("failed to patch %s %s and to roll back %d %ss, fix them manually", kind, failed, counts[patchResultRollbackFailed], kind)
+	}
+	return fmt.Errorf("failed to patch %s %s, no %s was changed", kind, failed, kind)
+}
+
+// Prints the changes a bulk patch would make without applying them
+func printBulkPatchPlan(cmd *cobra.Command, w io.Writer, kind string, targets []bulkPatchTarget) error {
+	fmt.Fprintf(w, "Dry run: %d %s(s) would be patched\n", len(targets), kind)
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "NAME\tRESOURCE ID\tCHANGES\n")
+	for _, t := range targets {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", t.name, t.resourceID, t.changes)
+	}
+	return writer.Flush()
+}
+
+// Reads a YAML or JSON patch file into patch, fields the patch does not know are rejected
+func readPatchFile(path string, patch interface{}) error {
+	if err := isSafePath(path); err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() > maxPatchFileSize {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("patch file %s is larger than %d bytes", path, maxPatchFileSize))
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("patch file %s is empty", path))
+	}
+	// JSON is YAML, the same decoder reads both
+	if err := yaml.UnmarshalStrict(data, patch); err != nil {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid patch file %s: %v", path, err))
+	}
+	return nil
+}
+
+// Error of a patch file selecting the resources both by ID and by filter or not at all
+func patchSelectionError(kind string, ids []string, filter string) error {
+	switch {
+	case len(ids) > 0 && filter != "":
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("the patch file lists %ss and sets a filter, use one of them", kind))
+	case len(ids) == 0 && filter == "":
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("the patch file must list %ss or set a filter", kind))
+	}
+	return nil
+}
+
+var errEmptyPatch = e.WithCode(e.CodeInvalidArgument, errors.New("the patch file changes nothing, set at least one field under patch"))
@@ -27,7 +27,7 @@ func getListChartsCommand() *cobra.Command {
 		Use:               "charts <registry-name> [<chart-name>] [flags]",
 		Args:              cobra.MinimumNArgs(1),
 		Short:             "List Helm charts or chart versions from a registry",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		Example: `# List all charts in a registry
 	orch-cli list charts my-registry --project my-project
 
@@ -158,6 +158,9 @@ func runListChartsCommand(cmd *cobra.Command, args []string) error {
 		NameLimit: -1,
 		Data:      charts,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return writer.Flush()
 }
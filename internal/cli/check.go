@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const checkMaintenanceExamples = `# Check whether a host is in maintenance now
+orch-cli check maintenance --host host-1234abcd --project some-project
+
+# Check whether a host given by name is in maintenance at a given time, in UTC
+orch-cli check maintenance --host edge-host-001 --at 2025-04-01T02:00 --project some-project
+
+# Check a time given in another timezone
+orch-cli check maintenance --host host-1234abcd --at "2025-04-01 02:00" --timezone Europe/Berlin --project some-project
+
+# Sample output
+Host host-1234abcd (edge-host-001) is in maintenance at 2025-04-01 02:00 CEST
+NAME             RESOURCE ID             TARGET                  STATUS                     WINDOW
+weekly-patch     repeatedsche-abcd1234   region-abcd1234         SCHEDULE_STATUS_OS_UPDATE  2025-04-01 01:00 CEST - 2025-04-01 03:00 CEST
+1 of 3 schedules targeting the host, its site or its regions apply
+`
+
+// Accepted layouts of --at, in order of preference
+var maintenanceTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04"}
+
+// maintenanceWindow is a schedule occurrence that covers the checked time
+type maintenanceWindow struct {
+	Name       string
+	ResourceID string
+	Target     string
+	Status     infra.ScheduleStatus
+	Start      time.Time
+	End        *time.Time
+}
+
+func getCheckCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Evaluate the configuration of Edge Orchestrator resources",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getCheckMaintenanceCommand(),
+	)
+	return cmd
+}
+
+func getCheckMaintenanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance --host <name|resourceID> [flags]",
+		Short: "Reports whether a host is in a maintenance window at a given time",
+		Long: "Evaluates the single and repeated schedules targeting a host, its site and the regions containing the site, " +
+			"and reports whether the host is in a maintenance window at the given time and which schedules apply. " +
+			"Repeated schedules are evaluated client-side from their UTC cron fields.",
+		Example: checkMaintenanceExamples,
+		Aliases: []string{"maint", "schedule", "schedules"},
+		Args:    cobra.NoArgs,
+		RunE:    runCheckMaintenanceCommand,
+	}
+	cmd.Flags().String("host", "", "Host to check, given by name or resource ID (mandatory)")
+	cmd.Flags().String("at", "", "Time to check in format \"YYYY-MM-DDTHH:MM\" or RFC 3339 (defaults to now)")
+	cmd.Flags().StringP("timezone", "t", viper.GetString("timezone"), "Timezone of --at and of the reported times: --timezone Europe/Berlin")
+	_ = cmd.MarkFlagRequired("host")
+	return cmd
+}
+
+// Parses --at in the given location; times with an explicit offset keep it
+func parseMaintenanceTime(value string, loc *time.Location, now time.Time) (time.Time, error) {
+	if value == "" {
+		return now, nil
+	}
+	for _, layout := range maintenanceTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --at %q, must be in format \"YYYY-MM-DDTHH:MM\" or RFC 3339", value)
+}
+
+// Reports whether a host is covered by a maintenance window at the requested time
+func runCheckMaintenanceCommand(cmd *cobra.Command, _ []string) error {
+	hostArg, _ := cmd.Flags().GetString("host")
+	atFlag, _ := cmd.Flags().GetString("at")
+	timezone, _ := cmd.Flags().GetString("timezone")
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
+		}
+	}
+	at, err := parseMaintenanceTime(atFlag, loc, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	host, err := getHostByNameOrID(ctx, client, projectName, hostArg)
+	if err != nil {
+		return err
+	}
+	targets, err := maintenanceTargets(ctx, client, projectName, host)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+		&infra.ScheduleServiceListSchedulesParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving schedules"); err != nil {
+		return err
+	}
+
+	windows, evaluated, err := findMaintenanceWindows(resp.JSON200.SingleSchedules, resp.JSON200.RepeatedSchedules, targets, at)
+	if err != nil {
+		return err
+	}
+
+	writer, _ := getOutputContext(cmd)
+	hostID := derefString(host.ResourceId)
+	if len(windows) == 0 {
+		fmt.Fprintf(writer, "Host %s (%s) is not in maintenance at %s\n", hostID, host.Name, at.In(loc).Format(scheduleDisplayTimeFormat))
+	} else {
+		fmt.Fprintf(writer, "Host %s (%s) is in maintenance at %s\n", hostID, host.Name, at.In(loc).Format(scheduleDisplayTimeFormat))
+		fmt.Fprintf(writer, "NAME\tRESOURCE ID\tTARGET\tSTATUS\tWINDOW\n")
+		for _, w := range windows {
+			window := w.Start.In(loc).Format(scheduleDisplayTimeFormat) + " - "
+			if w.End != nil {
+				window += w.End.In(loc).Format(scheduleDisplayTimeFormat)
+			} else {
+				window += "open ended"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", w.Name, w.ResourceID, w.Target, w.Status, window)
+		}
+	}
+	fmt.Fprintf(writer, "%d of %d schedules targeting the host, its site or its regions apply\n", len(windows), evaluated)
+	return writer.Flush()
+}
+
+// Retrieves a host given by resource ID or by name
+func getHostByNameOrID(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, query string) (infra.HostResource, error) {
+	if !isHostResourceID(query) {
+		nameFilter := fmt.Sprintf("name=%q", query)
+		resp, err := client.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{Filter: &nameFilter}, auth.AddAuthHeader)
+		if err != nil {
+			return infra.HostResource{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return infra.HostResource{}, err
+		}
+		host, err := findHostByName(resp.JSON200.Hosts, query)
+		if err != nil {
+			return infra.HostResource{}, err
+		}
+		query = derefString(host.ResourceId)
+	}
+
+	resp, err := client.HostServiceGetHostWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+	if err != nil {
+		return infra.HostResource{}, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting Host"); err != nil {
+		return infra.HostResource{}, err
+	}
+	return *resp.JSON200, nil
+}
+
+// Returns the resource IDs a schedule may target to cover the host: the host, its site and every region above the site
+func maintenanceTargets(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, host infra.HostResource) (map[string]bool, error) {
+	levels, err := hostScheduleLevels(ctx, client, projectName, host)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[string]bool, len(levels))
+	for id := range levels {
+		targets[id] = true
+	}
+	return targets, nil
+}
+
+// Returns the kind, host, site or region, of the resources a schedule may target to cover the host by resource ID
+func hostScheduleLevels(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, host infra.HostResource) (map[string]string, error) {
+	levels := map[string]string{derefString(host.ResourceId): "host"}
+
+	siteID := derefString(host.SiteId)
+	if siteID == "" && host.Site != nil {
+		siteID = derefString(host.Site.ResourceId)
+	}
+	if siteID == "" {
+		return levels, nil
+	}
+	return siteScheduleLevels(ctx, client, projectName, siteID, levels)
+}
+
+// Adds the site and every region above it to the schedule levels
+func siteScheduleLevels(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, siteID string, levels map[string]string) (map[string]string, error) {
+	levels[siteID] = "site"
+
+	sresp, err := client.SiteServiceGetSiteWithResponse(ctx, projectName, "empty", siteID, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(sresp.HTTPResponse, sresp.Body, "error getting site"); err != nil {
+		return nil, err
+	}
+	return regionScheduleLevels(ctx, client, projectName, siteRegionID(*sresp.JSON200), levels)
+}
+
+// Adds the region and every region above it to the schedule levels
+func regionScheduleLevels(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, regionID string, levels map[string]string) (map[string]string, error) {
+	// Walk up the region tree; the visited check guards against malformed parent links
+	for regionID != "" && levels[regionID] == "" {
+		levels[regionID] = "region"
+		rresp, err := client.RegionServiceGetRegionWithResponse(ctx, projectName, regionID, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(rresp.HTTPResponse, rresp.Body, "error getting region"); err != nil {
+			return nil, err
+		}
+		regionID = derefString(rresp.JSON200.ParentId)
+	}
+	return levels, nil
+}
+
+// Returns the schedule occurrences targeting one of the given resources that cover t, and how many schedules target them
+func findMaintenanceWindows(singleSchedules []infra.SingleScheduleResource, repeatedSchedules []infra.RepeatedScheduleResource,
+	targets map[string]bool, t time.Time) ([]maintenanceWindow, int, error) {
+	var windows []maintenanceWindow
+	evaluated := 0
+
+	for _, s := range singleSchedules {
+		target := scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)
+		if !targets[target] {
+			continue
+		}
+		evaluated++
+		if !isSingleScheduleActive(s, t) {
+			continue
+		}
+		w := maintenanceWindow{Name: derefString(s.Name), ResourceID: derefString(s.ResourceId), Target: target,
+			Status: s.ScheduleStatus, Start: time.Unix(int64(s.StartSeconds), 0)}
+		if s.EndSeconds != nil {
+			end := time.Unix(int64(*s.EndSeconds), 0)
+			w.End = &end
+		}
+		windows = append(windows, w)
+	}
+
+	for _, s := range repeatedSchedules {
+		target := scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)
+		if !targets[target] {
+			continue
+		}
+		evaluated++
+		start, active, err := activeRepeatedOccurrence(s, t)
+		if err != nil {
+			return nil, 0, fmt.Errorf("schedule %s: %w", derefString(s.ResourceId), err)
+		}
+		if !active {
+			continue
+		}
+		end := start.Add(time.Duration(s.DurationSeconds) * time.Second)
+		windows = append(windows, maintenanceWindow{Name: derefString(s.Name), ResourceID: derefString(s.ResourceId),
+			Target: target, Status: s.ScheduleStatus, Start: start, End: &end})
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	return windows, evaluated, nil
+}
+
+// Returns the resource ID targeted by a schedule
+func scheduleTarget(hostID, siteID, regionID *string) string {
+	for _, id := range []*string{hostID, siteID, regionID} {
+		if id != nil && strings.TrimSpace(*id) != "" {
+			return *id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMaintenanceTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	at, err := parseMaintenanceTime("", berlin, now)
+	require.NoError(t, err)
+	assert.Equal(t, now, at)
+
+	at, err = parseMaintenanceTime("2025-04-01T02:00", berlin, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), at.UTC())
+
+	at, err = parseMaintenanceTime("2025-04-01 02:00", time.UTC, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 4, 1, 2, 0, 0, 0, time.UTC), at.UTC())
+
+	at, err = parseMaintenanceTime("2025-04-01T02:00:00-05:00", berlin, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 4, 1, 7, 0, 0, 0, time.UTC), at.UTC())
+
+	_, err = parseMaintenanceTime("01/04/2025", time.UTC, now)
+	assert.EqualError(t, err, `invalid --at "01/04/2025", must be in format "YYYY-MM-DDTHH:MM" or RFC 3339`)
+}
+
+func (s *CLITestSuite) TestCheckMaintenance() {
+	out, err := s.runCommand("check maintenance --host host-abcd1234 --at 2026-04-04T03:30 --project maintenance-schedules")
+	s.NoError(err)
+	s.Contains(out, "Host host-abcd1234 (edge-host-001) is in maintenance at 2026-04-04 03:30 UTC\n")
+	s.Regexp(`weekly-patch\s+\|repeatedsche-abcd1111\s+\|region-abcd1111\s+\|SCHEDULE_STATUS_OS_UPDATE\s+\|2026-04-04 02:00 UTC - 2026-04-04 04:00 UTC`, out)
+	s.Regexp(`site-outage\s+\|singlesche-abcd1111\s+\|site-abc123\s+\|SCHEDULE_STATUS_MAINTENANCE\s+\|2026-04-04 03:00 UTC - 2026-04-04 05:00 UTC`, out)
+	s.NotContains(out, "other-site")
+	s.Contains(out, "2 of 2 schedules targeting the host, its site or its regions apply\n")
+
+	out, err = s.runCommand("check maintenance --host host-abcd1234 --at \"2026-04-04 06:30\" --timezone Europe/Berlin --project maintenance-schedules")
+	s.NoError(err)
+	s.Contains(out, "is in maintenance at 2026-04-04 06:30 CEST\n")
+	s.NotContains(out, "weekly-patch")
+	s.Contains(out, "1 of 2 schedules")
+
+	out, err = s.runCommand("check maintenance --host host-abcd1234 --at 2026-04-05T03:30 --project maintenance-schedules")
+	s.NoError(err)
+	s.Equal("Host host-abcd1234 (edge-host-001) is not in maintenance at 2026-04-05 03:30 UTC\n"+
+		"0 of 2 schedules targeting the host, its site or its regions apply\n", out)
+
+	_, err = s.runCommand("check maintenance --host host-abcd1234 --at tomorrow --project maintenance-schedules")
+	s.EqualError(err, `invalid --at "tomorrow", must be in format "YYYY-MM-DDTHH:MM" or RFC 3339`)
+
+	_, err = s.runCommand("check maintenance --host host-abcd1234 --project invalid-project")
+	s.Error(err)
+}
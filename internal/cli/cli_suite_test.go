@@ -12,6 +12,7 @@ import (
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	alertingmock "github.com/open-edge-platform/cli/internal/cli/mocks/alerting"
 	authmock "github.com/open-edge-platform/cli/internal/cli/mocks/auth"
 	catalogmock "github.com/open-edge-platform/cli/internal/cli/mocks/catalog"
 	catalogutilitiesmock "github.com/open-edge-platform/cli/internal/cli/mocks/catalogutilities"
@@ -70,6 +71,7 @@ func (s *CLITestSuite) SetupSuite() {
 	TenancyFactory = tenancymock.CreateTenancyMock(mctrl)
 	OrchestratorFactory = orchutilsmock.CreateOrchestratorMock(mctrl)
 	KeycloakAdminFactory = keycloakmock.CreateKeycloakAdminMock(mctrl)
+	AlertingFactory = alertingmock.CreateAlertingMock(mctrl)
 }
 
 func (s *CLITestSuite) TearDownSuite() {
@@ -83,6 +85,7 @@ func (s *CLITestSuite) TearDownSuite() {
 	TenancyFactory = nil
 	OrchestratorFactory = nil
 	KeycloakAdminFactory = nil
+	AlertingFactory = nil
 
 	viper.Set(auth.UserName, "")
 	viper.Set(auth.RefreshTokenField, "")
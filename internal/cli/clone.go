@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const cloneSiteExamples = `# Create site berlin-02 in the same region as site-abc
+orch-cli clone site site-abc --name berlin-02 --project some-project
+
+# Create site berlin-02 in another region, copying the metadata of site-abc and the schedules targeting it
+orch-cli clone site site-abc --name berlin-02 --region region-xyz --include-metadata --include-schedules --project some-project
+
+# Clone a site given by name and set the location of the new site
+orch-cli clone site berlin-01 --name berlin-02 --latitude 52.5200 --longitude 13.4050 --project some-project
+`
+
+func getCloneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone",
+		Short: "Create new resources from the configuration of existing ones",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getCloneSiteCommand(),
+	)
+	return cmd
+}
+
+func getCloneSiteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "site <name or resourceID> --name <new name> [flags]",
+		Short: "Creates a new site from the configuration of an existing site",
+		Long: "Creates a new site from the configuration of an existing site. The new site is placed in the region " +
+			"of the source site unless --region is given. Metadata, which carries the default provisioning settings " +
+			"inherited by the hosts of a site, and the maintenance schedules targeting the source site are only copied " +
+			"when requested. Hosts are never copied.",
+		Example: cloneSiteExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: siteAliases,
+		RunE:    runCloneSiteCommand,
+	}
+	cmd.Flags().String("name", "", "Name of the new site (mandatory)")
+	cmd.Flags().StringP("region", "r", "", "Region of the new site, given by name or resource ID (defaults to the region of the source site)")
+	cmd.Flags().StringP("latitude", "l", "", "Latitude of the new site")
+	cmd.Flags().StringP("longitude", "g", "", "Longitude of the new site")
+	cmd.Flags().Bool("include-metadata", false, "Copy the metadata of the source site")
+	cmd.Flags().Bool("include-schedules", false, "Copy the maintenance schedules targeting the source site")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+// Creates a new site from an existing one and optionally copies its metadata and schedules
+func runCloneSiteCommand(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	regFlag, _ := cmd.Flags().GetString("region")
+	ltdFlag, _ := cmd.Flags().GetString("latitude")
+	lngFlag, _ := cmd.Flags().GetString("longitude")
+	includeMetadata, _ := cmd.Flags().GetBool("include-metadata")
+	includeSchedules, _ := cmd.Flags().GetBool("include-schedules")
+
+	if err := checkName(name, SITE); err != nil {
+		return err
+	}
+	if includeSchedules && !isFeatureEnabled(Day2Feature) {
+		return errors.New("schedules are not available in the current Edge Orchestrator configuration, --include-schedules cannot be used")
+	}
+	siteLat, err := resolveLatitude(ltdFlag)
+	if err != nil {
+		return err
+	}
+	siteLng, err := resolveLongitude(lngFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx, siteClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	source, err := getSiteByNameOrID(ctx, siteClient, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	sourceID := derefString(source.ResourceId)
+
+	var regionID string
+	switch {
+	case regFlag != "":
+		if regionID, err = resolveRegionID(ctx, siteClient, projectName, regFlag); err != nil {
+			return err
+		}
+	case source.RegionId != nil:
+		regionID = *source.RegionId
+	case source.Region != nil:
+		regionID = derefString(source.Region.ResourceId)
+	}
+	if regionID == "" {
+		return fmt.Errorf("site %s is not in a region, use --region to place the new site", sourceID)
+	}
+
+	rresp, err := siteClient.RegionServiceGetRegionWithResponse(ctx, projectName, regionID, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(rresp.HTTPResponse, rresp.Body, "the region for site creation does not exist"); err != nil {
+		return err
+	}
+
+	body := infra.SiteServiceCreateSiteJSONRequestBody{
+		Name:     &name,
+		SiteLat:  siteLat,
+		SiteLng:  siteLng,
+		RegionId: &regionID,
+	}
+	if includeMetadata && source.Metadata != nil {
+		metadata := append([]infra.MetadataItem(nil), *source.Metadata...)
+		body.Metadata = &metadata
+	}
+
+	resp, err := siteClient.SiteServiceCreateSiteWithResponse(ctx, projectName, "empty", body, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while creating site"); err != nil {
+		return err
+	}
+	newID := derefString(resp.JSON200.ResourceId)
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Site %s (%s) created from site %s (%s) in region %s\n", name, newID,
+		derefString(source.Name), sourceID, regionID)
+	if body.Metadata != nil {
+		fmt.Fprintf(out, "Copied %d metadata item(s)\n", len(*body.Metadata))
+	}
+
+	if includeSchedules {
+		return cloneSiteSchedules(ctx, out, siteClient, projectName, sourceID, newID, name)
+	}
+	return nil
+}
+
+// Recreates the schedules targeting the source site for the new site, naming each copy after the new site
+func cloneSiteSchedules(ctx context.Context, out io.Writer, scheduleClient infra.ClientWithResponsesInterface,
+	projectName string, sourceID string, newID string, siteName string) error {
+	resp, err := scheduleClient.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+		&infra.ScheduleServiceListSchedulesParams{SiteId: &sourceID}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving schedules"); err != nil {
+		return err
+	}
+
+	copied := 0
+	for _, schedule := range resp.JSON200.RepeatedSchedules {
+		if derefString(schedule.TargetSiteId) != sourceID {
+			continue
+		}
+		name := fmt.Sprintf("%s-%s", derefString(schedule.Name), siteName)
+		cresp, err := scheduleClient.ScheduleServiceCreateRepeatedScheduleWithResponse(ctx, projectName,
+			infra.ScheduleServiceCreateRepeatedScheduleJSONRequestBody{
+				Name:            &name,
+				ScheduleStatus:  schedule.ScheduleStatus,
+				CronDayWeek:     schedule.CronDayWeek,
+				CronDayMonth:    schedule.CronDayMonth,
+				CronMonth:       schedule.CronMonth,
+				CronHours:       schedule.CronHours,
+				CronMinutes:     schedule.CronMinutes,
+				DurationSeconds: schedule.DurationSeconds,
+				TargetSiteId:    &newID,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(cresp.HTTPResponse, cresp.Body, fmt.Sprintf("error while creating schedule %s", name)); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Copied schedule %s (%s) as %s\n", derefString(schedule.Name), derefString(schedule.ResourceId), name)
+		copied++
+	}
+
+	for _, schedule := range resp.JSON200.SingleSchedules {
+		if derefString(schedule.TargetSiteId) != sourceID {
+			continue
+		}
+		name := fmt.Sprintf("%s-%s", derefString(schedule.Name), siteName)
+		cresp, err := scheduleClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName,
+			infra.ScheduleServiceCreateSingleScheduleJSONRequestBody{
+				Name:           &name,
+				ScheduleStatus: schedule.ScheduleStatus,
+				StartSeconds:   schedule.StartSeconds,
+				EndSeconds:     schedule.EndSeconds,
+				TargetSiteId:   &newID,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(cresp.HTTPResponse, cresp.Body, fmt.Sprintf("error while creating schedule %s", name)); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Copied schedule %s (%s) as %s\n", derefString(schedule.Name), derefString(schedule.ResourceId), name)
+		copied++
+	}
+
+	fmt.Fprintf(out, "Copied %d schedule(s)\n", copied)
+	return nil
+}
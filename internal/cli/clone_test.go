@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+func (s *CLITestSuite) TestCloneSite() {
+	out, err := s.runCommand("clone site site-abcd1234 --name berlin-02 --project " + project)
+	s.NoError(err)
+	s.Equal("Site berlin-02 (site-abcd1111) created from site site (site-abcd1234) in region region-abcd1234\n", out)
+
+	out, err = s.runCommand("clone site site-abcd1234 --name berlin-02 --region region-abcd1111 --include-metadata --include-schedules --project " + project)
+	s.NoError(err)
+	s.Contains(out, "created from site site (site-abcd1234) in region region-abcd1111\n")
+	s.Contains(out, "Copied schedule schedule (repeatedsche-abcd1234) as schedule-berlin-02\n")
+	s.Contains(out, "Copied schedule schedule (singlesche-abcd1234) as schedule-berlin-02\n")
+	s.Contains(out, "Copied 2 schedule(s)\n")
+	s.Regexp(`Copied \d+ metadata item\(s\)`, out)
+
+	_, err = s.runCommand("clone site site-abcd1234 --name berlin$02 --project " + project)
+	s.EqualError(err, "invalid site name")
+
+	_, err = s.runCommand("clone site site-abcd1234 --project " + project)
+	s.Error(err)
+
+	_, err = s.runCommand("clone site site-abcd1234 --name berlin-02 --region region-11111111 --project " + project)
+	s.Error(err)
+}
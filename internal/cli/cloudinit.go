@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const cloudConfigHeader = "#cloud-config"
+
+// Top-level keys of the cloud-init modules and of the base configuration
+var cloudInitKeys = []string{
+	"ansible", "apk_repos", "apt", "apt_pipelining", "autoinstall", "bootcmd", "byobu_by_default", "ca_certs",
+	"chef", "chpasswd", "create_hostname_file", "device_aliases", "disable_ec2_metadata", "disable_root",
+	"disable_root_opts", "disk_setup", "drivers", "fan", "final_message", "fqdn", "fs_setup", "groups", "growpart",
+	"hostname", "keyboard", "landscape", "locale", "locale_configfile", "lxd", "manage_etc_hosts",
+	"manage_resolv_conf", "mcollective", "merge_how", "merge_type", "mount_default_fields", "mounts", "network",
+	"ntp", "output", "package_reboot_if_required", "package_update", "package_upgrade", "packages", "password",
+	"phone_home", "power_state", "prefer_fqdn_over_hostname", "preserve_hostname", "puppet", "random_seed",
+	"reporting", "resize_rootfs", "resolv_conf", "rh_subscription", "rsyslog", "runcmd", "salt_minion", "snap",
+	"spacewalk", "ssh", "ssh_authorized_keys", "ssh_deletekeys", "ssh_fp_console_blacklist", "ssh_genkeytypes",
+	"ssh_import_id", "ssh_key_console_blacklist", "ssh_keys", "ssh_publish_hostkeys", "ssh_pwauth",
+	"ssh_quiet_keygen", "swap", "system_info", "timezone", "ubuntu_advantage", "ubuntu_pro", "updates", "user",
+	"users", "vendor_data", "wireguard", "write_files", "yum_repos", "zypper",
+}
+
+// Keys whose value must be a list
+var cloudInitListKeys = []string{"bootcmd", "groups", "mounts", "packages", "runcmd", "ssh_authorized_keys", "users", "write_files"}
+
+var cloudInitEncodings = []string{"b64", "base64", "gz", "gzip", "gz+base64", "gzip+base64", "gz+b64", "gzip+b64", "text/plain"}
+
+// Checks that a custom config is well-formed cloud-init and returns the warnings about its likely mistakes.
+// A config that is not YAML, lacks the #cloud-config header or is not a mapping is rejected, as cloud-init
+// would ignore it when provisioning the host.
+func lintCloudInit(data []byte) ([]string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(string(data)), cloudConfigHeader) {
+		return nil, fmt.Errorf("file does not start with %s", cloudConfigHeader)
+	}
+	var out interface{}
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if out == nil {
+		return []string{"the cloud-init config is empty"}, nil
+	}
+	if _, ok := out.(map[interface{}]interface{}); !ok {
+		return nil, fmt.Errorf("invalid cloud-init: the top level must be a mapping of module keys, not a %s", yamlKind(out))
+	}
+	// Decoded again in order, so that the warnings follow the file
+	var config yaml.MapSlice
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	var warnings []string
+	for _, item := range config {
+		key := fmt.Sprint(item.Key)
+		if !slices.Contains(cloudInitKeys, key) {
+			warning := fmt.Sprintf("unknown top-level key %q is ignored by cloud-init", key)
+			if suggestion := suggestCloudInitKey(key); suggestion != "" {
+				warning += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			warnings = append(warnings, warning)
+			continue
+		}
+		if slices.Contains(cloudInitListKeys, key) {
+			if _, ok := item.Value.([]interface{}); !ok {
+				warnings = append(warnings, fmt.Sprintf("%s must be a list, not a %s", key, yamlKind(item.Value)))
+				continue
+			}
+		}
+		switch key {
+		case "write_files":
+			warnings = append(warnings, lintCloudInitWriteFiles(item.Value.([]interface{}))...)
+		case "users":
+			warnings = append(warnings, lintCloudInitUsers(item.Value.([]interface{}))...)
+		}
+	}
+	return warnings, nil
+}
+
+func lintCloudInitWriteFiles(files []interface{}) []string {
+	var warnings []string
+	for i, entry := range files {
+		file, ok := entry.(yaml.MapSlice)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("write_files[%d] must be a mapping with a path and a content", i))
+			continue
+		}
+		values := mapSliceValues(file)
+		if path, ok := values["path"]; !ok || fmt.Sprint(path) == "" {
+			warnings = append(warnings, fmt.Sprintf("write_files[%d] has no path", i))
+		}
+		// YAML reads an unquoted 0644 as the number 420
+		if permissions, ok := values["permissions"]; ok {
+			if _, isString := permissions.(string); !isString {
+				warnings = append(warnings, fmt.Sprintf("write_files[%d].permissions should be a quoted octal string, e.g. '0644'", i))
+			}
+		}
+		if encoding, ok := values["encoding"]; ok && !slices.Contains(cloudInitEncodings, strings.ToLower(fmt.Sprint(encoding))) {
+			warnings = append(warnings, fmt.Sprintf("write_files[%d].encoding %q is unknown, must be one of: %s", i, fmt.Sprint(encoding), strings.Join(cloudInitEncodings, ", ")))
+		}
+	}
+	return warnings
+}
+
+func lintCloudInitUsers(users []interface{}) []string {
+	var warnings []string
+	for i, entry := range users {
+		switch user := entry.(type) {
+		case string:
+			// "default" or the name of a user
+		case yaml.MapSlice:
+			if name, ok := mapSliceValues(user)["name"]; !ok || fmt.Sprint(name) == "" {
+				warnings = append(warnings, fmt.Sprintf("users[%d] has no name", i))
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("users[%d] must be a user name or a mapping with a name", i))
+		}
+	}
+	return warnings
+}
+
+// Returns the known key a mistyped key was likely meant to be, e.g. run_cmd or write-files
+func suggestCloudInitKey(key string) string {
+	normalized := strings.ReplaceAll(strings.ToLower(key), "-", "_")
+	// Short keys allow fewer edits, "foo" is not a typo of "fan"
+	maxDistance := 2
+	if len(normalized) <= 4 {
+		maxDistance = 1
+	}
+	best, bestDistance := "", maxDistance+1
+	for _, known := range cloudInitKeys {
+		if distance := editDistance(normalized, known); distance < bestDistance {
+			best, bestDistance = known, distance
+		}
+	}
+	return best
+}
+
+// Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func mapSliceValues(m yaml.MapSlice) map[string]interface{} {
+	values := make(map[string]interface{}, len(m))
+	for _, item := range m {
+		values[fmt.Sprint(item.Key)] = item.Value
+	}
+	return values
+}
+
+func yamlKind(v interface{}) string {
+	switch v.(type) {
+	case map[interface{}]interface{}, yaml.MapSlice:
+		return "mapping"
+	case []interface{}:
+		return "list"
+	case string:
+		return "string"
+	case nil:
+		return "null value"
+	default:
+		return "scalar"
+	}
+}
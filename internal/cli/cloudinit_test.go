@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCloudInit(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		warnings []string
+		err      string
+	}{
+		{
+			name:   "valid",
+			config: "#cloud-config\nusers:\n- default\n- name: user\nwrite_files:\n- path: /tmp/testfile\n  content: TEST\n  permissions: '0644'\nruncmd:\n- echo TEST\n",
+		},
+		{
+			name:   "no header",
+			config: "runcmd:\n- echo TEST\n",
+			err:    "file does not start with #cloud-config",
+		},
+		{
+			name:   "invalid YAML",
+			config: "#cloud-config\nruncmd: [echo\n",
+			err:    "invalid YAML: yaml: line 2: did not find expected ',' or ']'",
+		},
+		{
+			name:   "not a mapping",
+			config: "#cloud-config\n- echo TEST\n",
+			err:    "invalid cloud-init: the top level must be a mapping of module keys, not a list",
+		},
+		{
+			name:     "empty",
+			config:   "#cloud-config\n",
+			warnings: []string{"the cloud-init config is empty"},
+		},
+		{
+			name:   "unknown keys",
+			config: "#cloud-config\nrun_cmd:\n- echo TEST\nfoo: bar\n",
+			warnings: []string{
+				`unknown top-level key "run_cmd" is ignored by cloud-init, did you mean "runcmd"?`,
+				`unknown top-level key "foo" is ignored by cloud-init`,
+			},
+		},
+		{
+			name:     "not a list",
+			config:   "#cloud-config\npackages: curl\n",
+			warnings: []string{"packages must be a list, not a string"},
+		},
+		{
+			name:   "write_files mistakes",
+			config: "#cloud-config\nwrite_files:\n- content: TEST\n- path: /tmp/testfile\n  permissions: 0644\n  encoding: base32\n- /tmp/testfile\n",
+			warnings: []string{
+				"write_files[0] has no path",
+				"write_files[1].permissions should be a quoted octal string, e.g. '0644'",
+				`write_files[1].encoding "base32" is unknown, must be one of: b64, base64, gz, gzip, gz+base64, gzip+base64, gz+b64, gzip+b64, text/plain`,
+				"write_files[2] must be a mapping with a path and a content",
+			},
+		},
+		{
+			name:     "users mistakes",
+			config:   "#cloud-config\nusers:\n- groups: sudo\n- [user]\n",
+			warnings: []string{"users[0] has no name", "users[1] must be a user name or a mapping with a name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := lintCloudInit([]byte(tt.config))
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.warnings, warnings)
+		})
+	}
+}
@@ -110,6 +110,7 @@ func getListClusterCommand() *cobra.Command {
 	}
 	addListOrderingFilteringPaginationFlags(cmd, "cluster")
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
 	cmd.Flags().Bool("not-ready", false, "Show only clusters that are not ready")
 	return cmd
 }
@@ -266,6 +267,10 @@ func runGetClusterCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get cluster details: %w", err)
 	}
 
+	if selected, err := printSelectedOutput(cmd, cluster); selected {
+		return err
+	}
+
 	fmt.Fprintf(writer, "Project: %s\n", projectName)
 
 	// Convert ClusterDetailInfo to ClusterInfo for consistent output
@@ -387,6 +392,11 @@ func getValidatedClusterFilter(
 }
 
 func printClusters(cmd *cobra.Command, writer io.Writer, clusterList *[]coapi.ClusterInfo, orderBy *string, outputFilter *string, verbose bool) error {
+	selected, err := selectItems(cmd, *clusterList)
+	if err != nil {
+		return err
+	}
+	clusterList = &selected
 	outputType, _ := cmd.Flags().GetString("output-type")
 
 	outputFormat, err := getClusterOutputFormat(cmd, verbose, true)
@@ -412,6 +422,9 @@ func printClusters(cmd *cobra.Command, writer io.Writer, clusterList *[]coapi.Cl
 		NameLimit: -1,
 		Data:      *clusterList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -4,11 +4,17 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"sort"
+	"strings"
 
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
@@ -38,6 +44,15 @@ Configuration: Use --output-type yaml to view full cluster configuration
 	CLUSTER_TEMPLATE_INSPECT_TEMPLATE_ENVVAR = "ORCH_CLI_CLUSTER_TEMPLATE_INSPECT_TEMPLATE"
 )
 
+const getClusterTemplateExamples = `# Get a cluster template
+orch-cli get clustertemplate baseline:v2.0.1 --project some-project
+
+# Export a cluster template to a file, e.g. to keep it in Git or promote it to another environment
+orch-cli get clustertemplate baseline:v2.0.1 --export baseline-v2.0.1.json --project some-project`
+
+const createClusterTemplateExamples = `# Create a cluster template exported with get clustertemplate --export
+orch-cli create clustertemplate --from-file baseline-v2.0.1.json --project some-project`
+
 func getListClusterTemplatesCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "clustertemplates [flags]",
@@ -215,6 +230,9 @@ func printClusterTemplates(cmd *cobra.Command, writer io.Writer, templates *[]co
 		NameLimit: -1,
 		Data:      *templates,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -305,3 +323,157 @@ func getValidatedClusterTemplateFilter(
 		return true, nil
 	})
 }
+
+func getGetClusterTemplateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clustertemplate <name>:<version> [flags]",
+		Aliases: clusterTemplateAliases,
+		Short:   "Get a cluster template",
+		Example: getClusterTemplateExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runGetClusterTemplateCommand,
+	}
+	cmd.Flags().String("export", "", "Write the cluster template as JSON to the given file, to be created elsewhere with create clustertemplate --from-file")
+	addStandardGetOutputFlags(cmd)
+	return cmd
+}
+
+func getCreateClusterTemplateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clustertemplate --from-file <file> [flags]",
+		Aliases: clusterTemplateAliases,
+		Short:   "Create a cluster template from a JSON file",
+		Example: createClusterTemplateExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runCreateClusterTemplateCommand,
+	}
+	cmd.Flags().String("from-file", "", "JSON file of the cluster template, as written by get clustertemplate --export")
+	_ = cmd.MarkFlagRequired("from-file")
+	return cmd
+}
+
+// Splits a cluster template reference of the form <name>:<version>
+func parseClusterTemplateRef(ref string) (string, string, error) {
+	name, version, found := strings.Cut(ref, ":")
+	name, version = strings.TrimSpace(name), strings.TrimSpace(version)
+	if !found || name == "" || version == "" {
+		return "", "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid cluster template %q, expected <name>:<version>", ref))
+	}
+	return name, version, nil
+}
+
+func runGetClusterTemplateCommand(cmd *cobra.Command, args []string) error {
+	name, version, err := parseClusterTemplateRef(args[0])
+	if err != nil {
+		return err
+	}
+	exportPath, _ := cmd.Flags().GetString("export")
+	if exportPath != "" {
+		if err := isSafePath(exportPath); err != nil {
+			return err
+		}
+	}
+
+	ctx, clusterTemplateClient, projectName, err := ClusterFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := clusterTemplateClient.GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx, projectName,
+		name, version, nil, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error getting cluster template %s:%s", name, version)); err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("error getting cluster template %s:%s: unexpected response format", name, version)
+	}
+
+	if exportPath != "" {
+		data, err := json.MarshalIndent(resp.JSON200, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding cluster template: %w", err)
+		}
+		if err := os.WriteFile(exportPath, append(data, '\n'), 0600); err != nil {
+			return fmt.Errorf("error writing cluster template: %w", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Cluster template %s:%s exported to %s\n", name, version, exportPath)
+		return nil
+	}
+
+	if selected, err := printSelectedOutput(cmd, *resp.JSON200); selected {
+		return err
+	}
+
+	writer, _ := getOutputContext(cmd)
+	outputType, _ := cmd.Flags().GetString("output-type")
+	outputFormat, err := resolveTableOutputTemplate(cmd, DEFAULT_CLUSTER_TEMPLATE_INSPECT_FORMAT, CLUSTER_TEMPLATE_INSPECT_TEMPLATE_ENVVAR)
+	if err != nil {
+		return err
+	}
+	result := CommandResult{
+		Format:    format.Format(outputFormat),
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      *resp.JSON200,
+	}
+	GenerateOutput(writer, &result)
+	return writer.Flush()
+}
+
+func runCreateClusterTemplateCommand(cmd *cobra.Command, _ []string) error {
+	path, _ := cmd.Flags().GetString("from-file")
+	template, err := readClusterTemplateFile(path)
+	if err != nil {
+		return err
+	}
+
+	ctx, clusterTemplateClient, projectName, err := ClusterFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := clusterTemplateClient.PostV2ProjectsProjectNameTemplatesWithResponse(ctx, projectName, nil, *template, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error creating cluster template %s:%s", template.Name, template.Version)); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Cluster template %s:%s created\n", template.Name, template.Version)
+	return nil
+}
+
+// Reads a cluster template written by get clustertemplate --export
+func readClusterTemplateFile(path string) (*coapi.TemplateInfo, error) {
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cluster template file: %w", err)
+	}
+	if len(data) > 1<<20 { // 1MB limit
+		return nil, fmt.Errorf("cluster template file too large")
+	}
+
+	var template coapi.TemplateInfo
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&template); err != nil {
+		return nil, fmt.Errorf("invalid cluster template file %s: %w", path, err)
+	}
+	var missing []string
+	for field, value := range map[string]string{"name": template.Name, "version": template.Version, "kubernetesVersion": template.KubernetesVersion} {
+		if value == "" {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("invalid cluster template file %s: missing %s", path, strings.Join(missing, ", "))
+	}
+	return &template, nil
+}
@@ -4,8 +4,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+
+	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
 )
 
 func (s *CLITestSuite) listClusterTemplates(publisher string, verbose bool, orderBy string, filter string, outputType string, pageSize string, args commandArgs) (string, error) {
@@ -122,3 +127,54 @@ func FuzzClusterTemplate(f *testing.F) {
 		}
 	})
 }
+
+func (s *CLITestSuite) TestExportImportClusterTemplate() {
+	out, err := s.runCommand("get clustertemplate baseline:v2.0.1 --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Name: baseline")
+	s.Contains(out, "Version: v2.0.1")
+
+	exportPath := filepath.Join(s.T().TempDir(), "baseline.json")
+	out, err = s.runCommand("get clustertemplate baseline:v2.0.1 --export " + exportPath + " --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Cluster template baseline:v2.0.1 exported to "+exportPath)
+	data, err := os.ReadFile(exportPath)
+	s.NoError(err)
+	var exported coapi.TemplateInfo
+	s.NoError(json.Unmarshal(data, &exported))
+	s.Equal("baseline", exported.Name)
+	s.Equal("v2.0.1", exported.Version)
+
+	// The mock does not return a Kubernetes version, set one to make the export a valid template
+	exported.KubernetesVersion = "v1.30.6+rke2r1"
+	data, err = json.Marshal(exported)
+	s.NoError(err)
+	s.NoError(os.WriteFile(exportPath, data, 0600))
+	out, err = s.runCommand("create clustertemplate --from-file " + exportPath + " --project " + project)
+	s.NoError(err)
+	s.Equal("Cluster template baseline:v2.0.1 created\n", out)
+
+	exported.Name = "existing-template"
+	data, err = json.Marshal(exported)
+	s.NoError(err)
+	s.NoError(os.WriteFile(exportPath, data, 0600))
+	_, err = s.runCommand("create clustertemplate --from-file " + exportPath + " --project " + project)
+	s.ErrorContains(err, "error creating cluster template existing-template:v2.0.1")
+
+	s.NoError(os.WriteFile(exportPath, []byte(`{"name":"baseline","versions":"v1"}`), 0600))
+	_, err = s.runCommand("create clustertemplate --from-file " + exportPath + " --project " + project)
+	s.ErrorContains(err, `json: unknown field "versions"`)
+
+	s.NoError(os.WriteFile(exportPath, []byte(`{"name":"baseline"}`), 0600))
+	_, err = s.runCommand("create clustertemplate --from-file " + exportPath + " --project " + project)
+	s.EqualError(err, "invalid cluster template file "+exportPath+": missing kubernetesVersion, version")
+
+	_, err = s.runCommand("create clustertemplate --project " + project)
+	s.EqualError(err, `required flag(s) "from-file" not set`)
+
+	_, err = s.runCommand("get clustertemplate baseline --project " + project)
+	s.EqualError(err, `invalid cluster template "baseline", expected <name>:<version>`)
+
+	_, err = s.runCommand("get clustertemplate nonexistent-template:v1 --project " + project)
+	s.ErrorContains(err, "error getting cluster template nonexistent-template:v1")
+}
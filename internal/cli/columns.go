@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/open-edge-platform/cli/pkg/format"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	columnsFlag   = "columns"
+	noHeadersFlag = "no-headers"
+	quietFlag     = "quiet"
+)
+
+// listRowSource is implemented by display rows built from an API resource; --columns may name the fields of
+// the resource as well as those of the row
+type listRowSource interface {
+	Source() interface{}
+}
+
+// listColumn is a column selected with --columns, read from the JSON fields of the items
+type listColumn struct {
+	header string
+	// JSON keys of the field, the ones past a map or free-form object matched ignoring case
+	path []string
+	// The field is one of the resource the row was built from
+	source bool
+}
+
+// Adds the --columns and --no-headers flags to a list command; the command applies them with withListColumns
+func addColumnsFlags(cmd *cobra.Command) {
+	cmd.Flags().String(columnsFlag, "", "Optional comma-separated fields shown as the columns of the table output, "+
+		"matched ignoring case and spaces, including nested fields separated by '.', "+
+		"e.g. 'ResourceID,Name,Site Name,instance.provisioningStatus'")
+	cmd.Flags().Bool(noHeadersFlag, false, "Do not print the header line of the table output")
+	cmd.Flags().BoolP(quietFlag, "q", false, "Only print the resource IDs of the listed resources, one per line, e.g. to pipe them to xargs")
+	// The IDs are an output of their own
+	cmd.MarkFlagsMutuallyExclusive(quietFlag, columnsFlag)
+	cmd.MarkFlagsMutuallyExclusive(quietFlag, "output-type")
+}
+
+// Fields holding the identifier of a listed resource, in order of preference; resources without a resource ID,
+// e.g. applications and deployment packages, are identified by their name
+var quietColumns = []string{"resourceId", "id", "deployId", "profileName", "name"}
+
+// Applies --columns, --no-headers and --quiet to the table output of a list command; the columns are checked
+// against the type of the items of result.Data
+func withListColumns(cmd *cobra.Command, result *CommandResult) error {
+	result.NoHeaders, _ = cmd.Flags().GetBool(noHeadersFlag)
+	if quiet, _ := cmd.Flags().GetBool(quietFlag); quiet {
+		return withQuietColumn(result)
+	}
+	spec, _ := cmd.Flags().GetString(columnsFlag)
+	if spec == "" || result.OutputAs != OUTPUT_TABLE {
+		return nil
+	}
+	for _, name := range strings.Split(spec, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result.Columns = append(result.Columns, name)
+		}
+	}
+	if len(result.Columns) == 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s must name at least one field", columnsFlag))
+	}
+	if _, err := resolveListColumns(reflect.TypeOf(result.Data), result.Columns); err != nil {
+		return e.WithCode(e.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+// Reduces the table output of a list to the identifiers of its items, without headers
+func withQuietColumn(result *CommandResult) error {
+	for _, name := range quietColumns {
+		if _, err := resolveListColumns(reflect.TypeOf(result.Data), []string{name}); err == nil {
+			result.Columns = []string{name}
+			result.NoHeaders = true
+			return nil
+		}
+	}
+	return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s is not supported by this command", quietFlag))
+}
+
+// Resolves the names of --columns against the fields of the items of a slice type
+func resolveListColumns(dataType reflect.Type, names []string) ([]listColumn, error) {
+	itemType := dataType
+	for itemType != nil && (itemType.Kind() == reflect.Slice || itemType.Kind() == reflect.Array || itemType.Kind() == reflect.Ptr) {
+		itemType = itemType.Elem()
+	}
+	if itemType == nil || itemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("--%s is not supported by this command", columnsFlag)
+	}
+	var sourceType reflect.Type
+	if row, ok := reflect.Zero(itemType).Interface().(listRowSource); ok {
+		sourceType = reflect.TypeOf(row.Source())
+	}
+
+	columns := make([]listColumn, 0, len(names))
+	for _, name := range names {
+		segments := strings.Split(name, ".")
+		column := listColumn{header: columnHeader(name)}
+		if path, ok := resolveJSONPath(itemType, segments); ok {
+			column.path = path
+		} else if path, ok := resolveJSONPath(sourceType, segments); ok {
+			column.path, column.source = path, true
+		} else {
+			return nil, fmt.Errorf("unknown column %q, available columns: %s", name, strings.Join(columnNames(itemType, sourceType), ", "))
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// Returns the JSON keys of the field named by segments, each matched ignoring case and spaces
+func resolveJSONPath(t reflect.Type, segments []string) ([]string, bool) {
+	if t == nil {
+		return nil, false
+	}
+	var path []string
+	for i, segment := range segments {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			key, fieldType, ok := jsonField(t, segment)
+			if !ok {
+				return nil, false
+			}
+			path, t = append(path, key), fieldType
+		case reflect.Map, reflect.Interface:
+			return append(path, segments[i:]...), true
+		default:
+			return nil, false
+		}
+	}
+	return path, true
+}
+
+// Finds the field of a struct encoded under a JSON key matching name; the fields of embedded structs are promoted
+func jsonField(t reflect.Type, name string) (string, reflect.Type, bool) {
+	for _, field := range jsonFields(t) {
+		if normalizeColumnName(field.key) == normalizeColumnName(name) {
+			return field.key, field.typ, true
+		}
+	}
+	return "", nil, false
+}
+
+type jsonStructField struct {
+	key string
+	typ reflect.Type
+}
+
+func jsonFields(t reflect.Type) []jsonStructField {
+	var fields []jsonStructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if key == "-" {
+			continue
+		}
+		if field.Anonymous && key == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, jsonFields(embedded)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if key == "" {
+			key = field.Name
+		}
+		fields = append(fields, jsonStructField{key: key, typ: field.Type})
+	}
+	return fields
+}
+
+func normalizeColumnName(name string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '_' || r == '-' {
+			return -1
+		}
+		return r
+	}, name))
+}
+
+// Returns the top-level fields of the items, and of their source resource, for the error of an unknown column
+func columnNames(itemType reflect.Type, sourceType reflect.Type) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, t := range []reflect.Type{itemType, sourceType} {
+		if t == nil || t.Kind() != reflect.Struct {
+			continue
+		}
+		for _, field := range jsonFields(t) {
+			if !seen[field.key] {
+				seen[field.key] = true
+				names = append(names, field.key)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Upper-cases a column name with its words separated, e.g. RESOURCE ID for ResourceID and
+// INSTANCE PROVISIONING STATUS for instance.provisioningStatus
+func columnHeader(name string) string {
+	var b strings.Builder
+	runes := []rune(strings.ReplaceAll(name, ".", " "))
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Writes the items of data as a table of the columns
+func printListColumns(writer io.Writer, columns []listColumn, withHeaders bool, data interface{}) error {
+	tabWriter := format.AsTableOutput(writer)
+	if withHeaders {
+		headers := make([]string, 0, len(columns))
+		for _, column := range columns {
+			headers = append(headers, column.header)
+		}
+		fmt.Fprintln(tabWriter, strings.Join(headers, "\t"))
+	}
+
+	items := reflect.ValueOf(data)
+	if items.Kind() != reflect.Slice {
+		items = reflect.ValueOf([]interface{}{data})
+	}
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i).Interface()
+		view, err := toSelectView(item)
+		if err != nil {
+			return err
+		}
+		var sourceView interface{}
+		if row, ok := item.(listRowSource); ok {
+			if sourceView, err = toSelectView(row.Source()); err != nil {
+				return err
+			}
+		}
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			if column.source {
+				values = append(values, columnValue(lookupJSONPath(sourceView, column.path)))
+			} else {
+				values = append(values, columnValue(lookupJSONPath(view, column.path)))
+			}
+		}
+		fmt.Fprintln(tabWriter, strings.Join(values, "\t"))
+	}
+	return tabWriter.Flush()
+}
+
+// Reads the value at a path of JSON keys; the path continues into every element of a list
+func lookupJSONPath(value interface{}, path []string) interface{} {
+	for i, key := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				for k, candidate := range v {
+					if normalizeColumnName(k) == normalizeColumnName(key) {
+						next, ok = candidate, true
+						break
+					}
+				}
+			}
+			if !ok {
+				return nil
+			}
+			value = next
+		case []interface{}:
+			values := make([]interface{}, 0, len(v))
+			for _, element := range v {
+				if found := lookupJSONPath(element, path[i:]); found != nil {
+					values = append(values, found)
+				}
+			}
+			return values
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// Renders a JSON value as a cell; a missing or empty value is shown as <none>
+func columnValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		if v == "" {
+			return "<none>"
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "<none>"
+		}
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			values = append(values, columnValue(element))
+		}
+		return strings.Join(values, ",")
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnHeader(t *testing.T) {
+	assert.Equal(t, "RESOURCE ID", columnHeader("ResourceID"))
+	assert.Equal(t, "SITE NAME", columnHeader("Site Name"))
+	assert.Equal(t, "INSTANCE PROVISIONING STATUS", columnHeader("instance.provisioningStatus"))
+	assert.Equal(t, "NAME", columnHeader("name"))
+}
+
+func TestResolveListColumns(t *testing.T) {
+	columns, err := resolveListColumns(reflect.TypeOf([]outfmt.HostRow{}), []string{"ResourceID", "Site Name", "instance.os.name", "metadata.key"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"resourceId"}, columns[0].path)
+	assert.Equal(t, []string{"siteName"}, columns[1].path)
+	assert.Equal(t, []string{"instance", "os", "name"}, columns[2].path)
+	assert.True(t, columns[2].source)
+	assert.Equal(t, []string{"metadata", "key"}, columns[3].path)
+
+	_, err = resolveListColumns(reflect.TypeOf([]infra.SiteResource{}), []string{"name", "instance"})
+	assert.ErrorContains(t, err, `unknown column "instance", available columns: `)
+
+	_, err = resolveListColumns(reflect.TypeOf([]string{}), []string{"name"})
+	assert.EqualError(t, err, "--columns is not supported by this command")
+}
+
+func TestPrintListColumns(t *testing.T) {
+	name, osName := "edge-host-001", "Ubuntu"
+	rows := outfmt.HostRows([]infra.HostResource{
+		{Name: name, ResourceId: &name, Instance: &infra.InstanceResource{Os: &infra.OperatingSystemResource{Name: &osName}}},
+		{Name: "edge-host-002"},
+	})
+	columns, err := resolveListColumns(reflect.TypeOf(rows), []string{"name", "instance.os.name", "cpuCores"})
+	require.NoError(t, err)
+
+	var out strings.Builder
+	require.NoError(t, printListColumns(&out, columns, true, rows))
+	assert.Equal(t, ""+
+		"NAME             INSTANCE OS NAME    CPU CORES\n"+
+		"edge-host-001    Ubuntu              <none>\n"+
+		"edge-host-002    <none>              <none>\n", out.String())
+
+	out.Reset()
+	require.NoError(t, printListColumns(&out, columns[:1], false, rows))
+	assert.Equal(t, "edge-host-001\nedge-host-002\n", out.String())
+}
+
+func TestColumnValue(t *testing.T) {
+	assert.Equal(t, "<none>", columnValue(nil))
+	assert.Equal(t, "<none>", columnValue(""))
+	assert.Equal(t, "16", columnValue(float64(16)))
+	assert.Equal(t, "true", columnValue(true))
+	assert.Equal(t, "a,b", columnValue([]interface{}{"a", "b"}))
+	assert.Equal(t, `{"key":"value"}`, columnValue(map[string]interface{}{"key": "value"}))
+}
+
+func (s *CLITestSuite) TestListHostColumns() {
+	out, err := s.runCommand(`list host --project ` + project + ` --columns "ResourceID,Name,Site Name,Host Status,instance.provisioningStatus"`)
+	s.NoError(err)
+	s.Regexp(`(?m)^RESOURCE ID\s+\|NAME\s+\|SITE NAME\s+\|HOST STATUS\s+\|INSTANCE PROVISIONING STATUS$`, out)
+	s.Regexp(`(?m)^host-abc12345\s+\|edge-host-001\s+\|site\s+\|Running\s+\|PROVISIONING_STATUS_COMPLETED$`, out)
+
+	out, err = s.runCommand(`list host --project ` + project + ` --columns "ResourceID,instance.provisioningStatus" --no-headers --output-filter "name=edge-host-001"`)
+	s.NoError(err)
+	s.Equal("host-abc12345   |PROVISIONING_STATUS_COMPLETED\n", out)
+
+	_, err = s.runCommand(`list host --project ` + project + ` --columns "ResourceID,bogus"`)
+	s.ErrorContains(err, `unknown column "bogus"`)
+}
+
+func (s *CLITestSuite) TestListQuiet() {
+	out, err := s.runCommand(`list host --project ` + project + ` -q`)
+	s.NoError(err)
+	s.Equal("host-abc12345\n", out)
+
+	// Resources without a resource ID are listed by name, regions by the IDs of their flat list instead of the tree
+	out, err = s.runCommand(`list clustertemplates --project ` + project + ` --quiet`)
+	s.NoError(err)
+	s.Equal("default-template\nha-template\n", out)
+	out, err = s.runCommand(`list region --project ` + project + ` --quiet`)
+	s.NoError(err)
+	s.Equal("region-abcd1111\n", out)
+
+	_, err = s.runCommand(`list host --project ` + project + ` -q -o json`)
+	s.Error(err)
+	_, err = s.runCommand(`list host --project ` + project + ` -q --columns name`)
+	s.Error(err)
+}
+
+func TestWithQuietColumn(t *testing.T) {
+	result := CommandResult{OutputAs: OUTPUT_TABLE, Data: []infra.SiteResource{}}
+	require.NoError(t, withQuietColumn(&result))
+	assert.Equal(t, []string{"resourceId"}, result.Columns)
+	assert.True(t, result.NoHeaders)
+
+	result = CommandResult{OutputAs: OUTPUT_TABLE, Data: []string{}}
+	assert.EqualError(t, withQuietColumn(&result), "--quiet is not supported by this command")
+}
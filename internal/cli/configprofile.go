@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultProfileKey = "default"
+	profilesKey       = "profiles"
+	configProfileFlag = "config-profile"
+	profileEnvPrefix  = "ORCH_CLI_"
+)
+
+const getDefaultsExamples = `# Default the project and site of all commands, so that --project and --site can be omitted
+orch-cli config set default.project some-project
+orch-cli config set default.site site-abcd1234
+
+# Keep the defaults of another environment in the "lab" profile and use them for one command
+orch-cli config set profiles.lab.project lab-project
+orch-cli list host --config-profile lab
+
+# Show the defaults in effect and where each one comes from
+orch-cli config get-defaults --config-profile lab`
+
+// profileDefaults maps the flags that take their default from the environment or a configuration
+// profile to the key holding it; the cluster template of create cluster shares the key of host import
+var profileDefaults = map[string]string{
+	project:            project,
+	"region":           "region",
+	"site":             "site",
+	"os-profile":       "os-profile",
+	"cluster-template": "cluster-template",
+	"template":         "cluster-template",
+}
+
+// Returns the sorted configuration keys of the profile defaults
+func profileDefaultKeys() []string {
+	keys := make([]string, 0, len(profileDefaults))
+	for _, key := range profileDefaults {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Returns the environment variable holding a default, e.g. ORCH_CLI_OS_PROFILE for os-profile
+func profileEnvVar(key string) string {
+	return profileEnvPrefix + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+}
+
+func getGetDefaultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-defaults",
+		Short: "Show the flag defaults of the configuration profiles and the environment",
+		Long: "Flags that are not given on the command line take their value from the ORCH_CLI_<FLAG> environment variable, " +
+			"then from the profile selected with --config-profile or ORCH_CLI_CONFIG_PROFILE (key profiles.<name>.<flag>), " +
+			"then from the default profile (key default.<flag>). Supported flags: " + strings.Join(profileDefaultKeys(), ", ") + ". " +
+			"Every other flag of every command is read from the environment only, the flag name upper-cased with '-' " +
+			"replaced by '_', e.g. ORCH_CLI_API_ENDPOINT for --api-endpoint or ORCH_CLI_OUTPUT_TYPE for --output-type.",
+		Example: getDefaultsExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runGetDefaultsCommand,
+	}
+	return cmd
+}
+
+func runGetDefaultsCommand(cmd *cobra.Command, _ []string) error {
+	profile, err := selectedConfigProfile(cmd)
+	if err != nil {
+		return err
+	}
+	writer, _ := getOutputContext(cmd)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Flag", "Value", "Source")
+	for _, key := range profileDefaultKeys() {
+		value, source, ok := lookupProfileDefault(key, profile)
+		if !ok {
+			value, source = "<none>", "-"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", key, value, source)
+	}
+	return writer.Flush()
+}
+
+// Returns the configuration profile chosen by --config-profile or the environment; empty for the default profile
+func selectedConfigProfile(cmd *cobra.Command) (string, error) {
+	name, _ := cmd.Flags().GetString(configProfileFlag)
+	if name == "" {
+		name = os.Getenv(profileEnvVar(configProfileFlag))
+	}
+	if name == "" || name == defaultProfileKey {
+		return "", nil
+	}
+	if !viper.IsSet(profilesKey + "." + name) {
+		return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("configuration profile %q not found, create it with 'orch-cli config set %s.%s.<flag> <value>'", name, profilesKey, name))
+	}
+	return name, nil
+}
+
+// Looks a default up in the environment, then in the selected profile, then in the default profile
+func lookupProfileDefault(key string, profile string) (string, string, bool) {
+	if value := os.Getenv(profileEnvVar(key)); value != "" {
+		return value, "env " + profileEnvVar(key), true
+	}
+	if profile != "" {
+		if value := viper.GetString(profilesKey + "." + profile + "." + key); value != "" {
+			return value, "profile " + profile, true
+		}
+	}
+	if value := viper.GetString(defaultProfileKey + "." + key); value != "" {
+		return value, "profile " + defaultProfileKey, true
+	}
+	return "", "", false
+}
+
+// Sets the flags of a command that were not given on the command line to their profile defaults, and
+// the other flags to their ORCH_CLI_<FLAG> environment variable. Every flag is looked up, so that flags
+// added later get their environment variable without being registered anywhere. The values are set
+// without marking the flags as changed, so that they stay defaults to the command.
+func setProfileDefaults(cmd *cobra.Command) error {
+	profile, err := selectedConfigProfile(cmd)
+	if err != nil {
+		return err
+	}
+	var setErr error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if setErr != nil || flag.Changed || flag.Name == "help" {
+			return
+		}
+		var value, source string
+		var ok bool
+		if key, isProfileDefault := profileDefaults[flag.Name]; isProfileDefault {
+			value, source, ok = lookupProfileDefault(key, profile)
+		} else if value = os.Getenv(profileEnvVar(flag.Name)); value != "" {
+			source, ok = "env "+profileEnvVar(flag.Name), true
+		}
+		if !ok {
+			return
+		}
+		if err := flag.Value.Set(value); err != nil {
+			setErr = e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid default %q for --%s from %s: %w", value, flag.Name, source, err))
+		}
+	})
+	return setErr
+}
+
+// Applies the profile defaults to every command of the tree except the ones managing the configuration
+func applyProfileDefaults(cmd *cobra.Command) {
+	if cmd.Name() == "config" && cmd.HasParent() && !cmd.Parent().HasParent() {
+		guardProfileConfigKeys(cmd)
+		return
+	}
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			if err := setProfileDefaults(c); err != nil {
+				return err
+			}
+			return run(c, args)
+		}
+	}
+	for _, child := range cmd.Commands() {
+		applyProfileDefaults(child)
+	}
+}
+
+// Rejects 'config set' of profile keys that no flag reads, which would otherwise be stored silently
+func guardProfileConfigKeys(configCmd *cobra.Command) {
+	for _, child := range configCmd.Commands() {
+		if child.Name() != "set" || child.RunE == nil {
+			continue
+		}
+		run := child.RunE
+		child.RunE = func(c *cobra.Command, args []string) error {
+			if err := verifyProfileConfigKey(args[0]); err != nil {
+				return err
+			}
+			return run(c, args)
+		}
+	}
+}
+
+func verifyProfileConfigKey(key string) error {
+	var flagKey string
+	switch parts := strings.Split(key, "."); {
+	case parts[0] == defaultProfileKey && len(parts) == 2:
+		flagKey = parts[1]
+	case parts[0] == profilesKey && len(parts) == 3:
+		if err := verifyContextName(parts[1]); err != nil {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid profile name %q: must start with a lowercase letter or digit and contain only lowercase letters, digits, '-' or '_'", parts[1]))
+		}
+		flagKey = parts[2]
+	case parts[0] == defaultProfileKey || parts[0] == profilesKey:
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid key %q, expected %s.<flag> or %s.<profile>.<flag>", key, defaultProfileKey, profilesKey))
+	default:
+		return nil
+	}
+	if !slices.Contains(profileDefaultKeys(), flagKey) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("unsupported default %q, must be one of: %s", flagKey, strings.Join(profileDefaultKeys(), ", ")))
+	}
+	return nil
+}
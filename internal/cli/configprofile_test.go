@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestConfigProfileDefaults() {
+	defer func() {
+		viper.Set(defaultProfileKey, map[string]interface{}{})
+		viper.Set(profilesKey, map[string]interface{}{})
+		s.NoError(viper.WriteConfig())
+	}()
+
+	// The default profile overrides the project of the configuration
+	_, err := s.runCommand("config set default.project nonexistent-project")
+	s.NoError(err)
+	_, err = s.runCommand("list region")
+	s.Error(err)
+
+	// A selected profile overrides the default profile
+	_, err = s.runCommand("config set profiles.lab.project " + project)
+	s.NoError(err)
+	_, err = s.runCommand("list region --config-profile lab")
+	s.NoError(err)
+
+	out, err := s.runCommand("config get-defaults --config-profile lab")
+	s.NoError(err)
+	s.Regexp(`(?m)^project\s+\|`+project+`\s+\|profile lab`, out)
+	s.Regexp(`(?m)^site\s+\|<none>\s+\|-`, out)
+
+	// The environment overrides the profiles and the command line overrides the environment
+	s.T().Setenv("ORCH_CLI_CONFIG_PROFILE", "lab")
+	_, err = s.runCommand("list region")
+	s.NoError(err)
+	s.T().Setenv("ORCH_CLI_PROJECT", "nonexistent-project")
+	_, err = s.runCommand("list region")
+	s.Error(err)
+	_, err = s.runCommand("list region --project " + project)
+	s.NoError(err)
+
+	out, err = s.runCommand("config get-defaults")
+	s.NoError(err)
+	s.Regexp(`(?m)^project\s+\|nonexistent-project\s+\|env ORCH_CLI_PROJECT`, out)
+
+	// Every other flag, global or of the command, is read from the environment too
+	s.T().Setenv("ORCH_CLI_PROJECT", project)
+	s.T().Setenv("ORCH_CLI_OUTPUT_TYPE", "json")
+	out, err = s.runCommand("list region")
+	s.NoError(err)
+	s.True(strings.HasPrefix(strings.TrimSpace(out), "["), out)
+	_, err = s.runCommand("list region --output-type table")
+	s.NoError(err)
+	s.T().Setenv("ORCH_CLI_OUTPUT_TYPE", "")
+	s.T().Setenv("ORCH_CLI_RETRIES", "many")
+	_, err = s.runCommand("list region")
+	s.EqualError(err, `invalid default "many" for --retries from env ORCH_CLI_RETRIES: strconv.ParseInt: parsing "many": invalid syntax`)
+	s.T().Setenv("ORCH_CLI_RETRIES", "")
+
+	_, err = s.runCommand("list region --config-profile missing")
+	s.EqualError(err, `configuration profile "missing" not found, create it with 'orch-cli config set profiles.missing.<flag> <value>'`)
+
+	_, err = s.runCommand("config set default.output-type json")
+	s.EqualError(err, `unsupported default "output-type", must be one of: cluster-template, os-profile, project, region, site`)
+
+	_, err = s.runCommand("config set profiles.lab x")
+	s.EqualError(err, `invalid key "profiles.lab", expected default.<flag> or profiles.<profile>.<flag>`)
+}
+
+func TestVerifyProfileConfigKey(t *testing.T) {
+	assert.NoError(t, verifyProfileConfigKey("default.site"))
+	assert.NoError(t, verifyProfileConfigKey("profiles.lab-2.cluster-template"))
+	assert.NoError(t, verifyProfileConfigKey(project))
+	assert.Error(t, verifyProfileConfigKey("profiles.Lab.site"))
+}
@@ -0,0 +1,333 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	contextsKey       = "contexts"
+	currentContextKey = "current-context"
+)
+
+const setContextExamples = `# Save the current API endpoint, project and login session as a named context
+orch-cli config set-context dev
+
+# Create or update a context for another orchestrator and project
+orch-cli config set-context prod --api-endpoint https://api.prod.example.com/ --project edge-prod --keycloak https://keycloak.prod.example.com/realms/master
+
+# Serve the read-only commands of a context from a warm standby orchestrator when its API endpoint fails
+orch-cli config set-context prod --fallback-api-endpoint https://api.dr.example.com/`
+
+const useContextExamples = `# Switch to a previously saved context
+orch-cli config use-context prod`
+
+const getContextsExamples = `# List all saved contexts; the active one is marked with '*'
+orch-cli config get-contexts`
+
+const deleteContextExamples = `# Delete a saved context
+orch-cli config delete-context dev`
+
+var contextNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// orchContext is a named combination of orchestrator endpoints, project and login session.
+// The fallback API endpoint, if any, is a warm standby orchestrator the API calls fail over to.
+// Contexts are kept as a list in the viper config so that a rewritten list fully replaces
+// the one read from the config file.
+type orchContext struct {
+	Name                string `mapstructure:"name"`
+	APIEndpoint         string `mapstructure:"api-endpoint"`
+	FallbackAPIEndpoint string `mapstructure:"fallback-api-endpoint"`
+	KeycloakEndpoint    string `mapstructure:"keycloak-endpoint"`
+	Project             string `mapstructure:"project"`
+	Username            string `mapstructure:"username"`
+	ClientID            string `mapstructure:"client-id"`
+	RefreshToken        string `mapstructure:"refresh-token"`
+}
+
+func (c orchContext) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":                     c.Name,
+		apiEndpoint:                c.APIEndpoint,
+		fallbackAPIEndpoint:        c.FallbackAPIEndpoint,
+		auth.KeycloakEndpointField: c.KeycloakEndpoint,
+		project:                    c.Project,
+		auth.UserName:              c.Username,
+		auth.ClientIDField:         c.ClientID,
+		auth.RefreshTokenField:     c.RefreshToken,
+	}
+}
+
+// hasSession reports whether the context carries login credentials.
+func (c orchContext) hasSession() bool {
+	return c.RefreshToken != ""
+}
+
+// captureSession copies the active login session into the context.
+func (c *orchContext) captureSession() {
+	c.KeycloakEndpoint = viper.GetString(auth.KeycloakEndpointField)
+	c.Username = viper.GetString(auth.UserName)
+	c.ClientID = viper.GetString(auth.ClientIDField)
+	c.RefreshToken = viper.GetString(auth.RefreshTokenField)
+}
+
+// activate makes the context the current configuration and login session.
+func (c orchContext) activate() {
+	viper.Set(apiEndpoint, c.APIEndpoint)
+	viper.Set(fallbackAPIEndpoint, c.FallbackAPIEndpoint)
+	viper.Set(project, c.Project)
+	viper.Set(auth.KeycloakEndpointField, c.KeycloakEndpoint)
+	viper.Set(auth.UserName, c.Username)
+	viper.Set(auth.ClientIDField, c.ClientID)
+	viper.Set(auth.RefreshTokenField, c.RefreshToken)
+}
+
+func getContextCommands() []*cobra.Command {
+	return []*cobra.Command{
+		getSetContextCommand(),
+		getUseContextCommand(),
+		getGetContextsCommand(),
+		getDeleteContextCommand(),
+	}
+}
+
+func getSetContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-context <name> [flags]",
+		Short:   "Create or update a named context",
+		Long:    "Saves the API endpoint, fallback API endpoint, Keycloak endpoint, project and current login session under a name that can later be activated with 'use-context'.",
+		Example: setContextExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runSetContextCommand,
+	}
+	cmd.Flags().String("keycloak", "", "Keycloak OIDC endpoint of the context - defaults to the endpoint of the current login session")
+	return cmd
+}
+
+func getUseContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "use-context <name>",
+		Short:   "Switch to a named context",
+		Example: useContextExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runUseContextCommand,
+	}
+	return cmd
+}
+
+func getGetContextsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get-contexts",
+		Short:   "List all named contexts",
+		Example: getContextsExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runGetContextsCommand,
+	}
+	return cmd
+}
+
+func getDeleteContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-context <name>",
+		Short:   "Delete a named context",
+		Example: deleteContextExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runDeleteContextCommand,
+	}
+	return cmd
+}
+
+// Loads the saved contexts from the configuration
+func loadContexts() ([]orchContext, error) {
+	contexts := []orchContext{}
+	if err := viper.UnmarshalKey(contextsKey, &contexts); err != nil {
+		return nil, fmt.Errorf("unable to read contexts from configuration: %w", err)
+	}
+	return contexts, nil
+}
+
+// Stores the contexts in the configuration and writes it out
+func saveContexts(contexts []orchContext, current string) error {
+	list := make([]interface{}, 0, len(contexts))
+	for _, c := range contexts {
+		list = append(list, c.toMap())
+	}
+	viper.Set(contextsKey, list)
+	viper.Set(currentContextKey, current)
+	return viper.WriteConfig()
+}
+
+func findContext(contexts []orchContext, name string) int {
+	for i, c := range contexts {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func verifyContextName(name string) error {
+	if !contextNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid context name %q: must start with a lowercase letter or digit and contain only lowercase letters, digits, '-' or '_'", name)
+	}
+	return nil
+}
+
+func runSetContextCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := verifyContextName(name); err != nil {
+		return err
+	}
+
+	contexts, err := loadContexts()
+	if err != nil {
+		return err
+	}
+
+	current := viper.GetString(currentContextKey)
+	idx := findContext(contexts, name)
+	if idx >= 0 && name == current {
+		// The active session is newer than the one stored with the context, e.g. after a re-login
+		contexts[idx].captureSession()
+	}
+	if idx < 0 {
+		// A new context starts out as a snapshot of the current configuration and session
+		oc := orchContext{
+			Name:                name,
+			APIEndpoint:         viper.GetString(apiEndpoint),
+			FallbackAPIEndpoint: viper.GetString(fallbackAPIEndpoint),
+			Project:             viper.GetString(project),
+		}
+		oc.captureSession()
+		contexts = append(contexts, oc)
+		idx = len(contexts) - 1
+	}
+	oc := &contexts[idx]
+
+	if cmd.Flags().Changed(apiEndpoint) {
+		oc.APIEndpoint, _ = cmd.Flags().GetString(apiEndpoint)
+	}
+	if cmd.Flags().Changed(fallbackAPIEndpoint) {
+		oc.FallbackAPIEndpoint, _ = cmd.Flags().GetString(fallbackAPIEndpoint)
+	}
+	if cmd.Flags().Changed(project) {
+		oc.Project, _ = cmd.Flags().GetString(project)
+	}
+	if cmd.Flags().Changed("keycloak") {
+		keycloakEp, _ := cmd.Flags().GetString("keycloak")
+		if keycloakEp != oc.KeycloakEndpoint {
+			// Credentials issued by a different identity provider are useless here
+			*oc = orchContext{
+				Name:                oc.Name,
+				APIEndpoint:         oc.APIEndpoint,
+				FallbackAPIEndpoint: oc.FallbackAPIEndpoint,
+				Project:             oc.Project,
+				KeycloakEndpoint:    keycloakEp,
+			}
+		}
+	}
+
+	// Changes to the current context take effect right away
+	if name == current {
+		oc.activate()
+	}
+
+	if err := saveContexts(contexts, current); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Context %q saved.\n", name)
+	return nil
+}
+
+func runUseContextCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	contexts, err := loadContexts()
+	if err != nil {
+		return err
+	}
+
+	idx := findContext(contexts, name)
+	if idx < 0 {
+		return fmt.Errorf("context %q not found", name)
+	}
+
+	// Remember the session of the context being left, or kept, e.g. after a re-login
+	if current := findContext(contexts, viper.GetString(currentContextKey)); current >= 0 {
+		contexts[current].captureSession()
+	}
+
+	oc := contexts[idx]
+	oc.activate()
+
+	if err := saveContexts(contexts, name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q.\n", name)
+	if !oc.hasSession() {
+		fmt.Fprintf(cmd.OutOrStdout(), "Context %q has no login session. Use 'orch-cli login' to authenticate.\n", name)
+	}
+	return nil
+}
+
+func runGetContextsCommand(cmd *cobra.Command, _ []string) error {
+	writer, _ := getOutputContext(cmd)
+
+	contexts, err := loadContexts()
+	if err != nil {
+		return err
+	}
+	if len(contexts) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No contexts configured")
+		return nil
+	}
+
+	current := viper.GetString(currentContextKey)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", "Current", "Name", "API Endpoint", "Fallback API Endpoint", "Project", "User")
+	for _, c := range contexts {
+		marker := ""
+		if c.Name == current {
+			marker = "*"
+		}
+		user := c.Username
+		if !c.hasSession() {
+			user = "<none>"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", marker, c.Name, c.APIEndpoint, valueOrNone(&c.FallbackAPIEndpoint), valueOrNone(&c.Project), user)
+	}
+	return writer.Flush()
+}
+
+func runDeleteContextCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	contexts, err := loadContexts()
+	if err != nil {
+		return err
+	}
+
+	idx := findContext(contexts, name)
+	if idx < 0 {
+		return fmt.Errorf("context %q not found", name)
+	}
+	contexts = append(contexts[:idx], contexts[idx+1:]...)
+
+	current := viper.GetString(currentContextKey)
+	if current == name {
+		current = ""
+	}
+
+	if err := saveContexts(contexts, current); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Context %q deleted.\n", name)
+	return nil
+}
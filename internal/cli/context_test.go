@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/spf13/viper"
+)
+
+func (s *CLITestSuite) TestContexts() {
+	savedEndpoint := viper.GetString(apiEndpoint)
+	savedProject := viper.GetString(project)
+	defer func() {
+		viper.Set(contextsKey, []interface{}{})
+		viper.Set(currentContextKey, "")
+		viper.Set(apiEndpoint, savedEndpoint)
+		viper.Set(project, savedProject)
+		viper.Set(fallbackAPIEndpoint, "")
+		s.NoError(viper.WriteConfig())
+	}()
+
+	out, err := s.runCommand("config get-contexts")
+	s.NoError(err)
+	s.Contains(out, "No contexts configured")
+
+	// A new context snapshots the login session of the test suite
+	_, err = s.runCommand("config set-context dev --project dev-project")
+	s.NoError(err)
+
+	_, err = s.runCommand("config set-context prod --project prod-project --keycloak https://keycloak.prod.example.com/realms/master --fallback-api-endpoint https://api.dr.example.com/")
+	s.NoError(err)
+
+	_, err = s.runCommand("config set-context Bad.Name")
+	s.EqualError(err, `invalid context name "Bad.Name": must start with a lowercase letter or digit and contain only lowercase letters, digits, '-' or '_'`)
+
+	out, err = s.runCommand("config use-context prod")
+	s.NoError(err)
+	s.Contains(out, `Switched to context "prod".`)
+	s.Contains(out, `Context "prod" has no login session.`)
+	s.Equal("prod-project", viper.GetString(project))
+	s.Equal(apiTest, viper.GetString(apiEndpoint))
+	s.Equal("https://api.dr.example.com/", viper.GetString(fallbackAPIEndpoint))
+	s.Equal("https://keycloak.prod.example.com/realms/master", viper.GetString(auth.KeycloakEndpointField))
+	s.Empty(viper.GetString(auth.RefreshTokenField))
+
+	out, err = s.runCommand("config get-contexts")
+	s.NoError(err)
+	expected := listCommandOutput{
+		{
+			"Current":               "",
+			"Name":                  "dev",
+			"API Endpoint":          apiTest,
+			"Fallback API Endpoint": "<none>",
+			"Project":               "dev-project",
+			"User":                  "u",
+		},
+		{
+			"Current":               "*",
+			"Name":                  "prod",
+			"API Endpoint":          apiTest,
+			"Fallback API Endpoint": "https://api.dr.example.com/",
+			"Project":               "prod-project",
+			"User":                  "<none>",
+		},
+	}
+	s.compareListOutput(expected, mapListOutput(out))
+
+	_, err = s.runCommand("config use-context dev")
+	s.NoError(err)
+	s.Equal("dev-project", viper.GetString(project))
+	s.Empty(viper.GetString(fallbackAPIEndpoint))
+	s.Equal(kcTest, viper.GetString(auth.KeycloakEndpointField))
+	s.NotEmpty(viper.GetString(auth.RefreshTokenField))
+
+	// Switching to the current context keeps a session newer than the one it stored
+	refreshToken := viper.GetString(auth.RefreshTokenField)
+	defer viper.Set(auth.RefreshTokenField, refreshToken)
+	viper.Set(auth.RefreshTokenField, "relogin-token")
+	_, err = s.runCommand("config use-context dev")
+	s.NoError(err)
+	s.Equal("relogin-token", viper.GetString(auth.RefreshTokenField))
+
+	// Changes to the current context take effect right away, without dropping the session
+	_, err = s.runCommand("config set-context dev --project other-project --fallback-api-endpoint https://api.dr.example.com/")
+	s.NoError(err)
+	s.Equal("other-project", viper.GetString(project))
+	s.Equal("https://api.dr.example.com/", viper.GetString(fallbackAPIEndpoint))
+	s.Equal("relogin-token", viper.GetString(auth.RefreshTokenField))
+
+	_, err = s.runCommand("config use-context missing")
+	s.EqualError(err, `context "missing" not found`)
+
+	_, err = s.runCommand("config delete-context dev")
+	s.NoError(err)
+
+	contexts, err := loadContexts()
+	s.NoError(err)
+	s.Len(contexts, 1)
+	s.Equal("prod", contexts[0].Name)
+	s.Empty(viper.GetString(currentContextKey))
+}
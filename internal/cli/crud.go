@@ -6,11 +6,11 @@ package cli
 import (
 	"fmt"
 
-	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/spf13/cobra"
 )
 
 var (
+	alertAliases             = []string{"alert", "alerts", "alrt", "alrts"}
 	amtAliases               = []string{"amtprofile", "amtprofiles", "amt", "amts"}
 	applicationAliases       = []string{"application", "applications", "app", "apps"}
 	artifactAliases          = []string{"artifact", "artifacts", "art", "arts"}
@@ -23,6 +23,8 @@ var (
 	deploymentAliases        = []string{"deployment", "deployments", "dep", "deps"}
 	featuresAliases          = []string{"feature", "features", "feat", "feats"}
 	hostAliases              = []string{"host", "hosts", "hs"}
+	instanceAliases          = []string{"instance", "instances", "inst", "insts"}
+	maintenanceAliases       = []string{"maintenance", "maint"}
 	osProfileAliases         = []string{"osprofile", "osprofiles", "osp", "osps"}
 	organizationAliases      = []string{"organization", "organizations", "org", "orgs"}
 	osUpdatePolicyAliases    = []string{"osupdatepolicy", "osupdatepolicies", "oup", "oups"}
@@ -34,8 +36,9 @@ var (
 	regionAliases            = []string{"region", "regions", "regn", "regns"}
 	siteAliases              = []string{"site", "sites", "st", "sts"}
 	scheduleAliases          = []string{"schedule", "schedules", "sch", "schs"}
-	sshKeyAliases            = []string{"sshkey", "sshkeys", "ssh", "sshs"}
+	sshKeyAliases            = []string{"sshkey", "sshkeys", "ssh", "sshs", "localaccount", "localaccounts"}
 	userAliases              = []string{"user", "users", "usr", "usrs"}
+	workloadAliases          = []string{"workload", "workloads", "wl", "wls"}
 	groupAliases             = []string{"group", "groups", "grp", "grps"}
 )
 
@@ -44,7 +47,7 @@ func getCreateCommand() *cobra.Command {
 		Use:               "create",
 		Args:              cobra.MinimumNArgs(1),
 		Short:             "Create various orchestrator service entities",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -71,6 +74,7 @@ func getCreateCommand() *cobra.Command {
 
 	// Cluster related commands
 	addCommandIfFeatureEnabled(cmd, getCreateClusterCommand(), ClusterOrchFeature)
+	addCommandIfFeatureEnabled(cmd, getCreateClusterTemplateCommand(), ClusterOrchFeature)
 
 	// Day2 related commands
 	addCommandIfFeatureEnabled(cmd, getCreateOSUpdatePolicyCommand(), Day2Feature)
@@ -104,7 +108,7 @@ func getListCommand() *cobra.Command {
 		Use:               "list",
 		Aliases:           []string{"ls", "show"},
 		Short:             "List various orchestrator service entities",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -150,6 +154,7 @@ func getListCommand() *cobra.Command {
 	addCommandIfFeatureEnabled(catalogListRootCmd, getListSiteCommand(), ProvisioningFeature)
 	addCommandIfFeatureEnabled(catalogListRootCmd, getListProviderCommand(), ProvisioningFeature)
 	addCommandIfFeatureEnabled(catalogListRootCmd, getListSSHKeyCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(catalogListRootCmd, getListWorkloadCommand(), ProvisioningFeature)
 
 	// Out of Band Management related commands
 	addCommandIfFeatureEnabled(catalogListRootCmd, getListAmtProfileCommand(), OobFeature)
@@ -164,6 +169,7 @@ func getListCommand() *cobra.Command {
 
 	// Observability related commands
 	addCommandIfFeatureEnabled(catalogListRootCmd, getListMetricNamesCommand(), EdgeNodeObservabilityFeature)
+	addCommandIfFeatureEnabled(catalogListRootCmd, getListAlertCommand(), OrchestratorObservabilityFeature)
 
 	return catalogListRootCmd
 }
@@ -172,7 +178,7 @@ func getGetCommand() *cobra.Command {
 	catalogGetRootCmd := &cobra.Command{
 		Use:               "get",
 		Short:             "Get various orchestrator service entities",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -197,6 +203,8 @@ func getGetCommand() *cobra.Command {
 
 	// Cluster related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetClusterCommand(), ClusterOrchFeature)
+	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetClusterTemplateCommand(), ClusterOrchFeature)
+	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetKubeconfigCommand(), ClusterOrchFeature)
 
 	// Day2 related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetScheduleCommand(), Day2Feature)
@@ -205,6 +213,7 @@ func getGetCommand() *cobra.Command {
 
 	// Onboarding related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetHostCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetMetadataCommand(), OnboardingFeature)
 
 	// Provisioning related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetOSProfileCommand(), ProvisioningFeature)
@@ -213,6 +222,7 @@ func getGetCommand() *cobra.Command {
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetSiteCommand(), ProvisioningFeature)
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetProviderCommand(), ProvisioningFeature)
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetSSHKeyCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetWorkloadCommand(), ProvisioningFeature)
 
 	// Out of Band Management related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetAmtProfileCommand(), OobFeature)
@@ -226,6 +236,7 @@ func getGetCommand() *cobra.Command {
 
 	// Observability related commands
 	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetMetricCommand(), EdgeNodeObservabilityFeature)
+	addCommandIfFeatureEnabled(catalogGetRootCmd, getGetAlertCommand(), OrchestratorObservabilityFeature)
 
 	return catalogGetRootCmd
 }
@@ -235,7 +246,7 @@ func getSetCommand() *cobra.Command {
 		Use:               "set",
 		Aliases:           []string{"update"},
 		Short:             "Update various orchestrator service entities",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -261,6 +272,10 @@ func getSetCommand() *cobra.Command {
 	// Onboarding related commands
 	addCommandIfFeatureEnabled(cmd, getSetHostCommand(), OnboardingFeature)
 
+	// Provisioning related commands
+	addCommandIfFeatureEnabled(cmd, getSetProviderCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(cmd, getSetInstanceCommand(), ProvisioningFeature)
+
 	// Day2 related commands
 	addCommandIfFeatureEnabled(cmd, getSetScheduleCommand(), Day2Feature)
 
@@ -273,7 +288,7 @@ func getUpgradeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "upgrade",
 		Short:             "Upgrade deployment",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -295,7 +310,7 @@ func getDeleteCommand() *cobra.Command {
 	catalogDeleteRootCmd := &cobra.Command{
 		Use:               "delete",
 		Short:             "Delete various orchestrator service entities",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -4,20 +4,19 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"regexp"
-	"strings"
 
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -33,16 +32,25 @@ orch-cli list customconfig --project some-project
 `
 
 const getCustomConfigExamples = `# Get detailed information about specific custom config (Cloud Init) resource using it's name
-orch-cli get customconfig myconfig --project some-project`
+orch-cli get customconfig myconfig --project some-project
+
+# Get a custom config (Cloud Init) resource using it's resource ID
+orch-cli get customconfig customconfig-1234abcd --project some-project`
 
 const createCustomConfigExamples = `# Create a custom config (Cloud Init) resource with a given name using cloud init file as input
 orch-cli create customconfig myconfig /path/to/cloudinit.yaml  --project some-project
 
 # Create a Cloud Init resource with an optional description 
-orch-cli create customconfig myconfig /path/to/cloudinit.yaml  --project some-project --description "This is a cloud init"`
+orch-cli create customconfig myconfig /path/to/cloudinit.yaml  --project some-project --description "This is a cloud init"
+
+# Create a custom config whose cloud-init is not checked, e.g. one using keys of a newer cloud-init release
+orch-cli create customconfig myconfig /path/to/cloudinit.yaml  --project some-project --skip-validation`
 
 const deleteCustomConfigExamples = `#Delete a custom config (Cloud Init) resource using it's name
-orch-cli delete customconfig myconfig --project some-project`
+orch-cli delete customconfig myconfig --project some-project
+
+#Delete a custom config (Cloud Init) resource using it's resource ID
+orch-cli delete customconfig customconfig-1234abcd --project some-project`
 
 func printCustomConfigs(cmd *cobra.Command, writer io.Writer, customConfigs *[]infra.CustomConfigResource, orderBy *string, outputFilter *string, verbose bool, forList bool) error {
 	outputType, _ := cmd.Flags().GetString("output-type")
@@ -70,6 +78,9 @@ func printCustomConfigs(cmd *cobra.Command, writer io.Writer, customConfigs *[]i
 		NameLimit: -1,
 		Data:      *customConfigs,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -102,27 +113,60 @@ func verifyName(n string) error {
 	return errors.New("input is not an alphanumeric single word")
 }
 
-// readCustomConfigFromYaml reads the contents of a YAML file and returns it as a string.
-func readCustomConfigFromYaml(path string) (string, error) {
+// readCustomConfigFromYaml reads the contents of a YAML file and returns it as a string, along with the warnings
+// about its cloud-init. The cloud-init is not checked when skipValidation is set.
+func readCustomConfigFromYaml(path string, skipValidation bool) (string, []string, error) {
 
 	if err := isSafePath(path); err != nil {
-		return "", err
+		return "", nil, err
 	}
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	if len(data) > 1<<20 { // 1MB limit
-		return "", fmt.Errorf("YAML file too large")
+		return "", nil, fmt.Errorf("YAML file too large")
 	}
-	if !strings.HasPrefix(strings.TrimSpace(string(data)), "#cloud-config") {
-		return "", fmt.Errorf("file does not start with #cloud-config")
+	if skipValidation {
+		return string(data), nil, nil
 	}
-	var out interface{}
-	if err := yaml.Unmarshal(data, &out); err != nil {
-		return "", fmt.Errorf("invalid YAML: %w", err)
+	warnings, err := lintCloudInit(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w (use --skip-validation to create the custom config anyway)", err)
 	}
-	return string(data), nil
+	return string(data), warnings, nil
+}
+
+// customConfigResourceIDPattern matches custom config resource IDs: "customconfig-" followed by 8 hex chars.
+var customConfigResourceIDPattern = regexp.MustCompile(`^customconfig-[0-9a-f]{8}$`)
+
+func isCustomConfigResourceID(s string) bool {
+	return customConfigResourceIDPattern.MatchString(s)
+}
+
+// Retrieves a custom config given by resource ID or by name
+func getCustomConfigByNameOrID(ctx context.Context, customConfigClient infra.ClientWithResponsesInterface, projectName string, query string) (*infra.CustomConfigResource, error) {
+	if isCustomConfigResourceID(query) {
+		resp, err := customConfigClient.CustomConfigServiceGetCustomConfigWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting Cloud Init config"); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	}
+
+	nameFilter := fmt.Sprintf("name=%q", query)
+	resp, err := customConfigClient.CustomConfigServiceListCustomConfigsWithResponse(ctx, projectName,
+		&infra.CustomConfigServiceListCustomConfigsParams{Filter: &nameFilter}, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting Cloud Init config"); err != nil {
+		return nil, err
+	}
+	return filterCustomConfigsByName(resp.JSON200.CustomConfigs, query)
 }
 
 // Filters list of pcustom configs to find one with specific name
@@ -137,7 +181,7 @@ func filterCustomConfigsByName(CustomConfigs []infra.CustomConfigResource, name
 
 func getGetCustomConfigCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "customconfig <name> [flags]",
+		Use:     "customconfig <name|resourceID> [flags]",
 		Short:   "Get a Cloud Init configuration",
 		Example: getCustomConfigExamples,
 		Args:    cobra.ExactArgs(1),
@@ -171,12 +215,13 @@ func getCreateCustomConfigCommand() *cobra.Command {
 		RunE:    runCreateCustomConfigCommand,
 	}
 	cmd.PersistentFlags().StringP("description", "d", viper.GetString("description"), "Optional flag used to provide a description to a cloud init config resource")
+	cmd.Flags().Bool("skip-validation", false, "Create the custom config without checking that it is well-formed cloud-init")
 	return cmd
 }
 
 func getDeleteCustomConfigCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "customconfig <name> [flags]",
+		Use:     "customconfig <name|resourceID> [flags]",
 		Short:   "Delete a Cloud Init config",
 		Example: deleteCustomConfigExamples,
 		Args:    cobra.ExactArgs(1),
@@ -194,20 +239,12 @@ func runGetCustomConfigCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	resp, err := customConfigClient.CustomConfigServiceListCustomConfigsWithResponse(ctx, projectName,
-		&infra.CustomConfigServiceListCustomConfigsParams{}, auth.AddAuthHeader)
+	cConfig, err := getCustomConfigByNameOrID(ctx, customConfigClient, projectName, args[0])
 	if err != nil {
-		return processError(err)
-	}
-
-	if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, true,
-		"", "error getting Cloud Init config"); !proceed {
 		return err
 	}
 
-	name := args[0]
-	cConfig, err := filterCustomConfigsByName(resp.JSON200.CustomConfigs, name)
-	if err != nil {
+	if selected, err := printSelectedOutput(cmd, *cConfig); selected {
 		return err
 	}
 
@@ -398,10 +435,14 @@ func runCreateCustomConfigCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	config, err := readCustomConfigFromYaml(path)
+	skipValidation, _ := cmd.Flags().GetBool("skip-validation")
+	config, warnings, err := readCustomConfigFromYaml(path, skipValidation)
 	if err != nil {
 		return err
 	}
+	for _, warning := range warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+	}
 
 	ctx, customConfigClient, projectName, err := InfraFactory(cmd)
 	if err != nil {
@@ -429,17 +470,7 @@ func runDeleteCustomConfigCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	gresp, err := customConfigClient.CustomConfigServiceListCustomConfigsWithResponse(ctx, projectName,
-		&infra.CustomConfigServiceListCustomConfigsParams{}, auth.AddAuthHeader)
-	if err != nil {
-		return processError(err)
-	}
-
-	if err = checkResponse(gresp.HTTPResponse, gresp.Body, "Error getting custom configs"); err != nil {
-		return err
-	}
-
-	cConfig, err := filterCustomConfigsByName(gresp.JSON200.CustomConfigs, name)
+	cConfig, err := getCustomConfigByNameOrID(ctx, customConfigClient, projectName, name)
 	if err != nil {
 		return err
 	}
@@ -53,6 +53,18 @@ func (s *CLITestSuite) TestCustomConfig() {
 	_, err = s.createCustomConfig(project, name, path, CArgs)
 	s.NoError(err)
 
+	//cloud-init without the #cloud-config header is rejected unless its validation is skipped
+	_, err = s.createCustomConfig(project, name, "./testdata/cloudinit-noheader.yaml", CArgs)
+	s.EqualError(err, "file does not start with #cloud-config (use --skip-validation to create the custom config anyway)")
+	_, err = s.createCustomConfig(project, name, "./testdata/cloudinit-noheader.yaml", commandArgs{"skip-validation": ""})
+	s.NoError(err)
+
+	//common mistakes are warned about
+	out, err := s.createCustomConfig(project, name, "./testdata/cloudinit-warnings.yaml", CArgs)
+	s.NoError(err)
+	s.Contains(out, `Warning: unknown top-level key "write-files" is ignored by cloud-init, did you mean "write_files"?`)
+	s.Contains(out, "Warning: runcmd must be a list, not a string")
+
 	CArgs = map[string]string{
 		"description": "test",
 	}
@@ -120,6 +132,17 @@ func (s *CLITestSuite) TestCustomConfig() {
 	s.Equal("haproxy configuration for web services", parsedOutput["Description:"], "Description should match")
 	s.Contains(parsedOutput, "Cloud Init:", "Should contain Cloud Init field")
 
+	//get custom config by resource ID
+	getOutput, err = s.getCustomConfig(project, "customconfig-abc12345", make(map[string]string))
+	s.NoError(err)
+	parsedOutput = mapGetOutput(getOutput)
+	s.Equal("haproxy-config", parsedOutput["Name:"], "Name should match")
+	s.Equal("customconfig-abc12345", parsedOutput["Resource ID:"], "Resource ID should match")
+
+	//get non existing custom config by resource ID
+	_, err = s.getCustomConfig(project, "customconfig-00000000", make(map[string]string))
+	s.EqualError(err, "error getting Cloud Init config: Not Found")
+
 	/////////////////////////////
 	// Test Custom Config Delete
 	/////////////////////////////
@@ -128,6 +151,10 @@ func (s *CLITestSuite) TestCustomConfig() {
 	_, err = s.deleteCustomConfig(project, name, make(map[string]string))
 	s.NoError(err)
 
+	//delete custom config by resource ID
+	_, err = s.deleteCustomConfig(project, "customconfig-abc12345", make(map[string]string))
+	s.NoError(err)
+
 	//delete invalid cusotm config
 	_, err = s.deleteCustomConfig(project, "nonexistent-config", make(map[string]string))
 	s.EqualError(err, "no custom config matches the given name")
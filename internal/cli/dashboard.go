@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultDashboardInterval = 10 * time.Second
+	defaultDashboardFailures = 10
+)
+
+const dashboardExamples = `# Watch the hosts of a project, refreshing every 10 seconds; press q to quit, r to refresh, p to pause,
+# Tab to move between the panels and Enter to open the details of the selected host or OS update run
+orch-cli dashboard --project some-project
+
+# Refresh every minute
+orch-cli dashboard --project some-project --interval 1m
+
+# Print the dashboard once, e.g. from a script or when the output is not a terminal
+orch-cli dashboard --project some-project --once
+`
+
+// dashboardData is what a frame of the dashboard shows
+type dashboardData struct {
+	summary       hostSummary
+	failures      []dashboardFailure
+	totalFailures int
+	runs          []infra.OSUpdateRun
+	showRuns      bool
+}
+
+// dashboardFailure is a host whose onboarding or provisioning failed
+type dashboardFailure struct {
+	host   infra.HostResource
+	status string
+	since  time.Time
+}
+
+func getDashboardCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dashboard [flags]",
+		Short: "Shows a live overview of the hosts of a project",
+		Long: "Shows the number of hosts per status, the hosts whose onboarding or provisioning failed most recently " +
+			"and the OS update runs in progress in an interactive terminal view, refreshed periodically. " +
+			"Keys: q or Esc quits, r refreshes, p pauses and resumes the refreshes, Tab and Shift+Tab move between the panels, " +
+			"the arrow keys select a row and Enter opens the details of the selected host or OS update run. " +
+			"The dashboard is printed once when --once is set or the output is not a terminal.",
+		Example:           dashboardExamples,
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: checkAuth,
+		RunE:              runDashboardCommand,
+	}
+	cmd.Flags().Duration("interval", defaultDashboardInterval, "Time between two refreshes")
+	cmd.Flags().Int("failures", defaultDashboardFailures, "Maximum number of failed hosts shown")
+	cmd.Flags().Bool("once", false, "Print the dashboard once and exit")
+	return cmd
+}
+
+func runDashboardCommand(cmd *cobra.Command, _ []string) error {
+	interval, _ := cmd.Flags().GetDuration("interval")
+	maxFailures, _ := cmd.Flags().GetInt("failures")
+	once, _ := cmd.Flags().GetBool("once")
+
+	if interval < time.Second {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be at least 1s"))
+	}
+	if maxFailures < 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--failures cannot be negative"))
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if once || !isTerminal(out) {
+		data, err := collectDashboard(ctx, client, projectName, maxFailures)
+		if err != nil {
+			return err
+		}
+		return renderDashboard(cmd, out, projectName, data, nil, time.Now())
+	}
+
+	// Ctrl+C is a key of the terminal view, which quits it like q
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM)
+	defer stop()
+	view := newDashboardView(projectName, interval, isFeatureEnabled(Day2Feature))
+	return view.run(ctx, func(ctx context.Context) (*dashboardData, error) {
+		return collectDashboard(ctx, client, projectName, maxFailures)
+	})
+}
+
+// Fetches the hosts and, when Day2 operations are enabled, the OS update runs of the project
+func collectDashboard(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, maxFailures int) (*dashboardData, error) {
+	hosts := make([]infra.HostResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return 0, false, err
+		}
+		hosts = append(hosts, resp.JSON200.Hosts...)
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	failures := dashboardFailures(hosts)
+	data := &dashboardData{
+		summary:       summarizeHosts(hosts, "status"),
+		totalFailures: len(failures),
+		failures:      failures[:min(len(failures), maxFailures)],
+	}
+
+	if !isFeatureEnabled(Day2Feature) {
+		return data, nil
+	}
+	data.showRuns = true
+	var runs []infra.OSUpdateRun
+	err = listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.OSUpdateRunListOSUpdateRunWithResponse(ctx, projectName,
+			&infra.OSUpdateRunListOSUpdateRunParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Update Runs"); err != nil {
+			return 0, false, err
+		}
+		runs = append(runs, resp.JSON200.OsUpdateRuns...)
+		return len(resp.JSON200.OsUpdateRuns), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data.runs = runsInProgress(runs)
+	return data, nil
+}
+
+// Returns the hosts whose onboarding or provisioning failed, the most recent failure first
+func dashboardFailures(hosts []infra.HostResource) []dashboardFailure {
+	var failures []dashboardFailure
+	for _, h := range hosts {
+		switch {
+		case h.Instance != nil && h.Instance.ProvisioningStatusIndicator != nil &&
+			*h.Instance.ProvisioningStatusIndicator == infra.STATUSINDICATIONERROR:
+			failures = append(failures, dashboardFailure{
+				host:   h,
+				status: valueOrNone(h.Instance.ProvisioningStatus),
+				since:  unixTimestamp(h.Instance.ProvisioningStatusTimestamp),
+			})
+		case h.OnboardingStatusIndicator != nil && *h.OnboardingStatusIndicator == infra.STATUSINDICATIONERROR:
+			failures = append(failures, dashboardFailure{
+				host:   h,
+				status: valueOrNone(h.OnboardingStatus),
+				since:  unixTimestamp(h.OnboardingStatusTimestamp),
+			})
+		}
+	}
+	sort.SliceStable(failures, func(i, j int) bool { return failures[i].since.After(failures[j].since) })
+	return failures
+}
+
+// Returns the OS update runs that have started and not ended, the most recently started first
+func runsInProgress(runs []infra.OSUpdateRun) []infra.OSUpdateRun {
+	var inProgress []infra.OSUpdateRun
+	for _, run := range runs {
+		if run.StatusIndicator != nil {
+			if *run.StatusIndicator == infra.STATUSINDICATIONINPROGRESS {
+				inProgress = append(inProgress, run)
+			}
+			continue
+		}
+		if run.StartTime != nil && *run.StartTime > 0 && (run.EndTime == nil || *run.EndTime == 0) {
+			inProgress = append(inProgress, run)
+		}
+	}
+	sort.SliceStable(inProgress, func(i, j int) bool {
+		return unixTimestamp(inProgress[i].StartTime).After(unixTimestamp(inProgress[j].StartTime))
+	})
+	return inProgress
+}
+
+func unixTimestamp(seconds *int) time.Time {
+	if seconds == nil || *seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(*seconds), 0)
+}
+
+func formatDashboardTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(scheduleDisplayTimeFormat)
+}
+
+// Writes a frame of the dashboard; data is nil when no refresh has succeeded yet
+func renderDashboard(cmd *cobra.Command, w io.Writer, projectName string, data *dashboardData, refreshErr error, now time.Time) error {
+	fmt.Fprintf(w, "Project %s - %s\n", projectName, now.UTC().Format(scheduleDisplayTimeFormat))
+	if refreshErr != nil {
+		fmt.Fprintf(w, "Refresh failed: %v\n", refreshErr)
+	}
+	if data == nil {
+		return nil
+	}
+	fmt.Fprintln(w)
+	if err := printHostSummary(cmd, w, data.summary); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nFailed onboarding or provisioning (%d):\n", data.totalFailures)
+	if len(data.failures) == 0 {
+		fmt.Fprintln(w, "  None")
+	} else {
+		writer := newOutputWriter(cmd, w)
+		fmt.Fprintf(writer, "  NAME\tRESOURCE ID\tSTATUS\tSINCE\n")
+		for _, f := range data.failures {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", f.host.Name, derefString(f.host.ResourceId), f.status, formatDashboardTime(f.since))
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if more := data.totalFailures - len(data.failures); more > 0 {
+			fmt.Fprintf(w, "  ... and %d more\n", more)
+		}
+	}
+
+	if !data.showRuns {
+		return nil
+	}
+	fmt.Fprintf(w, "\nOS update runs in progress (%d):\n", len(data.runs))
+	if len(data.runs) == 0 {
+		fmt.Fprintln(w, "  None")
+		return nil
+	}
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "  NAME\tRESOURCE ID\tINSTANCE\tPOLICY\tSTARTED\tSTATUS\n")
+	for _, run := range data.runs {
+		instance, policy := dashboardRunTargets(run)
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", valueOrNone(run.Name), derefString(run.ResourceId), instance, policy,
+			formatDashboardTime(unixTimestamp(run.StartTime)), valueOrNone(run.Status))
+	}
+	return writer.Flush()
+}
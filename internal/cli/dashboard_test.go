@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestDashboard() {
+	out, err := s.runCommand("dashboard --once --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Project "+project+" - ")
+	s.Regexp(`(?m)^Hosts:\s+\|1$`, out)
+	s.Regexp(`(?m)^Running\s+\|1\s+\|1\s+\|0`, out)
+	s.Contains(out, "Failed onboarding or provisioning (0):\n  None\n")
+	s.Contains(out, "OS update runs in progress (0):\n  None\n")
+
+	_, err = s.runCommand("dashboard --once --interval 100ms --project " + project)
+	s.EqualError(err, "--interval must be at least 1s")
+
+	_, err = s.runCommand("dashboard --once --project nonexistent-project")
+	s.Error(err)
+}
+
+func TestDashboardFailures(t *testing.T) {
+	errorIndicator := infra.STATUSINDICATIONERROR
+	older, newer := 1700000000, 1700000600
+	hosts := []infra.HostResource{
+		{Name: "healthy", HostStatus: stringPtr("Running")},
+		{
+			Name:                      "onboarding-failed",
+			OnboardingStatus:          stringPtr("Onboarding failed"),
+			OnboardingStatusIndicator: &errorIndicator,
+			OnboardingStatusTimestamp: &older,
+		},
+		{
+			Name: "provisioning-failed",
+			Instance: &infra.InstanceResource{
+				ProvisioningStatus:          stringPtr("Provisioning failed"),
+				ProvisioningStatusIndicator: &errorIndicator,
+				ProvisioningStatusTimestamp: &newer,
+			},
+		},
+	}
+
+	failures := dashboardFailures(hosts)
+	if assert.Len(t, failures, 2) {
+		assert.Equal(t, "provisioning-failed", failures[0].host.Name)
+		assert.Equal(t, "Provisioning failed", failures[0].status)
+		assert.Equal(t, "onboarding-failed", failures[1].host.Name)
+	}
+}
+
+func TestRunsInProgress(t *testing.T) {
+	inProgress, completed := infra.STATUSINDICATIONINPROGRESS, infra.STATUSINDICATIONIDLE
+	started, ended := 1700000000, 1700000600
+	runs := []infra.OSUpdateRun{
+		{Name: stringPtr("indicated"), StatusIndicator: &inProgress, StartTime: &started},
+		{Name: stringPtr("done"), StatusIndicator: &completed, StartTime: &started, EndTime: &ended},
+		{Name: stringPtr("not-ended"), StartTime: &ended},
+		{Name: stringPtr("ended"), StartTime: &started, EndTime: &ended},
+	}
+
+	result := runsInProgress(runs)
+	if assert.Len(t, result, 2) {
+		assert.Equal(t, "not-ended", *result[0].Name)
+		assert.Equal(t, "indicated", *result[1].Name)
+	}
+}
+
+// Returns the text shown on a simulated screen, one line per row
+func simulationScreenText(screen tcell.SimulationScreen) string {
+	cells, width, _ := screen.GetContents()
+	var text strings.Builder
+	for i, cell := range cells {
+		if len(cell.Runes) > 0 {
+			text.WriteString(string(cell.Runes))
+		} else {
+			text.WriteByte(' ')
+		}
+		if (i+1)%width == 0 {
+			text.WriteByte('\n')
+		}
+	}
+	return text.String()
+}
+
+func TestDashboardView(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+
+	errorIndicator := infra.STATUSINDICATIONERROR
+	failedAt := 1700000000
+	data := &dashboardData{
+		summary: hostSummary{hostCounts: hostCounts{Total: 2, Running: 1, Error: 1}},
+		failures: []dashboardFailure{{
+			host: infra.HostResource{Name: "edge-[7]", ResourceId: stringPtr("host-abcd1234"), SerialNumber: stringPtr("SN-42"),
+				OnboardingStatusIndicator: &errorIndicator},
+			status: "Onboarding failed",
+			since:  time.Unix(int64(failedAt), 0),
+		}},
+		totalFailures: 1,
+		showRuns:      true,
+	}
+	var refreshes atomic.Int32
+	collect := func(context.Context) (*dashboardData, error) {
+		if refreshes.Add(1) == 2 {
+			return nil, errors.New("error while retrieving hosts: 503 Service Unavailable")
+		}
+		return data, nil
+	}
+
+	view := newDashboardView("itep", time.Hour, true)
+	view.app.SetScreen(screen)
+	done := make(chan error, 1)
+	go func() { done <- view.run(context.Background(), collect) }()
+
+	shows := func(text string) func() bool {
+		return func() bool { return strings.Contains(simulationScreenText(screen), text) }
+	}
+	require.Eventually(t, shows("Failed onboarding or provisioning (1)"), 5*time.Second, 10*time.Millisecond)
+	// The application initializes the screen at 80x25, widen it to show the whole footer
+	screen.SetSize(140, 30)
+	require.NoError(t, screen.PostEvent(tcell.NewEventResize(140, 30)))
+	require.Eventually(t, shows("refreshing every 1h0m0s"), 5*time.Second, 10*time.Millisecond)
+	text := simulationScreenText(screen)
+	assert.Contains(t, text, "Project itep - refreshed at ")
+	assert.Contains(t, text, "Hosts (2)")
+	assert.Contains(t, text, "edge-[7]")
+	assert.Contains(t, text, "OS update runs in progress (0)")
+
+	// A failed refresh keeps showing the previous data
+	screen.InjectKey(tcell.KeyRune, 'r', tcell.ModNone)
+	require.Eventually(t, shows("Refresh failed: error while retrieving hosts: 503 Service Unavailable"), 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, simulationScreenText(screen), "edge-[7]")
+
+	screen.InjectKey(tcell.KeyRune, 'p', tcell.ModNone)
+	require.Eventually(t, shows("paused"), 5*time.Second, 10*time.Millisecond)
+
+	// Enter opens the details of the selected failed host, Esc closes them without quitting
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	require.Eventually(t, shows("Serial number: SN-42"), 5*time.Second, 10*time.Millisecond)
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	require.Eventually(t, func() bool { return !shows("Serial number: SN-42")() }, 5*time.Second, 10*time.Millisecond)
+
+	screen.InjectKey(tcell.KeyRune, 'q', tcell.ModNone)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the dashboard did not quit on q")
+	}
+}
@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/rivo/tview"
+)
+
+const (
+	dashboardDetailsPage = "details"
+	dashboardMainPage    = "main"
+)
+
+// Keys of the interactive dashboard, shown in its footer
+const dashboardKeys = "q quit  r refresh  p pause  Tab next panel  ↑↓ select  Enter details"
+
+// dashboardCollector fetches the data shown by a refresh of the dashboard
+type dashboardCollector func(ctx context.Context) (*dashboardData, error)
+
+// dashboardView is the interactive dashboard: the host counts per status, the failed hosts and the OS update runs
+// in progress are panels the keys move between, and Enter opens the details of the selected host or run. The data
+// is refreshed every interval, on r, and not while paused.
+type dashboardView struct {
+	app         *tview.Application
+	pages       *tview.Pages
+	header      *tview.TextView
+	hosts       *tview.Table
+	failures    *tview.Table
+	runs        *tview.Table
+	footer      *tview.TextView
+	panels      []*tview.Table
+	projectName string
+	interval    time.Duration
+
+	// Last data collected, nil until a refresh succeeds
+	data      *dashboardData
+	refreshed time.Time
+	// Read by the refresh loop, set by the keys
+	paused  atomic.Bool
+	refresh chan struct{}
+}
+
+func newDashboardView(projectName string, interval time.Duration, showRuns bool) *dashboardView {
+	v := &dashboardView{
+		app:         tview.NewApplication(),
+		pages:       tview.NewPages(),
+		header:      tview.NewTextView().SetDynamicColors(true),
+		hosts:       newDashboardTable(),
+		failures:    newDashboardTable(),
+		runs:        newDashboardTable(),
+		footer:      tview.NewTextView().SetDynamicColors(true),
+		projectName: projectName,
+		interval:    interval,
+		refresh:     make(chan struct{}, 1),
+	}
+	v.failures.SetSelectedFunc(func(row, _ int) { v.showFailure(row) })
+	v.runs.SetSelectedFunc(func(row, _ int) { v.showRun(row) })
+
+	top := tview.NewFlex().
+		AddItem(v.hosts, 0, 1, false).
+		AddItem(v.failures, 0, 2, true)
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.header, 2, 0, false).
+		AddItem(top, 0, 1, true)
+	v.panels = []*tview.Table{v.failures, v.hosts}
+	if showRuns {
+		layout.AddItem(v.runs, 0, 1, false)
+		v.panels = append(v.panels, v.runs)
+	}
+	layout.AddItem(v.footer, 1, 0, false)
+	v.pages.AddPage(dashboardMainPage, layout, true, true)
+
+	v.app.SetRoot(v.pages, true).SetFocus(v.failures)
+	v.app.SetInputCapture(v.handleKey)
+	v.update(nil, nil, time.Time{})
+	return v
+}
+
+func newDashboardTable() *tview.Table {
+	table := tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	table.SetBorder(true)
+	return table
+}
+
+// Runs the dashboard until q is pressed or ctx is done, refreshing it from collect in the background
+func (v *dashboardView) run(ctx context.Context, collect dashboardCollector) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go v.refreshLoop(ctx, collect)
+	go func() {
+		<-ctx.Done()
+		v.app.Stop()
+	}()
+	return v.app.Run()
+}
+
+// Collects the data right away, then every interval unless paused, or when r is pressed
+func (v *dashboardView) refreshLoop(ctx context.Context, collect dashboardCollector) {
+	ticker := time.NewTicker(v.interval)
+	defer ticker.Stop()
+	for {
+		data, err := collect(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		now := time.Now()
+		v.app.QueueUpdateDraw(func() { v.update(data, err, now) })
+
+		for waiting := true; waiting; {
+			select {
+			case <-ctx.Done():
+				return
+			case <-v.refresh:
+				waiting = false
+			case <-ticker.C:
+				waiting = v.paused.Load()
+			}
+		}
+	}
+}
+
+func (v *dashboardView) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	// The details dialog handles its own keys
+	if front, _ := v.pages.GetFrontPage(); front == dashboardDetailsPage {
+		return event
+	}
+	switch {
+	case event.Key() == tcell.KeyEscape || event.Rune() == 'q':
+		v.app.Stop()
+	case event.Rune() == 'r':
+		select {
+		case v.refresh <- struct{}{}:
+		default:
+		}
+	case event.Rune() == 'p':
+		v.paused.Store(!v.paused.Load())
+		v.updateFooter()
+	case event.Key() == tcell.KeyTab:
+		v.focusPanel(1)
+	case event.Key() == tcell.KeyBacktab:
+		v.focusPanel(-1)
+	default:
+		return event
+	}
+	return nil
+}
+
+// Moves the focus to the next panel, or the previous one when step is negative
+func (v *dashboardView) focusPanel(step int) {
+	current := 0
+	for i, panel := range v.panels {
+		if panel.HasFocus() {
+			current = i
+		}
+	}
+	next := (current + step + len(v.panels)) % len(v.panels)
+	v.app.SetFocus(v.panels[next])
+}
+
+// Shows the data of a refresh; a failed refresh keeps showing the previous data along with the error
+func (v *dashboardView) update(data *dashboardData, refreshErr error, now time.Time) {
+	if refreshErr == nil && data != nil {
+		v.data, v.refreshed = data, now
+	}
+
+	header := fmt.Sprintf("[::b]Project %s[::-]", tview.Escape(v.projectName))
+	if !v.refreshed.IsZero() {
+		header += " - refreshed at " + v.refreshed.UTC().Format(scheduleDisplayTimeFormat)
+	}
+	if refreshErr != nil {
+		header += fmt.Sprintf("\n[red]Refresh failed: %s[-]", tview.Escape(refreshErr.Error()))
+	} else if v.data == nil {
+		header += "\nLoading..."
+	}
+	v.header.SetText(header)
+	v.updateFooter()
+
+	if v.data == nil {
+		setDashboardTable(v.hosts, " Hosts ", []string{"STATUS", "HOSTS"}, nil)
+		setDashboardTable(v.failures, " Failed onboarding or provisioning ", []string{"NAME", "RESOURCE ID", "STATUS", "SINCE"}, nil)
+		setDashboardTable(v.runs, " OS update runs in progress ", []string{"NAME", "RESOURCE ID", "INSTANCE", "POLICY", "STARTED", "STATUS"}, nil)
+		return
+	}
+	setDashboardTable(v.hosts, fmt.Sprintf(" Hosts (%d) ", v.data.summary.Total), []string{"STATUS", "HOSTS"}, dashboardHostRows(v.data.summary))
+	setDashboardTable(v.failures, fmt.Sprintf(" Failed onboarding or provisioning (%d) ", v.data.totalFailures),
+		[]string{"NAME", "RESOURCE ID", "STATUS", "SINCE"}, dashboardFailureRows(v.data.failures))
+	setDashboardTable(v.runs, fmt.Sprintf(" OS update runs in progress (%d) ", len(v.data.runs)),
+		[]string{"NAME", "RESOURCE ID", "INSTANCE", "POLICY", "STARTED", "STATUS"}, dashboardRunRows(v.data.runs))
+}
+
+func (v *dashboardView) updateFooter() {
+	state := fmt.Sprintf("refreshing every %s", v.interval)
+	if v.paused.Load() {
+		state = "[yellow]paused[-]"
+	}
+	v.footer.SetText(dashboardKeys + "  |  " + state)
+}
+
+// dashboardRow is a row of a panel, in the color of the state it shows
+type dashboardRow struct {
+	cells []string
+	color tcell.Color
+}
+
+// Replaces the rows of a panel and keeps the selection on the same row, or the last one
+func setDashboardTable(table *tview.Table, title string, headers []string, rows []dashboardRow) {
+	selected, _ := table.GetSelection()
+	table.Clear()
+	table.SetTitle(title)
+	for col, header := range headers {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAttributes(tcell.AttrBold).SetSelectable(false).SetExpansion(1))
+	}
+	for i, row := range rows {
+		for col, text := range row.cells {
+			cell := tview.NewTableCell(tview.Escape(text)).SetExpansion(1)
+			if row.color != tcell.ColorDefault {
+				cell.SetTextColor(row.color)
+			}
+			table.SetCell(i+1, col, cell)
+		}
+	}
+	if len(rows) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("None").SetSelectable(false))
+		return
+	}
+	table.Select(max(1, min(selected, len(rows))), 0)
+}
+
+// Returns the number of hosts in each state, then in each status
+func dashboardHostRows(summary hostSummary) []dashboardRow {
+	rows := []dashboardRow{
+		{cells: []string{"Running", fmt.Sprint(summary.Running)}, color: tcell.ColorGreen},
+		{cells: []string{"Provisioning", fmt.Sprint(summary.Provisioning)}, color: tcell.ColorYellow},
+		{cells: []string{"Error", fmt.Sprint(summary.Error)}, color: tcell.ColorRed},
+		{cells: []string{"Deauthorized", fmt.Sprint(summary.Deauthorized)}, color: tcell.ColorDefault},
+		{cells: []string{"Other", fmt.Sprint(summary.Other)}, color: tcell.ColorDefault},
+	}
+	for _, group := range summary.Groups {
+		color := tcell.ColorDefault
+		switch {
+		case group.Error > 0:
+			color = tcell.ColorRed
+		case group.Provisioning > 0:
+			color = tcell.ColorYellow
+		case group.Running > 0:
+			color = tcell.ColorGreen
+		}
+		rows = append(rows, dashboardRow{cells: []string{"  " + group.Name, fmt.Sprint(group.Total)}, color: color})
+	}
+	return rows
+}
+
+func dashboardFailureRows(failures []dashboardFailure) []dashboardRow {
+	rows := make([]dashboardRow, 0, len(failures))
+	for _, f := range failures {
+		rows = append(rows, dashboardRow{
+			cells: []string{f.host.Name, derefString(f.host.ResourceId), f.status, formatDashboardTime(f.since)},
+			color: tcell.ColorRed,
+		})
+	}
+	return rows
+}
+
+func dashboardRunRows(runs []infra.OSUpdateRun) []dashboardRow {
+	rows := make([]dashboardRow, 0, len(runs))
+	for _, run := range runs {
+		instance, policy := dashboardRunTargets(run)
+		rows = append(rows, dashboardRow{
+			cells: []string{valueOrNone(run.Name), derefString(run.ResourceId), instance, policy,
+				formatDashboardTime(unixTimestamp(run.StartTime)), valueOrNone(run.Status)},
+			color: tcell.ColorDefault,
+		})
+	}
+	return rows
+}
+
+// Returns the instance and the policy of an OS update run, "-" when unknown
+func dashboardRunTargets(run infra.OSUpdateRun) (string, string) {
+	instance, policy := "-", "-"
+	if run.Instance != nil && derefString(run.Instance.ResourceId) != "" {
+		instance = *run.Instance.ResourceId
+	}
+	if run.AppliedPolicy != nil && run.AppliedPolicy.Name != "" {
+		policy = run.AppliedPolicy.Name
+	}
+	return instance, policy
+}
+
+// Opens the details of the failed host of a row of the failures panel
+func (v *dashboardView) showFailure(row int) {
+	if v.data == nil || row < 1 || row > len(v.data.failures) {
+		return
+	}
+	f := v.data.failures[row-1]
+	details := [][2]string{
+		{"Name", f.host.Name},
+		{"Resource ID", derefString(f.host.ResourceId)},
+		{"Status", f.status},
+		{"Since", formatDashboardTime(f.since)},
+		{"Host status", valueOrNone(f.host.HostStatus)},
+		{"Onboarding", valueOrNone(f.host.OnboardingStatus)},
+		{"Serial number", valueOrNone(f.host.SerialNumber)},
+		{"UUID", valueOrNone(f.host.Uuid)},
+	}
+	if f.host.Site != nil {
+		details = append(details, [2]string{"Site", valueOrNone(f.host.Site.Name)})
+	}
+	if f.host.Instance != nil {
+		details = append(details, [2]string{"Provisioning", valueOrNone(f.host.Instance.ProvisioningStatus)})
+	}
+	v.showDetails(v.failures, details)
+}
+
+// Opens the details of the OS update run of a row of the runs panel
+func (v *dashboardView) showRun(row int) {
+	if v.data == nil || row < 1 || row > len(v.data.runs) {
+		return
+	}
+	run := v.data.runs[row-1]
+	instance, policy := dashboardRunTargets(run)
+	v.showDetails(v.runs, [][2]string{
+		{"Name", valueOrNone(run.Name)},
+		{"Resource ID", derefString(run.ResourceId)},
+		{"Instance", instance},
+		{"Policy", policy},
+		{"Started", formatDashboardTime(unixTimestamp(run.StartTime))},
+		{"Status", valueOrNone(run.Status)},
+		{"Details", valueOrNone(run.StatusDetails)},
+	})
+}
+
+// Shows the fields in a dialog closed by Enter or Esc, which gives the focus back to the panel
+func (v *dashboardView) showDetails(panel *tview.Table, fields [][2]string) {
+	lines := make([]string, 0, len(fields))
+	for _, field := range fields {
+		lines = append(lines, field[0]+": "+tview.Escape(field[1]))
+	}
+	modal := tview.NewModal().
+		SetText(strings.Join(lines, "\n")).
+		AddButtons([]string{"Close"}).
+		SetDoneFunc(func(int, string) {
+			v.pages.RemovePage(dashboardDetailsPage)
+			v.app.SetFocus(panel)
+		})
+	v.pages.AddPage(dashboardDetailsPage, modal, true, true)
+	v.app.SetFocus(modal)
+}
@@ -4,6 +4,9 @@
 package cli
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -146,6 +149,8 @@ func getExportDeploymentPackageCommand() *cobra.Command {
 		Use:     "deployment-package <name> [<version>] [flags]",
 		Aliases: deploymentPackageAliases,
 		Short:   "Export a deployment package as a tarball",
+		Long: "Downloads a deployment package with its applications, profiles and artifacts as a tarball, which " +
+			"import deployment-package creates in another project or orchestrator.",
 		Args:    cobra.ExactArgs(2),
 		Example: "orch-cli export deployment-package my-package 0.1.1 --project some-project",
 		RunE:    runExportDeploymentPackageCommand,
@@ -154,6 +159,25 @@ func getExportDeploymentPackageCommand() *cobra.Command {
 	return cmd
 }
 
+const importDeploymentPackageExamples = `# Promote a deployment package from a staging orchestrator to a production one
+orch-cli export deployment-package my-package 0.1.1 --project staging --api-endpoint https://api.staging.example.com
+orch-cli import deployment-package my-package-0.1.1.tar.gz --project production --api-endpoint https://api.example.com`
+
+func getImportDeploymentPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployment-package <tarball> [flags]",
+		Aliases: deploymentPackageAliases,
+		Short:   "Import a deployment package tarball created by export deployment-package",
+		Long: "Uploads the files of a tarball created by export deployment-package to the catalog of the project, " +
+			"which creates the deployment package together with its applications, profiles, artifacts and registries. " +
+			"The catalog creates all of them or none.",
+		Args:    cobra.ExactArgs(1),
+		Example: importDeploymentPackageExamples,
+		RunE:    runImportDeploymentPackageCommand,
+	}
+	return cmd
+}
+
 func getDeploymentPackageOutputFormat(cmd *cobra.Command, verbose bool) (string, error) {
 	if verbose {
 		return resolveTableOutputTemplate(cmd, DEFAULT_DEPLOYMENT_PACKAGE_INSPECT_FORMAT, DEPLOYMENT_PACKAGE_INSPECT_TEMPLATE_ENVVAR)
@@ -187,6 +211,9 @@ func printDeploymentPackages(cmd *cobra.Command, writer io.Writer, caList *[]cat
 		NameLimit: -1,
 		Data:      *caList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -598,6 +625,10 @@ func runGetDeploymentPackageCommand(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no versions of deployment package %s found", name)
 		}
 	}
+	if selected, err := printSelectedOutput(cmd, deploymentPkgs); selected {
+		return err
+	}
+
 	if err := printDeploymentPackages(cmd, writer, &deploymentPkgs, nil, nil, verbose); err != nil {
 		return err
 	}
@@ -819,3 +850,86 @@ func runExportDeploymentPackageCommand(cmd *cobra.Command, args []string) error
 
 	return nil
 }
+
+// Largest file of a deployment package tarball that will be uploaded
+const maxDeploymentPackageFileSize = 16 << 20
+
+// Reads the regular files of a gzipped or plain tarball, in their order in the tarball
+func readDeploymentPackageTarball(path string) ([]catapi.CatalogV3Upload, error) {
+	data, err := readInput(path)
+	if err != nil {
+		return nil, err
+	}
+	var reader io.Reader = bytes.NewReader(data)
+	if gz, err := gzip.NewReader(bytes.NewReader(data)); err == nil {
+		defer gz.Close()
+		reader = gz
+	}
+
+	var files []catapi.CatalogV3Upload
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployment package tarball %s: %w", path, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > maxDeploymentPackageFileSize {
+			return nil, fmt.Errorf("file %s of %s exceeds %d bytes", header.Name, path, maxDeploymentPackageFileSize)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployment package tarball %s: %w", path, err)
+		}
+		files = append(files, catapi.CatalogV3Upload{FileName: header.Name, Artifact: content})
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("deployment package tarball %s contains no files", path)
+	}
+	return files, nil
+}
+
+func runImportDeploymentPackageCommand(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	files, err := readDeploymentPackageTarball(path)
+	if err != nil {
+		return err
+	}
+	ctx, catalogClient, projectName, err := CatalogFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	// The files are uploaded in one session; the catalog creates the entities once the last one is received
+	var sessionID *string
+	for i, file := range files {
+		uploadNumber := i + 1
+		lastUpload := uploadNumber == len(files)
+		resp, err := catalogClient.CatalogServiceUploadCatalogEntitiesWithResponse(ctx, projectName,
+			&catapi.CatalogServiceUploadCatalogEntitiesParams{
+				SessionId:    sessionID,
+				UploadNumber: &uploadNumber,
+				LastUpload:   &lastUpload,
+			}, file, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error uploading %s of deployment package %s", file.FileName, path)); err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("error uploading %s of deployment package %s: empty response", file.FileName, path)
+		}
+		if messages := resp.JSON200.ErrorMessages; messages != nil && len(*messages) > 0 {
+			return fmt.Errorf("error importing deployment package %s: %s", path, strings.Join(*messages, "; "))
+		}
+		sessionID = &resp.JSON200.SessionId
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Deployment package imported from %s (%d files)\n", path, len(files))
+	return nil
+}
@@ -4,8 +4,14 @@
 package cli
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func (s *CLITestSuite) createDeploymentPackage(project string, applicationName string, applicationVersion string, args commandArgs) error {
@@ -253,6 +259,54 @@ func (s *CLITestSuite) TestDeploymentPackage() {
 	// TODO not viable to mock at this time - just testing if command call works, not the actual export logic
 }
 
+// Writes a gzipped tarball of the files, in the order given
+func writeTestTarball(t *testing.T, names ...string) string {
+	path := filepath.Join(t.TempDir(), "package.tar.gz")
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	defer file.Close()
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "package/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for _, name := range names {
+		content := []byte("specSchema: Application\n")
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write(content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gz.Close())
+	return path
+}
+
+func (s *CLITestSuite) TestImportDeploymentPackage() {
+	tarball := writeTestTarball(s.T(), "package/app.yaml", "package/package.yaml")
+	out, err := s.runCommand("import deployment-package " + tarball + " --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Deployment package imported from "+tarball+" (2 files)")
+
+	tarball = writeTestTarball(s.T(), "app.yaml", "invalid.yaml")
+	_, err = s.runCommand("import deployment-package " + tarball + " --project " + project)
+	s.EqualError(err, "error importing deployment package "+tarball+": invalid.yaml: unknown specSchema")
+
+	tarball = writeTestTarball(s.T())
+	_, err = s.runCommand("import deployment-package " + tarball + " --project " + project)
+	s.EqualError(err, "deployment package tarball "+tarball+" contains no files")
+}
+
+func TestReadDeploymentPackageTarball(t *testing.T) {
+	files, err := readDeploymentPackageTarball(writeTestTarball(t, "b.yaml", "a.yaml"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "b.yaml", files[0].FileName)
+	assert.Equal(t, []byte("specSchema: Application\n"), files[0].Artifact)
+
+	notTar := filepath.Join(t.TempDir(), "package.tar.gz")
+	assert.NoError(t, os.WriteFile(notTar, []byte("not a tarball at all, just some text that is long enough"), 0o600))
+	_, err = readDeploymentPackageTarball(notTar)
+	assert.Error(t, err)
+}
+
 func FuzzDeploymentPackage(f *testing.F) {
 	// Seed with valid and invalid input combinations
 	f.Add("pubtest", "deployment-pkg", "1.0.0", "app1", "1.0.0", "display.name", "desc")
@@ -122,6 +122,9 @@ func printDeploymentProfiles(cmd *cobra.Command, writer io.Writer, profileList *
 		NameLimit: -1,
 		Data:      *profileList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -243,6 +246,10 @@ func runGetDeploymentProfileCommand(cmd *cobra.Command, args []string) error {
 	pkg := resp.JSON200.DeploymentPackage
 	for _, profile := range *pkg.Profiles {
 		if profile.Name == profileName {
+			if selected, err := printSelectedOutput(cmd, profile); selected {
+				return err
+			}
+
 			if err := printDeploymentProfiles(cmd, writer, &[]catapi.CatalogV3DeploymentProfile{profile}, nil, verbose); err != nil {
 				return err
 			}
@@ -12,7 +12,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/open-edge-platform/cli/internal/validator"
 	"github.com/open-edge-platform/cli/pkg/auth"
@@ -138,7 +137,7 @@ func getDeploymentOutputFormat(cmd *cobra.Command, verbose bool) (string, error)
 	return resolveTableOutputTemplate(cmd, DEFAULT_DEPLOYMENT_FORMAT, DEPLOYMENT_OUTPUT_TEMPLATE_ENVVAR)
 }
 
-func printDeployments(cmd *cobra.Command, writer *tabwriter.Writer, deployments *[]depapi.DeploymentV1Deployment, orderBy *string, outputFilter *string, verbose bool) error {
+func printDeployments(cmd *cobra.Command, writer *format.TableWriter, deployments *[]depapi.DeploymentV1Deployment, orderBy *string, outputFilter *string, verbose bool) error {
 	outputType, _ := cmd.Flags().GetString("output-type")
 	outputFormat, err := getDeploymentOutputFormat(cmd, verbose)
 	if err != nil {
@@ -163,6 +162,9 @@ func printDeployments(cmd *cobra.Command, writer *tabwriter.Writer, deployments
 		NameLimit: -1,
 		Data:      *deployments,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -659,6 +661,10 @@ func runGetDeploymentCommand(cmd *cobra.Command, args []string) error {
 		"", fmt.Sprintf("error getting deployment %s", query)); !proceed {
 		return err
 	}
+	if selected, err := printSelectedOutput(cmd, resp.JSON200.Deployment); selected {
+		return err
+	}
+
 	if err := printDeployments(cmd, writer, &[]depapi.DeploymentV1Deployment{resp.JSON200.Deployment}, nil, nil, verbose); err != nil {
 		return err
 	}
@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/internal/validator"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const diffHostsExamples = `# Show what re-applying a host CSV file would change in the project
+orch-cli diff host --project some-project --import-from-csv test.csv
+
+# Sample output
++ Serial number : 2500JF3  UUID : 4c4c4544-2046-5310-8052-cac04f515233  new host
+~ Serial number : 2500JF4  UUID : 4c4c4544-2046-5310-8052-cac04f515234  host-1234abcd
+    site: site-a (site-7ca0a77c) -> site-b (site-c69a3c81)
+    security: SECURITY_FEATURE_NONE -> SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION
+= Serial number : 2500JF5  UUID : 4c4c4544-2046-5310-8052-cac04f515235  host-5678abcd
+1 to create, 1 to update, 1 unchanged, 0 invalid
+`
+
+type hostDiffAction string
+
+const (
+	hostDiffCreate    hostDiffAction = "+"
+	hostDiffUpdate    hostDiffAction = "~"
+	hostDiffUnchanged hostDiffAction = "="
+	hostDiffInvalid   hostDiffAction = "!"
+)
+
+// hostFieldChange is a single attribute whose live value differs from the CSV record
+type hostFieldChange struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+// hostDiff is the outcome of comparing one CSV record with the live host
+type hostDiff struct {
+	Record  types.HostRecord
+	HostID  string
+	Action  hostDiffAction
+	Changes []hostFieldChange
+	Error   string
+}
+
+// hostDesiredState holds the resources a CSV record resolves to
+type hostDesiredState struct {
+	OSProfile  infra.OperatingSystemResource
+	Site       infra.SiteResource
+	Security   infra.SecurityFeature
+	CloudInits []infra.CustomConfigResource
+}
+
+func getDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare input files with the live state of Edge Orchestrator, or two of its resources",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getDiffHostCommand(),
+		getDiffOSProfileCommand(),
+	)
+	return cmd
+}
+
+func getDiffHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "host [flags]",
+		Short:   "Shows what importing a host CSV file would change in the project",
+		Example: diffHostsExamples,
+		Aliases: hostAliases,
+		Args:    cobra.NoArgs,
+		RunE:    runDiffHostCommand,
+	}
+	cmd.Flags().StringP("import-from-csv", "i", "", "CSV file containing information about provisioned hosts (mandatory)")
+	_ = cmd.MarkFlagRequired("import-from-csv")
+	return cmd
+}
+
+// Compares each record of a host CSV file with the matching host of the project
+func runDiffHostCommand(cmd *cobra.Command, _ []string) error {
+	csvFilePath, _ := cmd.Flags().GetString("import-from-csv")
+
+	if err := verifyCSVInput(csvFilePath); err != nil {
+		return err
+	}
+
+	records, err := validator.CheckCSV(csvFilePath, types.HostRecord{}, true)
+	if err != nil {
+		return err
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	respCache := ResponseCache{
+		OSProfileCache: make(map[string]infra.OperatingSystemResource),
+		SiteCache:      make(map[string]infra.SiteResource),
+		CICache:        make(map[string]infra.CustomConfigResource),
+	}
+
+	diffs := make([]hostDiff, 0, len(records))
+	for _, record := range records {
+		desired, err := resolveHostDesiredState(ctx, hostClient, projectName, record, respCache)
+		if err != nil {
+			diffs = append(diffs, hostDiff{Record: record, Action: hostDiffInvalid, Error: err.Error()})
+			continue
+		}
+
+		host, err := findHostForRecord(ctx, hostClient, projectName, record)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, diffHostRecord(record, host, desired))
+	}
+
+	printHostDiffs(cmd.OutOrStdout(), diffs)
+
+	for _, d := range diffs {
+		if d.Action == hostDiffInvalid {
+			return e.NewCustomError(e.ErrCheckFailed)
+		}
+	}
+	return nil
+}
+
+// Resolves the named resources of a record the same way the import does
+func resolveHostDesiredState(ctx context.Context, hClient infra.ClientWithResponsesInterface, projectName string,
+	record types.HostRecord, respCache ResponseCache,
+) (*hostDesiredState, error) {
+	// Failures are reported per record by the caller, the resolvers' own bookkeeping is not needed
+	erringRecords := []types.HostRecord{}
+
+	if _, err := resolveOSProfile(ctx, hClient, projectName, record.OSProfile, "", record, respCache, &erringRecords); err != nil {
+		return nil, err
+	}
+	if err := validateSecurityFeature(record.OSProfile, "", record.Secure, record, respCache, &erringRecords); err != nil {
+		return nil, err
+	}
+	if _, err := resolveSite(ctx, hClient, projectName, record.Site, "", record, respCache, &erringRecords); err != nil {
+		return nil, err
+	}
+	if _, err := resolveCloudInit(ctx, hClient, projectName, record.CloudInitMeta, "", record, respCache, &erringRecords); err != nil {
+		return nil, err
+	}
+
+	desired := &hostDesiredState{
+		OSProfile: respCache.OSProfileCache[record.OSProfile],
+		Site:      respCache.SiteCache[record.Site],
+	}
+
+	// Mirrors the security feature selection done when the instance is created
+	desired.Security = infra.SECURITYFEATURENONE
+	if record.Secure == types.SecureTrue && desired.OSProfile.SecurityFeature != nil {
+		desired.Security = *desired.OSProfile.SecurityFeature
+	}
+
+	if record.CloudInitMeta != "" {
+		for _, cloudInit := range *breakupCloudInitMetadata(record.CloudInitMeta) {
+			desired.CloudInits = append(desired.CloudInits, respCache.CICache[cloudInit])
+		}
+	}
+	return desired, nil
+}
+
+// Finds the host registered with the serial number and UUID of the record, if any
+func findHostForRecord(ctx context.Context, hClient infra.ClientWithResponsesInterface, projectName string,
+	record types.HostRecord,
+) (*infra.HostResource, error) {
+	var conditions []string
+	if record.Serial != "" {
+		conditions = append(conditions, fmt.Sprintf("serialNumber='%s'", record.Serial))
+	}
+	if record.UUID != "" {
+		conditions = append(conditions, fmt.Sprintf("uuid='%s'", record.UUID))
+	}
+	hFilter := strings.Join(conditions, " AND ")
+
+	resp, err := hClient.HostServiceListHostsWithResponse(ctx, projectName,
+		&infra.HostServiceListHostsParams{
+			Filter: &hFilter,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+		return nil, err
+	}
+
+	var match *infra.HostResource
+	for i, host := range resp.JSON200.Hosts {
+		if record.Serial != "" && derefString(host.SerialNumber) != record.Serial {
+			continue
+		}
+		if record.UUID != "" && !strings.EqualFold(derefString(host.Uuid), record.UUID) {
+			continue
+		}
+		if match != nil {
+			return nil, e.NewCustomError(e.ErrHostDetailMismatch)
+		}
+		match = &resp.JSON200.Hosts[i]
+	}
+	return match, nil
+}
+
+// Compares the live host, nil when not registered, with the desired state of the record
+func diffHostRecord(record types.HostRecord, host *infra.HostResource, desired *hostDesiredState) hostDiff {
+	if host == nil {
+		return hostDiff{Record: record, Action: hostDiffCreate}
+	}
+
+	d := hostDiff{Record: record, HostID: derefString(host.ResourceId), Action: hostDiffUnchanged}
+	addChange := func(field, current, desired string) {
+		d.Changes = append(d.Changes, hostFieldChange{Field: field, Current: current, Desired: desired})
+	}
+
+	var currentSite infra.SiteResource
+	if host.Site != nil {
+		currentSite = *host.Site
+	} else if host.SiteId != nil {
+		currentSite.ResourceId = host.SiteId
+	}
+	if !sameResource(currentSite.ResourceId, currentSite.Name, desired.Site.ResourceId, desired.Site.Name) {
+		addChange("site", resourceDisplayName(derefString(currentSite.Name), derefString(currentSite.ResourceId)),
+			resourceDisplayName(derefString(desired.Site.Name), derefString(desired.Site.ResourceId)))
+	}
+
+	instance := host.Instance
+	if instance == nil {
+		instance = &infra.InstanceResource{}
+	}
+
+	var currentOS infra.OperatingSystemResource
+	if instance.Os != nil {
+		currentOS = *instance.Os
+	}
+	if currentOS.ResourceId == nil {
+		currentOS.ResourceId = instance.OsID
+	}
+	if !sameResource(currentOS.ResourceId, currentOS.Name, desired.OSProfile.ResourceId, desired.OSProfile.Name) {
+		addChange("os-profile", resourceDisplayName(derefString(currentOS.Name), derefString(currentOS.ResourceId)),
+			resourceDisplayName(derefString(desired.OSProfile.Name), derefString(desired.OSProfile.ResourceId)))
+	}
+
+	// An instance reported without a security feature runs without one
+	currentSecurity := ""
+	if host.Instance != nil {
+		currentSecurity = string(infra.SECURITYFEATURENONE)
+	}
+	if instance.SecurityFeature != nil && *instance.SecurityFeature != infra.SECURITYFEATUREUNSPECIFIED {
+		currentSecurity = string(*instance.SecurityFeature)
+	}
+	if currentSecurity != string(desired.Security) {
+		addChange("security", displayOrNone(currentSecurity), string(desired.Security))
+	}
+
+	var currentCloudInits []infra.CustomConfigResource
+	if instance.CustomConfig != nil {
+		currentCloudInits = *instance.CustomConfig
+	}
+	currentNames, currentIDs := customConfigKeys(currentCloudInits)
+	desiredNames, desiredIDs := customConfigKeys(desired.CloudInits)
+	if strings.Join(currentIDs, "&") != strings.Join(desiredIDs, "&") {
+		addChange("cloud-init", displayOrNone(strings.Join(currentNames, "&")), displayOrNone(strings.Join(desiredNames, "&")))
+	}
+
+	if len(d.Changes) > 0 {
+		d.Action = hostDiffUpdate
+	}
+	return d
+}
+
+// Two resources are the same when their IDs match, or their names when an ID is missing
+func sameResource(currentID *string, currentName *string, desiredID *string, desiredName *string) bool {
+	if currentID != nil && desiredID != nil {
+		return *currentID == *desiredID
+	}
+	return derefString(currentName) != "" && derefString(currentName) == derefString(desiredName)
+}
+
+func resourceDisplayName(name, id string) string {
+	switch {
+	case name == "" && id == "":
+		return "<none>"
+	case name == "" || name == id:
+		return id
+	case id == "":
+		return name
+	default:
+		return fmt.Sprintf("%s (%s)", name, id)
+	}
+}
+
+func displayOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// Returns the sorted names and resource IDs of a list of custom configs
+func customConfigKeys(configs []infra.CustomConfigResource) ([]string, []string) {
+	names := make([]string, 0, len(configs))
+	ids := make([]string, 0, len(configs))
+	for _, config := range configs {
+		names = append(names, config.Name)
+		ids = append(ids, derefString(config.ResourceId))
+	}
+	sort.Strings(names)
+	sort.Strings(ids)
+	return names, ids
+}
+
+func printHostDiffs(w io.Writer, diffs []hostDiff) {
+	counts := map[hostDiffAction]int{}
+	for _, d := range diffs {
+		counts[d.Action]++
+		line := fmt.Sprintf("%s Serial number : %s  UUID : %s", d.Action, d.Record.Serial, d.Record.UUID)
+		switch d.Action {
+		case hostDiffCreate:
+			fmt.Fprintf(w, "%s  new host\n", line)
+		case hostDiffInvalid:
+			fmt.Fprintf(w, "%s  - %s\n", line, d.Error)
+		default:
+			fmt.Fprintf(w, "%s  %s\n", line, d.HostID)
+		}
+		for _, c := range d.Changes {
+			fmt.Fprintf(w, "    %s: %s -> %s\n", c.Field, c.Current, c.Desired)
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d unchanged, %d invalid\n",
+		counts[hostDiffCreate], counts[hostDiffUpdate], counts[hostDiffUnchanged], counts[hostDiffInvalid])
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffHostRecord(t *testing.T) {
+	secure := infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION
+	desired := &hostDesiredState{
+		OSProfile: infra.OperatingSystemResource{ResourceId: stringPtr("os-1234abcd"), Name: stringPtr("Ubuntu 24.04"), SecurityFeature: &secure},
+		Site:      infra.SiteResource{ResourceId: stringPtr("site-c69a3c81"), Name: stringPtr("rack-1")},
+		Security:  secure,
+		CloudInits: []infra.CustomConfigResource{
+			{Name: "proxy", ResourceId: stringPtr("customconfig-2222bbbb")},
+			{Name: "ntp", ResourceId: stringPtr("customconfig-1111aaaa")},
+		},
+	}
+	record := types.HostRecord{Serial: "2500JF3"}
+
+	d := diffHostRecord(record, nil, desired)
+	assert.Equal(t, hostDiffCreate, d.Action)
+	assert.Empty(t, d.Changes)
+
+	host := &infra.HostResource{
+		ResourceId: stringPtr("host-1234abcd"),
+		SiteId:     stringPtr("site-c69a3c81"),
+		Instance: &infra.InstanceResource{
+			OsID:            stringPtr("os-1234abcd"),
+			SecurityFeature: &secure,
+			CustomConfig: &[]infra.CustomConfigResource{
+				{Name: "ntp", ResourceId: stringPtr("customconfig-1111aaaa")},
+				{Name: "proxy", ResourceId: stringPtr("customconfig-2222bbbb")},
+			},
+		},
+	}
+	d = diffHostRecord(record, host, desired)
+	assert.Equal(t, hostDiffUnchanged, d.Action)
+	assert.Equal(t, "host-1234abcd", d.HostID)
+
+	host.Site = &infra.SiteResource{ResourceId: stringPtr("site-7ca0a77c"), Name: stringPtr("rack-2")}
+	host.Instance.SecurityFeature = nil
+	host.Instance.CustomConfig = nil
+	d = diffHostRecord(record, host, desired)
+	assert.Equal(t, hostDiffUpdate, d.Action)
+	assert.Equal(t, []hostFieldChange{
+		{Field: "site", Current: "rack-2 (site-7ca0a77c)", Desired: "rack-1 (site-c69a3c81)"},
+		{Field: "security", Current: "SECURITY_FEATURE_NONE", Desired: "SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION"},
+		{Field: "cloud-init", Current: "<none>", Desired: "ntp&proxy"},
+	}, d.Changes)
+
+	// A registered host without an instance gets all its provisioning fields set
+	host.Instance = nil
+	d = diffHostRecord(record, host, desired)
+	assert.Equal(t, []string{"site", "os-profile", "security", "cloud-init"},
+		[]string{d.Changes[0].Field, d.Changes[1].Field, d.Changes[2].Field, d.Changes[3].Field})
+	assert.Equal(t, "<none>", d.Changes[1].Current)
+}
+
+func TestPrintHostDiffs(t *testing.T) {
+	var out bytes.Buffer
+	printHostDiffs(&out, []hostDiff{
+		{Record: types.HostRecord{Serial: "2500JF3"}, Action: hostDiffCreate},
+		{Record: types.HostRecord{Serial: "2500JF4"}, HostID: "host-1234abcd", Action: hostDiffUpdate,
+			Changes: []hostFieldChange{{Field: "site", Current: "rack-2", Desired: "rack-1"}}},
+		{Record: types.HostRecord{Serial: "2500JF5"}, Action: hostDiffInvalid, Error: "Invalid Site"},
+	})
+	assert.Equal(t, "+ Serial number : 2500JF3  UUID :   new host\n"+
+		"~ Serial number : 2500JF4  UUID :   host-1234abcd\n"+
+		"    site: rack-2 -> rack-1\n"+
+		"! Serial number : 2500JF5  UUID :   - Invalid Site\n"+
+		"1 to create, 1 to update, 0 unchanged, 1 invalid\n", out.String())
+}
+
+func (s *CLITestSuite) TestDiffHost() {
+	out, err := s.runCommand("diff host --project " + project + " --import-from-csv ./testdata/diff.csv")
+	s.NoError(err)
+	s.Contains(out, "~ Serial number : 1234567890  UUID : 550e8400-e29b-41d4-a716-446655440000  host-abc12345\n"+
+		"    site: site (site-abcd1234) -> site (site-7ceae560)\n")
+	s.Contains(out, "+ Serial number : SN123456789  UUID :   new host\n")
+	s.Contains(out, "1 to create, 1 to update, 0 unchanged, 0 invalid\n")
+
+	_, err = s.runCommand("diff host --project " + project + " --import-from-csv ./testdata/mock.lol")
+	s.EqualError(err, "host import input file must be a CSV file")
+}
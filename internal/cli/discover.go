@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/internal/validator"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const (
+	REDFISH_PASSWORD_ENVVAR = "ORCH_CLI_REDFISH_PASSWORD"
+
+	defaultDiscoveryFile        = "discovered_hosts.csv"
+	defaultDiscoveryConcurrency = 32
+	defaultDiscoveryTimeout     = 5 * time.Second
+
+	// Largest range that will be scanned in one go (a /16 network)
+	maxDiscoveryAddresses = 1 << 16
+)
+
+const discoverHostsExamples = `# Scan a range of BMCs over Redfish and write a CSV file ready for 'orch-cli create host --import-from-csv'
+orch-cli discover hosts --bmc-range 10.0.0.0/24 --redfish-user admin --project some-project
+
+# Scan a range of BMCs with self-signed certificates and pre-fill the OS profile and site for all hosts
+orch-cli discover hosts --bmc-range 10.0.0.0/24 --redfish-user admin --bmc-insecure-skip-verify --os-profile "Edge Microvisor Toolkit 3.0.20250617" --site site-c69a3c81 --output rack1.csv
+
+# Scan a range of BMCs and register the discovered hosts directly
+orch-cli discover hosts --bmc-range 10.0.0.0/24 --redfish-user admin --register --project some-project
+
+The Redfish password is read from the --redfish-password flag, the ORCH_CLI_REDFISH_PASSWORD environment variable, or prompted for.
+`
+
+// DiscoveredHost holds the identity of a machine as reported by its BMC
+type DiscoveredHost struct {
+	BMCAddress   string
+	SerialNumber string
+	UUID         string
+	Manufacturer string
+	Model        string
+}
+
+type redfishCollection struct {
+	Members []struct {
+		ODataID string `json:"@odata.id"`
+	} `json:"Members"`
+}
+
+type redfishSystem struct {
+	SerialNumber string `json:"SerialNumber"`
+	UUID         string `json:"UUID"`
+	Manufacturer string `json:"Manufacturer"`
+	Model        string `json:"Model"`
+}
+
+// redfishClient queries the Redfish service of a single BMC
+type redfishClient struct {
+	httpClient *http.Client
+	scheme     string
+	port       int
+	username   string
+	password   string
+}
+
+// RedfishHTTPClientFactory builds the HTTP client used to reach BMCs; can be replaced in tests
+var RedfishHTTPClientFactory = func(insecure bool, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion:         tls.VersionTLS12,
+				InsecureSkipVerify: insecure, //nolint:gosec // BMCs commonly use self-signed certificates; opt-in only
+			},
+		},
+	}
+}
+
+func getDiscoverCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Discover resources outside of Edge Orchestrator",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getDiscoverHostsCommand(),
+	)
+	return cmd
+}
+
+func getDiscoverHostsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hosts [flags]",
+		Short:   "Discovers hosts by querying their BMCs over Redfish",
+		Example: discoverHostsExamples,
+		Aliases: hostAliases,
+		Args:    cobra.NoArgs,
+		RunE:    runDiscoverHostsCommand,
+	}
+	cmd.Flags().String("bmc-range", "", "BMC address or CIDR range to scan, e.g. 10.0.0.0/24 (mandatory)")
+	cmd.Flags().Int("bmc-port", 443, "Port of the Redfish service on the BMCs")
+	cmd.Flags().Bool("bmc-http", false, "Use plain HTTP instead of HTTPS to reach the Redfish service")
+	cmd.Flags().String("redfish-user", "", "Redfish user name (mandatory)")
+	cmd.Flags().String("redfish-password", "", "Redfish password - prefer the ORCH_CLI_REDFISH_PASSWORD environment variable")
+	cmd.Flags().Bool("bmc-insecure-skip-verify", false, "Do not verify the TLS certificates presented by the BMCs; the API endpoint is still verified unless --insecure-skip-verify is given")
+	cmd.Flags().Int("concurrency", defaultDiscoveryConcurrency, "Maximum number of BMCs queried in parallel")
+	cmd.Flags().Duration("bmc-timeout", defaultDiscoveryTimeout, "Timeout for each request to a BMC")
+	cmd.Flags().String("output", defaultDiscoveryFile, "CSV file to write the discovered hosts to")
+	cmd.Flags().String("os-profile", "", "OS profile name or resource ID to set for all discovered hosts")
+	cmd.Flags().String("site", "", "Site resource ID to set for all discovered hosts")
+	cmd.Flags().Bool("register", false, "Register the discovered hosts with Edge Orchestrator after writing the CSV file")
+	_ = cmd.MarkFlagRequired("bmc-range")
+	_ = cmd.MarkFlagRequired("redfish-user")
+	return cmd
+}
+
+// Expands a single address or a CIDR range into the list of host addresses to scan
+func expandBMCRange(bmcRange string) ([]string, error) {
+	bmcRange = strings.TrimSpace(bmcRange)
+	if ip := net.ParseIP(bmcRange); ip != nil {
+		return []string{ip.String()}, nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(bmcRange)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BMC range %q: must be an IP address or CIDR range", bmcRange)
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("invalid BMC range %q: only IPv4 ranges are supported", bmcRange)
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	if bits-ones > 16 {
+		return nil, fmt.Errorf("BMC range %q is too large: at most %d addresses can be scanned", bmcRange, maxDiscoveryAddresses)
+	}
+
+	var addresses []string
+	for cur := ipNet.IP.Mask(ipNet.Mask).To4(); ipNet.Contains(cur); cur = nextIP(cur) {
+		addresses = append(addresses, cur.String())
+	}
+
+	// Skip the network and broadcast addresses unless the range is a point-to-point link or single host
+	if bits-ones >= 2 {
+		addresses = addresses[1 : len(addresses)-1]
+	}
+	return addresses, nil
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func (c *redfishClient) get(ctx context.Context, address string, path string, out interface{}) error {
+	url := fmt.Sprintf("%s://%s%s", c.scheme, net.JoinHostPort(address, fmt.Sprint(c.port)), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxValuesYAMLSize))
+	if err != nil {
+		return err
+	}
+	if err := checkResponse(resp, body, fmt.Sprintf("error querying %s", url)); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+// Queries the computer systems managed by the BMC at the given address
+func (c *redfishClient) discover(ctx context.Context, address string) ([]DiscoveredHost, error) {
+	systems := redfishCollection{}
+	if err := c.get(ctx, address, "/redfish/v1/Systems", &systems); err != nil {
+		return nil, err
+	}
+
+	hosts := make([]DiscoveredHost, 0, len(systems.Members))
+	for _, member := range systems.Members {
+		// Only follow links within the Redfish service
+		if !strings.HasPrefix(member.ODataID, "/redfish/v1/Systems/") {
+			continue
+		}
+		system := redfishSystem{}
+		if err := c.get(ctx, address, member.ODataID, &system); err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, DiscoveredHost{
+			BMCAddress:   address,
+			SerialNumber: strings.TrimSpace(system.SerialNumber),
+			UUID:         strings.ToLower(strings.TrimSpace(system.UUID)),
+			Manufacturer: strings.TrimSpace(system.Manufacturer),
+			Model:        strings.TrimSpace(system.Model),
+		})
+	}
+	return hosts, nil
+}
+
+// Scans all addresses in parallel; addresses without a reachable Redfish service are skipped
+func scanBMCs(ctx context.Context, client *redfishClient, addresses []string, concurrency int, verbose bool, errOut io.Writer) []DiscoveredHost {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		discovered []DiscoveredHost
+	)
+	queue := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for address := range queue {
+				hosts, err := client.discover(ctx, address)
+				mu.Lock()
+				if err != nil {
+					if verbose {
+						fmt.Fprintf(errOut, "Skipping %s: %v\n", address, err)
+					}
+				} else {
+					discovered = append(discovered, hosts...)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, address := range addresses {
+		queue <- address
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.Slice(discovered, func(i, j int) bool {
+		a, b := net.ParseIP(discovered[i].BMCAddress).To4(), net.ParseIP(discovered[j].BMCAddress).To4()
+		if cmp := strings.Compare(string(a), string(b)); cmp != 0 {
+			return cmp < 0
+		}
+		return discovered[i].SerialNumber < discovered[j].SerialNumber
+	})
+	return discovered
+}
+
+// Gets the Redfish password from the flag, the environment, or a prompt
+func getRedfishPassword(cmd *cobra.Command) (string, error) {
+	password, _ := cmd.Flags().GetString("redfish-password")
+	if password != "" {
+		return password, nil
+	}
+	if password = os.Getenv(REDFISH_PASSWORD_ENVVAR); password != "" {
+		return password, nil
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("redfish password is required: use --redfish-password or %s", REDFISH_PASSWORD_ENVVAR)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), "Enter Redfish Password: ")
+	bytePassword, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(cmd.OutOrStdout())
+	if err != nil {
+		return "", err
+	}
+	return string(bytePassword), nil
+}
+
+func printDiscoveredHosts(cmd *cobra.Command, hosts []DiscoveredHost) error {
+	writer, _ := getOutputContext(cmd)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", "BMC Address", "Serial", "UUID", "Manufacturer", "Model")
+	for _, h := range hosts {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", h.BMCAddress, valueOrNone(&h.SerialNumber), valueOrNone(&h.UUID),
+			valueOrNone(&h.Manufacturer), valueOrNone(&h.Model))
+	}
+	return writer.Flush()
+}
+
+func runDiscoverHostsCommand(cmd *cobra.Command, _ []string) error {
+	bmcRange, _ := cmd.Flags().GetString("bmc-range")
+	bmcPort, _ := cmd.Flags().GetInt("bmc-port")
+	useHTTP, _ := cmd.Flags().GetBool("bmc-http")
+	username, _ := cmd.Flags().GetString("redfish-user")
+	insecure, _ := cmd.Flags().GetBool("bmc-insecure-skip-verify")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	timeout, _ := cmd.Flags().GetDuration("bmc-timeout")
+	outputPath, _ := cmd.Flags().GetString("output")
+	osProfileIn, _ := cmd.Flags().GetString("os-profile")
+	siteIn, _ := cmd.Flags().GetString("site")
+	register, _ := cmd.Flags().GetBool("register")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	if !strings.HasSuffix(strings.ToLower(outputPath), ".csv") {
+		return fmt.Errorf("--output requires that file name ends with .csv")
+	}
+	if err := isSafePath(outputPath); err != nil {
+		return err
+	}
+	if bmcPort <= 0 || bmcPort > 65535 {
+		return fmt.Errorf("invalid BMC port %d", bmcPort)
+	}
+
+	if register {
+		// Fail early rather than after a lengthy scan
+		if err := checkAuth(cmd, nil); err != nil {
+			return err
+		}
+	}
+
+	addresses, err := expandBMCRange(bmcRange)
+	if err != nil {
+		return err
+	}
+
+	password, err := getRedfishPassword(cmd)
+	if err != nil {
+		return err
+	}
+
+	scheme := "https"
+	if useHTTP {
+		scheme = "http"
+	}
+	client := &redfishClient{
+		httpClient: RedfishHTTPClientFactory(insecure, timeout),
+		scheme:     scheme,
+		port:       bmcPort,
+		username:   username,
+		password:   password,
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Scanning %d BMC address(es) in %s\n", len(addresses), bmcRange)
+	discovered := scanBMCs(commandContext(cmd), client, addresses, concurrency, verbose, cmd.ErrOrStderr())
+	if len(discovered) == 0 {
+		return errors.New("no hosts discovered")
+	}
+
+	if err := printDiscoveredHosts(cmd, discovered); err != nil {
+		return err
+	}
+
+	records := make([]types.HostRecord, 0, len(discovered))
+	for _, h := range discovered {
+		records = append(records, types.HostRecord{
+			Serial:    h.SerialNumber,
+			UUID:      h.UUID,
+			OSProfile: osProfileIn,
+			Site:      siteIn,
+		})
+	}
+	if err := files.WriteHostRecords(outputPath, records); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%d host(s) written to %s\n", len(records), outputPath)
+
+	if !register {
+		return nil
+	}
+
+	validated, err := validator.CheckCSV(outputPath, types.HostRecord{}, viper.GetBool(ProvisioningFeature))
+	if err != nil {
+		return err
+	}
+
+	erringRecords, _, err := registerHostRecords(cmd, validated, &types.HostRecord{})
+	if err != nil {
+		return err
+	}
+	printImportSummary(cmd.OutOrStdout(), len(validated), erringRecords)
+	if len(erringRecords) > 0 {
+		newFilename := fmt.Sprintf("%s_%s_%s", "import_error", time.Now().Format(time.RFC3339), filepath.Base(outputPath))
+		fmt.Printf("Generating error file: %s\n", newFilename)
+		if err := files.WriteHostRecords(newFilename, erringRecords); err != nil {
+			return e.NewCustomError(e.ErrFileRW)
+		}
+		return e.NewCustomError(e.ErrImportFailed)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandBMCRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		first     string
+		last      string
+		count     int
+		wantError bool
+	}{
+		{name: "single address", input: "10.0.0.5", first: "10.0.0.5", last: "10.0.0.5", count: 1},
+		{name: "single host cidr", input: "10.0.0.5/32", first: "10.0.0.5", last: "10.0.0.5", count: 1},
+		{name: "point to point", input: "10.0.0.4/31", first: "10.0.0.4", last: "10.0.0.5", count: 2},
+		{name: "class c", input: "10.0.0.17/24", first: "10.0.0.1", last: "10.0.0.254", count: 254},
+		{name: "too large", input: "10.0.0.0/8", wantError: true},
+		{name: "ipv6", input: "fd00::/120", wantError: true},
+		{name: "garbage", input: "not-a-range", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addresses, err := expandBMCRange(tt.input)
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, addresses, tt.count)
+			assert.Equal(t, tt.first, addresses[0])
+			assert.Equal(t, tt.last, addresses[len(addresses)-1])
+		})
+	}
+}
+
+func newRedfishTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redfish/v1/Systems", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Members":[{"@odata.id":"/redfish/v1/Systems/1"},{"@odata.id":"https://elsewhere/redfish/v1/Systems/2"}]}`))
+	})
+	mux.HandleFunc("/redfish/v1/Systems/1", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"SerialNumber":" 2500JF3 ","UUID":"4C4C4544-2046-5310-8052-CAC04F515233","Manufacturer":"Dell Inc.","Model":"PowerEdge R650"}`))
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func (s *CLITestSuite) TestDiscoverHosts() {
+	server := newRedfishTestServer()
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	s.NoError(err)
+	outputPath := filepath.Join(s.T().TempDir(), "discovered.csv")
+
+	out, err := s.runCommand(fmt.Sprintf("discover hosts --bmc-range %s --bmc-port %s --bmc-insecure-skip-verify --redfish-user admin --redfish-password secret --site site-c69a3c81 --output %s",
+		serverURL.Hostname(), serverURL.Port(), outputPath))
+	s.NoError(err)
+	s.Contains(out, "1 host(s) written to "+outputPath)
+
+	records, err := files.ReadHostRecords(outputPath)
+	s.NoError(err)
+	s.Len(records, 1)
+	s.Equal("2500JF3", records[0].Serial)
+	s.Equal("4c4c4544-2046-5310-8052-cac04f515233", records[0].UUID)
+	s.Equal("site-c69a3c81", records[0].Site)
+
+	// Wrong credentials make the only BMC unreachable
+	_, err = s.runCommand(fmt.Sprintf("discover hosts --bmc-range %s --bmc-port %s --bmc-insecure-skip-verify --redfish-user admin --redfish-password wrong --output %s",
+		serverURL.Hostname(), serverURL.Port(), outputPath))
+	s.EqualError(err, "no hosts discovered")
+
+	_, err = s.runCommand("discover hosts --bmc-range 10.0.0.0/24 --redfish-user admin --redfish-password secret --output hosts.txt")
+	s.EqualError(err, "--output requires that file name ends with .csv")
+}
+
+func TestDiscoverHostsBMCInsecureSkipVerify(t *testing.T) {
+	api := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer api.Close()
+
+	parse := func(args ...string) *cobra.Command {
+		root := &cobra.Command{Use: "orch-cli"}
+		root.PersistentFlags().Bool(insecureSkipVerifyFlag, false, "insecure")
+		discover := getDiscoverCommand()
+		root.AddCommand(discover)
+		cmd, flags, err := root.Find(append([]string{"discover", "hosts"}, args...))
+		require.NoError(t, err)
+		require.NoError(t, cmd.ParseFlags(flags))
+		return cmd
+	}
+
+	// Skipping the verification of the BMCs leaves the one of the API endpoint the hosts are registered with
+	cmd := parse("--bmc-insecure-skip-verify", "--register")
+	bmcInsecure, _ := cmd.Flags().GetBool("bmc-insecure-skip-verify")
+	assert.True(t, bmcInsecure)
+	assert.False(t, networkConfig(cmd).InsecureSkipVerify)
+	client, err := newAPIHTTPClient(cmd)
+	require.NoError(t, err)
+	_, err = client.Get(api.URL + "/v1/projects/itep/compute/hosts")
+	var certErr *tls.CertificateVerificationError
+	assert.True(t, errors.As(err, &certErr), "the API endpoint certificate must be verified: %v", err)
+
+	cmd = parse("--insecure-skip-verify", "--register")
+	bmcInsecure, _ = cmd.Flags().GetBool("bmc-insecure-skip-verify")
+	assert.False(t, bmcInsecure)
+	assert.True(t, networkConfig(cmd).InsecureSkipVerify)
+}
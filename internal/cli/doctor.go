@@ -0,0 +1,374 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	catapi "github.com/open-edge-platform/cli/pkg/rest/catalog"
+	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	orchapi "github.com/open-edge-platform/cli/pkg/rest/orchutilities"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const doctorExamples = `# Diagnose the connection to the configured Edge Orchestrator and access to a project
+orch-cli doctor --project some-project
+
+# Sample output
+CHECK               STATUS   DETAIL
+api-endpoint        ok       https://api.example.com
+login               ok       logged in as admin
+keycloak            ok       https://keycloak.example.com/realms/master
+token               ok       access token issued
+project             ok       some-project
+infra-service       ok       reachable
+catalog-service     ok       reachable
+cluster-service     fail     503 Service Unavailable
+version             warn     CLI v2025.2.0, orchestrator v2026.0.0
+
+Fixes
+cluster-service: the cluster orchestration service failed, check the orchestrator deployment or retry later
+version: install the CLI release matching orchestrator v2026.0.0
+`
+
+type doctorStatus string
+
+const (
+	doctorOK   doctorStatus = "ok"
+	doctorWarn doctorStatus = "warn"
+	doctorFail doctorStatus = "fail"
+	doctorSkip doctorStatus = "skip"
+)
+
+// doctorCheck is the outcome of one diagnostic, with the action to take when it did not pass
+type doctorCheck struct {
+	Name   string
+	Status doctorStatus
+	Detail string
+	Fix    string
+}
+
+func getDoctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor [flags]",
+		Short: "Diagnoses the connection to Edge Orchestrator",
+		Long: "Verifies that the API endpoint is valid, that Keycloak can be reached and issues an access token for the " +
+			"stored login, that the project can be accessed, that the infrastructure, catalog and cluster services answer " +
+			"and that the CLI release matches the orchestrator release, then prints how to fix each problem found.",
+		Example: doctorExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runDoctorCommand,
+	}
+	return cmd
+}
+
+// Runs the diagnostics in order; the ones depending on a failed diagnostic are skipped
+func runDoctorCommand(cmd *cobra.Command, _ []string) error {
+	noAuth, _ := cmd.Flags().GetBool("noauth")
+	projectName, _ := cmd.Flags().GetString("project")
+
+	checks := []doctorCheck{doctorEndpoint(cmd)}
+	endpointOK := checks[0].Status == doctorOK
+
+	tokenOK := noAuth
+	if noAuth {
+		checks = append(checks,
+			doctorCheck{Name: "login", Status: doctorSkip, Detail: "--noauth is set"},
+			doctorCheck{Name: "keycloak", Status: doctorSkip, Detail: "--noauth is set"},
+			doctorCheck{Name: "token", Status: doctorSkip, Detail: "--noauth is set"})
+	} else {
+		login := doctorLogin(cmd)
+		keycloak := doctorKeycloak(cmd)
+		token := doctorCheck{Name: "token", Status: doctorSkip, Detail: "not logged in"}
+		if login.Status == doctorOK && keycloak.Status == doctorOK {
+			token = doctorToken(cmd)
+		} else if login.Status == doctorOK {
+			token.Detail = "keycloak is not reachable"
+		}
+		tokenOK = token.Status == doctorOK
+		checks = append(checks, login, keycloak, token)
+	}
+
+	project := doctorCheck{Name: "project", Status: doctorSkip, Detail: "no project given",
+		Fix: "pass --project or set it with 'orch-cli config set project <name>'"}
+	switch {
+	case projectName == "":
+	case !endpointOK || !tokenOK:
+		project.Detail, project.Fix = "not authenticated against the API endpoint", ""
+	default:
+		project = doctorProject(cmd, projectName)
+	}
+	checks = append(checks, project)
+
+	for _, service := range doctorServices {
+		if project.Status != doctorOK {
+			checks = append(checks, doctorCheck{Name: service.name, Status: doctorSkip, Detail: "project is not accessible"})
+			continue
+		}
+		resp, err := service.call(cmd)
+		checks = append(checks, doctorServiceCheck(service.name, service.description, resp, err))
+	}
+
+	version := doctorCheck{Name: "version", Status: doctorSkip, Detail: "not authenticated against the API endpoint"}
+	if endpointOK && tokenOK {
+		version = doctorVersion(cmd, noAuth)
+	}
+	checks = append(checks, version)
+
+	return printDoctorReport(cmd, checks)
+}
+
+func doctorEndpoint(cmd *cobra.Command) doctorCheck {
+	check := doctorCheck{Name: "api-endpoint"}
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("invalid endpoint %q", endpoint)
+		check.Fix = "set the orchestrator API URL with 'orch-cli config set api-endpoint https://api.<cluster-fqdn>'"
+		return check
+	}
+	check.Status, check.Detail = doctorOK, endpoint
+	return check
+}
+
+func doctorLogin(cmd *cobra.Command) doctorCheck {
+	check := doctorCheck{Name: "login"}
+	if err := auth.CheckAuth(cmd, nil); err != nil {
+		check.Status, check.Detail = doctorFail, err.Error()
+		check.Fix = "log in with 'orch-cli login <username>'"
+		return check
+	}
+	check.Status, check.Detail = doctorOK, fmt.Sprintf("logged in as %s", viper.GetString(auth.UserName))
+	return check
+}
+
+func doctorKeycloak(cmd *cobra.Command) doctorCheck {
+	check := doctorCheck{Name: "keycloak"}
+	keycloakEp := viper.GetString(auth.KeycloakEndpointField)
+	if keycloakEp == "" {
+		check.Status, check.Detail = doctorSkip, "no keycloak endpoint stored by login"
+		return check
+	}
+	fix := fmt.Sprintf("check that %s can be reached from this machine, or log in again with --keycloak", keycloakEp)
+	kcClient, err := auth.KeycloakFactory(cmd.Context(), keycloakEp)
+	if err != nil {
+		check.Status, check.Detail, check.Fix = doctorFail, err.Error(), fix
+		return check
+	}
+	resp, err := kcClient.GetWellKnownOpenidConfigurationWithResponse(cmd.Context())
+	if err != nil {
+		check.Status, check.Detail, check.Fix = doctorFail, err.Error(), fix
+		return check
+	}
+	if resp.JSON200 == nil || resp.JSON200.TokenEndpoint == nil {
+		check.Status, check.Detail, check.Fix = doctorFail, "not an OpenID Connect provider", fix
+		return check
+	}
+	check.Status, check.Detail = doctorOK, keycloakEp
+	return check
+}
+
+func doctorToken(cmd *cobra.Command) doctorCheck {
+	check := doctorCheck{Name: "token"}
+	if _, err := auth.GetAccessToken(cmd.Context()); err != nil {
+		check.Status, check.Detail = doctorFail, err.Error()
+		check.Fix = "the session expired or was revoked, log in again with 'orch-cli login <username>'"
+		return check
+	}
+	check.Status, check.Detail = doctorOK, "access token issued"
+	return check
+}
+
+func doctorProject(cmd *cobra.Command, projectName string) doctorCheck {
+	check := doctorCheck{Name: "project"}
+	if err := checkProjectExists(cmd, projectName); err != nil {
+		check.Status, check.Detail = doctorFail, err.Error()
+		check.Fix = fmt.Sprintf("list the projects you can access with 'orch-cli list projects', or ask an administrator to add you to %s", projectName)
+		return check
+	}
+	check.Status, check.Detail = doctorOK, projectName
+	return check
+}
+
+// doctorServices are called with the smallest list request each service offers
+var doctorServices = []struct {
+	name        string
+	description string
+	call        func(cmd *cobra.Command) (*http.Response, error)
+}{
+	{
+		name:        "infra-service",
+		description: "edge infrastructure manager",
+		call: func(cmd *cobra.Command) (*http.Response, error) {
+			ctx, client, projectName, err := InfraFactory(cmd)
+			if err != nil {
+				return nil, err
+			}
+			pageSize := 1
+			resp, err := client.RegionServiceListRegionsWithResponse(ctx, projectName,
+				&infra.RegionServiceListRegionsParams{PageSize: &pageSize}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, err
+			}
+			return resp.HTTPResponse, nil
+		},
+	},
+	{
+		name:        "catalog-service",
+		description: "application catalog",
+		call: func(cmd *cobra.Command) (*http.Response, error) {
+			ctx, client, projectName, err := CatalogFactory(cmd)
+			if err != nil {
+				return nil, err
+			}
+			pageSize := int32(1)
+			resp, err := client.CatalogServiceListRegistriesWithResponse(ctx, projectName,
+				&catapi.CatalogServiceListRegistriesParams{PageSize: &pageSize}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, err
+			}
+			return resp.HTTPResponse, nil
+		},
+	},
+	{
+		name:        "cluster-service",
+		description: "cluster orchestration",
+		call: func(cmd *cobra.Command) (*http.Response, error) {
+			ctx, client, projectName, err := ClusterFactory(cmd)
+			if err != nil {
+				return nil, err
+			}
+			pageSize := 1
+			resp, err := client.GetV2ProjectsProjectNameTemplatesWithResponse(ctx, projectName,
+				&coapi.GetV2ProjectsProjectNameTemplatesParams{PageSize: &pageSize}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, err
+			}
+			return resp.HTTPResponse, nil
+		},
+	},
+}
+
+// Any answer below 500 shows the service is up; permission and routing problems get their own fix
+func doctorServiceCheck(name string, description string, resp *http.Response, err error) doctorCheck {
+	check := doctorCheck{Name: name, Status: doctorFail}
+	switch {
+	case err != nil:
+		check.Detail = processError(err).Error()
+		check.Fix = fmt.Sprintf("the %s service could not be reached, check the network path and proxy settings to the API endpoint", description)
+	case resp == nil:
+		check.Detail = "no response"
+		check.Fix = fmt.Sprintf("the %s service could not be reached, check the network path and proxy settings to the API endpoint", description)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		check.Detail = resp.Status
+		check.Fix = fmt.Sprintf("ask an administrator for a role giving access to the %s in the project", description)
+	case resp.StatusCode == http.StatusNotFound:
+		check.Status, check.Detail = doctorWarn, resp.Status
+		check.Fix = fmt.Sprintf("the %s may not be installed on this orchestrator", description)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		check.Detail = resp.Status
+		check.Fix = fmt.Sprintf("the %s service failed, check the orchestrator deployment or retry later", description)
+	default:
+		check.Status, check.Detail = doctorOK, "reachable"
+	}
+	return check
+}
+
+func doctorVersion(cmd *cobra.Command, noAuth bool) doctorCheck {
+	check := doctorCheck{Name: "version"}
+	ctx, orchClient, err := OrchestratorFactory(cmd)
+	if err != nil {
+		check.Status, check.Detail = doctorFail, err.Error()
+		return check
+	}
+	var editors []orchapi.RequestEditorFn
+	if !noAuth {
+		editors = append(editors, auth.AddAuthHeader)
+	}
+	resp, err := orchClient.GetOrchestratorInfoWithResponse(ctx, editors...)
+	if err != nil {
+		check.Status, check.Detail = doctorFail, processError(err).Error()
+		check.Fix = "the orchestrator info service could not be reached, check the network path to the API endpoint"
+		return check
+	}
+	if resp.JSON200 == nil || resp.JSON200.Orchestrator == nil || resp.JSON200.Orchestrator.Version == nil {
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("orchestrator version not available (%s)", resp.Status())
+		check.Fix = "the orchestrator predates the component status service, use the CLI release shipped with it"
+		return check
+	}
+	return compareDoctorVersions(Version, *resp.JSON200.Orchestrator.Version)
+}
+
+// The CLI and the orchestrator are released together; their year and release number must match
+func compareDoctorVersions(cliVersion string, orchVersion string) doctorCheck {
+	check := doctorCheck{Name: "version", Detail: fmt.Sprintf("CLI %s, orchestrator %s", cliVersion, orchVersion)}
+	cliRelease, cliOK := doctorRelease(cliVersion)
+	orchRelease, orchOK := doctorRelease(orchVersion)
+	switch {
+	case !cliOK:
+		check.Status = doctorWarn
+		check.Fix = fmt.Sprintf("this is a development build, install the CLI release matching orchestrator %s for supported behavior", orchVersion)
+	case !orchOK:
+		check.Status = doctorWarn
+		check.Fix = "the orchestrator version could not be parsed, check that the CLI release matches it"
+	case cliRelease != orchRelease:
+		check.Status = doctorWarn
+		check.Fix = fmt.Sprintf("install the CLI release matching orchestrator %s", orchVersion)
+	default:
+		check.Status = doctorOK
+	}
+	return check
+}
+
+// Returns the "<year>.<release>" part of a version such as v2025.2.1
+func doctorRelease(version string) (string, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	for _, part := range parts[:2] {
+		if strings.Trim(part, "0123456789") != "" {
+			return "", false
+		}
+	}
+	return parts[0] + "." + parts[1], true
+}
+
+func printDoctorReport(cmd *cobra.Command, checks []doctorCheck) error {
+	w := cmd.OutOrStdout()
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "CHECK\tSTATUS\tDETAIL\n")
+	failed := 0
+	for _, check := range checks {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", check.Name, check.Status, check.Detail)
+		if check.Status == doctorFail {
+			failed++
+		}
+	}
+	_ = writer.Flush()
+
+	header := false
+	for _, check := range checks {
+		if check.Fix == "" || check.Status == doctorOK {
+			continue
+		}
+		if !header {
+			fmt.Fprintf(w, "\nFixes\n")
+			header = true
+		}
+		fmt.Fprintf(w, "%s: %s\n", check.Name, check.Fix)
+	}
+
+	if failed > 0 {
+		return e.WithCode(e.CodeUnavailable, fmt.Errorf("%d of %d checks failed", failed, len(checks)))
+	}
+	return nil
+}
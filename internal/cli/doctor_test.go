@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareDoctorVersions(t *testing.T) {
+	assert.Equal(t, doctorOK, compareDoctorVersions("v2026.0.3", "v2026.0.0-test").Status)
+	assert.Equal(t, doctorOK, compareDoctorVersions("2025.2.0", "v2025.2").Status)
+
+	check := compareDoctorVersions("v2025.2.0", "v2026.0.0")
+	assert.Equal(t, doctorWarn, check.Status)
+	assert.Equal(t, "CLI v2025.2.0, orchestrator v2026.0.0", check.Detail)
+	assert.Equal(t, "install the CLI release matching orchestrator v2026.0.0", check.Fix)
+
+	assert.Equal(t, doctorWarn, compareDoctorVersions("dev", "v2026.0.0").Status)
+	assert.Equal(t, doctorWarn, compareDoctorVersions("v2026.0.0", "main-abc").Status)
+}
+
+func TestDoctorServiceCheck(t *testing.T) {
+	ok := doctorServiceCheck("infra-service", "edge infrastructure manager", &http.Response{StatusCode: 200, Status: "200 OK"}, nil)
+	assert.Equal(t, doctorOK, ok.Status)
+	assert.Empty(t, ok.Fix)
+
+	denied := doctorServiceCheck("infra-service", "edge infrastructure manager", &http.Response{StatusCode: 403, Status: "403 Forbidden"}, nil)
+	assert.Equal(t, doctorFail, denied.Status)
+	assert.Contains(t, denied.Fix, "ask an administrator")
+
+	missing := doctorServiceCheck("cluster-service", "cluster orchestration", &http.Response{StatusCode: 404, Status: "404 Not Found"}, nil)
+	assert.Equal(t, doctorWarn, missing.Status)
+
+	down := doctorServiceCheck("catalog-service", "application catalog", &http.Response{StatusCode: 503, Status: "503 Service Unavailable"}, nil)
+	assert.Equal(t, doctorFail, down.Status)
+	assert.Equal(t, "503 Service Unavailable", down.Detail)
+
+	unreachable := doctorServiceCheck("catalog-service", "application catalog", nil, errors.New("dial tcp: connection refused"))
+	assert.Equal(t, doctorFail, unreachable.Status)
+	assert.Contains(t, unreachable.Fix, "could not be reached")
+}
+
+func (s *CLITestSuite) TestDoctor() {
+	out, err := s.runCommand("doctor --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^api-endpoint\s+\|ok\s+\|http://unit-test-api`, out)
+	s.Regexp(`(?m)^login\s+\|ok\s+\|logged in as u`, out)
+	s.Regexp(`(?m)^keycloak\s+\|ok\s+\|`+kcTest, out)
+	s.Regexp(`(?m)^token\s+\|ok\s`, out)
+	s.Regexp(`(?m)^project\s+\|ok\s+\|`+project, out)
+	s.Regexp(`(?m)^infra-service\s+\|ok\s+\|reachable`, out)
+	s.Regexp(`(?m)^catalog-service\s+\|ok\s+\|reachable`, out)
+	s.Regexp(`(?m)^cluster-service\s+\|ok\s+\|reachable`, out)
+	s.Regexp(`(?m)^version\s+\|warn\s+\|CLI dev, orchestrator v2026.0.0-test`, out)
+	s.Contains(out, "version: this is a development build")
+
+	// Without a project the project-scoped services are skipped
+	out, err = s.runCommand("doctor")
+	s.NoError(err)
+	s.Regexp(`(?m)^project\s+\|skip\s+\|no project given`, out)
+	s.Regexp(`(?m)^infra-service\s+\|skip\s`, out)
+	s.Contains(out, "project: pass --project")
+
+	out, err = s.runCommand("doctor --project nonexistent-project")
+	s.EqualError(err, "1 of 9 checks failed")
+	s.Regexp(`(?m)^project\s+\|fail\s+\|project nonexistent-project does not exist`, out)
+
+	s.NoError(s.logout())
+	out, err = s.runCommand("doctor --project " + project)
+	s.EqualError(err, "1 of 9 checks failed")
+	s.Regexp(`(?m)^login\s+\|fail\s`, out)
+	s.Regexp(`(?m)^version\s+\|skip\s`, out)
+	s.Contains(out, "login: log in with 'orch-cli login <username>'")
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const eventsHostExamples = `# Show the activity of a host over the last 30 days
+orch-cli events host host-1234abcd --project some-project
+
+# Show the activity of a host given by name over the last week, in another timezone
+orch-cli events host edge-host-001 --since 168h --timezone Europe/Berlin --project some-project
+
+# Sample output
+TIME                   SOURCE        RESOURCE ID              EVENT
+2025-03-02 09:12 UTC   host          host-1234abcd            Host created
+2025-03-02 09:20 UTC   host          host-1234abcd            Onboarding status: Onboarded
+2025-03-02 09:41 UTC   instance      inst-1234abcd            Provisioning status: Provisioned
+2025-03-08 02:00 UTC   schedule      repeatedsche-1234abcd    Maintenance window weekly-patch (SCHEDULE_STATUS_OS_UPDATE) on region-1234abcd starts
+2025-03-08 02:05 UTC   osupdaterun   osupdaterun-1234abcd     OS update run weekly-patch started, policy security-policy
+2025-03-08 02:31 UTC   osupdaterun   osupdaterun-1234abcd     OS update run weekly-patch ended: completed
+2025-03-08 04:00 UTC   schedule      repeatedsche-1234abcd    Maintenance window weekly-patch (SCHEDULE_STATUS_OS_UPDATE) on region-1234abcd ends
+`
+
+// Maximum number of past occurrences of a repeated schedule shown in a timeline, the latest are kept
+const maxEventOccurrences = 100
+
+// HostEvent is an entry of the timeline of a host
+type HostEvent struct {
+	Time       time.Time `json:"time" yaml:"time"`
+	Source     string    `json:"source" yaml:"source"`
+	ResourceId string    `json:"resourceId" yaml:"resourceId"` //nolint:revive
+	Event      string    `json:"event" yaml:"event"`
+}
+
+func getEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "events",
+		Short:             "Show the activity of Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getEventsHostCommand(),
+	)
+	return cmd
+}
+
+func getEventsHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> [flags]",
+		Short: "Show the timeline of a host",
+		Long: "Shows in chronological order when the host was created, its latest registration, onboarding and host " +
+			"status changes, the creation and latest provisioning, instance and update status changes of its instance, " +
+			"the OS update runs of its instance and the maintenance windows of the schedules targeting the host, its site " +
+			"or its regions. Edge Orchestrator only keeps the time of the latest change of each status, earlier changes are not shown. " +
+			"The next maintenance windows are shown after the events up to now.",
+		Example: eventsHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runEventsHostCommand,
+	}
+	cmd.Flags().Duration("since", 30*24*time.Hour, "Show the events of this period up to now")
+	cmd.Flags().String("timezone", "", "Timezone of the times shown, e.g. Europe/Berlin (default UTC)")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	return cmd
+}
+
+func runEventsHostCommand(cmd *cobra.Command, args []string) error {
+	since, _ := cmd.Flags().GetDuration("since")
+	timezone, _ := cmd.Flags().GetString("timezone")
+	outputType, _ := cmd.Flags().GetString("output-type")
+
+	if since <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--since must be positive"))
+	}
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
+		}
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	host, err := getHostByNameOrID(ctx, client, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	events := hostStatusEvents(host)
+
+	if isFeatureEnabled(Day2Feature) {
+		runEvents, err := hostOSUpdateRunEvents(ctx, client, projectName, host)
+		if err != nil {
+			return err
+		}
+		events = append(events, runEvents...)
+
+		targets, err := maintenanceTargets(ctx, client, projectName, host)
+		if err != nil {
+			return err
+		}
+		var singleSchedules []infra.SingleScheduleResource
+		var repeatedSchedules []infra.RepeatedScheduleResource
+		err = listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+			resp, err := client.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+				&infra.ScheduleServiceListSchedulesParams{
+					PageSize: &pageSize,
+					Offset:   &offset,
+				}, auth.AddAuthHeader)
+			if err != nil {
+				return 0, false, processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving schedules"); err != nil {
+				return 0, false, err
+			}
+			singleSchedules = append(singleSchedules, resp.JSON200.SingleSchedules...)
+			repeatedSchedules = append(repeatedSchedules, resp.JSON200.RepeatedSchedules...)
+			return len(resp.JSON200.SingleSchedules) + len(resp.JSON200.RepeatedSchedules), resp.JSON200.HasNext, nil
+		})
+		if err != nil {
+			return err
+		}
+		scheduleEvents, err := maintenanceEvents(singleSchedules, repeatedSchedules, targets, now.Add(-since), now)
+		if err != nil {
+			return err
+		}
+		events = append(events, scheduleEvents...)
+	}
+
+	events = sortHostEvents(events, now.Add(-since))
+	if outputType == "json" || outputType == "yaml" {
+		result := CommandResult{
+			OutputAs: toOutputType(outputType),
+			Data:     events,
+		}
+		GenerateOutput(cmd.OutOrStdout(), &result)
+		return nil
+	}
+	return printHostEvents(cmd, cmd.OutOrStdout(), events, loc)
+}
+
+// Returns the events recorded on the host and its instance: creation times and the latest change of each status
+func hostStatusEvents(host infra.HostResource) []HostEvent {
+	var events []HostEvent
+	hostID := derefString(host.ResourceId)
+	if host.Timestamps != nil && host.Timestamps.CreatedAt != nil {
+		events = append(events, HostEvent{Time: *host.Timestamps.CreatedAt, Source: "host", ResourceId: hostID, Event: "Host created"})
+	}
+	events = appendStatusEvent(events, "host", hostID, "Registration status", host.RegistrationStatus, host.RegistrationStatusTimestamp)
+	events = appendStatusEvent(events, "host", hostID, "Onboarding status", host.OnboardingStatus, host.OnboardingStatusTimestamp)
+	events = appendStatusEvent(events, "host", hostID, "Host status", host.HostStatus, host.HostStatusTimestamp)
+
+	instance := host.Instance
+	if instance == nil {
+		return events
+	}
+	instanceID := derefString(instance.ResourceId)
+	if instance.Timestamps != nil && instance.Timestamps.CreatedAt != nil {
+		event := "Instance created"
+		if instance.Os != nil && derefString(instance.Os.Name) != "" {
+			event += " with OS " + *instance.Os.Name
+		}
+		events = append(events, HostEvent{Time: *instance.Timestamps.CreatedAt, Source: "instance", ResourceId: instanceID, Event: event})
+	}
+	events = appendStatusEvent(events, "instance", instanceID, "Provisioning status", instance.ProvisioningStatus, instance.ProvisioningStatusTimestamp)
+	events = appendStatusEvent(events, "instance", instanceID, "Instance status", instance.InstanceStatus, instance.InstanceStatusTimestamp)
+	events = appendStatusEvent(events, "instance", instanceID, "Update status", instance.UpdateStatus, instance.UpdateStatusTimestamp)
+	return events
+}
+
+func appendStatusEvent(events []HostEvent, source, resourceID, label string, status *string, timestamp *int) []HostEvent {
+	if timestamp == nil || *timestamp <= 0 || derefString(status) == "" {
+		return events
+	}
+	return append(events, HostEvent{
+		Time:       time.Unix(int64(*timestamp), 0),
+		Source:     source,
+		ResourceId: resourceID,
+		Event:      fmt.Sprintf("%s: %s", label, *status),
+	})
+}
+
+// Returns the start and end of the OS update runs of the instance of the host
+func hostOSUpdateRunEvents(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, host infra.HostResource) ([]HostEvent, error) {
+	if host.Instance == nil || derefString(host.Instance.ResourceId) == "" {
+		return nil, nil
+	}
+	runs, err := listInstanceOSUpdateRuns(ctx, client, projectName, *host.Instance.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []HostEvent
+	for _, run := range runs {
+		runID := derefString(run.ResourceId)
+		name := valueOrNone(run.Name)
+		if run.StartTime != nil && *run.StartTime > 0 {
+			event := fmt.Sprintf("OS update run %s started", name)
+			if run.AppliedPolicy != nil && run.AppliedPolicy.Name != "" {
+				event += ", policy " + run.AppliedPolicy.Name
+			}
+			events = append(events, HostEvent{Time: time.Unix(int64(*run.StartTime), 0), Source: "osupdaterun", ResourceId: runID, Event: event})
+		}
+		if run.EndTime != nil && *run.EndTime > 0 {
+			event := fmt.Sprintf("OS update run %s ended: %s", name, valueOrNone(run.Status))
+			if derefString(run.StatusDetails) != "" {
+				event += " - " + *run.StatusDetails
+			}
+			events = append(events, HostEvent{Time: time.Unix(int64(*run.EndTime), 0), Source: "osupdaterun", ResourceId: runID, Event: event})
+		}
+	}
+	return events, nil
+}
+
+// Lists the OS update runs of an instance
+func listInstanceOSUpdateRuns(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, instanceID string) ([]infra.OSUpdateRun, error) {
+	var runs []infra.OSUpdateRun
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.OSUpdateRunListOSUpdateRunWithResponse(ctx, projectName,
+			&infra.OSUpdateRunListOSUpdateRunParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Update Runs"); err != nil {
+			return 0, false, err
+		}
+		for _, run := range resp.JSON200.OsUpdateRuns {
+			if run.Instance != nil && derefString(run.Instance.ResourceId) == instanceID {
+				runs = append(runs, run)
+			}
+		}
+		return len(resp.JSON200.OsUpdateRuns), resp.JSON200.HasNext, nil
+	})
+	return runs, err
+}
+
+// Returns the starts and ends of the maintenance windows of the schedules targeting one of the given resources from
+// the given time up to now, and the next window of each schedule
+func maintenanceEvents(singleSchedules []infra.SingleScheduleResource, repeatedSchedules []infra.RepeatedScheduleResource,
+	targets map[string]bool, from, now time.Time) ([]HostEvent, error) {
+	var events []HostEvent
+	window := func(name, resourceID, target string, status infra.ScheduleStatus, start time.Time, end *time.Time) {
+		label := fmt.Sprintf("Maintenance window %s (%s) on %s", name, status, target)
+		events = append(events, HostEvent{Time: start, Source: "schedule", ResourceId: resourceID, Event: label + " starts"})
+		if end != nil {
+			events = append(events, HostEvent{Time: *end, Source: "schedule", ResourceId: resourceID, Event: label + " ends"})
+		}
+	}
+
+	for _, s := range singleSchedules {
+		target := scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)
+		if !targets[target] {
+			continue
+		}
+		var end *time.Time
+		if s.EndSeconds != nil {
+			t := time.Unix(int64(*s.EndSeconds), 0)
+			end = &t
+		}
+		window(valueOrNone(s.Name), derefString(s.ResourceId), target, s.ScheduleStatus, time.Unix(int64(s.StartSeconds), 0), end)
+	}
+
+	for _, s := range repeatedSchedules {
+		target := scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)
+		if !targets[target] {
+			continue
+		}
+		duration := time.Duration(s.DurationSeconds) * time.Second
+		starts, err := repeatedOccurrences(s, from.Add(-duration), now, maxEventOccurrences)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %w", derefString(s.ResourceId), err)
+		}
+		next, found, err := nextRepeatedOccurrence(s, now, now.AddDate(1, 0, 0))
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %w", derefString(s.ResourceId), err)
+		}
+		if found {
+			starts = append(starts, next)
+		}
+		for _, start := range starts {
+			end := start.Add(duration)
+			window(valueOrNone(s.Name), derefString(s.ResourceId), target, s.ScheduleStatus, start, &end)
+		}
+	}
+	return events, nil
+}
+
+// Drops the events before from and sorts the others chronologically
+func sortHostEvents(events []HostEvent, from time.Time) []HostEvent {
+	kept := make([]HostEvent, 0, len(events))
+	for _, event := range events {
+		if !event.Time.Before(from) {
+			kept = append(kept, event)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Time.Before(kept[j].Time) })
+	return kept
+}
+
+func printHostEvents(cmd *cobra.Command, w io.Writer, events []HostEvent, loc *time.Location) error {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found")
+		return nil
+	}
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "TIME\tSOURCE\tRESOURCE ID\tEVENT\n")
+	for _, event := range events {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", event.Time.In(loc).Format(scheduleDisplayTimeFormat), event.Source, event.ResourceId, event.Event)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestEventsHost() {
+	out, err := s.runCommand("events host host-abcd1234 --project maintenance-schedules --since 336h")
+	s.NoError(err)
+	s.Regexp(`(?m)^TIME\s+\|SOURCE\s+\|RESOURCE ID\s+\|EVENT$`, out)
+	s.Regexp(`(?m)\|schedule\s+\|\S+\s+\|Maintenance window weekly-patch \(\S+\) on region-abcd1111 starts$`, out)
+	s.NotContains(out, "other-site")
+
+	out, err = s.runCommand("events host host-abcd1234 --project maintenance-schedules --since 336h -o json")
+	s.NoError(err)
+	var events []HostEvent
+	s.NoError(json.Unmarshal([]byte(out), &events))
+	s.NotEmpty(events)
+	for i := 1; i < len(events); i++ {
+		s.False(events[i].Time.Before(events[i-1].Time))
+	}
+
+	_, err = s.runCommand("events host host-abcd1234 --project maintenance-schedules --since 0s")
+	s.EqualError(err, "--since must be positive")
+
+	_, err = s.runCommand("events host host-abcd1234 --project maintenance-schedules --timezone Nowhere/Town")
+	s.ErrorContains(err, "invalid timezone 'Nowhere/Town'")
+}
+
+func TestRepeatedOccurrences(t *testing.T) {
+	weekly := infra.RepeatedScheduleResource{
+		CronMinutes: "0", CronHours: "2", CronDayMonth: "*", CronMonth: "*", CronDayWeek: "6", DurationSeconds: 7200,
+	}
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	starts, err := repeatedOccurrences(weekly, from, to, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 8, 2, 0, 0, 0, time.UTC),
+	}, starts)
+
+	// The latest occurrences are kept
+	starts, err = repeatedOccurrences(weekly, from, to, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{time.Date(2025, 3, 8, 2, 0, 0, 0, time.UTC)}, starts)
+
+	next, found, err := nextRepeatedOccurrence(weekly, time.Date(2025, 3, 8, 2, 1, 0, 0, time.UTC), to.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC), next)
+
+	_, found, err = nextRepeatedOccurrence(weekly, from, from.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestHostStatusEvents(t *testing.T) {
+	created := time.Date(2025, 3, 2, 9, 12, 0, 0, time.UTC)
+	onboarded := int(created.Add(8 * time.Minute).Unix())
+	provisioned := int(created.Add(30 * time.Minute).Unix())
+	hostID, instanceID, osName := "host-1234abcd", "inst-1234abcd", "Ubuntu 22.04"
+	onboardingStatus, provisioningStatus, emptyStatus := "Onboarded", "Provisioned", ""
+
+	host := infra.HostResource{
+		ResourceId:                &hostID,
+		Timestamps:                &infra.Timestamps{CreatedAt: &created},
+		OnboardingStatus:          &onboardingStatus,
+		OnboardingStatusTimestamp: &onboarded,
+		HostStatus:                &emptyStatus,
+		HostStatusTimestamp:       &onboarded,
+		Instance: &infra.InstanceResource{
+			ResourceId:                  &instanceID,
+			Os:                          &infra.OperatingSystemResource{Name: &osName},
+			ProvisioningStatus:          &provisioningStatus,
+			ProvisioningStatusTimestamp: &provisioned,
+		},
+	}
+
+	events := sortHostEvents(hostStatusEvents(host), created)
+	require.Len(t, events, 3)
+	assert.Equal(t, HostEvent{Time: created, Source: "host", ResourceId: hostID, Event: "Host created"}, events[0])
+	assert.Equal(t, "Onboarding status: Onboarded", events[1].Event)
+	assert.Equal(t, HostEvent{Time: time.Unix(int64(provisioned), 0), Source: "instance", ResourceId: instanceID,
+		Event: "Provisioning status: Provisioned"}, events[2])
+
+	// Events before the period are dropped
+	assert.Len(t, sortHostEvents(hostStatusEvents(host), created.Add(time.Hour)), 0)
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const explainExamples = `# List the resources that can be explained
+orch-cli explain
+
+# Show the fields of a host, their types, valid values and whether they can be set
+orch-cli explain host
+
+# Show the fields of the OS of an instance, going through the instance fields
+orch-cli explain instance.os
+
+# Show the valid values of the power state of a host
+orch-cli explain host.desiredPowerState
+`
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// explainResource is a resource type explained from the schema of an OpenAPI definition
+type explainResource struct {
+	Name    string
+	Aliases []string
+	API     string
+	Spec    []byte
+	Schema  string
+}
+
+// Resources explained, with the definition and the schema of their API
+var explainResources = []explainResource{
+	{Name: "host", Aliases: hostAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "HostResource"},
+	{Name: "instance", Aliases: instanceAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "InstanceResource"},
+	{Name: "site", Aliases: siteAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "SiteResource"},
+	{Name: "region", Aliases: regionAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "RegionResource"},
+	{Name: "osprofile", Aliases: osProfileAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "OperatingSystemResource"},
+	{Name: "osupdatepolicy", Aliases: osUpdatePolicyAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "OSUpdatePolicy"},
+	{Name: "osupdaterun", Aliases: osUpdateRunAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "OSUpdateRun"},
+	{Name: "schedule", Aliases: scheduleAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "SingleScheduleResource"},
+	{Name: "repeatedschedule", Aliases: []string{"repeatedschedule", "repeatedschedules"}, API: "infra", Spec: infra.OpenAPISpec, Schema: "RepeatedScheduleResource"},
+	{Name: "customconfig", Aliases: customConfigAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "CustomConfigResource"},
+	{Name: "provider", Aliases: providerAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "ProviderResource"},
+	{Name: "sshkey", Aliases: sshKeyAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "LocalAccountResource"},
+	{Name: "workload", Aliases: workloadAliases, API: "infra", Spec: infra.OpenAPISpec, Schema: "WorkloadResource"},
+	{Name: "cluster", Aliases: clusterAliases, API: "cluster", Spec: coapi.OpenAPISpec, Schema: "ClusterDetailInfo"},
+	{Name: "clustertemplate", Aliases: clusterTemplateAliases, API: "cluster", Spec: coapi.OpenAPISpec, Schema: "TemplateInfo"},
+}
+
+// openAPISchema is the part of an OpenAPI schema explained
+type openAPISchema struct {
+	Type        string           `yaml:"type"`
+	Format      string           `yaml:"format"`
+	Ref         string           `yaml:"$ref"`
+	Description string           `yaml:"description"`
+	ReadOnly    bool             `yaml:"readOnly"`
+	Enum        []string         `yaml:"enum"`
+	Items       *openAPISchema   `yaml:"items"`
+	AllOf       []*openAPISchema `yaml:"allOf"`
+	Required    []string         `yaml:"required"`
+	Properties  openAPIFields    `yaml:"properties"`
+}
+
+// openAPIField is a property of a schema
+type openAPIField struct {
+	Name   string
+	Schema *openAPISchema
+}
+
+// openAPIFields are the properties of a schema, in the order of the definition
+type openAPIFields []openAPIField
+
+// UnmarshalYAML keeps the properties in the order they are defined
+func (f *openAPIFields) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return errors.New("properties of a schema must be a mapping")
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var schema openAPISchema
+		if err := node.Content[i+1].Decode(&schema); err != nil {
+			return err
+		}
+		*f = append(*f, openAPIField{Name: node.Content[i].Value, Schema: &schema})
+	}
+	return nil
+}
+
+// Schemas of the components of an OpenAPI definition, by name
+type openAPISchemas map[string]*openAPISchema
+
+func parseOpenAPISchemas(spec []byte) (openAPISchemas, error) {
+	var document struct {
+		Components struct {
+			Schemas openAPISchemas `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(spec, &document); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI definition: %w", err)
+	}
+	return document.Components.Schemas, nil
+}
+
+// Returns the schema a property refers to, directly or as the single member of an allOf, and its name
+func (s openAPISchemas) resolve(schema *openAPISchema) (*openAPISchema, string) {
+	ref := schema.Ref
+	if ref == "" && len(schema.AllOf) == 1 {
+		ref = schema.AllOf[0].Ref
+	}
+	if name, ok := strings.CutPrefix(ref, schemaRefPrefix); ok && s[name] != nil {
+		return s[name], name
+	}
+	return schema, ""
+}
+
+// Describes the type of a property, e.g. string, []string, HostState or []NodeInfo
+func (s openAPISchemas) typeName(schema *openAPISchema) string {
+	if resolved, name := s.resolve(schema); name != "" {
+		if len(resolved.Enum) > 0 {
+			return name + " (enum)"
+		}
+		return name
+	}
+	switch {
+	case schema.Type == "array" && schema.Items != nil:
+		return "[]" + s.typeName(schema.Items)
+	case schema.Format != "":
+		return schema.Type + " (" + schema.Format + ")"
+	case schema.Type == "":
+		return "object"
+	}
+	return schema.Type
+}
+
+// The object schema the fields of a property are read from, through the items of an array
+func (s openAPISchemas) objectOf(schema *openAPISchema) (*openAPISchema, string) {
+	if schema.Type == "array" && schema.Items != nil {
+		schema = schema.Items
+	}
+	return s.resolve(schema)
+}
+
+func getExplainCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain [resource[.field...]]",
+		Short: "Describes the fields of a resource",
+		Long: "Prints the fields of a resource type with their types, their valid values and whether they can be set " +
+			"by the set and patch commands, as defined by the OpenAPI definitions the client is built from. " +
+			"A field path, e.g. instance.os, describes the fields of a nested object or the values of an enum field. " +
+			"Without argument, the resources that can be explained are listed.",
+		Example: explainExamples,
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    runExplainCommand,
+	}
+	return cmd
+}
+
+func runExplainCommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return printExplainResources(cmd, cmd.OutOrStdout())
+	}
+	path := strings.Split(args[0], ".")
+	resource, err := findExplainResource(path[0])
+	if err != nil {
+		return err
+	}
+	schemas, err := parseOpenAPISchemas(resource.Spec)
+	if err != nil {
+		return err
+	}
+	schema, name := schemas[resource.Schema], resource.Schema
+	if schema == nil {
+		return fmt.Errorf("schema %s not found in the %s API definition", resource.Schema, resource.API)
+	}
+
+	// Walk down the field path, through the objects the fields refer to
+	var field *openAPISchema
+	for i, fieldName := range path[1:] {
+		if field != nil {
+			if schema, name = schemas.objectOf(field); len(schema.Properties) == 0 {
+				return e.WithCode(e.CodeInvalidArgument,
+					fmt.Errorf("field %s of %s has no fields", path[i], strings.Join(path[:i], ".")))
+			}
+		}
+		idx := slices.IndexFunc(schema.Properties, func(f openAPIField) bool { return strings.EqualFold(f.Name, fieldName) })
+		if idx < 0 {
+			return e.WithCode(e.CodeInvalidArgument,
+				fmt.Errorf("field %s not found in %s (%s)", fieldName, strings.Join(path[:i+1], "."), name))
+		}
+		field = schema.Properties[idx].Schema
+	}
+
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "RESOURCE: %s\n", args[0])
+	if field == nil {
+		fmt.Fprintf(w, "SCHEMA:   %s (%s API)\n", name, resource.API)
+		printSchemaFields(w, schemas, schema)
+		return nil
+	}
+
+	fmt.Fprintf(w, "TYPE:     %s\n", schemas.typeName(field))
+	fmt.Fprintf(w, "ACCESS:   %s\n", settableValue(field))
+	if field.Description != "" {
+		fmt.Fprintf(w, "\nDESCRIPTION:\n%s\n", indentText(field.Description, "  "))
+	}
+	if values := enumValues(schemas, field); len(values) > 0 {
+		fmt.Fprintf(w, "\nVALUES:\n")
+		for _, value := range values {
+			fmt.Fprintf(w, "  %s\n", value)
+		}
+	}
+	if object, objectName := schemas.objectOf(field); len(object.Properties) > 0 {
+		fmt.Fprintf(w, "\nSCHEMA:   %s (%s API)\n", objectName, resource.API)
+		printSchemaFields(w, schemas, object)
+	}
+	return nil
+}
+
+func printExplainResources(cmd *cobra.Command, w io.Writer) error {
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintln(writer, "Resource\tSchema\tAPI")
+	for _, resource := range explainResources {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", resource.Name, resource.Schema, resource.API)
+	}
+	return writer.Flush()
+}
+
+func findExplainResource(name string) (explainResource, error) {
+	for _, resource := range explainResources {
+		if resource.Name == name || slices.Contains(resource.Aliases, name) {
+			return resource, nil
+		}
+	}
+	names := make([]string, 0, len(explainResources))
+	for _, resource := range explainResources {
+		names = append(names, resource.Name)
+	}
+	return explainResource{}, e.WithCode(e.CodeInvalidArgument,
+		fmt.Errorf("unknown resource %s, expected one of %s", name, strings.Join(names, ", ")))
+}
+
+// Prints the fields of an object schema with their type, whether they can be set, their description and the
+// values of the enum fields
+func printSchemaFields(w io.Writer, schemas openAPISchemas, schema *openAPISchema) {
+	if schema.Description != "" {
+		fmt.Fprintf(w, "\nDESCRIPTION:\n%s\n", indentText(schema.Description, "  "))
+	}
+	fmt.Fprintf(w, "\nFIELDS:\n")
+	for _, field := range schema.Properties {
+		settable := settableValue(field.Schema)
+		if slices.Contains(schema.Required, field.Name) {
+			settable += ", required"
+		}
+		fmt.Fprintf(w, "  %s <%s> %s\n", field.Name, schemas.typeName(field.Schema), settable)
+		if field.Schema.Description != "" {
+			fmt.Fprintln(w, indentText(field.Schema.Description, "    "))
+		}
+		if values := enumValues(schemas, field.Schema); len(values) > 0 {
+			fmt.Fprintf(w, "    Values: %s\n", strings.Join(values, ", "))
+		}
+	}
+}
+
+// The valid values of an enum field, given directly or by the schema it refers to
+func enumValues(schemas openAPISchemas, schema *openAPISchema) []string {
+	if schema.Type == "array" && schema.Items != nil {
+		schema = schema.Items
+	}
+	resolved, _ := schemas.resolve(schema)
+	return resolved.Enum
+}
+
+// Read-only fields are set by the Edge Orchestrator, the others by the set and patch commands
+func settableValue(schema *openAPISchema) string {
+	if schema.ReadOnly {
+		return "read-only"
+	}
+	return "settable"
+}
+
+// Indents every line of a possibly multi-line description
+func indentText(text string, indent string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = indent + strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestExplain() {
+	out, err := s.runCommand("explain")
+	s.NoError(err)
+	s.Regexp(`host\s*\|HostResource\s*\|infra`, out)
+	s.Regexp(`clustertemplate\s*\|TemplateInfo\s*\|cluster`, out)
+
+	out, err = s.runCommand("explain hosts")
+	s.NoError(err)
+	s.Contains(out, "RESOURCE: hosts\nSCHEMA:   HostResource (infra API)\n")
+	s.Contains(out, "  name <string> settable, required\n    The host name.\n")
+	s.Contains(out, "  serialNumber <string> read-only\n")
+	s.Contains(out, "  desiredPowerState <PowerState (enum)> settable\n")
+	s.Contains(out, "    Values: POWER_STATE_UNSPECIFIED, POWER_STATE_ON, POWER_STATE_OFF,")
+
+	out, err = s.runCommand("explain host.desiredAmtState")
+	s.NoError(err)
+	s.Contains(out, "RESOURCE: host.desiredAmtState\nTYPE:     AmtState (enum)\nACCESS:   settable\n")
+	s.Contains(out, "\nVALUES:\n  AMT_STATE_UNSPECIFIED\n  AMT_STATE_PROVISIONED\n  AMT_STATE_UNPROVISIONED\n")
+
+	// Fields of nested objects, including the items of arrays
+	out, err = s.runCommand("explain instance.os.profileName")
+	s.NoError(err)
+	s.Contains(out, "TYPE:     string\nACCESS:   settable\n")
+
+	out, err = s.runCommand("explain cluster.nodes")
+	s.NoError(err)
+	s.Contains(out, "TYPE:     []NodeInfo\n")
+	s.Contains(out, "\nSCHEMA:   NodeInfo (cluster API)\n")
+
+	_, err = s.runCommand("explain host.name.length")
+	s.EqualError(err, "field name of host has no fields")
+
+	_, err = s.runCommand("explain host.powerState")
+	s.EqualError(err, "field powerState not found in host (HostResource)")
+
+	_, err = s.runCommand("explain node")
+	s.ErrorContains(err, "unknown resource node, expected one of host, instance, site,")
+}
+
+// Every resource explained must be defined by its API
+func TestExplainResourcesSchemas(t *testing.T) {
+	for _, resource := range explainResources {
+		schemas, err := parseOpenAPISchemas(resource.Spec)
+		require.NoError(t, err)
+		schema := schemas[resource.Schema]
+		if assert.NotNil(t, schema, resource.Name) {
+			assert.NotEmpty(t, schema.Properties, resource.Name)
+		}
+	}
+}
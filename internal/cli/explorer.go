@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const serveUIExamples = `# Serve a read-only dashboard of the project on localhost
+orch-cli serve ui --project some-project
+
+# Serve the dashboard to the other machines of the network, e.g. to share it during an incident
+orch-cli serve ui --addr 0.0.0.0:8081 --project some-project
+`
+
+const (
+	defaultExplorerAddr = "localhost:8081"
+
+	// Page size of the list calls made to collect the resources of the project
+	explorerPageSize = 100
+
+	// Header carrying the access token of the dashboard on API calls
+	explorerTokenHeader = "X-Explorer-Token"
+
+	explorerShutdownTimeout = 5 * time.Second
+)
+
+//go:embed explorer
+var explorerFiles embed.FS
+
+// explorerSite is a site as shown by the dashboard
+type explorerSite struct {
+	ResourceID string `json:"resourceId"`
+	Name       string `json:"name"`
+	RegionID   string `json:"regionId,omitempty"`
+	RegionName string `json:"regionName,omitempty"`
+}
+
+// explorerRegion is a region as shown by the dashboard
+type explorerRegion struct {
+	ResourceID string `json:"resourceId"`
+	Name       string `json:"name"`
+	ParentID   string `json:"parentId,omitempty"`
+	TotalSites int32  `json:"totalSites"`
+}
+
+// explorerHandler serves the embedded dashboard and the read-only API it is built on
+type explorerHandler struct {
+	client      infra.ClientWithResponsesInterface
+	projectName string
+	token       string
+}
+
+func getServeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve local views of Edge Orchestrator resources",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getServeUICommand(),
+	)
+	return cmd
+}
+
+func getServeUICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ui [flags]",
+		Short: "Serves a read-only web dashboard of the hosts, sites and regions of a project",
+		Long: "Serves a read-only web dashboard of the hosts, sites and regions of a project until interrupted. " +
+			"The dashboard calls the Edge Orchestrator with the credentials of the current context, so the people it is " +
+			"shared with need no access to the orchestrator console. Its data is only served to browsers opening the " +
+			"printed link, which carries a random access token; only GET requests are accepted.",
+		Example: serveUIExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runServeUICommand,
+	}
+	cmd.Flags().String("addr", defaultExplorerAddr, "Address to listen on, in format host:port")
+	return cmd
+}
+
+// Serves the dashboard until the command is interrupted
+func runServeUICommand(cmd *cobra.Command, _ []string) error {
+	addr, _ := cmd.Flags().GetString("addr")
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	token, err := newExplorerToken()
+	if err != nil {
+		return err
+	}
+	handler, err := newExplorerHandler(client, projectName, token)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen on %s: %w", addr, err)
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Serving a read-only view of project %s at http://%s/?token=%s\n", projectName, listener.Addr(), token)
+	if tcpAddr, ok := listener.Addr().(*net.TCPAddr); ok && !tcpAddr.IP.IsLoopback() {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: anyone who can reach %s and has the link can see the hosts, sites and regions of project %s\n",
+			listener.Addr(), projectName)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), "Press Ctrl+C to stop")
+
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), explorerShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func newExplorerToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate an access token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Routes the embedded assets and the API; requests other than GET are rejected by the patterns
+func newExplorerHandler(client infra.ClientWithResponsesInterface, projectName string, token string) (http.Handler, error) {
+	assets, err := fs.Sub(explorerFiles, "explorer")
+	if err != nil {
+		return nil, err
+	}
+	h := &explorerHandler{client: client, projectName: projectName, token: token}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /", http.FileServer(http.FS(assets)))
+	mux.HandleFunc("GET /api/project", h.authorized(h.serveProject))
+	mux.HandleFunc("GET /api/hosts", h.authorized(h.serveHosts))
+	mux.HandleFunc("GET /api/sites", h.authorized(h.serveSites))
+	mux.HandleFunc("GET /api/regions", h.authorized(h.serveRegions))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy", "default-src 'self'")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		mux.ServeHTTP(w, r)
+	}), nil
+}
+
+// Only serves the data of the project to callers presenting the access token
+func (h *explorerHandler) authorized(next func(ctx context.Context) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(explorerTokenHeader)), []byte(h.token)) != 1 {
+			writeExplorerJSON(w, http.StatusUnauthorized, map[string]string{"error": "missing or invalid access token"})
+			return
+		}
+		data, err := next(r.Context())
+		if err != nil {
+			writeExplorerJSON(w, http.StatusBadGateway, map[string]string{"error": err.Error()})
+			return
+		}
+		writeExplorerJSON(w, http.StatusOK, data)
+	}
+}
+
+func writeExplorerJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func (h *explorerHandler) serveProject(_ context.Context) (interface{}, error) {
+	return map[string]string{"project": h.projectName}, nil
+}
+
+func (h *explorerHandler) serveHosts(ctx context.Context) (interface{}, error) {
+	hosts := make([]infra.HostResource, 0)
+	pageSize := explorerPageSize
+	offset := 0
+	for {
+		resp, err := h.client.HostServiceListHostsWithResponse(ctx, h.projectName,
+			&infra.HostServiceListHostsParams{PageSize: &pageSize, Offset: &offset}, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, resp.JSON200.Hosts...)
+		if !resp.JSON200.HasNext || len(resp.JSON200.Hosts) == 0 {
+			break
+		}
+		offset += len(resp.JSON200.Hosts)
+	}
+	return outfmt.HostRows(hosts), nil
+}
+
+func (h *explorerHandler) serveSites(ctx context.Context) (interface{}, error) {
+	sites := make([]explorerSite, 0)
+	pageSize := explorerPageSize
+	offset := 0
+	for {
+		resp, err := h.client.SiteServiceListSitesWithResponse(ctx, h.projectName, queryRegion,
+			&infra.SiteServiceListSitesParams{PageSize: &pageSize, Offset: &offset}, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving sites"); err != nil {
+			return nil, err
+		}
+		for _, s := range resp.JSON200.Sites {
+			site := explorerSite{ResourceID: derefString(s.ResourceId), Name: derefString(s.Name), RegionID: derefString(s.RegionId)}
+			if s.Region != nil {
+				site.RegionName = derefString(s.Region.Name)
+				if site.RegionID == "" {
+					site.RegionID = derefString(s.Region.ResourceId)
+				}
+			}
+			sites = append(sites, site)
+		}
+		if !resp.JSON200.HasNext || len(resp.JSON200.Sites) == 0 {
+			break
+		}
+		offset += len(resp.JSON200.Sites)
+	}
+	return sites, nil
+}
+
+func (h *explorerHandler) serveRegions(ctx context.Context) (interface{}, error) {
+	regions := make([]explorerRegion, 0)
+	pageSize := explorerPageSize
+	offset := 0
+	for {
+		resp, err := h.client.RegionServiceListRegionsWithResponse(ctx, h.projectName,
+			&infra.RegionServiceListRegionsParams{PageSize: &pageSize, Offset: &offset}, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving regions"); err != nil {
+			return nil, err
+		}
+		for _, r := range resp.JSON200.Regions {
+			region := explorerRegion{ResourceID: derefString(r.ResourceId), Name: derefString(r.Name), ParentID: derefString(r.ParentId)}
+			if r.TotalSites != nil {
+				region.TotalSites = *r.TotalSites
+			}
+			regions = append(regions, region)
+		}
+		if !resp.JSON200.HasNext || len(resp.JSON200.Regions) == 0 {
+			break
+		}
+		offset += len(resp.JSON200.Regions)
+	}
+	return regions, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Renders the read-only dashboard from the API of orch-cli serve ui.
+// The access token comes with the link printed by the CLI and is sent as a header on every call.
+'use strict';
+
+const REFRESH_INTERVAL_MS = 30000;
+const token = new URLSearchParams(window.location.search).get('token') || '';
+const state = { hosts: [], sites: [], regions: [] };
+
+async function fetchJSON(path) {
+  const resp = await fetch(path, { headers: { 'X-Explorer-Token': token } });
+  const body = await resp.json();
+  if (!resp.ok) {
+    throw new Error(body.error || resp.statusText);
+  }
+  return body;
+}
+
+function cell(text) {
+  const td = document.createElement('td');
+  td.textContent = text === undefined || text === null || text === '' ? '-' : String(text);
+  return td;
+}
+
+function fillTable(id, rows, columns) {
+  const tbody = document.getElementById(id);
+  tbody.replaceChildren(...rows.map((row) => {
+    const tr = document.createElement('tr');
+    columns.forEach((column) => tr.appendChild(cell(column(row))));
+    return tr;
+  }));
+}
+
+function matches(filter, ...values) {
+  return filter === '' || values.some((v) => String(v || '').toLowerCase().includes(filter));
+}
+
+function countBy(items, key) {
+  return items.reduce((counts, item) => {
+    const value = key(item) || 'Unknown';
+    counts[value] = (counts[value] || 0) + 1;
+    return counts;
+  }, {});
+}
+
+function renderSummary() {
+  const cards = [
+    ['Hosts', state.hosts.length],
+    ['Sites', state.sites.length],
+    ['Regions', state.regions.length],
+  ];
+  Object.entries(countBy(state.hosts, (h) => h.hostStatus))
+    .sort((a, b) => b[1] - a[1])
+    .forEach(([status, count]) => cards.push([status, count]));
+
+  document.getElementById('summary').replaceChildren(...cards.map(([label, value]) => {
+    const card = document.createElement('div');
+    card.className = 'card';
+    const v = document.createElement('div');
+    v.className = 'value';
+    v.textContent = value;
+    const l = document.createElement('div');
+    l.className = 'label';
+    l.textContent = label;
+    card.append(v, l);
+    return card;
+  }));
+}
+
+function render() {
+  const filter = document.getElementById('filter').value.trim().toLowerCase();
+  const hostsPerSite = countBy(state.hosts, (h) => h.siteId);
+  const regionNames = Object.fromEntries(state.regions.map((r) => [r.resourceId, r.name]));
+
+  const hosts = state.hosts.filter((h) => matches(filter, h.name, h.resourceId, h.hostStatus,
+    h.provisioningStatus, h.serialNumber, h.siteName, h.siteId));
+  fillTable('hosts', hosts, [
+    (h) => h.name, (h) => h.resourceId, (h) => h.hostStatus, (h) => h.provisioningStatus,
+    (h) => h.serialNumber, (h) => h.operatingSystem, (h) => h.siteName || h.siteId,
+  ]);
+  document.getElementById('host-count').textContent = `(${hosts.length} of ${state.hosts.length})`;
+
+  const sites = state.sites.filter((s) => matches(filter, s.name, s.resourceId, s.regionName, s.regionId));
+  fillTable('sites', sites, [
+    (s) => s.name, (s) => s.resourceId, (s) => s.regionName || regionNames[s.regionId] || s.regionId,
+    (s) => hostsPerSite[s.resourceId] || 0,
+  ]);
+  document.getElementById('site-count').textContent = `(${sites.length} of ${state.sites.length})`;
+
+  const regions = state.regions.filter((r) => matches(filter, r.name, r.resourceId, r.parentId));
+  fillTable('regions', regions, [
+    (r) => r.name, (r) => r.resourceId, (r) => regionNames[r.parentId] || r.parentId, (r) => r.totalSites,
+  ]);
+  document.getElementById('region-count').textContent = `(${regions.length} of ${state.regions.length})`;
+
+  renderSummary();
+}
+
+async function refresh() {
+  const error = document.getElementById('error');
+  try {
+    const [project, hosts, sites, regions] = await Promise.all([
+      fetchJSON('api/project'), fetchJSON('api/hosts'), fetchJSON('api/sites'), fetchJSON('api/regions'),
+    ]);
+    document.getElementById('project').textContent = project.project;
+    Object.assign(state, { hosts, sites, regions });
+    error.hidden = true;
+    document.getElementById('updated').textContent = `Updated ${new Date().toLocaleTimeString()}`;
+    render();
+  } catch (err) {
+    error.textContent = `Cannot load the project: ${err.message}`;
+    error.hidden = false;
+  }
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+  document.getElementById('filter').addEventListener('input', render);
+  document.getElementById('refresh').addEventListener('click', refresh);
+  refresh();
+  setInterval(refresh, REFRESH_INTERVAL_MS);
+});
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/spf13/cobra"
+)
+
+func (s *CLITestSuite) newExplorerServer() *httptest.Server {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("project", "itep", "")
+	_, client, projectName, err := InfraFactory(cmd)
+	s.NoError(err)
+	handler, err := newExplorerHandler(client, projectName, "secret")
+	s.NoError(err)
+	return httptest.NewServer(handler)
+}
+
+func (s *CLITestSuite) explorerGet(srv *httptest.Server, path string, token string) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	s.NoError(err)
+	if token != "" {
+		req.Header.Set(explorerTokenHeader, token)
+	}
+	resp, err := srv.Client().Do(req)
+	s.NoError(err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	s.NoError(err)
+	return resp, string(body)
+}
+
+func (s *CLITestSuite) TestExplorerAPI() {
+	srv := s.newExplorerServer()
+	defer srv.Close()
+
+	resp, body := s.explorerGet(srv, "/api/hosts", "")
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+	s.Contains(body, "missing or invalid access token")
+
+	resp, _ = s.explorerGet(srv, "/api/hosts", "wrong")
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	resp, body = s.explorerGet(srv, "/api/project", "secret")
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.JSONEq(`{"project":"itep"}`, body)
+
+	resp, body = s.explorerGet(srv, "/api/hosts", "secret")
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("no-store", resp.Header.Get("Cache-Control"))
+	var hosts []outfmt.HostRow
+	s.NoError(json.Unmarshal([]byte(body), &hosts))
+	s.NotEmpty(hosts)
+	s.Contains(body, "edge-host-001")
+
+	for _, path := range []string{"/api/sites", "/api/regions"} {
+		resp, body = s.explorerGet(srv, path, "secret")
+		s.Equal(http.StatusOK, resp.StatusCode, path)
+		s.True(strings.HasPrefix(body, "["), path)
+	}
+}
+
+func (s *CLITestSuite) TestExplorerReadOnly() {
+	srv := s.newExplorerServer()
+	defer srv.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, srv.URL+"/api/hosts", strings.NewReader("{}"))
+		s.NoError(err)
+		req.Header.Set(explorerTokenHeader, "secret")
+		resp, err := srv.Client().Do(req)
+		s.NoError(err)
+		resp.Body.Close()
+		s.Equal(http.StatusMethodNotAllowed, resp.StatusCode, method)
+	}
+}
+
+func (s *CLITestSuite) TestExplorerAssets() {
+	srv := s.newExplorerServer()
+	defer srv.Close()
+
+	resp, body := s.explorerGet(srv, "/", "")
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Contains(body, `<script src="app.js" defer></script>`)
+	s.Equal("default-src 'self'", resp.Header.Get("Content-Security-Policy"))
+
+	resp, body = s.explorerGet(srv, "/app.js", "")
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Contains(body, explorerTokenHeader)
+}
@@ -6,7 +6,6 @@ package cli
 import (
 	"fmt"
 
-	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/spf13/cobra"
 )
 
@@ -14,7 +13,7 @@ func getExportCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "export",
 		Short:             "Export resources from the orchestrator",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		Example:           "orch-cli export deployment-package wordpress 0.1.1",
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -27,8 +26,7 @@ func getExportCommand() *cobra.Command {
 			return c.Usage()
 		},
 	}
-	cmd.AddCommand(
-		getExportDeploymentPackageCommand(),
-	)
+	addCommandIfFeatureEnabled(cmd, getExportDeploymentPackageCommand(), AppOrchFeature)
+	addCommandIfFeatureEnabled(cmd, getExportMaintenanceCommand(), Day2Feature)
 	return cmd
 }
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/internal/validator"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const findHostExamples = `# Find which project and site own the device with a given serial number, searching every accessible project
+orch-cli find host --serial 2500JF3 --all-projects
+
+# Find a device by UUID in the active project only
+orch-cli find host --uuid 4c4c4544-2046-5310-8052-cac04f515233 --project some-project
+
+# Sample output
+PROJECT        SITE                          HOST ID         NAME            SERIAL    UUID                                   STATUS
+some-project   store-042 (site-c69a3c81)     host-1234abcd   edge-host-001   2500JF3   4c4c4544-2046-5310-8052-cac04f515233   Running
+Found 1 host in 3 projects searched
+`
+
+// hostLocation is a host found by serial number or UUID, with the project owning it
+type hostLocation struct {
+	Project string
+	Host    infra.HostResource
+}
+
+func getFindCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find",
+		Short: "Locate Edge Orchestrator resources",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getFindHostCommand(),
+	)
+	return cmd
+}
+
+func getFindHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host {--serial <serial> | --uuid <uuid>} [--all-projects] [flags]",
+		Short: "Finds the project and site owning a host given by serial number or UUID",
+		Long: "Searches the hosts of the active project, or of every project accessible to the user with --all-projects, " +
+			"for the given serial number and/or UUID, and reports the project and site owning each match.",
+		Example: findHostExamples,
+		Aliases: hostAliases,
+		Args:    cobra.NoArgs,
+		RunE:    runFindHostCommand,
+	}
+	cmd.Flags().String("serial", "", "Serial number of the host")
+	cmd.Flags().StringP("uuid", "u", "", "UUID of the host")
+	cmd.Flags().Bool("all-projects", false, "Search every project accessible to the user instead of the active project only")
+	cmd.MarkFlagsOneRequired("serial", "uuid")
+	return cmd
+}
+
+// Searches the projects for the host and lists where it is registered
+func runFindHostCommand(cmd *cobra.Command, _ []string) error {
+	serial, _ := cmd.Flags().GetString("serial")
+	uuid, _ := cmd.Flags().GetString("uuid")
+	allProjects, _ := cmd.Flags().GetBool("all-projects")
+
+	// The values end up in the filter of the API call, so only well-formed ones are accepted
+	if !regexp.MustCompile(validator.SNPATTERN).MatchString(serial) {
+		return e.WithCode(e.CodeInvalidArgument, e.NewCustomError(e.ErrInvalidSN))
+	}
+	if !regexp.MustCompile(validator.UPATTERN).MatchString(uuid) {
+		return e.WithCode(e.CodeInvalidArgument, e.NewCustomError(e.ErrInvalidUUID))
+	}
+
+	projects := []string{""}
+	if allProjects {
+		var err error
+		projects, err = listAccessibleProjects(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	record := types.HostRecord{Serial: serial, UUID: uuid}
+	var found []hostLocation
+	for _, projectName := range projects {
+		location, err := findHostInProject(cmd, projectName, record)
+		if err != nil {
+			if !allProjects {
+				return err
+			}
+			// A project that cannot be searched must not hide the matches in the others
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: skipping project %s: %v\n", projectName, err)
+			continue
+		}
+		if location != nil {
+			found = append(found, *location)
+		}
+	}
+
+	if len(found) == 0 {
+		return e.WithCode(e.CodeNotFound, fmt.Errorf("no host with %s found in %d project(s) searched", describeHostRecord(record), len(projects)))
+	}
+
+	writer, _ := getOutputContext(cmd)
+	fmt.Fprintf(writer, "PROJECT\tSITE\tHOST ID\tNAME\tSERIAL\tUUID\tSTATUS\n")
+	for _, location := range found {
+		host := location.Host
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", location.Project, hostSiteDisplay(host),
+			derefString(host.ResourceId), host.Name, derefString(host.SerialNumber), derefString(host.Uuid), derefString(host.HostStatus))
+	}
+	hostWord := "hosts"
+	if len(found) == 1 {
+		hostWord = "host"
+	}
+	fmt.Fprintf(writer, "Found %d %s in %d projects searched\n", len(found), hostWord, len(projects))
+	return writer.Flush()
+}
+
+// Lists the names of the projects the user has access to, sorted
+func listAccessibleProjects(cmd *cobra.Command) ([]string, error) {
+	ctx, projectClient, err := TenancyFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := projectClient.LISTV1ProjectsWithResponse(ctx, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting projects"); err != nil {
+		return nil, err
+	}
+
+	var projects []string
+	if resp.JSON200 != nil {
+		for _, p := range *resp.JSON200 {
+			if name := derefString(p.Name); name != "" {
+				projects = append(projects, name)
+			}
+		}
+	}
+	sort.Strings(projects)
+	return projects, nil
+}
+
+// Searches a single project, the active one when projectName is empty; other projects are selected
+// through the project flag so that InfraFactory checks the access to them
+func findHostInProject(cmd *cobra.Command, projectName string, record types.HostRecord) (*hostLocation, error) {
+	if projectName != "" {
+		if err := cmd.Flags().Set(project, projectName); err != nil {
+			return nil, err
+		}
+	}
+	ctx, hClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	host, err := findHostForRecord(ctx, hClient, projectName, record)
+	if err != nil || host == nil {
+		return nil, err
+	}
+	return &hostLocation{Project: projectName, Host: *host}, nil
+}
+
+func describeHostRecord(record types.HostRecord) string {
+	switch {
+	case record.Serial != "" && record.UUID != "":
+		return fmt.Sprintf("serial number %s and UUID %s", record.Serial, record.UUID)
+	case record.Serial != "":
+		return fmt.Sprintf("serial number %s", record.Serial)
+	default:
+		return fmt.Sprintf("UUID %s", record.UUID)
+	}
+}
+
+// Shows the site as "name (resource ID)", or just the ID when its name is unknown
+func hostSiteDisplay(host infra.HostResource) string {
+	siteID := derefString(host.SiteId)
+	if host.Site != nil {
+		if siteID == "" {
+			siteID = derefString(host.Site.ResourceId)
+		}
+		if name := derefString(host.Site.Name); name != "" {
+			return fmt.Sprintf("%s (%s)", name, siteID)
+		}
+	}
+	if siteID == "" {
+		return "-"
+	}
+	return siteID
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestFindHost() {
+	out, err := s.runCommand("find host --serial 1234567890 --all-projects")
+	s.NoError(err)
+	s.Regexp(`PROJECT\s+\|SITE\s+\|HOST ID\s+\|NAME\s+\|SERIAL\s+\|UUID\s+\|STATUS`, out)
+	s.Regexp(`itep\s+\|site \(site-abcd1234\)\s+\|host-abc12345\s+\|edge-host-001\s+\|1234567890\s+\|550e8400-e29b-41d4-a716-446655440000\s+\|Running`, out)
+	s.Contains(out, "Found 1 host in 1 projects searched\n")
+
+	out, err = s.runCommand("find host --uuid 550E8400-E29B-41D4-A716-446655440000 --project some-project")
+	s.NoError(err)
+	s.Regexp(`some-project\s+\|site \(site-abcd1234\)\s+\|host-abc12345`, out)
+
+	_, err = s.runCommand("find host --serial ABCDE12345 --all-projects")
+	s.EqualError(err, "no host with serial number ABCDE12345 found in 1 project(s) searched")
+	s.Equal(e.CodeNotFound, e.CodeOf(err))
+
+	_, err = s.runCommand("find host --serial \"1' OR name='x\" --project some-project")
+	s.EqualError(err, "Invalid Serial number")
+
+	_, err = s.runCommand("find host --project some-project")
+	s.Error(err)
+}
+
+func TestHostSiteDisplay(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	assert.Equal(t, "-", hostSiteDisplay(infra.HostResource{}))
+	assert.Equal(t, "site-1234abcd", hostSiteDisplay(infra.HostResource{SiteId: stringPtr("site-1234abcd")}))
+	assert.Equal(t, "store-042 (site-1234abcd)", hostSiteDisplay(infra.HostResource{
+		Site: &infra.SiteResource{ResourceId: stringPtr("site-1234abcd"), Name: stringPtr("store-042")},
+	}))
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	geocoderURLFlag    = "geocoder-url"
+	defaultGeocoderURL = "https://nominatim.openstreetmap.org/search"
+	geocoderTimeout    = 30 * time.Second
+)
+
+// geocodedAddress is the location a geocoding service found for an address
+type geocodedAddress struct {
+	lat         float64
+	lng         float64
+	displayName string
+}
+
+// Looks an address up with a geocoding service offering the search API of Nominatim, taking its best match
+func geocodeAddress(ctx context.Context, client *http.Client, endpoint string, address string) (*geocodedAddress, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid geocoder URL %q", endpoint)
+	}
+	query := u.Query()
+	query.Set("q", address)
+	query.Set("format", "jsonv2")
+	query.Set("limit", "1")
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	// Public Nominatim instances require a User-Agent identifying the application
+	req.Header.Set("User-Agent", CLIName+"/"+Version)
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to geocode address %q: %w", address, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to geocode address %q: geocoder answered %s", address, resp.Status)
+	}
+
+	var places []struct {
+		Lat         string `json:"lat"`
+		Lon         string `json:"lon"`
+		DisplayName string `json:"display_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&places); err != nil {
+		return nil, fmt.Errorf("failed to geocode address %q: invalid geocoder response: %w", address, err)
+	}
+	if len(places) == 0 {
+		return nil, fmt.Errorf("no location found for address %q, give --latitude and --longitude instead", address)
+	}
+	lat, latErr := strconv.ParseFloat(places[0].Lat, 64)
+	lng, lngErr := strconv.ParseFloat(places[0].Lon, 64)
+	if latErr != nil || lngErr != nil {
+		return nil, fmt.Errorf("failed to geocode address %q: invalid coordinates %q, %q", address, places[0].Lat, places[0].Lon)
+	}
+	return &geocodedAddress{lat: lat, lng: lng, displayName: places[0].DisplayName}, nil
+}
@@ -58,6 +58,9 @@ func printGroups(cmd *cobra.Command, writer io.Writer, groups []keycloak.GroupRe
 		NameLimit: -1,
 		Data:      groups,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -6,7 +6,6 @@ package cli
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -20,6 +19,7 @@ import (
 
 	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/internal/files"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
 	"github.com/open-edge-platform/cli/internal/types"
 	"github.com/open-edge-platform/cli/internal/validator"
 	"github.com/open-edge-platform/cli/pkg/auth"
@@ -31,15 +31,34 @@ import (
 	"github.com/spf13/viper"
 )
 
+// Sheet of an Excel workbook imported by create host
+const sheetFlag = "sheet"
+
+const columnMapFlag = "column-map"
+
 const listHostExamples = `# List all hosts
 orch-cli list host --project some-project
 
 # List hosts using a predefined filter (options: provisioned, onboarded, registered, "not connected", deauthorized) 
 orch-cli list host --project some-project --filter provisioned
 
+# List only the resource IDs of the deauthorized hosts, one per line, to delete them
+orch-cli list host --project some-project --filter deauthorized -q | xargs -n1 orch-cli delete host --project some-project
+
 # List hosts using a custom filter (see: https://google.aip.dev/160 and API spec @ https://github.com/open-edge-platform/orch-utils/blob/main/tenancy-api-mapping/openapispecs/generated/amc-infra-core-edge-infrastructure-manager-openapi-all.yaml )
 orch-cli list host --project some-project --filter "serialNumber='123456789'"
 
+# List the provisioned hosts of an OS profile that have an OS update available; typed filters are combined with AND,
+# also with --filter
+orch-cli list host --project some-project --status provisioned --os-profile ubuntu-22.04-lts-generic --has-update
+
+# List the host of a serial number, or of a UUID
+orch-cli list host --project some-project --serial 123456789
+orch-cli list host --project some-project --uuid 4c4c4544-0044-4210-8031-c2c04f305233
+
+# List the hosts without Secure Boot and Full Disk Encryption
+orch-cli list host --project some-project --secure=false
+
 # List hosts in a specific site using site ID (--site flag will take precedence over --region flag)
 orch-cli list host --project some-project --site site-c69a3c81
 
@@ -51,13 +70,37 @@ orch-cli list host --project some-project --workload cluster-sn000320
 
 # List hosts without a workload using NotAssigned argument
 orch-cli list host --project some-project --workload NotAssigned
+
+# List hosts with a client-side expression over their fields, nested ones included, which the server filter cannot reach
+orch-cli list host --project some-project --select 'cpuCores >= 16 && hostStatus == "Running"'
+
+# List the hosts whose OS has known CVEs of critical priority
+orch-cli list host --project some-project --select '"critical" in instance.existingCves.priority'
+
+# List chosen fields of the hosts, nested ones included, without the header line, e.g. for scripts
+orch-cli list host --project some-project --columns "ResourceID,Name,Site Name,Host Status,instance.provisioningStatus" --no-headers
+
+# Show the hosts of the last listing immediately and update the table once they are refreshed
+orch-cli list host --project some-project --cached
+
+# List at most 50 hosts starting at the 100th, fetched 25 per request
+orch-cli list host --project some-project --offset 100 --limit 50 --page-size 25
+
+# List the hosts per site, each site followed by its number of hosts, sorted by status within the site
+orch-cli list host --project some-project --group-by site --sort-by status
 `
 
 const getHostExamples = `# Get a host by resource ID
 orch-cli get host host-1234abcd --project some-project
 
 # Get a host by name
-orch-cli get host my-host --project some-project`
+orch-cli get host my-host --project some-project
+
+# Print the provisioning status of the instance of a host, with a Go template over the fields of the host
+orch-cli get host host-1234abcd --template '{{.Instance.ProvisioningStatus}}' --project some-project
+
+# Print the resource ID and the serial number of a host, with JSONPath over its JSON output
+orch-cli get host my-host --jsonpath '{.resourceId} {.serialNumber}' --project some-project`
 
 func createHostExamples() string {
 	examples := `# Provision a host or a number of hosts from a CSV file
@@ -79,7 +122,7 @@ OSProfile - OS Profile to be used for provisioning of the host - name of the pro
 Site - The resource ID of the site to which the host will be provisioned - mandatory field
 Secure - Optional security feature to configure for the host - must be supported by OS Profile if enabled
 Remote User - Optional remote user name or resource ID to configure for the host
-Metadata - Optional metadata to configure for the host
+Metadata - Optional metadata to configure for the host, key=value pairs separated by & (at most 32, keys unique, lowercase alphanumerics, '-', '_' and '.', keys prefixed with kubernetes.io/, k8s.io/ or edge-orchestrator.intel.com/ are reserved)
 LVMSize - Optional LVM size to be configured for the host
 CloudInitMeta - Optional Cloud Init Metadata to be configured for the host
 K8sEnable - Optional command to enable cluster deployment (only used if Cluster Orchestration feature is enabled in the Edge Orchestrator)
@@ -93,12 +136,48 @@ Serial,UUID,OSProfile,Site,Secure,RemoteUser,Metadata,LVMSize,CloudInitMeta,K8sE
 11002F3,2c4c4544-2046-5310-8052-cac04f512233,"Edge Microvisor Toolkit 3.0.20250617",site-c69a3c81,false,,key1=value2&key3=value4,,cloudinitname&customconfig-1234abcd
 25002F3,214c4544-2046-5310-8052-cac04f512233,"Edge Microvisor Toolkit 3.0.20250617",site-c69a3c81,false,user,key1=value2&key3=value4,60,,true,baseline:v2.0.2,,role:all;name:mycluster;labels:key1=val1&key2=val2
 
-# --dry-run allows for verification of the validity of the input csv file without creating hosts
+# --dry-run allows for verification of the validity of the input csv file without creating hosts. The hosts already registered
+# with a cluster to deploy are checked against the min-cpu-cores, min-memory-gb and min-storage-gb cluster labels of the
+# cluster template, mismatches are written as warnings to a preflight_warning file
 orch-cli create host --project some-project --import-from-csv test.csv --dry-run
 
-# Create hosts - --import-from-csv is a mandatory flag pointing to the input file. Successfully provisioned host indicated by output - errors provided in output file
+# Create hosts - --import-from-csv is a mandatory flag pointing to the input file. A summary, broken down per site, is printed - errors provided in output file
 orch-cli create host --project some-project --import-from-csv test.csv
 
+# Create the hosts of the manifest written by the EMT pre-boot discovery image, selecting their site and OS profile interactively
+orch-cli create host --project some-project --from-discovery discovery.json
+
+# Create hosts from the "Store 42" sheet of an Excel workbook whose columns are the ones of the CSV file
+orch-cli create host --project some-project --import-from-csv inventory.xlsx --sheet "Store 42"
+
+# Create hosts from the sheet of another asset system, whose headers name the columns differently
+orch-cli create host --project some-project --import-from-csv assets.csv --column-map "Serial=SN,UUID=DeviceGUID,Site=Location,OSProfile=Image"
+
+# Create hosts without progress reporting (e.g. in CI) - failures are still summarized and written to the error file
+orch-cli create host --project some-project --import-from-csv test.csv --quiet
+
+# Write the serial number to host ID mapping of all rows, failed ones included, to a CSV file for downstream automation
+orch-cli create host --project some-project --import-from-csv test.csv --output-ids host-ids.csv
+
+# Print the outcome of every row, with its host ID or error code, as JSON
+orch-cli create host --project some-project --import-from-csv test.csv --output-type json
+
+# Write the per-site summary of the import (hosts attempted, succeeded and failed, success rate, average, median and 95th percentile registration time, clusters created) to a JSON file
+orch-cli create host --project some-project --import-from-csv test.csv --output-site-summary sites.json
+
+# Keep the OS profiles, sites, local accounts and cluster templates resolved by the import on disk for 30 minutes, so that the next imports do not look them up again
+orch-cli create host --project some-project --import-from-csv test.csv --cache-dir ~/.orch-cli/resolve --cache-ttl 30m
+
+# Checkpoint the registered rows of a large import so that an interrupted import can be run again without registering the same hosts twice, registering at most 5 hosts per second
+orch-cli create host --project some-project --import-from-csv test.csv --state-file import.state --rate-limit 5
+
+# Run a large import in the background and check its progress later with 'orch-cli jobs status <jobID>'
+orch-cli create host --project some-project --import-from-csv test.csv --async
+
+# Create the clusters requested by the CSV file only once the hosts are provisioned and running, waiting up to an hour;
+# the rows of the hosts that fail to provision or are not running in time are reported as failed, without a cluster
+orch-cli create host --project some-project --import-from-csv test.csv --wait-for-host-ready --host-ready-timeout 1h
+
 # Optional flag ovverides - the flag will override all instances of an attribute inside the CSV file
 
 --serial - serial number of the host
@@ -136,9 +215,18 @@ Serial,UUID,OSProfile,Site,Secure,RemoteUser,Metadata,LVMSize,CloudInitMeta,K8sE
 # --dry-run allows for verification of the validity of the input csv file without creating hosts
 orch-cli create host --project some-project --import-from-csv test.csv --dry-run
 
-# Create hosts - --import-from-csv is a mandatory flag pointing to the input file. Successfully onboarded hosts indicated by output - errors provided in output file
+# Create hosts - --import-from-csv is a mandatory flag pointing to the input file. A summary is printed - errors provided in output file
 orch-cli create host --project some-project --import-from-csv test.csv
 
+# Create hosts without progress reporting (e.g. in CI) - failures are still summarized and written to the error file
+orch-cli create host --project some-project --import-from-csv test.csv --quiet
+
+# Write the serial number to host ID mapping of all rows, failed ones included, to a CSV file for downstream automation
+orch-cli create host --project some-project --import-from-csv test.csv --output-ids host-ids.csv
+
+# Print the outcome of every row, with its host ID or error code, as JSON
+orch-cli create host --project some-project --import-from-csv test.csv --output-type json
+
 # Create a single host directly using flags
 orch-cli create host <name> --project some-project --serial 2500JF3 --uuid 4c4c4544-2046-5310-8052-cac04f515233 --site site-c69a3c81
 
@@ -154,10 +242,22 @@ orch-cli create host <name> --project some-project --serial 2500JF3 --uuid 4c4c4
 const deleteHostExamples = `#Delete a host using its resource ID
 orch-cli delete host host-1234abcd  --project itep
 #Delete a host using its name
-orch-cli delete host "my-host"  --project itep`
+orch-cli delete host "my-host"  --project itep
+
+#Power off the host and unprovision its AMT before deleting it, recording the wipe in the host note
+orch-cli delete host host-1234abcd --wipe --project itep
+
+#Give the host up to 30 minutes to report it is powered off and AMT unprovisioned, it is kept otherwise
+orch-cli delete host host-1234abcd --wipe --wipe-timeout 30m --project itep`
 
 const deauthorizeHostExamples = `#Deauthorize the host and it's access to Edge Orchestrator using the host Resource ID
-orch-cli deauthorize host host-1234abcd  --project itep`
+orch-cli deauthorize host host-1234abcd  --project itep
+
+#Deauthorize several hosts and record the reason
+orch-cli deauthorize host host-1234abcd host-5678abcd --note "decommissioned" --project itep
+
+#Deauthorize all hosts matching a filter, after confirming the list of affected hosts
+orch-cli deauthorize host --filter "site.name='lab'" --note "site closed" --project itep`
 
 const updateHostExamples = `#Update the host OS
 orch-cli update-os host host-1234abcd  --project itep
@@ -196,7 +296,24 @@ host-3,host-3234abcd,osupdatepolicy-1234abcd
 
 func setHostExamples() string {
 	examples := `#Set an attribute of a host or execute an action - at least one flag must be specified
+
+#Set the note of a host, replacing its current note
+orch-cli set host host-1234abcd --project some-project --note "RMA scheduled 5/3"
+
+#Add a timestamped entry to the note of a host
+orch-cli set host host-1234abcd --project some-project --note "disk replaced" --append
+
+--note - Set the note of the host, "" removes it
+--append - Add the note as an entry prefixed with the current time to the current note of the host
+`
+	if isFeatureEnabled(ProvisioningFeature) {
+		examples += `
+#Grow the user LVM of a host to 80 GB
+orch-cli set host host-1234abcd --project some-project --lvm-size 80
+
+--lvm-size - Set the size in GB of the user LVM of the host, bounded by its largest disk; the LVM of a provisioned host can only grow
 `
+	}
 	// Add AMT and power-related examples only if OobFeature is enabled
 	if isFeatureEnabled(OobFeature) {
 		examples += `
@@ -256,6 +373,7 @@ orch-cli set host --project some-project --site site-1234abcd --power on
 orch-cli set host --project some-project --region region-1234abcd --power reset
 orch-cli set host --project some-project --site site-1234abcd --amt-state provisioned --control-mode admin
 orch-cli set host --project some-project --filter "hostStatus='onboarded'" --power on --amt-state provisioned
+orch-cli set host --project some-project --filter "site.resourceId='site-1234abcd'" --amt-state provisioned --concurrency 20
 
 # Dry run to see which hosts would be affected
 orch-cli set host --project some-project --filter "hostStatus='onboarded'" --power off --dry-run
@@ -272,124 +390,23 @@ orch-cli set host host-1234abcd  --project itep --osupdatepolicy <resourceID>
 orch-cli set host --project itep --site site-1234abcd --osupdatepolicy <resourceID>
 
 --osupdatepolicy - Set the OS Update policy for the host, must be a valid resource ID of an OS Update policy
+
+#Set host OS Update policy and update the host OS right away
+orch-cli update host host-1234abcd  --project itep --policy <resourceID> --now
+
+--policy - Same as --osupdatepolicy
+--now - Create a single maintenance schedule starting now which updates the host OS with its OS Update policy, only for a single host
 `
 	}
 
 	return examples
 }
 
-const (
-	// Default list format for onboarding mode (provisioning disabled)
-	DEFAULT_HOST_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.SerialNumber}}"
-
-	// Default list format for provisioning mode
-	DEFAULT_HOST_PROVISIONING_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.ProvisioningStatus}}\t{{.SerialNumber}}\t{{.OperatingSystem}}\t{{.SiteId}}\t{{.SiteName}}\t{{.Workload}}"
-
-	// Verbose list format for onboarding mode
-	DEFAULT_HOST_VERBOSE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.SerialNumber}}\t{{.Uuid}}"
-
-	// Verbose list format for provisioning mode
-	DEFAULT_HOST_PROVISIONING_VERBOSE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.ProvisioningStatus}}\t{{.SerialNumber}}\t{{.OperatingSystem}}\t{{.SiteId}}\t{{.SiteName}}\t{{.Workload}}\t{{.Uuid}}\t{{.CpuModel}}\t{{.OsUpdateAvailable}}\t{{.TrustedCompute}}"
-
-	HOST_OUTPUT_TEMPLATE_ENVVAR = "ORCH_CLI_HOST_OUTPUT_TEMPLATE"
-)
-
 var hostname = ""
 
-// HostListRow is a flat display struct for table output of the host list.
-// It pre-computes values that require conditional logic (feature-gating, deep nil
-// chains, "Waiting on node agents" special case) so templates use simple field
-// references that produce clean column headers.
-type HostListRow struct { //nolint:revive
-	ResourceId         string `json:"resourceId"`
-	Name               string `json:"name"`
-	HostStatus         string `json:"hostStatus"`
-	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
-	SerialNumber       string `json:"serialNumber"`
-	OperatingSystem    string `json:"operatingSystem,omitempty"`
-	SiteId             string `json:"siteId,omitempty"`
-	SiteName           string `json:"siteName,omitempty"`
-	Workload           string `json:"workload,omitempty"`
-	Uuid               string `json:"uuid,omitempty"`
-	CpuModel           string `json:"cpuModel,omitempty"`
-	OsUpdateAvailable  string `json:"osUpdateAvailable,omitempty"`
-	TrustedCompute     string `json:"trustedCompute,omitempty"`
-}
-
-// toHostListRows converts a slice of HostResource into flat HostListRow display rows.
-func toHostListRows(hosts []infra.HostResource) []HostListRow {
-	rows := make([]HostListRow, 0, len(hosts))
-	for _, h := range hosts {
-		row := HostListRow{
-			ResourceId:   safeString(h.ResourceId),
-			Name:         h.Name,
-			HostStatus:   hostStatusDisplay(h),
-			SerialNumber: safeString(h.SerialNumber),
-			SiteId:       safeString(h.SiteId),
-			Uuid:         safeString(h.Uuid),
-			CpuModel:     safeString(h.CpuModel),
-		}
-		if h.Site != nil && h.Site.Name != nil {
-			row.SiteName = *h.Site.Name
-		}
-		if h.Instance != nil {
-			if h.Instance.ProvisioningStatus != nil {
-				row.ProvisioningStatus = *h.Instance.ProvisioningStatus
-			} else {
-				row.ProvisioningStatus = "Not Provisioned"
-			}
-			if h.Instance.Os != nil && h.Instance.Os.Name != nil {
-				row.OperatingSystem = *h.Instance.Os.Name
-			} else {
-				row.OperatingSystem = "Not Provisioned"
-			}
-			if h.Instance.OsUpdateAvailable != nil && *h.Instance.OsUpdateAvailable != "" {
-				row.OsUpdateAvailable = "Available"
-			} else {
-				row.OsUpdateAvailable = "No update"
-			}
-			if h.Instance.TrustedAttestationStatus != nil && *h.Instance.TrustedAttestationStatus != "" {
-				row.TrustedCompute = *h.Instance.TrustedAttestationStatus
-			} else {
-				row.TrustedCompute = "Not compatible"
-			}
-			if h.Instance.WorkloadMembers != nil && len(*h.Instance.WorkloadMembers) > 0 {
-				row.Workload = safeString((*h.Instance.WorkloadMembers)[0].Workload.Name)
-			} else {
-				row.Workload = "Not Assigned"
-			}
-		} else {
-			row.ProvisioningStatus = "Not Provisioned"
-			row.OperatingSystem = "Not Provisioned"
-			row.OsUpdateAvailable = "No update"
-			row.TrustedCompute = "Not compatible"
-			row.Workload = "Not Assigned"
-		}
-		rows = append(rows, row)
-	}
-	return rows
-}
-
-// hostStatusDisplay returns the human-readable host status, handling the
-// "Waiting on node agents" special case for error-state hosts.
-func hostStatusDisplay(h infra.HostResource) string {
-	if h.HostStatus != nil && *h.HostStatus != "" {
-		if strings.EqualFold(*h.HostStatus, "error") &&
-			h.Instance != nil &&
-			h.Instance.InstanceStatusDetail != nil &&
-			strings.Contains(*h.Instance.InstanceStatusDetail, "of 10 components running") {
-			return "Waiting on node agents"
-		}
-		return *h.HostStatus
-	}
-	return "Not Connected"
-}
-
 var hostHeaderGet = "\nDetailed Host Information\n"
 var filename = "test.csv"
 
-const kVSize = 2
-
 type UpdateHostRecord struct {
 	Name           string
 	ResourceID     string
@@ -407,42 +424,10 @@ type ResponseCache struct {
 	CICache                 map[string]infra.CustomConfigResource
 }
 
-type CVEEntry struct {
-	CVEID            string   `json:"cve_id"`
-	Priority         string   `json:"priority"`
-	AffectedPackages []string `json:"affected_packages"`
-}
-
-// toJSON is a helper function to format a value into a JSON string
-// Returns "nil" for nil pointers, otherwise returns the JSON representation
-func toJSON(v interface{}) string {
-	if v == nil {
-		return "nil"
-	}
-	// Convert to JSON format
-	jsonBytes, err := json.Marshal(v)
-	if err != nil {
-		return fmt.Sprintf("%v", v)
-	}
-	return string(jsonBytes)
-}
-
 func filterHelper(f string) *string {
 	if f != "" {
-		switch f {
-		case "onboarded":
-			f = "hostStatus='onboarded'"
-		case "registered":
-			f = "hostStatus='registered'"
-		case "provisioned":
-			f = "hostStatus='provisioned'"
-		case "deauthorized":
-			f = "hostStatus='invalidated'"
-		case "not connected":
-			f = "hostStatus=''"
-		case "error":
-			f = "hostStatus='error'"
-		default:
+		if predefined, ok := hostStatusFilters[f]; ok {
+			f = predefined
 		}
 		return &f
 	}
@@ -450,6 +435,73 @@ func filterHelper(f string) *string {
 
 }
 
+// Statuses accepted by list host --status and as predefined --filter values, with the filter selecting them
+var hostStatusFilters = map[string]string{
+	"onboarded":     "hostStatus='onboarded'",
+	"registered":    "hostStatus='registered'",
+	"provisioned":   "hostStatus='provisioned'",
+	"deauthorized":  "hostStatus='invalidated'",
+	"not connected": "hostStatus=''",
+	"error":         "hostStatus='error'",
+}
+
+var hostStatusNames = []string{"onboarded", "registered", "provisioned", "deauthorized", "not connected", "error"}
+
+// Composes the typed filter flags of list host (--status, --os-profile, --serial, --uuid, --has-update, --secure)
+// into a filter; predefined --filter values select a status and cannot be combined with --status
+func hostFilterFlagsHelper(cmd *cobra.Command, filter string) (string, error) {
+	terms := make([]string, 0)
+
+	status, _ := cmd.Flags().GetString("status")
+	if status != "" {
+		term, ok := hostStatusFilters[status]
+		if !ok {
+			return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --status %q, must be one of: %s", status, strings.Join(hostStatusNames, ", ")))
+		}
+		if _, predefined := hostStatusFilters[filter]; predefined {
+			return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--status %q cannot be combined with the predefined --filter %q, both select the host status", status, filter))
+		}
+		terms = append(terms, term)
+	}
+
+	for _, field := range []struct{ flag, key string }{
+		{"os-profile", "instance.os.profileName"},
+		{"serial", "serialNumber"},
+		{"uuid", "uuid"},
+	} {
+		value, _ := cmd.Flags().GetString(field.flag)
+		if value == "" {
+			continue
+		}
+		if strings.ContainsAny(value, "'\",") {
+			return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s %q, it must not contain quotes or commas", field.flag, value))
+		}
+		if field.flag == "serial" && !regexp.MustCompile(validator.SNPATTERN).MatchString(value) {
+			return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --serial %q, expected 5 to 20 letters and digits", value))
+		}
+		if field.flag == "uuid" && !regexp.MustCompile(validator.UPATTERN).MatchString(value) {
+			return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --uuid %q, expected a UUID such as 4c4c4544-0044-4210-8031-c2c04f305233", value))
+		}
+		terms = append(terms, fmt.Sprintf("%s='%s'", field.key, value))
+	}
+
+	if cmd.Flags().Changed("has-update") {
+		if hasUpdate, _ := cmd.Flags().GetBool("has-update"); hasUpdate {
+			terms = append(terms, "instance.osUpdateAvailable!=''")
+		} else {
+			terms = append(terms, "instance.osUpdateAvailable=''")
+		}
+	}
+	if cmd.Flags().Changed("secure") {
+		if secure, _ := cmd.Flags().GetBool("secure"); secure {
+			terms = append(terms, fmt.Sprintf("instance.securityFeature=%s", infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION))
+		} else {
+			terms = append(terms, fmt.Sprintf("instance.securityFeature!=%s", infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION))
+		}
+	}
+	return strings.Join(terms, " AND "), nil
+}
+
 func filterSitesHelper(s string) (*string, error) {
 	if s != "" {
 		re := regexp.MustCompile(`^site-[a-zA-Z0-9]{8}$`)
@@ -472,28 +524,36 @@ func filterRegionsHelper(r string) (*string, error) {
 	return nil, nil
 }
 
+const HOST_OUTPUT_TEMPLATE_ENVVAR = "ORCH_CLI_HOST_OUTPUT_TEMPLATE"
+
 // getHostOutputFormat returns the appropriate template format string for host output.
 // When verbose is true it selects the verbose list format; otherwise it resolves
 // the non-verbose template from flags / envvar / default.
 func getHostOutputFormat(cmd *cobra.Command, verbose bool) (string, error) {
+	provisioning := isFeatureEnabled(ProvisioningFeature)
 	if verbose {
-		if isFeatureEnabled(ProvisioningFeature) {
-			return DEFAULT_HOST_PROVISIONING_VERBOSE_FORMAT, nil
-		}
-		return DEFAULT_HOST_VERBOSE_FORMAT, nil
+		return outfmt.HostTableFormat(provisioning, true), nil
 	}
-	defaultFmt := DEFAULT_HOST_FORMAT
-	if isFeatureEnabled(ProvisioningFeature) {
-		defaultFmt = DEFAULT_HOST_PROVISIONING_FORMAT
-	}
-	return resolveTableOutputTemplate(cmd, defaultFmt, HOST_OUTPUT_TEMPLATE_ENVVAR)
+	return resolveTableOutputTemplate(cmd, outfmt.HostTableFormat(provisioning, false), HOST_OUTPUT_TEMPLATE_ENVVAR)
 }
 
 // printHosts renders a host list using the standard template-based output pipeline.
-// For table output, hosts are converted to flat HostListRow values so the header
+// For table output, hosts are converted to flat HostRow values so the header
 // extractor produces clean column names without {{if}} blocks.
 // For JSON/YAML, the full raw HostResource slice is serialized.
 func printHosts(cmd *cobra.Command, writer io.Writer, hosts *[]infra.HostResource, orderBy *string, outputFilter *string, verbose bool) error {
+	sortBy, groupBy, err := getHostListLayout(cmd)
+	if err != nil {
+		return err
+	}
+	selected, err := selectItemViews(cmd, *hosts, hostSelectView)
+	if err != nil {
+		return err
+	}
+	if sortBy != "" {
+		sortHosts(selected, sortBy)
+	}
+	hosts = &selected
 	outputType, _ := cmd.Flags().GetString("output-type")
 
 	sortSpec := ""
@@ -522,7 +582,7 @@ func printHosts(cmd *cobra.Command, writer io.Writer, hosts *[]infra.HostResourc
 		return err
 	}
 
-	rows := toHostListRows(*hosts)
+	rows := outfmt.HostRows(*hosts)
 	result := CommandResult{
 		Format:    format.Format(outputFormat),
 		Filter:    filterSpec,
@@ -531,6 +591,12 @@ func printHosts(cmd *cobra.Command, writer io.Writer, hosts *[]infra.HostResourc
 		NameLimit: -1,
 		Data:      rows,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
+	if groupBy != "" {
+		return printHostGroups(writer, result, rows, groupBy)
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
@@ -547,7 +613,7 @@ func getValidatedHostOrderBy(ctx context.Context, cmd *cobra.Command, hostClient
 	outputType, _ := cmd.Flags().GetString("output-type")
 	if outputType == "table" {
 		// Client-side sorting uses the flat display row so field names match the template.
-		return normalizeOrderByForClientSorting(raw, HostListRow{})
+		return normalizeOrderByForClientSorting(raw, outfmt.HostRow{})
 	}
 
 	return normalizeOrderByWithAPIProbe(raw, "hosts", infra.HostResource{}, func(orderBy string) (bool, error) {
@@ -576,545 +642,21 @@ func getValidatedHostOrderBy(ctx context.Context, cmd *cobra.Command, hostClient
 // Host inspect (get) templating support
 // ---------------------------------------------------------------------------
 
-// Sub-row types used in HostInspectItem for range loops in the inspect template.
-
-type HostStorageRow struct {
-	Wwid     string `json:"wwid"`
-	Capacity string `json:"capacity"`
-	Model    string `json:"model"`
-	Serial   string `json:"serial"`
-	Vendor   string `json:"vendor"`
-}
-
-type HostNicRow struct { //nolint:revive
-	Name         string `json:"name"`
-	LinkState    string `json:"linkState"`
-	Mtu          string `json:"mtu"`
-	MacAddress   string `json:"macAddress"`
-	PciId        string `json:"pciId"`
-	Sriov        string `json:"sriov"`
-	SriovVFTotal string `json:"sriovVFTotal"`
-	SriovVFNum   string `json:"sriovVFNum"`
-	BmcInterface string `json:"bmcInterface"`
-}
-
-type HostGpuRow struct { //nolint:revive
-	DeviceName   string `json:"deviceName"`
-	Vendor       string `json:"vendor"`
-	Capabilities string `json:"capabilities"`
-	PciId        string `json:"pciId"`
-}
-
-type HostUsbRow struct { //nolint:revive
-	Class     string `json:"class"`
-	Serial    string `json:"serial"`
-	VendorId  string `json:"vendorId"`
-	ProductId string `json:"productId"`
-	Bus       string `json:"bus"`
-	Address   string `json:"address"`
-}
-
-type HostCveRow struct { //nolint:revive
-	CveId            string `json:"cveId"`
-	Priority         string `json:"priority"`
-	AffectedPackages string `json:"affectedPackages"`
-}
-
-type HostMetadataRow struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-}
-
-// HostInspectItem is the flat struct fed to the inspect template.
-// All complex conditional logic (feature-gating, nil chains, unit conversions,
-// "Waiting on node agents" special case) is resolved in toHostInspectItem so
-// the template only needs simple field references and range loops.
-type HostInspectItem struct { //nolint:revive
-	// Identity
-	ResourceId string `json:"resourceId"`
-	Name       string `json:"name"`
-
-	// Status
-	HostStatus         string `json:"hostStatus"`
-	HostStatusDetails  string `json:"hostStatusDetails"`
-	ProvisioningStatus string `json:"provisioningStatus"`
-	UpdateStatus       string `json:"updateStatus"`
-	OsUpdatePolicy     string `json:"osUpdatePolicy"`
-
-	// Specification
-	SerialNumber    string `json:"serialNumber"`
-	Uuid            string `json:"uuid"`
-	OperatingSystem string `json:"operatingSystem"`
-	BiosVendor      string `json:"biosVendor"`
-	BiosVersion     string `json:"biosVersion"`
-	ProductName     string `json:"productName"`
-
-	// Provisioning-specific identity
-	OsProfile string `json:"osProfile"`
-	NicIps    string `json:"nicIps"`
-	LvmSize   string `json:"lvmSize"`
-
-	// Customizations
-	CustomConfigs string            `json:"customConfigs"`
-	Metadata      []HostMetadataRow `json:"metadata"`
-
-	// CPU
-	CpuModel        string `json:"cpuModel"`
-	CpuCores        string `json:"cpuCores"`
-	CpuArchitecture string `json:"cpuArchitecture"`
-	CpuThreads      string `json:"cpuThreads"`
-	CpuSockets      string `json:"cpuSockets"`
-
-	// Memory
-	MemoryGB string `json:"memoryGB"`
-
-	// Hardware sub-tables
-	Storage []HostStorageRow `json:"storage"`
-	Gpus    []HostGpuRow     `json:"gpus"`
-	Usbs    []HostUsbRow     `json:"usbs"`
-	Nics    []HostNicRow     `json:"nics"`
-	Cves    []HostCveRow     `json:"cves"`
-
-	// AMT
-	AmtEnabled      bool   `json:"amtEnabled"`
-	AmtProvisioned  bool   `json:"amtProvisioned"`
-	AmtSku          string `json:"amtSku"`
-	CurrentAmtState string `json:"currentAmtState"`
-	DesiredAmtState string `json:"desiredAmtState"`
-	AmtControlMode  string `json:"amtControlMode"`
-	AmtDnsSuffix    string `json:"amtDnsSuffix"`
-	CurrentPower    string `json:"currentPower"`
-	DesiredPower    string `json:"desiredPower"`
-	PowerStatus     string `json:"powerStatus"`
-	PowerOnTime     string `json:"powerOnTime"`
-
-	// KVM
-	DesiredKvmState  string `json:"desiredKvmState"`
-	CurrentKvmState  string `json:"currentKvmState"`
-	KvmStatus        string `json:"kvmStatus"`
-	KvmSessionStatus string `json:"kvmSessionStatus"`
-
-	// SOL
-	DesiredSolState  string `json:"desiredSolState"`
-	CurrentSolState  string `json:"currentSolState"`
-	SolSessionStatus string `json:"solSessionStatus"`
-}
-
-// toHostInspectItem converts a HostResource into a fully pre-computed HostInspectItem.
-func toHostInspectItem(host *infra.HostResource) HostInspectItem {
-	item := HostInspectItem{
-		ResourceId:      safeString(host.ResourceId),
-		Name:            host.Name,
-		HostStatus:      hostStatusDisplay(*host),
-		SerialNumber:    safeString(host.SerialNumber),
-		Uuid:            safeString(host.Uuid),
-		BiosVendor:      safeString(host.BiosVendor),
-		BiosVersion:     safeString(host.BiosVersion),
-		ProductName:     safeString(host.ProductName),
-		CpuModel:        safeString(host.CpuModel),
-		CpuCores:        strconv.Itoa(safeInt(host.CpuCores)),
-		CpuArchitecture: safeString(host.CpuArchitecture),
-		CpuThreads:      strconv.Itoa(safeInt(host.CpuThreads)),
-		CpuSockets:      strconv.Itoa(safeInt(host.CpuSockets)),
-	}
-
-	// Instance-derived fields
-	if host.Instance != nil {
-		if host.Instance.ProvisioningStatus != nil {
-			item.ProvisioningStatus = *host.Instance.ProvisioningStatus
-		}
-		if host.Instance.InstanceStatusDetail != nil {
-			item.HostStatusDetails = *host.Instance.InstanceStatusDetail
-		}
-		if host.Instance.UpdateStatus != nil {
-			item.UpdateStatus = toJSON(host.Instance.UpdateStatus)
-		}
-		if host.Instance.UpdatePolicy != nil {
-			item.OsUpdatePolicy = safeString(host.Instance.UpdatePolicy.ResourceId)
-		}
-		if host.Instance.Os != nil && host.Instance.Os.Name != nil {
-			item.OperatingSystem = *host.Instance.Os.Name
-			item.OsProfile = *host.Instance.Os.Name
-		}
-		if host.Instance.CustomConfig != nil && len(*host.Instance.CustomConfig) > 0 {
-			configs := ""
-			for _, ccfg := range *host.Instance.CustomConfig {
-				configs += ccfg.Name + " "
-			}
-			item.CustomConfigs = strings.TrimSpace(configs)
-		}
-		// CVEs
-		if host.Instance.ExistingCves != nil && *host.Instance.ExistingCves != "" {
-			var cveEntries []CVEEntry
-			if err := json.Unmarshal([]byte(*host.Instance.ExistingCves), &cveEntries); err == nil {
-				for _, cve := range cveEntries {
-					item.Cves = append(item.Cves, HostCveRow{
-						CveId:            cve.CVEID,
-						Priority:         cve.Priority,
-						AffectedPackages: fmt.Sprintf("%v", cve.AffectedPackages),
-					})
-				}
-			}
-		}
-	}
-
-	// NIC IPs summary string
-	if host.HostNics != nil {
-		ip := ""
-		for _, nic := range *host.HostNics {
-			if nic.Ipaddresses != nil && len(*nic.Ipaddresses) > 0 && nic.DeviceName != nil && (*nic.Ipaddresses)[0].Address != nil {
-				ip += *nic.DeviceName + " " + *(*nic.Ipaddresses)[0].Address + "; "
-			}
-		}
-		item.NicIps = strings.TrimSuffix(ip, "; ")
-	}
-
-	// LVM size
-	if host.UserLvmSize != nil {
-		item.LvmSize = strconv.FormatInt(int64(*host.UserLvmSize), 10) + " GB"
-	}
-
-	// Memory
-	if host.MemoryBytes != nil {
-		if memBytes, err := strconv.ParseInt(*host.MemoryBytes, 10, 64); err == nil {
-			item.MemoryGB = fmt.Sprintf("%d", int(float64(memBytes)/(1024*1024*1024)+0.5))
-		}
-	}
-
-	// Metadata
-	if host.Metadata != nil {
-		for _, m := range *host.Metadata {
-			item.Metadata = append(item.Metadata, HostMetadataRow{Key: m.Key, Value: m.Value})
-		}
-	}
-
-	// Storage
-	if host.HostStorages != nil {
-		for _, s := range *host.HostStorages {
-			row := HostStorageRow{Wwid: "N/A", Capacity: "N/A", Model: "N/A", Serial: "N/A", Vendor: "N/A"}
-			if s.Wwid != nil {
-				row.Wwid = *s.Wwid
-			}
-			if s.CapacityBytes != nil {
-				if b, err := strconv.ParseInt(*s.CapacityBytes, 10, 64); err == nil {
-					row.Capacity = fmt.Sprintf("%d GB", b/(1024*1024*1024))
-				}
-			}
-			if s.Model != nil {
-				row.Model = *s.Model
-			}
-			if s.Serial != nil {
-				row.Serial = *s.Serial
-			}
-			if s.Vendor != nil {
-				row.Vendor = *s.Vendor
-			}
-			item.Storage = append(item.Storage, row)
-		}
-	}
-
-	// GPUs
-	if host.HostGpus != nil {
-		for _, g := range *host.HostGpus {
-			row := HostGpuRow{DeviceName: "N/A", Vendor: "N/A", Capabilities: "N/A", PciId: "N/A"}
-			if g.DeviceName != nil {
-				row.DeviceName = *g.DeviceName
-			}
-			if g.Vendor != nil {
-				row.Vendor = *g.Vendor
-			}
-			if g.Capabilities != nil {
-				row.Capabilities = strings.Join(*g.Capabilities, ",")
-			}
-			if g.PciId != nil {
-				row.PciId = *g.PciId
-			}
-			item.Gpus = append(item.Gpus, row)
-		}
-	}
-
-	// USBs
-	if host.HostUsbs != nil {
-		for _, u := range *host.HostUsbs {
-			row := HostUsbRow{Class: "N/A", Serial: "N/A", VendorId: "N/A", ProductId: "N/A", Bus: "N/A", Address: "N/A"}
-			if u.Class != nil && *u.Class != "" {
-				row.Class = *u.Class
-			}
-			if u.Serial != nil {
-				row.Serial = *u.Serial
-			}
-			if u.IdVendor != nil {
-				row.VendorId = *u.IdVendor
-			}
-			if u.IdProduct != nil {
-				row.ProductId = *u.IdProduct
-			}
-			if u.Bus != nil {
-				row.Bus = strconv.Itoa(*u.Bus)
-			}
-			if u.Addr != nil {
-				row.Address = strconv.Itoa(*u.Addr)
-			}
-			item.Usbs = append(item.Usbs, row)
-		}
-	}
-
-	// NICs
-	if host.HostNics != nil {
-		for _, n := range *host.HostNics {
-			row := HostNicRow{
-				Name: "N/A", LinkState: "N/A", Mtu: "N/A", MacAddress: "N/A",
-				PciId: "N/A", Sriov: "N/A", SriovVFTotal: "N/A", SriovVFNum: "N/A", BmcInterface: "N/A",
-			}
-			if n.DeviceName != nil {
-				row.Name = *n.DeviceName
-			}
-			if n.LinkState != nil && n.LinkState.Type != nil {
-				switch string(*n.LinkState.Type) {
-				case "NETWORK_INTERFACE_LINK_STATE_UP":
-					row.LinkState = "UP"
-				case "NETWORK_INTERFACE_LINK_STATE_DOWN":
-					row.LinkState = "DOWN"
-				default:
-					row.LinkState = "UNSPECIFIED"
-				}
-			}
-			if n.Mtu != nil {
-				row.Mtu = strconv.Itoa(*n.Mtu)
-			}
-			if n.MacAddr != nil {
-				row.MacAddress = *n.MacAddr
-			}
-			if n.PciIdentifier != nil {
-				row.PciId = *n.PciIdentifier
-			}
-			if n.SriovEnabled != nil {
-				row.Sriov = strconv.FormatBool(*n.SriovEnabled)
-				if *n.SriovEnabled {
-					if n.SriovVfsTotal != nil {
-						row.SriovVFTotal = strconv.Itoa(*n.SriovVfsTotal)
-					}
-					if n.SriovVfsNum != nil {
-						row.SriovVFNum = strconv.Itoa(*n.SriovVfsNum)
-					}
-				}
-			}
-			if n.BmcInterface != nil {
-				row.BmcInterface = strconv.FormatBool(*n.BmcInterface)
-			}
-			item.Nics = append(item.Nics, row)
-		}
-	}
-	currentAmtState := "N/A"
-	if host.CurrentAmtState != nil {
-		currentAmtState = fmt.Sprintf("%v", *host.CurrentAmtState)
-	}
-	desiredAmtState := "N/A"
-	if host.DesiredAmtState != nil {
-		desiredAmtState = fmt.Sprintf("%v", *host.DesiredAmtState)
-	}
-
-	amtControlMode := "N/A"
-	if host.AmtControlMode != nil {
-		amtControlMode = fmt.Sprintf("%v", *host.AmtControlMode)
-	}
-
-	desiredKvmState := "N/A"
-	if host.DesiredKvmState != nil {
-		desiredKvmState = fmt.Sprintf("%v", *host.DesiredKvmState)
-	}
-
-	currentKvmState := "N/A"
-	if host.CurrentKvmState != nil {
-		currentKvmState = fmt.Sprintf("%v", *host.CurrentKvmState)
-	}
-
-	kvmStatus := "N/A"
-	if host.KvmStatus != nil {
-		kvmStatus = fmt.Sprintf("%v", *host.KvmStatus)
-	}
-
-	kvmSessionStatus := "N/A"
-	if host.KvmSessionStatus != nil && *host.KvmSessionStatus != "" {
-		kvmSessionStatus = *host.KvmSessionStatus
-	}
-
-	desiredSolState := "N/A"
-	if host.DesiredSolState != nil {
-		desiredSolState = fmt.Sprintf("%v", *host.DesiredSolState)
-	}
-	currentSolState := "N/A"
-	if host.CurrentSolState != nil {
-		currentSolState = fmt.Sprintf("%v", *host.CurrentSolState)
-	}
-	solSessionStatus := "N/A"
-	if host.SolSessionStatus != nil && *host.SolSessionStatus != "" {
-		solSessionStatus = *host.SolSessionStatus
-	}
-
-	// AMT — only populate when SKU is specified (not UNSPECIFIED)
-	if host.AmtSku != nil && *host.AmtSku != infra.AMTSKUUNSPECIFIED {
-		item.AmtEnabled = true
-		item.AmtSku = fmt.Sprintf("%v", *host.AmtSku)
-		item.CurrentAmtState = currentAmtState
-		item.DesiredAmtState = desiredAmtState
-		item.AmtControlMode = amtControlMode
-		if host.AmtDnsSuffix != nil {
-			item.AmtDnsSuffix = fmt.Sprintf("%v", *host.AmtDnsSuffix)
-		}
-		item.DesiredKvmState = desiredKvmState
-		item.CurrentKvmState = currentKvmState
-		item.KvmStatus = kvmStatus
-		item.KvmSessionStatus = kvmSessionStatus
-		item.DesiredSolState = desiredSolState
-		item.CurrentSolState = currentSolState
-		item.SolSessionStatus = solSessionStatus
-		// Power info only when provisioned
-		if host.CurrentAmtState != nil && *host.CurrentAmtState == infra.AMTSTATEPROVISIONED {
-			item.AmtProvisioned = true
-			if host.CurrentPowerState != nil {
-				item.CurrentPower = fmt.Sprintf("%v", *host.CurrentPowerState)
-			}
-			if host.DesiredPowerState != nil {
-				item.DesiredPower = fmt.Sprintf("%v", *host.DesiredPowerState)
-			}
-			if host.PowerStatus != nil {
-				item.PowerStatus = fmt.Sprintf("%v", *host.PowerStatus)
-			}
-			if host.PowerOnTime != nil {
-				item.PowerOnTime = time.Unix(int64(*host.PowerOnTime), 0).UTC().Format(time.RFC3339)
-			}
-		}
-	}
-
-	return item
-}
-
-const DEFAULT_HOST_INSPECT_FORMAT = `Host Info:
-  Resource ID:          {{.ResourceId}}
-  Name:                 {{.Name}}
-
-Status:
-  Host Status:          {{.HostStatus}}
-
-Specification:
-  Serial Number:        {{.SerialNumber}}
-  UUID:                 {{.Uuid}}
-  
-AMT Info:{{if .AmtEnabled}}
-  AMT SKU:              {{.AmtSku}}
-  Current State:        {{.CurrentAmtState}}
-  Desired State:        {{.DesiredAmtState}}
-  Control Mode:         {{.AmtControlMode}}
-  DNS Suffix:           {{.AmtDnsSuffix}}
-  KVM Desired State:    {{.DesiredKvmState}}
-  KVM Current State:    {{.CurrentKvmState}}
-  KVM Status:           {{.KvmStatus}}
-  KVM Session Status:   {{.KvmSessionStatus}}
-  SOL Desired State:    {{.DesiredSolState}}
-  SOL Current State:    {{.CurrentSolState}}
-  SOL Session Status:   {{.SolSessionStatus}}{{if .AmtProvisioned}}
-  Current Power:        {{.CurrentPower}}
-  Desired Power:        {{.DesiredPower}}
-  Power Status:         {{.PowerStatus}}
-  Power On Time:        {{.PowerOnTime}}{{else}}
-  AMT not active and/or not supported: No info available{{end}}{{else}}
-  AMT not enabled{{end}}`
-
-const DEFAULT_HOST_PROVISIONING_INSPECT_FORMAT = `Host Info:
-  Resource ID:          {{.ResourceId}}
-  Name:                 {{.Name}}
-  OS Profile:           {{.OsProfile}}
-  NIC Name and IP:      {{.NicIps}}
-  LVM Size:             {{.LvmSize}}
-
-Status:
-  Host Status:          {{.HostStatus}}
-  Host Status Details:  {{.HostStatusDetails}}
-  Provisioning Status:  {{.ProvisioningStatus}}
-  Update Status:        {{.UpdateStatus}}
-  OS Update Policy:     {{.OsUpdatePolicy}}
-
-Specification:
-  Serial Number:        {{.SerialNumber}}
-  UUID:                 {{.Uuid}}
-  OS:                   {{.OperatingSystem}}
-  BIOS Vendor:          {{.BiosVendor}}
-  BIOS Version:         {{.BiosVersion}}
-  Product Name:         {{.ProductName}}
-
-Customizations:
-  Custom Configs:       {{.CustomConfigs}}{{if .Metadata}}
-
-Metadata:{{range .Metadata}}
-  {{.Key}}: {{.Value}}{{end}}{{end}}
-
-CPU Info:
-  Model:                {{.CpuModel}}
-  Cores:                {{.CpuCores}}
-  Architecture:         {{.CpuArchitecture}}
-  Threads:              {{.CpuThreads}}
-  Sockets:              {{.CpuSockets}}
-
-Memory:
-  Total:                {{.MemoryGB}} GB
-
-Storage:{{if .Storage}}{{range .Storage}}
-  - WWID: {{.Wwid}}, Capacity: {{.Capacity}}, Model: {{.Model}}, Serial: {{.Serial}}, Vendor: {{.Vendor}}{{end}}{{else}}
-  None{{end}}
-
-GPU:{{if .Gpus}}{{range .Gpus}}
-  - Device: {{.DeviceName}}, Vendor: {{.Vendor}}, Capabilities: {{.Capabilities}}, PCI: {{.PciId}}{{end}}{{else}}
-  None{{end}}
-
-USB:{{if .Usbs}}{{range .Usbs}}
-  - Class: {{.Class}}, Serial: {{.Serial}}, Vendor ID: {{.VendorId}}, Product ID: {{.ProductId}}, Bus: {{.Bus}}, Address: {{.Address}}{{end}}{{else}}
-  None{{end}}
-
-Interfaces:{{if .Nics}}{{range .Nics}}
-  - Name: {{.Name}}, Link: {{.LinkState}}, MTU: {{.Mtu}}, MAC: {{.MacAddress}}, PCI: {{.PciId}}, SRIOV: {{.Sriov}}, VF Total: {{.SriovVFTotal}}, VF Num: {{.SriovVFNum}}, BMC: {{.BmcInterface}}{{end}}{{else}}
-  None{{end}}
-
-CVEs:{{if .Cves}}{{range .Cves}}
-  - CVE ID: {{.CveId}}, Priority: {{.Priority}}, Affected: {{.AffectedPackages}}{{end}}{{else}}
-  None{{end}}
-
-AMT Info:{{if .AmtEnabled}}
-  AMT SKU:              {{.AmtSku}}
-  Current State:        {{.CurrentAmtState}}
-  Desired State:        {{.DesiredAmtState}}
-  Control Mode:         {{.AmtControlMode}}
-  DNS Suffix:           {{.AmtDnsSuffix}}
-  KVM Desired State:    {{.DesiredKvmState}}
-  KVM Current State:    {{.CurrentKvmState}}
-  KVM Status:           {{.KvmStatus}}
-  KVM Session Status:   {{.KvmSessionStatus}}
-  SOL Desired State:    {{.DesiredSolState}}
-  SOL Current State:    {{.CurrentSolState}}
-  SOL Session Status:   {{.SolSessionStatus}}{{if .AmtProvisioned}}
-  Current Power:        {{.CurrentPower}}
-  Desired Power:        {{.DesiredPower}}
-  Power Status:         {{.PowerStatus}}
-  Power On Time:        {{.PowerOnTime}}{{else}}
-  AMT not active and/or not supported: No info available{{end}}{{else}}
-  AMT not enabled{{end}}
-`
-
 const HOST_INSPECT_TEMPLATE_ENVVAR = "ORCH_CLI_HOST_INSPECT_OUTPUT_TEMPLATE"
 
 // getHostInspectFormat returns the inspect template, allowing override via flag or envvar.
 func getHostInspectFormat(cmd *cobra.Command) (string, error) {
-	defaultFmt := DEFAULT_HOST_INSPECT_FORMAT
-	if isFeatureEnabled(ProvisioningFeature) {
-		defaultFmt = DEFAULT_HOST_PROVISIONING_INSPECT_FORMAT
-	}
-	return resolveTableOutputTemplate(cmd, defaultFmt, HOST_INSPECT_TEMPLATE_ENVVAR)
+	return resolveTableOutputTemplate(cmd, outfmt.HostInspectFormat(isFeatureEnabled(ProvisioningFeature)), HOST_INSPECT_TEMPLATE_ENVVAR)
 }
 
 // printHost renders a single host using the template-based inspect pipeline.
 // For JSON/YAML it passes the raw HostResource; for table it uses the pre-computed
-// HostInspectItem so the template has simple field references.
+// HostDetail so the template has simple field references.
 func printHost(cmd *cobra.Command, writer io.Writer, host *infra.HostResource) error {
+	if selected, err := printSelectedOutput(cmd, host); selected {
+		return err
+	}
+
 	outputType, _ := cmd.Flags().GetString("output-type")
 
 	if outputType == "json" || outputType == "yaml" {
@@ -1131,7 +673,7 @@ func printHost(cmd *cobra.Command, writer io.Writer, host *infra.HostResource) e
 		return err
 	}
 
-	item := toHostInspectItem(host)
+	item := outfmt.NewHostDetail(host)
 	result := CommandResult{
 		Format:    format.Format(outputFormat),
 		OutputAs:  toOutputType(outputType),
@@ -1157,6 +699,49 @@ func verifyCSVInput(path string) error {
 	return nil
 }
 
+// Helper function to verify that the host import file exists and is a CSV file or an Excel workbook
+func verifyHostImportInput(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return fmt.Errorf("file does not exist: %s", path)
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".csv" && ext != ".xlsx" {
+		return errors.New("host import input file must be a CSV file or an Excel .xlsx workbook")
+	}
+
+	return nil
+}
+
+func isXLSXFile(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".xlsx"
+}
+
+// Reads and validates the hosts of a CSV file, of a sheet of an Excel workbook or of a discovery manifest; the
+// columns of a CSV file or a workbook are found by header when a column map is given
+func checkHostImportFile(path string, sheet string, columns files.ColumnMap, globalAttr types.HostRecord, provisioningSupported bool) ([]types.HostRecord, error) {
+	if isDiscoveryManifest(path) {
+		return validator.CheckDiscovery(path, globalAttr, provisioningSupported)
+	}
+	if columns != nil {
+		return validator.CheckMapped(path, sheet, columns, globalAttr, provisioningSupported)
+	}
+	if isXLSXFile(path) {
+		return validator.CheckXLSX(path, sheet, globalAttr, provisioningSupported)
+	}
+	return validator.CheckCSV(path, globalAttr, provisioningSupported)
+}
+
+// Name of the CSV files of host records derived from an import file, an Excel workbook or a discovery manifest
+// becoming a CSV file
+func hostRecordsFileName(path string) string {
+	name := filepath.Base(path)
+	if isXLSXFile(name) || isDiscoveryManifest(name) {
+		return strings.TrimSuffix(name, filepath.Ext(name)) + ".csv"
+	}
+	return name
+}
+
 func generateCSV(filename string) error {
 	// The CSV generation logic
 	fmt.Printf("Generating empty CSV template file: %s\n", filename)
@@ -1164,7 +749,7 @@ func generateCSV(filename string) error {
 }
 
 // Runs the registration workflow
-func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientWithResponsesInterface, projectName string, rIn types.HostRecord, respCache ResponseCache, globalAttr *types.HostRecord, erringRecords *[]types.HostRecord, cClient cluster.ClientWithResponsesInterface) {
+func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientWithResponsesInterface, projectName string, rIn types.HostRecord, respCache ResponseCache, globalAttr *types.HostRecord, erringRecords *[]types.HostRecord, cClient cluster.ClientWithResponsesInterface, deferredClusters *[]deferredCluster) (string, error) {
 
 	// get the required fields from the record
 	sNo := rIn.Serial
@@ -1177,7 +762,7 @@ func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientW
 
 	rOut, err := sanitizeProvisioningFields(ctx, ctx2, hClient, projectName, rIn, respCache, globalAttr, erringRecords, cClient)
 	if err != nil {
-		return
+		return "", err
 	}
 
 	if rOut.LVMSize != "" {
@@ -1190,7 +775,7 @@ func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientW
 	if err != nil {
 		rIn.Error = err.Error()
 		*erringRecords = append(*erringRecords, rIn)
-		return
+		return "", err
 	}
 
 	if isFeatureEnabled(ProvisioningFeature) {
@@ -1198,22 +783,27 @@ func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientW
 		if err != nil {
 			rIn.Error = err.Error()
 			*erringRecords = append(*erringRecords, rIn)
-			return
+			return "", err
 		}
 
 		err = allocateHostToSiteAndAddMetadata(ctx, hClient, projectName, hostID, hostName, rOut)
 		if err != nil {
 			rIn.Error = err.Error()
 			*erringRecords = append(*erringRecords, rIn)
-			return
+			return "", err
 		}
 
 		if rOut.K8sEnable == "true" && isFeatureEnabled(ClusterOrchFeature) {
+			if deferredClusters != nil {
+				// The cluster is created once the instance of the host is running, see createDeferredClusters
+				*deferredClusters = append(*deferredClusters, deferredCluster{HostID: hostID, Record: rOut, Row: rIn})
+				return hostID, nil
+			}
 			err = createCluster(ctx2, cClient, respCache, projectName, hostID, rOut)
 			if err != nil {
 				rIn.Error = err.Error()
 				*erringRecords = append(*erringRecords, rIn)
-				return
+				return "", err
 			}
 		}
 	} else {
@@ -1224,31 +814,25 @@ func doRegister(ctx context.Context, ctx2 context.Context, hClient infra.ClientW
 		if err != nil {
 			rIn.Error = err.Error()
 			*erringRecords = append(*erringRecords, rIn)
-			return
+			return "", err
 		}
 	}
 
-	// Print host_id from response if successful
-	fmt.Printf("✔ Host Serial number : %s  UUID : %s registered. Host ID : %s\n", sNo, uuid, hostID)
+	return hostID, nil
 }
 
 // Decodes the provided metadata from input string
 func decodeMetadata(metadata string) (*[]infra.MetadataItem, error) {
 	metadataList := make([]infra.MetadataItem, 0)
-	if metadata == "" {
-		return &metadataList, nil
+	pairs, err := validator.ParseMetadata(metadata)
+	if err != nil {
+		return &metadataList, err
 	}
-	metadataPairs := strings.Split(metadata, "&")
-	for _, pair := range metadataPairs {
-		kv := strings.Split(pair, "=")
-		if len(kv) != kVSize {
-			return &metadataList, e.NewCustomError(e.ErrInvalidMetadata)
-		}
-		mItem := infra.MetadataItem{
-			Key:   kv[0],
-			Value: kv[1],
-		}
-		metadataList = append(metadataList, mItem)
+	for _, pair := range pairs {
+		metadataList = append(metadataList, infra.MetadataItem{
+			Key:   pair.Key,
+			Value: pair.Value,
+		})
 	}
 	return &metadataList, nil
 }
@@ -1780,7 +1364,7 @@ func getDeauthorizeCommand() *cobra.Command {
 		Use:               "deauthorize",
 		Args:              cobra.MinimumNArgs(1),
 		Short:             "Deauthorize host",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -1801,7 +1385,7 @@ func getUpdateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "update-os",
 		Short:             "Update host OS",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				if isCommandDisabledWithParent(c, args[0]) {
@@ -1832,14 +1416,24 @@ func getListHostCommand() *cobra.Command {
 	cmd.PersistentFlags().StringP("site", "s", viper.GetString("site"), "Optional filter provided as part of host list to filter hosts by site")
 	cmd.PersistentFlags().StringP("region", "r", viper.GetString("region"), "Optional filter provided as part of host list to filter hosts by region")
 	cmd.PersistentFlags().StringP("workload", "w", viper.GetString("workload"), "Optional filter provided as part of host list to filter hosts by workload")
+	cmd.Flags().String("status", "", "Optional filter selecting the hosts of a status: onboarded, registered, provisioned, deauthorized, \"not connected\", error")
+	cmd.Flags().String("os-profile", "", "Optional filter selecting the hosts running an OS profile")
+	cmd.Flags().String("serial", "", "Optional filter selecting the host of a serial number")
+	cmd.Flags().String("uuid", "", "Optional filter selecting the host of a UUID")
+	cmd.Flags().Bool("has-update", false, "Optional filter selecting the hosts with an OS update available, --has-update=false selects the hosts without one")
+	cmd.Flags().Bool("secure", false, "Optional filter selecting the hosts with Secure Boot and Full Disk Encryption, --secure=false selects the hosts without them")
 
 	// Standard ordering and pagination flags
 	cmd.Flags().String("order-by", "", "host list order by field (e.g. name, serialNumber, hostStatus, -name)")
 	cmd.Flags().Int32("page-size", 0, "host list maximum number of items per page")
 	cmd.Flags().Int32("offset", 0, "host list starting offset")
+	addListLimitFlag(cmd, "host")
+	addHostListLayoutFlags(cmd)
+	cmd.Flags().Bool("cached", false, "show the hosts of the last listing with the same flags immediately, then refresh them from the orchestrator (table output only)")
 
 	// Standard output format flags (--output-type, --output-filter, --output-template, --output-template-file)
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
 	return cmd
 }
 
@@ -1867,12 +1461,22 @@ func getCreateHostCommand() *cobra.Command {
 	}
 
 	// Local persistent flags - always available
-	cmd.PersistentFlags().StringP("import-from-csv", "i", viper.GetString("import-from-csv"), "CSV file containing information about to be provisioned hosts")
+	cmd.PersistentFlags().StringP("import-from-csv", "i", viper.GetString("import-from-csv"), "CSV file, or Excel .xlsx workbook with the same columns, containing information about to be provisioned hosts")
+	cmd.PersistentFlags().String(sheetFlag, "", "Sheet of the Excel workbook given to --import-from-csv, by name or 1-based position; the first sheet by default")
+	cmd.PersistentFlags().String(columnMapFlag, "", "Headers of the file given to --import-from-csv holding the host columns, as Column=Header pairs, e.g. \"Serial=SN,UUID=DeviceGUID,Site=Location\"; the columns are then found by header, unmapped ones under their own name")
+	cmd.PersistentFlags().String(fromDiscoveryFlag, "", "JSON manifest of the hosts booted on the EMT pre-boot discovery image (ISO or USB); the site and OS profile of the hosts are asked for unless given by --site and --os-profile")
 	cmd.PersistentFlags().BoolP("dry-run", "d", viper.GetBool("dry-run"), "Verify the validity of input CSV file")
 	cmd.PersistentFlags().StringP("generate-csv", "g", viper.GetString("generate-csv"), "Generates a template CSV file for host import")
 	cmd.PersistentFlags().Lookup("generate-csv").NoOptDefVal = filename
 	cmd.PersistentFlags().String("serial", viper.GetString("serial"), "Serial number of the host")
 	cmd.PersistentFlags().StringP("uuid", "u", viper.GetString("uuid"), "UUID of the host")
+	cmd.PersistentFlags().BoolP("quiet", "q", false, "Do not report the progress of a CSV import")
+	cmd.PersistentFlags().String("output-ids", "", "CSV file to write the serial number to host ID mapping of the registered hosts to, failed rows included")
+	cmd.PersistentFlags().String("output-type", importOutputText, "output type of the registration summary: text or json")
+	cmd.PersistentFlags().String("output-site-summary", "", "JSON file to write the per-site summary of a CSV import to")
+	addResolveCacheFlags(cmd)
+	addImportBatchFlags(cmd)
+	addAsyncFlag(cmd)
 
 	// Provisioning-specific overrides - only when provisioning is enabled
 	if isFeatureEnabled(ProvisioningFeature) {
@@ -1890,6 +1494,7 @@ func getCreateHostCommand() *cobra.Command {
 		cmd.PersistentFlags().StringP("cluster-deploy", "c", viper.GetString("cluster-deploy"), "Override the cluster deployment flag provided in CSV file for all hosts")
 		cmd.PersistentFlags().StringP("cluster-template", "t", viper.GetString("cluster-template"), "Override the cluster template provided in CSV file for all hosts")
 		cmd.PersistentFlags().StringP("cluster-config", "f", viper.GetString("cluster-config"), "Override the cluster configuration provided in CSV file for all hosts")
+		addHostReadyFlags(cmd)
 	}
 
 	return cmd
@@ -1904,6 +1509,8 @@ func getDeleteHostCommand() *cobra.Command {
 		Aliases: hostAliases,
 		RunE:    runDeleteHostCommand,
 	}
+	cmd.Flags().Bool(wipeFlag, false, "Power off the host and unprovision its AMT before deleting it, the host must have AMT provisioned")
+	cmd.Flags().Duration(wipeTimeoutFlag, defaultWipeTimeout, "Time given to the host to report it is powered off and AMT unprovisioned with --wipe, it is not deleted otherwise")
 	return cmd
 }
 
@@ -1937,6 +1544,11 @@ func getSetHostCommand() *cobra.Command {
 	}
 	cmd.PersistentFlags().StringP("generate-csv", "g", viper.GetString("generate-csv"), "Generates a template CSV file for host import")
 	cmd.PersistentFlags().Lookup("generate-csv").NoOptDefVal = filename
+	cmd.PersistentFlags().String("note", "", "Set the note of the host, \"\" removes it")
+	cmd.PersistentFlags().Bool("append", false, "Add --note as an entry prefixed with the current time to the current note of the host")
+	if isFeatureEnabled(ProvisioningFeature) {
+		cmd.PersistentFlags().Int(lvmSizeFlag, 0, "Set the size in GB of the user LVM of the host, the LVM of a provisioned host can only grow")
+	}
 	if isFeatureEnabled(OobFeature) {
 		cmd.PersistentFlags().StringP("import-from-csv", "i", viper.GetString("import-from-csv"), "CSV file containing information about provisioned hosts")
 		cmd.PersistentFlags().BoolP("dry-run", "d", viper.GetBool("dry-run"), "Verify the validity of input CSV file")
@@ -1950,9 +1562,13 @@ func getSetHostCommand() *cobra.Command {
 		cmd.PersistentFlags().StringP("filter", "f", viper.GetString("filter"), "Filter hosts for bulk operations using AIP-160 filter expressions")
 		cmd.PersistentFlags().StringP("site", "s", viper.GetString("site"), "Filter hosts by site for bulk operations")
 		cmd.PersistentFlags().StringP("region", "", viper.GetString("region"), "Filter hosts by region for bulk operations")
+		cmd.PersistentFlags().Int("concurrency", defaultBulkHostConcurrency, "Maximum number of hosts updated in parallel by bulk operations")
 	}
 	if isFeatureEnabled(Day2Feature) {
 		cmd.PersistentFlags().StringP("osupdatepolicy", "u", viper.GetString("osupdatepolicy"), "Set OS update policy <resourceID>")
+		cmd.PersistentFlags().Bool("now", false, "Update the host OS immediately with its OS update policy by creating a single maintenance schedule starting now")
+		cmd.PersistentFlags().String("policy", "", "Same as --osupdatepolicy")
+		cmd.MarkFlagsMutuallyExclusive("osupdatepolicy", "policy")
 	}
 
 	return cmd
@@ -1960,13 +1576,18 @@ func getSetHostCommand() *cobra.Command {
 
 func getDeauthorizeHostCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "host <resourceID> [flags]",
-		Short:   "Deauthorizes a host",
+		Use:   "host [<resourceID>...] [flags]",
+		Short: "Deauthorizes hosts",
+		Long: "Deauthorizes the hosts given by resource ID, or all hosts matching --filter. " +
+			"The reason given with --note is recorded with the deauthorization; it is mandatory with --filter, " +
+			"which lists the affected hosts and asks for confirmation unless --yes is set.",
 		Example: deauthorizeHostExamples,
-		Args:    cobra.ExactArgs(1),
 		Aliases: hostAliases,
 		RunE:    runDeauthorizeHostCommand,
 	}
+	cmd.Flags().String("note", "", "Reason for the deauthorization, recorded with it")
+	cmd.Flags().StringP("filter", "f", "", "Deauthorize all hosts matching the filter instead of the given ones\nUsage:\n\tCustom filter: --filter \"<custom filter>\" see https://google.aip.dev/160 and API spec. \n\tPredefined filters: --filter provisioned/onboarded/registered/not connected/error")
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
 	return cmd
 }
 
@@ -2001,43 +1622,70 @@ func getUpdateHostCommand() *cobra.Command {
 	cmd.PersistentFlags().StringP("filter", "f", viper.GetString("filter"), "Optional filter provided as part of host discovery command\nUsage:\n\tCustom filter: --filter \"<custom filter>\" ie. --filter \"osType=OS_TYPE_IMMUTABLE\" see https://google.aip.dev/160 and API spec. \n\tPredefined filters: --filter provisioned/onboarded/registered/nor connected/deauthorized")
 	cmd.PersistentFlags().StringP("site", "s", viper.GetString("site"), "Optional filter provided as part of host discovery command to filter hosts by site")
 	cmd.PersistentFlags().StringP("region", "r", viper.GetString("region"), "Optional filter provided as part of host discovery command to filter hosts by region")
+	addAsyncFlag(cmd)
 	return cmd
 }
 
 // Lists all Hosts - retrieves all hosts and displays selected information in tabular format
 func runListHostCommand(cmd *cobra.Command, _ []string) error {
+	writer, verbose := getOutputContext(cmd)
+	outputFilter, _ := cmd.Flags().GetString("output-filter")
+
+	if cached, _ := cmd.Flags().GetBool("cached"); cached {
+		return runCachedListHost(cmd, outputFilter, verbose)
+	}
+
+	hosts, validatedOrderBy, err := fetchHosts(cmd)
+	if err != nil {
+		return err
+	}
+	saveHostSnapshot(cmd, hosts, validatedOrderBy)
+
+	if err := printHosts(cmd, writer, &hosts, validatedOrderBy, &outputFilter, verbose); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// Retrieves the hosts selected by the list host flags, together with the validated order-by for client-side sorting
+func fetchHosts(cmd *cobra.Command) ([]infra.HostResource, *string, error) {
 
 	workload, _ := cmd.Flags().GetString("workload")
 	filtflag, _ := cmd.Flags().GetString("filter")
 	filter := filterHelper(filtflag)
 
+	typedFilter, err := hostFilterFlagsHelper(cmd, filtflag)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	siteFlag, _ := cmd.Flags().GetString("site")
 	site, err := filterSitesHelper(siteFlag)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	regFlag, _ := cmd.Flags().GetString("region")
 	region, err := filterRegionsHelper(regFlag)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if siteFlag != "" && regFlag != "" {
 		fmt.Printf("--region flag ignored, using --site as it is more precise")
 	}
 
-	writer, verbose := getOutputContext(cmd)
-
 	ctx, hostClient, projectName, err := InfraFactory(cmd)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	// Subroutines listing the same pages, such as the workload mapping, fetch them once
+	hostClient = newMemoInfraClient(hostClient)
 
 	// Validate and normalise --order-by; for table output this is client-side.
 	validatedOrderBy, err := getValidatedHostOrderBy(ctx, cmd, hostClient, projectName)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// For table output sorting is done client-side; don't send orderBy to the API.
@@ -2052,6 +1700,13 @@ func runListHostCommand(cmd *cobra.Command, _ []string) error {
 	if filter != nil {
 		combinedRaw = *filter
 	}
+	if typedFilter != "" {
+		if combinedRaw != "" {
+			combinedRaw = fmt.Sprintf("%s AND (%s)", combinedRaw, typedFilter)
+		} else {
+			combinedRaw = typedFilter
+		}
+	}
 
 	// Build site/region additions and append to combinedRaw
 	if siteFlag == "" && regFlag != "" {
@@ -2062,7 +1717,7 @@ func runListHostCommand(cmd *cobra.Command, _ []string) error {
 				Filter: &regFilter,
 			}, auth.AddAuthHeader)
 		if err != nil {
-			return processError(err)
+			return nil, nil, processError(err)
 		}
 
 		siteFilter := ""
@@ -2075,7 +1730,7 @@ func runListHostCommand(cmd *cobra.Command, _ []string) error {
 				}
 			}
 		} else {
-			return errors.New("no site was found in provided region")
+			return nil, nil, errors.New("no site was found in provided region")
 		}
 
 		if combinedRaw != "" {
@@ -2117,24 +1772,30 @@ func runListHostCommand(cmd *cobra.Command, _ []string) error {
 		return true, nil
 	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Resolve pagination flags.
-	pageSize32, offset32, err := getPageSizeOffset(cmd)
+	pagination, err := getListPagination(cmd)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	pageSize := int(pageSize32)
-	offset := int(offset32)
-	if pageSize <= 0 {
-		pageSize = 20 // API default page size
+
+	// The instances are fetched while the hosts are, to map workload membership onto the hosts
+	var instancesDone chan instanceFetchResult
+	if isFeatureEnabled(ProvisioningFeature) {
+		instancesDone = make(chan instanceFetchResult, 1)
+		// Stops paging the instances when the hosts cannot be listed
+		instancesCtx, cancelInstances := context.WithCancel(ctx)
+		defer cancelInstances()
+		go func() {
+			instances, err := listAllInstances(instancesCtx, hostClient, projectName)
+			instancesDone <- instanceFetchResult{instances: instances, err: err}
+		}()
 	}
 
 	hosts := make([]infra.HostResource, 0)
-
-	if cmd.Flags().Changed("page-size") || cmd.Flags().Changed("offset") {
-		// Single-page fetch when explicit pagination is requested.
+	err = pagination.fetch(func(pageSize int, offset int) (int, bool, error) {
 		resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
 			&infra.HostServiceListHostsParams{
 				Filter:   validatedFilter,
@@ -2143,102 +1804,85 @@ func runListHostCommand(cmd *cobra.Command, _ []string) error {
 				Offset:   &offset,
 			}, auth.AddAuthHeader)
 		if err != nil {
-			return processError(err)
+			return 0, false, processError(err)
 		}
 		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
-			return err
+			return 0, false, err
 		}
 		hosts = append(hosts, resp.JSON200.Hosts...)
-	} else {
-		// Auto-paginate to collect all hosts.
-		for {
-			resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
-				&infra.HostServiceListHostsParams{
-					Filter:   validatedFilter,
-					OrderBy:  apiOrderBy,
-					PageSize: &pageSize,
-					Offset:   &offset,
-				}, auth.AddAuthHeader)
-			if err != nil {
-				return processError(err)
-			}
-			if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
-				return err
-			}
-			hosts = append(hosts, resp.JSON200.Hosts...)
-			if !resp.JSON200.HasNext {
-				break
-			}
-			offset += len(resp.JSON200.Hosts)
-		}
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
+	hosts = limitListItems(hosts, pagination)
 
-	if isFeatureEnabled(ProvisioningFeature) {
-		// Fetch instances to map workload membership onto host records.
-		instancePageSize := 20
-		instanceOffset := 0
-		instances := make([]infra.InstanceResource, 0)
-		for {
-			iresp, err := hostClient.InstanceServiceListInstancesWithResponse(ctx, projectName,
-				&infra.InstanceServiceListInstancesParams{
-					PageSize: &instancePageSize,
-					Offset:   &instanceOffset,
-				}, auth.AddAuthHeader)
-			if err != nil {
-				return processError(err)
-			}
-			if err := checkResponse(iresp.HTTPResponse, iresp.Body, "error while retrieving instance"); err != nil {
-				return err
-			}
-			instances = append(instances, iresp.JSON200.Instances...)
-			if !iresp.JSON200.HasNext {
-				break
-			}
-			instanceOffset += len(iresp.JSON200.Instances)
+	if instancesDone != nil {
+		result := <-instancesDone
+		if result.err != nil {
+			return nil, nil, result.err
 		}
+		hosts = joinHostWorkloads(hosts, result.instances, workload)
+	}
 
-		matchedHosts := make([]infra.HostResource, 0)
-		notMatchedHosts := make([]infra.HostResource, 0)
+	return hosts, validatedOrderBy, nil
+}
 
-		// Map workload membership onto each host via instance lookup.
-		for _, host := range hosts {
-			for _, instance := range instances {
-				if instance.WorkloadMembers != nil && instance.InstanceID != nil && host.Instance != nil && host.Instance.InstanceID != nil && *instance.InstanceID == *host.Instance.InstanceID {
-					host.Instance.WorkloadMembers = instance.WorkloadMembers
-					if workload != "" && len(*host.Instance.WorkloadMembers) > 0 {
-						if *(*host.Instance.WorkloadMembers)[0].Workload.Name == workload {
-							matchedHosts = append(matchedHosts, host)
-						}
-					}
-					break
-				}
-			}
-			if workload == "NotAssigned" {
-				if (host.Instance != nil && len(*host.Instance.WorkloadMembers) == 0) || host.Instance == nil {
-					notMatchedHosts = append(notMatchedHosts, host)
-				}
+type instanceFetchResult struct {
+	instances []infra.InstanceResource
+	err       error
+}
+
+// Maps the workload membership of the instances onto the hosts they run on, and keeps the hosts that are
+// members of the workload given by --workload, or that are members of none for NotAssigned
+func joinHostWorkloads(hosts []infra.HostResource, instances []infra.InstanceResource, workload string) []infra.HostResource {
+	membersByInstance := make(map[string]*[]infra.WorkloadMember, len(instances))
+	for _, instance := range instances {
+		if instance.WorkloadMembers != nil && instance.InstanceID != nil {
+			membersByInstance[*instance.InstanceID] = instance.WorkloadMembers
+		}
+	}
+
+	for _, host := range hosts {
+		if host.Instance != nil && host.Instance.InstanceID != nil {
+			if members, ok := membersByInstance[*host.Instance.InstanceID]; ok {
+				host.Instance.WorkloadMembers = members
 			}
 		}
+	}
+	if workload == "" {
+		return hosts
+	}
 
-		if workload != "" {
-			hosts = matchedHosts
+	selected := make([]infra.HostResource, 0)
+	for _, host := range hosts {
+		var members []infra.WorkloadMember
+		if host.Instance != nil && host.Instance.WorkloadMembers != nil {
+			members = *host.Instance.WorkloadMembers
 		}
 		if workload == "NotAssigned" {
-			hosts = notMatchedHosts
+			if len(members) == 0 {
+				selected = append(selected, host)
+			}
+		} else if len(members) > 0 && members[0].Workload != nil && derefString(members[0].Workload.Name) == workload {
+			selected = append(selected, host)
 		}
 	}
-
-	outputFilter, _ := cmd.Flags().GetString("output-filter")
-	if err := printHosts(cmd, writer, &hosts, validatedOrderBy, &outputFilter, verbose); err != nil {
-		return err
-	}
-	return writer.Flush()
+	return selected
 }
 
 // Gets specific Host - retrieves a host using resource ID and displays detailed information
 // hostResourceIDPattern matches host resource IDs: "host-" followed by 8 hex chars.
 var hostResourceIDPattern = regexp.MustCompile(`^host-[0-9a-f]{8}$`)
 
+// The note of a host as validated by the API
+var hostNotePattern = regexp.MustCompile(`^[a-zA-Z0-9_./:;=@?!#,<>*()" -]*$`)
+
+const (
+	hostNoteMaxLength      = 512
+	hostNoteEntrySeparator = "; "
+)
+
 func isHostResourceID(s string) bool {
 	return hostResourceIDPattern.MatchString(s)
 }
@@ -2302,8 +1946,12 @@ func runGetHostCommand(cmd *cobra.Command, args []string) error {
 		return processError(err)
 	}
 
+	header := hostHeaderGet
+	if outputSelected(cmd) {
+		header = ""
+	}
 	if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, verbose,
-		hostHeaderGet, "error getting Host"); !proceed {
+		header, "error getting Host"); !proceed {
 		return err
 	}
 
@@ -2333,6 +1981,9 @@ func runGetHostCommand(cmd *cobra.Command, args []string) error {
 
 // Lists all Hosts - retrieves all hosts and displays selected information in tabular format
 func runCreateHostCommand(cmd *cobra.Command, args []string) error {
+	if started, err := startAsyncJob(cmd, args); started || err != nil {
+		return err
+	}
 
 	currentPath, err := os.Getwd()
 	if err != nil {
@@ -2343,6 +1994,7 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 	generate, _ := cmd.Flags().GetString("generate-csv")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	csvFilePath, _ := cmd.Flags().GetString("import-from-csv")
+	discoveryPath, _ := cmd.Flags().GetString(fromDiscoveryFlag)
 	osProfileIn, _ := cmd.Flags().GetString("os-profile")
 	siteIn, _ := cmd.Flags().GetString("site")
 	metadataIn, _ := cmd.Flags().GetString("metadata")
@@ -2355,6 +2007,9 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 	lvmIn, _ := cmd.Flags().GetString("lvm-size")
 	serialIn, _ := cmd.Flags().GetString("serial")
 	uuidIn, _ := cmd.Flags().GetString("uuid")
+	outputIDs, _ := cmd.Flags().GetString("output-ids")
+	outputType, _ := cmd.Flags().GetString("output-type")
+	outputSiteSummary, _ := cmd.Flags().GetString("output-site-summary")
 
 	globalAttr := &types.HostRecord{
 		OSProfile:          osProfileIn,
@@ -2371,8 +2026,8 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 		UUID:               uuidIn,
 	}
 
-	if cmd.Flags().Changed("generate-csv") && (dryRun || csvFilePath != "") {
-		return fmt.Errorf("cannot use --generate-csv flag with --dry-run and/or --import-from-csv")
+	if cmd.Flags().Changed("generate-csv") && (dryRun || csvFilePath != "" || discoveryPath != "") {
+		return fmt.Errorf("cannot use --generate-csv flag with --dry-run, --import-from-csv and/or --%s", fromDiscoveryFlag)
 	}
 
 	if cmd.Flags().Changed("generate-csv") {
@@ -2390,6 +2045,32 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if outputType != importOutputText && outputType != importOutputJSON {
+		return fmt.Errorf("invalid --output-type %q, must be one of: %s, %s", outputType, importOutputText, importOutputJSON)
+	}
+	for _, path := range []string{outputIDs, outputSiteSummary} {
+		if path != "" {
+			if err := isSafePath(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if discoveryPath != "" {
+		if len(args) > 0 || csvFilePath != "" {
+			return fmt.Errorf("cannot use --%s with a host name or --import-from-csv", fromDiscoveryFlag)
+		}
+		// Notices go to stderr with JSON output so that stdout can be parsed
+		notices := cmd.OutOrStdout()
+		if outputType == importOutputJSON {
+			notices = cmd.ErrOrStderr()
+		}
+		if err := prepareDiscoveryImport(cmd, notices, discoveryPath, globalAttr); err != nil {
+			return err
+		}
+		csvFilePath = discoveryPath
+	}
+
 	if (csvFilePath == "" || strings.HasPrefix(csvFilePath, "--")) && len(args) == 0 {
 		return fmt.Errorf("a host name or --import-from-csv <path/to/file.csv> is required")
 	}
@@ -2397,28 +2078,78 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 && csvFilePath != "" && !strings.HasPrefix(csvFilePath, "--") {
 		return fmt.Errorf("cannot use both a host name and --import-from-csv at the same time")
 	}
+	if len(args) > 0 && cmd.Flags().Changed(stateFileFlag) {
+		return fmt.Errorf("--%s can only be used with --import-from-csv", stateFileFlag)
+	}
+	if stateFile, _ := cmd.Flags().GetString(stateFileFlag); stateFile != "" {
+		if err := isSafePath(stateFile); err != nil {
+			return err
+		}
+	}
+
+	sheet, _ := cmd.Flags().GetString(sheetFlag)
+	if sheet != "" && (len(args) > 0 || !isXLSXFile(csvFilePath)) {
+		return fmt.Errorf("--%s can only be used with an Excel workbook given to --import-from-csv", sheetFlag)
+	}
+
+	var columns files.ColumnMap
+	if columnMap, _ := cmd.Flags().GetString(columnMapFlag); columnMap != "" {
+		if len(args) > 0 || discoveryPath != "" {
+			return fmt.Errorf("--%s can only be used with a CSV file or an Excel workbook given to --import-from-csv", columnMapFlag)
+		}
+		if columns, err = files.ParseColumnMap(columnMap); err != nil {
+			return e.WithCode(e.CodeInvalidArgument, err)
+		}
+	}
 
 	var validated []types.HostRecord
 
 	if len(args) == 0 {
-		err = verifyCSVInput(csvFilePath)
-		if err != nil {
-			return err
+		if discoveryPath == "" {
+			if err := verifyHostImportInput(csvFilePath); err != nil {
+				return err
+			}
 		}
 
 		if dryRun {
 			fmt.Println("--dry-run flag provided, validating input, hosts will not be imported")
 			provisioningSupported := viper.GetBool(ProvisioningFeature)
-			_, err := validator.CheckCSV(csvFilePath, *globalAttr, provisioningSupported)
+			checked, err := checkHostImportFile(csvFilePath, sheet, columns, *globalAttr, provisioningSupported)
+			if err != nil {
+				return err
+			}
+			duplicates, err := checkDuplicateHosts(cmd, checked)
 			if err != nil {
 				return err
 			}
-			fmt.Println("CSV validation successful")
+			printDuplicateHosts(cmd.OutOrStdout(), duplicates)
+			if isFeatureEnabled(ClusterOrchFeature) {
+				warned, err := checkMachineRequirements(cmd, checked, globalAttr)
+				if err != nil {
+					return err
+				}
+				if len(warned) > 0 {
+					newFilename := fmt.Sprintf("%s_%s_%s", "preflight_warning",
+						time.Now().Format(time.RFC3339), hostRecordsFileName(csvFilePath))
+					fmt.Fprintf(cmd.OutOrStdout(), "%d hosts may not meet the machine requirements of their cluster template\n", len(warned))
+					fmt.Fprintf(cmd.OutOrStdout(), "Generating warning file: %s\n", newFilename)
+					if err := files.WriteHostRecords(newFilename, warned); err != nil {
+						return e.NewCustomError(e.ErrFileRW)
+					}
+				}
+			}
+			if isXLSXFile(csvFilePath) {
+				fmt.Println("Excel validation successful")
+			} else if discoveryPath != "" {
+				fmt.Println("Discovery manifest validation successful")
+			} else {
+				fmt.Println("CSV validation successful")
+			}
 			return nil
 		}
 
 		provisioningSupported := viper.GetBool(ProvisioningFeature)
-		validated, err = validator.CheckCSV(csvFilePath, *globalAttr, provisioningSupported)
+		validated, err = checkHostImportFile(csvFilePath, sheet, columns, *globalAttr, provisioningSupported)
 		if err != nil {
 			return err
 		}
@@ -2427,10 +2158,19 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 		if dryRun {
 			fmt.Println("--dry-run flag provided, validating input, hosts will not be imported")
 			provisioningSupported := viper.GetBool(ProvisioningFeature)
-			_, err := validator.CheckDirectInput(*globalAttr, provisioningSupported)
+			checked, err := validator.CheckDirectInput(*globalAttr, provisioningSupported)
 			if err != nil {
 				return err
 			}
+			if isFeatureEnabled(ClusterOrchFeature) {
+				warned, err := checkMachineRequirements(cmd, checked, globalAttr)
+				if err != nil {
+					return err
+				}
+				for _, record := range warned {
+					fmt.Fprintln(cmd.OutOrStdout(), record.Error)
+				}
+			}
 			fmt.Println("Single host input validation successful")
 			return nil
 		}
@@ -2442,7 +2182,58 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	respCache := ResponseCache{
+	erringRecords, registrations, err := registerHostRecords(cmd, validated, globalAttr)
+	if err != nil {
+		return err
+	}
+
+	if outputIDs != "" {
+		if err := files.WriteHostRegistrations(outputIDs, registrations); err != nil {
+			return err
+		}
+	}
+	sites := summarizeImportBySite(registrations)
+	if outputSiteSummary != "" {
+		if err := writeSiteImportSummary(outputSiteSummary, sites); err != nil {
+			return err
+		}
+	}
+	// Notices go to stderr with JSON output so that stdout can be parsed
+	notices := cmd.OutOrStdout()
+	if outputType == importOutputJSON {
+		if err := printImportReportJSON(cmd.OutOrStdout(), registrations); err != nil {
+			return err
+		}
+		notices = cmd.ErrOrStderr()
+	} else {
+		printImportSummary(cmd.OutOrStdout(), len(validated), erringRecords)
+		printResumedImportSummary(cmd.OutOrStdout(), registrations)
+		// The per-site breakdown is for CSV imports, a single host has a single site
+		if len(args) == 0 {
+			printSiteImportSummary(cmd.OutOrStdout(), sites)
+		}
+	}
+
+	if len(erringRecords) > 0 {
+		// A single host given on the command line has no CSV file to fix and re-import
+		if len(args) == 0 {
+			newFilename := fmt.Sprintf("%s_%s_%s", "import_error",
+				time.Now().Format(time.RFC3339), filepath.Base(currentPath))
+			fmt.Fprintf(notices, "Generating error file: %s\n", newFilename)
+			if err := files.WriteHostRecords(newFilename, erringRecords); err != nil {
+				return e.NewCustomError(e.ErrFileRW)
+			}
+		}
+		return e.NewCustomError(e.ErrImportFailed)
+	}
+
+	return nil
+
+}
+
+// Creates the empty caches of the resources resolved while registering hosts
+func newResponseCache() ResponseCache {
+	return ResponseCache{
 		OSProfileCache:          make(map[string]infra.OperatingSystemResource),
 		SiteCache:               make(map[string]infra.SiteResource),
 		LACache:                 make(map[string]infra.LocalAccountResource),
@@ -2451,42 +2242,119 @@ func runCreateHostCommand(cmd *cobra.Command, args []string) error {
 		K8sClusterNodesCache:    make(map[string][]cluster.NodeSpec),
 		CICache:                 make(map[string]infra.CustomConfigResource),
 	}
+}
+
+// Runs the registration workflow for each of the validated records and returns the records which failed
+// along with the outcome of every record
+func registerHostRecords(cmd *cobra.Command, records []types.HostRecord, globalAttr *types.HostRecord) ([]types.HostRecord, []types.HostRegistration, error) {
+	respCache := newResponseCache()
 
 	ctx, hostClient, projectName, err := InfraFactory(cmd)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	ctx2, clusterClient, _, err := ClusterFactory(cmd)
+	persisted, err := loadResolveCache(cmd, projectName, respCache)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	erringRecords := []types.HostRecord{}
+	ctx2, clusterClient, _, err := ClusterFactory(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for _, record := range validated {
-		doRegister(ctx, ctx2, hostClient, projectName, record, respCache, globalAttr, &erringRecords, clusterClient)
+	state, err := loadImportState(cmd, projectName)
+	if err != nil {
+		return nil, nil, err
+	}
+	pacer, err := newImportPacer(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	readyTimeout, err := getHostReadyTimeout(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deferred *[]deferredCluster
+	if readyTimeout > 0 {
+		deferred = &[]deferredCluster{}
 	}
 
-	if len(erringRecords) > 0 {
-		if len(args) > 0 {
-			// Single host direct input - print errors to console instead of writing to file
-			for _, record := range erringRecords {
-				fmt.Printf("Error creating host: %s\n", record.Error)
-			}
+	erringRecords := []types.HostRecord{}
+	registrations := make([]types.HostRegistration, 0, len(records))
+
+	progress := newImportProgress(cmd, len(records))
+	for _, record := range records {
+		registration := types.HostRegistration{Serial: record.Serial, UUID: record.UUID}
+		if isFeatureEnabled(ProvisioningFeature) {
+			registration.Site = valueOrDefault(globalAttr.Site, record.Site)
+		}
+		if hostID, ok := state.registered(record); ok {
+			// Registered by a previous run of the import, registering it again would fail as a duplicate
+			registration.Status = types.RegistrationRegistered
+			registration.HostID = hostID
+			registration.Resumed = true
+			registrations = append(registrations, registration)
+			progress.recordSuccess()
+			continue
+		}
+		if err := pacer.wait(ctx); err != nil {
+			progress.done()
+			return nil, nil, err
+		}
+		queued := 0
+		if deferred != nil {
+			queued = len(*deferred)
+		}
+		start := time.Now()
+		hostID, err := doRegister(ctx, ctx2, hostClient, projectName, record, respCache, globalAttr, &erringRecords, clusterClient, deferred)
+		registration.Duration = time.Since(start)
+		if err != nil {
+			registration.Status = types.RegistrationFailed
+			registration.ErrorCode = string(e.CodeOf(err))
+			registration.Error = err.Error()
+			progress.recordFailure()
 		} else {
-			newFilename := fmt.Sprintf("%s_%s_%s", "import_error",
-				time.Now().Format(time.RFC3339), filepath.Base(currentPath))
-			fmt.Printf("Generating error file: %s\n", newFilename)
-			if err := files.WriteHostRecords(newFilename, erringRecords); err != nil {
-				return e.NewCustomError(e.ErrFileRW)
+			registration.Status = types.RegistrationRegistered
+			registration.HostID = hostID
+			// doRegister deploys a single node cluster on the host when one is requested
+			registration.ClusterCreated = isFeatureEnabled(ProvisioningFeature) && isFeatureEnabled(ClusterOrchFeature) &&
+				resolveCluster(record.K8sEnable, globalAttr.K8sEnable) == "true"
+			if deferred != nil && len(*deferred) > queued {
+				// The cluster is created once the host is running, or not at all, see below
+				(*deferred)[queued].Registration = len(registrations)
+			}
+			if err := state.record(record, hostID); err != nil {
+				progress.done()
+				return nil, nil, err
+			}
+			progress.recordSuccess()
+		}
+		registrations = append(registrations, registration)
+	}
+	progress.done()
+
+	if deferred != nil {
+		failed := createDeferredClusters(cmd, ctx, ctx2, hostClient, clusterClient, respCache, projectName, *deferred, readyTimeout, defaultWaitInterval)
+		for i, d := range *deferred {
+			err := failed[i]
+			if err == nil {
+				continue
 			}
+			// The host is registered but left without its cluster, the row is reported as failed
+			registration := &registrations[d.Registration]
+			registration.Status = types.RegistrationFailed
+			registration.ClusterCreated = false
+			registration.ErrorCode = string(e.CodeOf(err))
+			registration.Error = err.Error()
+			d.Row.Error = err.Error()
+			erringRecords = append(erringRecords, d.Row)
 		}
-		return e.NewCustomError(e.ErrImportFailed)
 	}
+	persisted.save(respCache)
 
-	return nil
-
+	return erringRecords, registrations, nil
 }
 
 // Deletes specific Host - finds a host using resource ID and deletes it
@@ -2523,8 +2391,26 @@ func runDeleteHostCommand(cmd *cobra.Command, args []string) error {
 	if err := checkResponse(resp1.HTTPResponse, resp1.Body, "error while retrieving host"); err != nil {
 		return err
 	}
-	host := *resp1.JSON200
+	if wipe, _ := cmd.Flags().GetBool(wipeFlag); wipe {
+		timeout, _ := cmd.Flags().GetDuration(wipeTimeoutFlag)
+		if timeout <= 0 {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s must be a positive duration, got %s", wipeTimeoutFlag, timeout))
+		}
+		wipeCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		if err := wipeHost(wipeCtx, cmd, hostClient, projectName, *resp1.JSON200, time.Now(), wipePollInterval); err != nil {
+			return err
+		}
+	}
+	if err := deleteHostAndInstance(ctx, hostClient, projectName, *resp1.JSON200); err != nil {
+		return err
+	}
+	fmt.Printf("Host %s deleted successfully\n", hostID)
+	return nil
+}
 
+// Deletes the instance of the host, if it has one, and then the host
+func deleteHostAndInstance(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource) error {
 	// delete the instance if it exists
 	if host.Instance != nil {
 		instanceID := host.Instance.InstanceID
@@ -2542,15 +2428,11 @@ func runDeleteHostCommand(cmd *cobra.Command, args []string) error {
 
 	// delete the host
 	resp3, err := hostClient.HostServiceDeleteHostWithResponse(ctx, projectName,
-		hostID, auth.AddAuthHeader)
+		derefString(host.ResourceId), auth.AddAuthHeader)
 	if err != nil {
 		return processError(err)
 	}
-	if err := checkResponse(resp3.HTTPResponse, resp3.Body, "error while deleting host"); err != nil {
-		return err
-	}
-	fmt.Printf("Host %s deleted successfully\n", hostID)
-	return nil
+	return checkResponse(resp3.HTTPResponse, resp3.Body, "error while deleting host")
 }
 
 // Set attributes for specific Host - finds a host using resource ID
@@ -2561,6 +2443,9 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 	policyFlag, _ := cmd.Flags().GetString("power-policy")
 	powerFlag, _ := cmd.Flags().GetString("power")
 	updFlag, _ := cmd.Flags().GetString("osupdatepolicy")
+	if updFlag == "" {
+		updFlag, _ = cmd.Flags().GetString("policy")
+	}
 	amtFlag, _ := cmd.Flags().GetString("amt-state")
 	amtModeFlag, _ := cmd.Flags().GetString("control-mode")
 	sessionType, _ := cmd.Flags().GetString("session-type")
@@ -2569,6 +2454,25 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 	siteFlag, _ := cmd.Flags().GetString("site")
 	regFlag, _ := cmd.Flags().GetString("region")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	nowFlag, _ := cmd.Flags().GetBool("now")
+	noteFlag, _ := cmd.Flags().GetString("note")
+	appendNote, _ := cmd.Flags().GetBool("append")
+	setNote := cmd.Flags().Changed("note")
+	lvmSize, _ := cmd.Flags().GetInt(lvmSizeFlag)
+	setLvmSize := cmd.Flags().Changed(lvmSizeFlag)
+
+	if nowFlag && (generateCSV != "" || importCSV != "" || filtflag != "" || siteFlag != "" || regFlag != "") {
+		return errors.New("--now updates a single host, use \"update-os host\" to update the OS of several hosts")
+	}
+	if appendNote && !setNote {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--append requires --note"))
+	}
+	if setNote && (generateCSV != "" || importCSV != "" || filtflag != "" || siteFlag != "" || regFlag != "") {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--note sets the note of a single host"))
+	}
+	if setLvmSize && (generateCSV != "" || importCSV != "" || filtflag != "" || siteFlag != "" || regFlag != "") {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--lvm-size sets the LVM size of a single host"))
+	}
 
 	// Bulk CSV generation
 	if generateCSV != "" {
@@ -2782,11 +2686,17 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		if powerFlag == "" && policyFlag == "" && amtFlag == "" && amtModeFlag == "" && updFlag == "" {
 			return fmt.Errorf("--filter, --site, and --region require at least one action flag (--power, --power-policy, --amt-state, --control-mode, --osupdatepolicy)")
 		}
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		if concurrency <= 0 {
+			return e.WithCode(e.CodeInvalidArgument, errors.New("--concurrency must be positive"))
+		}
 
 		ctx, hostClient, projectName, err := InfraFactory(cmd)
 		if err != nil {
 			return err
 		}
+		// The sites, regions and OS update policies listed to resolve the flags are fetched once
+		hostClient = newMemoInfraClient(hostClient)
 
 		// Resolve --site by name if not already a resource ID
 		if siteFlag != "" && !isSiteResourceID(siteFlag) {
@@ -2957,7 +2867,7 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		}
 
 		if len(hosts) == 0 {
-			fmt.Println("No hosts matched the provided filters")
+			fmt.Fprintln(cmd.OutOrStdout(), "No hosts matched the provided filters")
 			return nil
 		}
 
@@ -2980,99 +2890,22 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		actionSummary := strings.Join(actions, ", ")
 
 		if dryRun {
-			fmt.Printf("Dry run: %d host(s) would be updated [%s]:\n", len(hosts), actionSummary)
+			fmt.Fprintf(cmd.OutOrStdout(), "Dry run: %d host(s) would be updated [%s]:\n", len(hosts), actionSummary)
 			for _, h := range hosts {
-				rid := ""
-				if h.ResourceId != nil {
-					rid = *h.ResourceId
-				}
-				fmt.Printf("  %s (%s)\n", h.Name, rid)
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s (%s)\n", h.Name, derefString(h.ResourceId))
 			}
 			return nil
 		}
 
-		fmt.Printf("Applying [%s] to %d host(s)\n", actionSummary, len(hosts))
-
-		updated := 0
-		skipped := 0
-		failed := 0
-		for i, h := range hosts {
-			rid := ""
-			if h.ResourceId != nil {
-				rid = *h.ResourceId
-			}
-
-			didUpdate := false
-			didFail := false
-
-			if power != nil || policy != nil {
-				if h.CurrentAmtState == nil || *h.CurrentAmtState != infra.AMTSTATEPROVISIONED {
-					fmt.Printf("[%d/%d]  %s (%s)  power/policy skipped (AMT not provisioned)\n", i+1, len(hosts), h.Name, rid)
-				} else {
-					resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, rid, &infra.HostServicePatchHostParams{}, infra.HostServicePatchHostJSONRequestBody{
-						PowerCommandPolicy: policy,
-						DesiredPowerState:  power,
-						Name:               h.Name,
-					}, auth.AddAuthHeader)
-					if err != nil {
-						fmt.Printf("[%d/%d]  %s (%s)  power/policy failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-						didFail = true
-					} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting power state/policy"); err != nil {
-						fmt.Printf("[%d/%d]  %s (%s)  power/policy failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-						didFail = true
-					} else {
-						didUpdate = true
-					}
-				}
-			}
-
-			if amtState != nil || amtMode != nil {
-				resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, rid, &infra.HostServicePatchHostParams{}, infra.HostServicePatchHostJSONRequestBody{
-					DesiredAmtState: amtState,
-					AmtControlMode:  amtMode,
-					Name:            h.Name,
-				}, auth.AddAuthHeader)
-				if err != nil {
-					fmt.Printf("[%d/%d]  %s (%s)  amt failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-					didFail = true
-				} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting AMT state"); err != nil {
-					fmt.Printf("[%d/%d]  %s (%s)  amt failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-					didFail = true
-				} else {
-					didUpdate = true
-				}
-			}
-
-			if updFlag != "" {
-				if h.Instance == nil || h.Instance.InstanceID == nil {
-					fmt.Printf("[%d/%d]  %s (%s)  osupdatepolicy skipped (no instance)\n", i+1, len(hosts), h.Name, rid)
-				} else {
-					resp, err := hostClient.InstanceServicePatchInstanceWithResponse(ctx, projectName, *h.Instance.InstanceID, &infra.InstanceServicePatchInstanceParams{}, infra.InstanceServicePatchInstanceJSONRequestBody{
-						OsUpdatePolicyID: &updFlag,
-					}, auth.AddAuthHeader)
-					if err != nil {
-						fmt.Printf("[%d/%d]  %s (%s)  osupdatepolicy failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-						didFail = true
-					} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting OS update policy"); err != nil {
-						fmt.Printf("[%d/%d]  %s (%s)  osupdatepolicy failed: %v\n", i+1, len(hosts), h.Name, rid, err)
-						didFail = true
-					} else {
-						didUpdate = true
-					}
-				}
-			}
-
-			if didFail {
-				failed++
-			} else if didUpdate {
-				fmt.Printf("[%d/%d]  %s (%s)  updated\n", i+1, len(hosts), h.Name, rid)
-				updated++
-			} else {
-				skipped++
-			}
-		}
-		fmt.Printf("Done: %d updated, %d skipped, %d failed\n", updated, skipped, failed)
-		return nil
+		fmt.Fprintf(cmd.OutOrStdout(), "Applying [%s] to %d host(s)\n", actionSummary, len(hosts))
+		results := applyBulkHostActions(ctx, hostClient, projectName, hosts, bulkHostActions{
+			power:          power,
+			policy:         policy,
+			amtState:       amtState,
+			amtMode:        amtMode,
+			osUpdatePolicy: updFlag,
+		}, concurrency)
+		return printBulkHostResults(cmd, cmd.OutOrStdout(), results)
 	}
 
 	if len(args) == 0 {
@@ -3080,7 +2913,7 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 	}
 	hostID := args[0]
 
-	if (policyFlag == "" || strings.HasPrefix(policyFlag, "--")) && (powerFlag == "" || strings.HasPrefix(powerFlag, "--")) && updFlag == "" && (amtFlag == "" || strings.HasPrefix(amtFlag, "--")) && (amtModeFlag == "" || strings.HasPrefix(amtModeFlag, "--")) && (sessionType == "" || strings.HasPrefix(sessionType, "--")) && (sessionState == "" || strings.HasPrefix(sessionState, "--")) {
+	if (policyFlag == "" || strings.HasPrefix(policyFlag, "--")) && (powerFlag == "" || strings.HasPrefix(powerFlag, "--")) && updFlag == "" && !nowFlag && !setNote && !setLvmSize && (amtFlag == "" || strings.HasPrefix(amtFlag, "--")) && (amtModeFlag == "" || strings.HasPrefix(amtModeFlag, "--")) && (sessionType == "" || strings.HasPrefix(sessionType, "--")) && (sessionState == "" || strings.HasPrefix(sessionState, "--")) {
 		return errors.New("a flag must be provided with the set host command and value cannot be \"\"")
 	}
 
@@ -3193,6 +3026,15 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("host %s does not seem to have AMT enabled, power toggle and policy not supported", hostID)
 	}
 
+	if nowFlag {
+		if host.Instance == nil || host.Instance.InstanceID == nil {
+			return fmt.Errorf("host %s does not have an instance associated with it, fully onboard the host before updating its OS", hostID)
+		}
+		if updatePolicy == nil && (host.Instance.UpdatePolicy == nil || host.Instance.UpdatePolicy.ResourceId == nil) {
+			return fmt.Errorf("host %s has no OS update policy, set one with --osupdatepolicy to update its OS", hostID)
+		}
+	}
+
 	if updatePolicy != nil && host.Instance != nil && host.Instance.InstanceID != nil && updFlag != "" {
 		resp, err := hostClient.InstanceServicePatchInstanceWithResponse(ctx, projectName, *host.Instance.InstanceID, &infra.InstanceServicePatchInstanceParams{}, infra.InstanceServicePatchInstanceJSONRequestBody{
 			OsUpdatePolicyID: updatePolicy,
@@ -3219,6 +3061,25 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if setLvmSize {
+		if err := validateHostLvmSize(host, lvmSize); err != nil {
+			return err
+		}
+		if err := setHostLvmSize(ctx, hostClient, projectName, host, lvmSize); err != nil {
+			return err
+		}
+	}
+
+	if setNote {
+		note, err := hostNoteValue(derefString(host.Note), noteFlag, appendNote, time.Now())
+		if err != nil {
+			return err
+		}
+		if err := setHostNote(ctx, hostClient, projectName, host, note); err != nil {
+			return err
+		}
+	}
+
 	// Handle KVM/SOL session start/stop flow
 	if sessionType != "" || sessionState != "" {
 		orchCA, _ := cmd.Flags().GetString("orch-ca")
@@ -3227,11 +3088,61 @@ func runSetHostCommand(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if nowFlag {
+		schedule := newImmediateOSUpdateSchedule(hostID)
+		resp, err := hostClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName,
+			schedule, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", *schedule.Name)); err != nil {
+			return err
+		}
+		fmt.Printf("Host %s OS update scheduled to start now in maintenance window %s\n", hostID, *schedule.Name)
+	}
+
 	fmt.Printf("Host %s updated successfully\n", hostID)
 
 	return nil
 }
 
+// Returns the note of a host once --note is applied to its current note, appended notes are
+// entries prefixed with their time and separated by "; " as the API does not accept line breaks
+func hostNoteValue(current, note string, appendNote bool, now time.Time) (string, error) {
+	note = strings.TrimSpace(note)
+	if appendNote {
+		if note == "" {
+			return "", e.WithCode(e.CodeInvalidArgument, errors.New("--append requires a non-empty --note"))
+		}
+		note = fmt.Sprintf("%s: %s", now.UTC().Format(scheduleDisplayTimeFormat), note)
+		if current != "" {
+			note = current + hostNoteEntrySeparator + note
+		}
+	}
+	if !hostNotePattern.MatchString(note) {
+		return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid note %q: only letters, digits, spaces and -_./:;=@?!#,<>*()\" are allowed", note))
+	}
+	if len(note) > hostNoteMaxLength {
+		return "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("the note of a host is limited to %d characters, got %d", hostNoteMaxLength, len(note)))
+	}
+	return note, nil
+}
+
+// Sets the note of a host, an empty note removes it
+func setHostNote(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource, note string) error {
+	fieldMask := "note"
+	resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, derefString(host.ResourceId),
+		&infra.HostServicePatchHostParams{FieldMask: &fieldMask},
+		infra.HostServicePatchHostJSONRequestBody{
+			Name: host.Name,
+			Note: &note,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body, "error while setting host note")
+}
+
 // runHostSessionCommand handles the KVM/SOL session start/stop flow.
 func runHostSessionCommand(
 	ctx context.Context,
@@ -3308,6 +3219,9 @@ func runHostSessionCommand(
 
 // Run an immediate OS update single schedule on a host
 func runUpdateHostCommand(cmd *cobra.Command, args []string) error {
+	if started, err := startAsyncJob(cmd, args); started || err != nil {
+		return err
+	}
 
 	generateCSV, _ := cmd.Flags().GetString("generate-csv")
 	importCSV, _ := cmd.Flags().GetString("import-from-csv")
@@ -3743,24 +3657,15 @@ func runUpdateHostCommand(cmd *cobra.Command, args []string) error {
 	// Schedule an immediate OS update on all hosts in the updateRecords
 	for _, record := range updateRecords {
 
-		name := record.ResourceID + "_immediate_os_update"
-		maintenanceType := infra.SCHEDULESTATUSOSUPDATE
 		hostID := record.ResourceID
-		startSeconds := time.Now().Unix() + 30
-		endSeconds := int(time.Now().Unix() + 660)
+		schedule := newImmediateOSUpdateSchedule(hostID)
 
 		resp, err := hostClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName,
-			infra.ScheduleServiceCreateSingleScheduleJSONRequestBody{
-				Name:           &name,
-				ScheduleStatus: maintenanceType,
-				StartSeconds:   int(startSeconds),
-				EndSeconds:     &endSeconds,
-				TargetHostId:   &hostID,
-			}, auth.AddAuthHeader)
+			schedule, auth.AddAuthHeader)
 		if err != nil {
 			return processError(err)
 		}
-		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", name)); err != nil {
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", *schedule.Name)); err != nil {
 			fmt.Printf("Host %s %s update schedule failed - %s\n", record.Name, hostID, err)
 			continue
 		}
@@ -3770,21 +3675,121 @@ func runUpdateHostCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// Deauthorizes specific Host - finds a host using resource ID and invalidates it
+// Single OS update schedule of a host starting right away, giving the update a ten minute maintenance window
+func newImmediateOSUpdateSchedule(hostID string) infra.ScheduleServiceCreateSingleScheduleJSONRequestBody {
+	name := hostID + "_immediate_os_update"
+	startSeconds := time.Now().Unix() + 30
+	endSeconds := int(time.Now().Unix() + 660)
+	return infra.ScheduleServiceCreateSingleScheduleJSONRequestBody{
+		Name:           &name,
+		ScheduleStatus: infra.SCHEDULESTATUSOSUPDATE,
+		StartSeconds:   int(startSeconds),
+		EndSeconds:     &endSeconds,
+		TargetHostId:   &hostID,
+	}
+}
+
+// Deauthorizes the hosts given by resource ID or matching a filter - invalidates them with the note as the reason
 func runDeauthorizeHostCommand(cmd *cobra.Command, args []string) error {
-	hostID := args[0]
+	note, _ := cmd.Flags().GetString("note")
+	filter, _ := cmd.Flags().GetString("filter")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	switch {
+	case filter == "" && len(args) == 0:
+		return e.WithCode(e.CodeInvalidArgument, errors.New("give the resource IDs of the hosts to deauthorize or --filter"))
+	case filter != "" && len(args) > 0:
+		return e.WithCode(e.CodeInvalidArgument, errors.New("resource IDs and --filter cannot be combined"))
+	case filter != "" && strings.TrimSpace(note) == "":
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--note is required to deauthorize hosts matching --filter"))
+	}
+
 	ctx, hostClient, projectName, err := InfraFactory(cmd)
 	if err != nil {
 		return err
 	}
 
-	resp, err := hostClient.HostServiceInvalidateHostWithResponse(ctx, projectName,
-		hostID, &infra.HostServiceInvalidateHostParams{}, auth.AddAuthHeader)
-	if err != nil {
-		return processError(err)
+	hostIDs := args
+	if filter != "" {
+		hosts, err := listHostsMatching(ctx, hostClient, projectName, filterHelper(filter))
+		if err != nil {
+			return err
+		}
+		if len(hosts) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "No hosts match filter %s\n", filter)
+			return nil
+		}
+		writer := newOutputWriter(cmd, cmd.OutOrStdout())
+		fmt.Fprintf(writer, "RESOURCE ID\tNAME\tHOST STATUS\tSERIAL NUMBER\n")
+		hostIDs = make([]string, 0, len(hosts))
+		for _, h := range hosts {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", derefString(h.ResourceId), h.Name, outfmt.HostStatus(h), valueOrNone(h.SerialNumber))
+			hostIDs = append(hostIDs, derefString(h.ResourceId))
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if !yes {
+			fmt.Fprintf(cmd.OutOrStdout(), "Warning: %d hosts will lose their access to Edge Orchestrator.\n", len(hostIDs))
+			fmt.Fprintln(cmd.OutOrStdout(), "Are you sure you want to proceed? (y/n)")
+			var response string
+			if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+				return errors.New("operation cancelled by user")
+			}
+		}
+	}
+
+	params := &infra.HostServiceInvalidateHostParams{}
+	if note != "" {
+		params.Note = &note
+	}
+	// A single host given by resource ID is deauthorized without output, failures of one of many hosts do not stop the others
+	single := filter == "" && len(hostIDs) == 1
+	var failed []string
+	for _, hostID := range hostIDs {
+		resp, err := hostClient.HostServiceInvalidateHostWithResponse(ctx, projectName,
+			hostID, params, auth.AddAuthHeader)
+		if err != nil {
+			err = processError(err)
+		} else {
+			err = checkResponse(resp.HTTPResponse, resp.Body, "error while invalidating host")
+		}
+		if single {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Host %s: %v\n", hostID, err)
+			failed = append(failed, hostID)
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Host %s deauthorized\n", hostID)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to deauthorize %d of %d hosts: %s", len(failed), len(hostIDs), strings.Join(failed, ", "))
 	}
+	return nil
+}
 
-	return checkResponse(resp.HTTPResponse, resp.Body, "error while invalidating host")
+// Lists all hosts matching the filter, e.g. of a bulk deauthorization
+func listHostsMatching(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, filter *string) ([]infra.HostResource, error) {
+	hosts := make([]infra.HostResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{
+				Filter:   filter,
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return 0, false, err
+		}
+		hosts = append(hosts, resp.JSON200.Hosts...)
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	return hosts, err
 }
 
 // Function containing the logic to register the host and retrieve the host ID
@@ -5,13 +5,24 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func (s *CLITestSuite) createHost(publisher string, args commandArgs) (string, error) {
@@ -182,7 +193,66 @@ func (s *CLITestSuite) TestHost() {
 		"dry-run":         "true",
 	}
 	_, err = s.createHost(project, HostArgs)
-	s.EqualError(err, "host import input file must be a CSV file")
+	s.EqualError(err, "host import input file must be a CSV file or an Excel .xlsx workbook")
+
+	//Dry run host creation from a sheet of an Excel workbook
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.xlsx",
+		"sheet":           "Hosts",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.NoError(err)
+
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.xlsx",
+		"sheet":           "Inventory",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.EqualError(err, `sheet "Inventory" not found, the workbook has: Readme, Hosts`)
+
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.csv",
+		"sheet":           "Hosts",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.EqualError(err, "--sheet can only be used with an Excel workbook given to --import-from-csv")
+
+	//Dry run host creation from a sheet of another asset system, its columns found by header
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/assets.csv",
+		"column-map":      "Serial=SN,UUID=DeviceGUID,Site=Location,OSProfile=Image",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.NoError(err)
+
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/assets.csv",
+		"column-map":      "Serial=SN,UUID=DeviceGUID,Site=Location",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.EqualError(err, "mandatory columns not mapped: OSProfile")
+
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/assets.csv",
+		"column-map":      "Serial=SN,Owner=Asset Owner",
+		"dry-run":         "true",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.ErrorContains(err, `unknown column "Owner" in column mapping`)
+
+	//Host creation from the second sheet of an Excel workbook
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.xlsx",
+		"sheet":           "2",
+	}
+	out, err := s.createHost(project, HostArgs)
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
 
 	//Dry run host creation with overrides
 	HostArgs = map[string]string{
@@ -205,8 +275,59 @@ func (s *CLITestSuite) TestHost() {
 	HostArgs = map[string]string{
 		"import-from-csv": "./testdata/mock.csv",
 	}
+	out, err = s.createHost(project, HostArgs)
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
+	s.NotContains(out, "registered. Host ID")
+	s.Regexp(`Site +Attempted +Succeeded +Failed +Success Rate +Avg Registration Time +P50 +P95 +Clusters Created\nsite-7ceae560 +1 +1 +0 +100.0% +\S+ +\S+ +\S+ +1\n`, out)
+
+	//host creation with the per-site summary written to a file
+	sitesFile := filepath.Join(s.T().TempDir(), "sites.json")
+	HostArgs = map[string]string{
+		"import-from-csv":     "./testdata/mock.csv",
+		"output-site-summary": sitesFile,
+	}
 	_, err = s.createHost(project, HostArgs)
 	s.NoError(err)
+	sites, err := os.ReadFile(sitesFile)
+	s.NoError(err)
+	s.Contains(string(sites), `"site": "site-7ceae560"`)
+	s.Contains(string(sites), `"clustersCreated": 1`)
+
+	//host creation with the serial to host ID mapping written to a file and printed as JSON
+	idsFile := filepath.Join(s.T().TempDir(), "ids.csv")
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.csv",
+		"output-ids":      idsFile,
+		"output-type":     "json",
+	}
+	out, err = s.createHost(project, HostArgs)
+	s.NoError(err)
+	s.Contains(out, `"succeeded": 1`)
+	s.Contains(out, `"serial": "SN123456789"`)
+	s.Contains(out, `"status": "registered"`)
+	ids, err := os.ReadFile(idsFile)
+	s.NoError(err)
+	s.Regexp(`^Serial,UUID,HostID,Status,ErrorCode,Error\nSN123456789,550e8400-e29b-41d4-a716-446655440000,host-\w+,registered,,\n$`, string(ids))
+
+	//failed rows are part of the mapping
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.csv",
+		"output-ids":      idsFile,
+	}
+	out, err = s.createHost("duplicate-host-project", HostArgs)
+	s.EqualError(err, "Failed to provision hosts")
+	s.Contains(out, "0 of 1 host(s) imported, 1 failed")
+	ids, err = os.ReadFile(idsFile)
+	s.NoError(err)
+	s.Contains(string(ids), "SN123456789,550e8400-e29b-41d4-a716-446655440000,,failed,")
+
+	HostArgs = map[string]string{
+		"import-from-csv": "./testdata/mock.csv",
+		"output-type":     "yaml",
+	}
+	_, err = s.createHost(project, HostArgs)
+	s.EqualError(err, `invalid --output-type "yaml", must be one of: text, json`)
 
 	//host creation single host
 	HostArgs = map[string]string{
@@ -382,6 +503,7 @@ func (s *CLITestSuite) TestHost() {
 			"CPU MODEL":           processor,
 			"OS UPDATE AVAILABLE": update,
 			"TRUSTED COMPUTE":     compute,
+			"NOTE":                "Edge computing host",
 		},
 	}
 
@@ -418,6 +540,36 @@ func (s *CLITestSuite) TestHost() {
 	_, err = s.listHost(project, HostArgs)
 	s.NoError(err)
 
+	// Test list hosts functionality with typed filter flags
+	HostArgs = map[string]string{
+		"status":     "provisioned",
+		"os-profile": "ubuntu-22.04-lts-generic",
+		"serial":     "ABCD12345",
+		"has-update": "",
+		"secure":     "false",
+	}
+	_, err = s.listHost(project, HostArgs)
+	s.NoError(err)
+
+	HostArgs = map[string]string{
+		"status": "broken",
+	}
+	_, err = s.listHost(project, HostArgs)
+	s.EqualError(err, `invalid --status "broken", must be one of: onboarded, registered, provisioned, deauthorized, not connected, error`)
+
+	HostArgs = map[string]string{
+		"status": "error",
+		"filter": "onboarded",
+	}
+	_, err = s.listHost(project, HostArgs)
+	s.EqualError(err, `--status "error" cannot be combined with the predefined --filter "onboarded", both select the host status`)
+
+	HostArgs = map[string]string{
+		"uuid": "not-a-uuid",
+	}
+	_, err = s.listHost(project, HostArgs)
+	s.EqualError(err, `invalid --uuid "not-a-uuid", expected a UUID such as 4c4c4544-0044-4210-8031-c2c04f305233`)
+
 	// Test get specific host
 	hostID := resourceID
 	getOutput, err := s.getHost(project, hostID, make(map[string]string))
@@ -664,6 +816,22 @@ func (s *CLITestSuite) TestHost() {
 	_, err = s.setHost(project, hostID, HostArgs)
 	s.NoError(err)
 
+	// Test OSupdate policy set and immediate OS update through the update alias
+	_, err = s.runCommand(fmt.Sprintf("update host %s --project %s --policy osupdatepolicy-1234abcd --now", hostID, project))
+	s.NoError(err)
+
+	// Test immediate OS update with the OS update policy already set on the host
+	_, err = s.setHost(project, hostID, map[string]string{"now": ""})
+	s.NoError(err)
+
+	// Test immediate OS update rejected for bulk operations
+	_, err = s.setHost(project, hostID, map[string]string{"now": "", "site": "site-abcd1234"})
+	s.EqualError(err, "--now updates a single host, use \"update-os host\" to update the OS of several hosts")
+
+	// Test --policy and --osupdatepolicy together
+	_, err = s.setHost(project, hostID, map[string]string{"policy": "osupdatepolicy-1234abcd", "osupdatepolicy": "osupdatepolicy-1234abcd"})
+	s.Error(err)
+
 	// Test deauthorize host
 	_, err = s.deauthorizeHost(project, hostID, make(map[string]string))
 	s.NoError(err)
@@ -874,8 +1042,29 @@ host-153,host-0a6e769d,AMT_STATE_PROVISIONED,AMT_CONTROL_MODE_ACM,POWER_STATE_ON
 		"filter":    "hostStatus='onboarded'",
 		"amt-state": "provisioned",
 	}
-	_, err = s.setHostBulk(project, HostArgs)
+	// Hosts already in the desired AMT state are skipped
+	out, err = s.setHostBulk(project, HostArgs)
 	s.NoError(err)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|skipped\s+\|amt skipped \(already AMT_STATE_PROVISIONED\)$`, out)
+	s.Contains(out, "Done: 0 updated, 1 skipped, 0 failed")
+
+	HostArgs = map[string]string{
+		"filter":      "site.resourceId='site-7ceae560'",
+		"amt-state":   "unprovisioned",
+		"concurrency": "4",
+	}
+	out, err = s.setHostBulk(project, HostArgs)
+	s.NoError(err)
+	s.Regexp(`(?m)^NAME\s+\|RESOURCE ID\s+\|RESULT\s+\|DETAILS$`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|updated\s+\|-$`, out)
+
+	out, err = s.setHostBulk("host-not-found-project", HostArgs)
+	s.EqualError(err, "failed to update 1 of 1 hosts")
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|failed\s+\|amt failed: `, out)
+
+	HostArgs["concurrency"] = "0"
+	_, err = s.setHostBulk(project, HostArgs)
+	s.EqualError(err, "--concurrency must be positive")
 
 	// Bulk combined power + control-mode
 	HostArgs = map[string]string{
@@ -1164,6 +1353,46 @@ host-66,host-abcd1002,osupdatepolicy-abcd1234
 
 // TestHostOnboarding covers the setHostName code path, which is only reached
 // when the provisioning feature is disabled (onboarding-only mode).
+func (s *CLITestSuite) TestCreateHostWaitForHostReady() {
+	// The instance of the host is running, the cluster is created after it
+	out, err := s.createHost(project, map[string]string{
+		"import-from-csv":     "./testdata/mock.csv",
+		"wait-for-host-ready": "true",
+	})
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
+	s.Regexp(`site-7ceae560 +1 +1 +0 +100.0% +\S+ +\S+ +\S+ +1\n`, out)
+
+	// The provisioning of the host failed, no cluster is created
+	out, err = s.createHost("provisioning-failed-project", map[string]string{
+		"import-from-csv":     "./testdata/mock.csv",
+		"wait-for-host-ready": "true",
+		"output-type":         "json",
+	})
+	s.Error(err)
+	s.Contains(out, `"status": "failed"`)
+	s.Contains(out, "cluster not created, provisioning of host host-1111abcd failed")
+	s.NotContains(out, `"clusterCreated": true`)
+
+	// The host is still provisioning when the wait times out
+	out, err = s.createHost("provisioning-pending-project", map[string]string{
+		"import-from-csv":     "./testdata/mock.csv",
+		"wait-for-host-ready": "true",
+		"host-ready-timeout":  "10ms",
+		"output-type":         "json",
+	})
+	s.Error(err)
+	s.Contains(out, "was not running within 10ms, last status: state INSTANCE_STATE_UNSPECIFIED, provisioning Provisioning In Progress")
+	s.Contains(out, `"errorCode": "deadline_exceeded"`)
+
+	_, err = s.createHost(project, map[string]string{
+		"import-from-csv":     "./testdata/mock.csv",
+		"wait-for-host-ready": "true",
+		"host-ready-timeout":  "0s",
+	})
+	s.EqualError(err, "--host-ready-timeout must be a positive duration, got 0s")
+}
+
 func (s *CLITestSuite) TestHostOnboarding() {
 	// Switch to onboarding-only mode: provisioning=false
 	viper.Set("test_orchestrator_features_disabled", true)
@@ -1285,3 +1514,292 @@ func PathExists(path string) bool {
 func HasCSVExtension(path string) bool {
 	return strings.HasSuffix(path, ".csv")
 }
+
+func (s *CLITestSuite) TestDeauthorizeHosts() {
+	out, err := s.deauthorizeHost(project, "host-abc12345 host-abcd1004", map[string]string{"note": "decommissioned"})
+	s.NoError(err)
+	s.Contains(out, "Host host-abc12345 deauthorized")
+	s.Contains(out, "Host host-abcd1004 deauthorized")
+
+	out, err = s.deauthorizeHost(project, "host-abc12345 host-11111111", map[string]string{"note": "decommissioned"})
+	s.EqualError(err, "failed to deauthorize 1 of 2 hosts: host-11111111")
+	s.Contains(out, "Host host-abc12345 deauthorized")
+
+	out, err = s.runCommand(`deauthorize host --filter provisioned --note "site closed" --yes --project ` + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^host-abc12345\s+\|edge-host-001\s`, out)
+	s.Contains(out, "Host host-abc12345 deauthorized")
+
+	_, err = s.runCommand("deauthorize host --filter provisioned --yes --project " + project)
+	s.EqualError(err, "--note is required to deauthorize hosts matching --filter")
+
+	_, err = s.runCommand("deauthorize host host-abc12345 --filter provisioned --note x --project " + project)
+	s.EqualError(err, "resource IDs and --filter cannot be combined")
+
+	_, err = s.runCommand("deauthorize host --project " + project)
+	s.EqualError(err, "give the resource IDs of the hosts to deauthorize or --filter")
+}
+
+func TestHostFilterFlagsHelper(t *testing.T) {
+	cases := []struct {
+		name   string
+		args   []string
+		filter string
+		want   string
+	}{
+		{name: "none", want: ""},
+		{name: "status", args: []string{"--status", "not connected"}, want: "hostStatus=''"},
+		{name: "status with custom filter", args: []string{"--status", "error"}, filter: "osType=OS_TYPE_IMMUTABLE", want: "hostStatus='error'"},
+		{
+			name: "combined",
+			args: []string{"--os-profile", "ubuntu-22.04-lts-generic", "--uuid", "4c4c4544-0044-4210-8031-c2c04f305233", "--has-update", "--secure"},
+			want: "instance.os.profileName='ubuntu-22.04-lts-generic' AND uuid='4c4c4544-0044-4210-8031-c2c04f305233' AND " +
+				"instance.osUpdateAvailable!='' AND instance.securityFeature=SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION",
+		},
+		{name: "negated", args: []string{"--has-update=false", "--secure=false"}, want: "instance.osUpdateAvailable='' AND instance.securityFeature!=SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := getListHostCommand()
+			if err := cmd.ParseFlags(tc.args); err != nil {
+				t.Fatal(err)
+			}
+			got, err := hostFilterFlagsHelper(cmd, tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	cmd := getListHostCommand()
+	if err := cmd.ParseFlags([]string{"--os-profile", "a'b"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hostFilterFlagsHelper(cmd, ""); err == nil || err.Error() != `invalid --os-profile "a'b", it must not contain quotes or commas` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func (s *CLITestSuite) TestSetHostNote() {
+	_, err := s.runCommand(`set host host-abc12345 --note "RMA scheduled 5/3" --project ` + project)
+	s.NoError(err)
+
+	_, err = s.runCommand(`set host edge-host-001 --note "disk replaced" --append --project ` + project)
+	s.NoError(err)
+
+	_, err = s.runCommand(`set host host-abc12345 --append --project ` + project)
+	s.EqualError(err, "--append requires --note")
+
+	_, err = s.runCommand(`set host host-abc12345 --note "RMA [urgent]" --project ` + project)
+	s.ErrorContains(err, `invalid note "RMA [urgent]"`)
+
+	_, err = s.runCommand(`set host host-11111111 --note "RMA scheduled 5/3" --project ` + project)
+	s.Error(err)
+}
+
+func TestHostNoteValue(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	note, err := hostNoteValue("Edge computing host", " RMA scheduled 5/3 ", false, now)
+	require.NoError(t, err)
+	assert.Equal(t, "RMA scheduled 5/3", note)
+
+	note, err = hostNoteValue("Edge computing host", "disk replaced", true, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Edge computing host; 2025-01-15 10:30 UTC: disk replaced", note)
+
+	note, err = hostNoteValue("", "disk replaced", true, now)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-15 10:30 UTC: disk replaced", note)
+
+	note, err = hostNoteValue("Edge computing host", "", false, now)
+	require.NoError(t, err)
+	assert.Empty(t, note)
+
+	_, err = hostNoteValue("", " ", true, now)
+	assert.EqualError(t, err, "--append requires a non-empty --note")
+
+	_, err = hostNoteValue(strings.Repeat("a", 500), "disk replaced", true, now)
+	assert.EqualError(t, err, "the note of a host is limited to 512 characters, got 537")
+}
+
+func (s *CLITestSuite) TestSetHostLvmSize() {
+	_, err := s.runCommand(`set host host-abcd1003 --lvm-size 80 --project ` + project)
+	s.NoError(err)
+
+	_, err = s.runCommand(`set host host-abcd1003 --lvm-size 0 --project ` + project)
+	s.EqualError(err, "invalid --lvm-size 0: the LVM size must be at least 1 GB")
+
+	_, err = s.runCommand(`set host --site site-abcd1234 --lvm-size 80 --project ` + project)
+	s.EqualError(err, "--lvm-size sets the LVM size of a single host")
+
+	_, err = s.runCommand(`set host host-11111111 --lvm-size 80 --project ` + project)
+	s.Error(err)
+}
+
+func TestValidateHostLvmSize(t *testing.T) {
+	running := infra.INSTANCESTATERUNNING
+	inProgress := infra.STATUSINDICATIONINPROGRESS
+	deleted := infra.INSTANCESTATEDELETED
+	lvm := 40
+	host := infra.HostResource{
+		ResourceId: stringPtr("host-1234abcd"),
+		HostStorages: &[]infra.HoststorageResource{
+			{CapacityBytes: stringPtr("128849018880")},
+			{CapacityBytes: stringPtr("not-reported")},
+		},
+		UserLvmSize: &lvm,
+	}
+
+	// Before provisioning only the disks bound the LVM
+	assert.NoError(t, validateHostLvmSize(host, 20))
+	assert.NoError(t, validateHostLvmSize(host, 120))
+	assert.EqualError(t, validateHostLvmSize(host, 121), "invalid --lvm-size 121: host host-1234abcd has no disk larger than 120 GB")
+
+	host.Instance = &infra.InstanceResource{CurrentState: &running}
+	assert.NoError(t, validateHostLvmSize(host, 80))
+	assert.EqualError(t, validateHostLvmSize(host, 20),
+		"the LVM of host host-1234abcd is 40 GB and its instance is running, the LVM of a provisioned host can only grow")
+
+	host.Instance = &infra.InstanceResource{ProvisioningStatus: stringPtr("Provisioning In Progress"), ProvisioningStatusIndicator: &inProgress}
+	assert.EqualError(t, validateHostLvmSize(host, 80),
+		"host host-1234abcd is being provisioned (Provisioning In Progress), wait for the provisioning to end before resizing its LVM")
+
+	host.Instance = &infra.InstanceResource{CurrentState: &running, DesiredState: &deleted}
+	assert.EqualError(t, validateHostLvmSize(host, 80), "the instance of host host-1234abcd is being deleted, its LVM cannot be resized")
+
+	// A host that has not reported its disks yet is not bounded
+	host.HostStorages = nil
+	host.Instance = nil
+	assert.NoError(t, validateHostLvmSize(host, 4096))
+}
+
+func TestJoinHostWorkloads(t *testing.T) {
+	newHosts := func() []infra.HostResource {
+		return []infra.HostResource{
+			{Name: "member", Instance: &infra.InstanceResource{InstanceID: stringPtr("instance-1")}},
+			{Name: "unassigned", Instance: &infra.InstanceResource{InstanceID: stringPtr("instance-2")}},
+			{Name: "no-instance"},
+		}
+	}
+	instances := []infra.InstanceResource{
+		{InstanceID: stringPtr("instance-2"), WorkloadMembers: &[]infra.WorkloadMember{}},
+		{InstanceID: stringPtr("instance-1"), WorkloadMembers: &[]infra.WorkloadMember{{Workload: &infra.WorkloadResource{Name: stringPtr("cluster-1")}}}},
+	}
+
+	hosts := joinHostWorkloads(newHosts(), instances, "")
+	require.Len(t, hosts, 3)
+	require.NotNil(t, hosts[0].Instance.WorkloadMembers)
+	assert.Equal(t, "cluster-1", *(*hosts[0].Instance.WorkloadMembers)[0].Workload.Name)
+
+	hosts = joinHostWorkloads(newHosts(), instances, "cluster-1")
+	require.Len(t, hosts, 1)
+	assert.Equal(t, "member", hosts[0].Name)
+
+	hosts = joinHostWorkloads(newHosts(), instances, "NotAssigned")
+	require.Len(t, hosts, 2)
+	assert.Equal(t, "unassigned", hosts[0].Name)
+	assert.Equal(t, "no-instance", hosts[1].Name)
+
+	assert.Empty(t, joinHostWorkloads(newHosts(), instances, "cluster-2"))
+}
+
+func (s *CLITestSuite) TestGetHostFieldSelection() {
+	out, err := s.getHost(project, "host-abcd1234", commandArgs{"template": "{{.Instance.ProvisioningStatus}}"})
+	s.NoError(err)
+	s.Equal("PROVISIONING_STATUS_COMPLETED\n", out)
+
+	out, err = s.getHost(project, "edge-host-001", commandArgs{"jsonpath": "{.resourceId} {.serialNumber} {.hostNics[*].macAddr}"})
+	s.NoError(err)
+	s.Equal("host-abc12345 1234567890 30:d0:42:d9:02:7c\n", out)
+
+	// A missing field of the JSON output selects nothing
+	out, err = s.getHost(project, "host-abcd1234", commandArgs{"jsonpath": ".noSuchField"})
+	s.NoError(err)
+	s.Equal("\n", out)
+
+	_, err = s.getHost(project, "host-abcd1234", commandArgs{"template": "{{.NoSuchField}}"})
+	s.ErrorContains(err, "invalid --template: ")
+	s.ErrorContains(err, "can't evaluate field NoSuchField")
+
+	_, err = s.getHost(project, "host-abcd1234", commandArgs{"jsonpath": "{.hostNics[9]}"})
+	s.EqualError(err, "invalid --jsonpath: index [9] is out of range, the array has 1 elements")
+
+	_, err = s.getHost(project, "host-abcd1234", commandArgs{"jsonpath": "{.resourceId}", "output-type": "json"})
+	s.EqualError(err, "--template and --jsonpath cannot be combined with --output-type json")
+
+	_, err = s.getHost(project, "host-abcd1234", commandArgs{"jsonpath": "{.resourceId}", "template": "{{.ResourceId}}"})
+	s.ErrorContains(err, "if any flags in the group [template jsonpath] are set none of the others can be")
+}
+
+func (s *CLITestSuite) TestDeleteHostWipe() {
+	// The mocked host never reports the power off, it is not deleted
+	_, err := s.deleteHost(project, "host-abc12345", commandArgs{"wipe": "true", "wipe-timeout": "1ms"})
+	s.EqualError(err, "host host-abc12345 did not meet condition powered off, last status: power POWER_STATE_ON, AMT AMT_STATE_PROVISIONED; host host-abc12345 was not deleted")
+	s.Equal(e.CodeDeadlineExceeded, e.CodeOf(err))
+
+	_, err = s.deleteHost(project, "host-abc12345", commandArgs{"wipe": "true", "wipe-timeout": "0s"})
+	s.EqualError(err, "--wipe-timeout must be a positive duration, got 0s")
+
+	_, err = s.deleteHost(project, "host-abcd1003", commandArgs{"wipe": "true"})
+	s.EqualError(err, "host host-abcd1003 does not have AMT provisioned, it cannot be wiped, delete it without --wipe and sanitize it out of band")
+}
+
+func TestWipeHost(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	client := infra.NewMockClientWithResponsesInterface(mctrl)
+	provisioned, powerOn := infra.AMTSTATEPROVISIONED, infra.POWERSTATEON
+	host := infra.HostResource{ResourceId: stringPtr("host-abcd1234"), Name: "edge-host", CurrentAmtState: &provisioned, CurrentPowerState: &powerOn}
+
+	// The host reports each requested state from the second poll after the request
+	var calls []string
+	requested, reported, polls := host, host, 0
+	client.EXPECT().HostServicePatchHostWithResponse(gomock.Any(), "itep", "host-abcd1234", gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ string, params *infra.HostServicePatchHostParams, body infra.HostServicePatchHostJSONRequestBody,
+			_ ...infra.RequestEditorFn) (*infra.HostServicePatchHostResponse, error) {
+			calls = append(calls, "patch "+*params.FieldMask)
+			if body.DesiredPowerState != nil {
+				requested.CurrentPowerState = body.DesiredPowerState
+			}
+			if body.DesiredAmtState != nil {
+				requested.CurrentAmtState = body.DesiredAmtState
+			}
+			polls = 0
+			return &infra.HostServicePatchHostResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}, JSON200: &host}, nil
+		}).AnyTimes()
+	client.EXPECT().HostServiceGetHostWithResponse(gomock.Any(), "itep", "host-abcd1234", gomock.Any()).DoAndReturn(
+		func(context.Context, string, string, ...infra.RequestEditorFn) (*infra.HostServiceGetHostResponse, error) {
+			calls = append(calls, "get")
+			if polls++; polls == 2 {
+				reported = requested
+			}
+			current := reported
+			return &infra.HostServiceGetHostResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}, JSON200: &current}, nil
+		}).AnyTimes()
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	require.NoError(t, wipeHost(context.Background(), cmd, client, "itep", host, now, time.Millisecond))
+	assert.Equal(t, []string{"patch desiredPowerState", "get", "get", "patch desiredAmtState", "get", "get", "patch note"}, calls)
+	assert.Equal(t, "host host-abcd1234 condition powered off met: power POWER_STATE_OFF, AMT AMT_STATE_PROVISIONED\n"+
+		"host host-abcd1234 condition AMT unprovisioned met: power POWER_STATE_OFF, AMT AMT_STATE_UNPROVISIONED\n", out.String())
+}
+
+func TestHostWipeNoteValue(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	note, err := hostWipeNoteValue(infra.HostResource{Note: stringPtr("decommissioned")}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "decommissioned; 2026-10-16 09:30 UTC: secure wipe before deletion: powered off, AMT unprovisioned", note)
+
+	// A note without room for the entry is replaced
+	note, err = hostWipeNoteValue(infra.HostResource{Note: stringPtr(strings.Repeat("a", hostNoteMaxLength))}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-10-16 09:30 UTC: secure wipe before deletion: powered off, AMT unprovisioned", note)
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+// Default number of hosts updated in parallel by set host --filter, --site and --region
+const defaultBulkHostConcurrency = 10
+
+// bulkHostActions are the changes applied to every host matched by a bulk set host
+type bulkHostActions struct {
+	power          *infra.PowerState
+	policy         *infra.PowerCommandPolicy
+	amtState       *infra.AmtState
+	amtMode        *infra.AmtControlMode
+	osUpdatePolicy string
+}
+
+// bulkHostResult is the outcome of the bulk actions on one host
+type bulkHostResult struct {
+	name       string
+	resourceID string
+	result     string
+	details    []string
+}
+
+// Applies the actions to the hosts, at most concurrency of them at a time; the results are in the order of the hosts
+func applyBulkHostActions(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string,
+	hosts []infra.HostResource, actions bulkHostActions, concurrency int) []bulkHostResult {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([]bulkHostResult, len(hosts))
+	var wg sync.WaitGroup
+	queue := make(chan int)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range queue {
+				results[index] = applyHostActions(ctx, client, projectName, hosts[index], actions)
+			}
+		}()
+	}
+	for index := range hosts {
+		queue <- index
+	}
+	close(queue)
+	wg.Wait()
+	return results
+}
+
+// Applies the actions to a host; a failed action does not prevent the others
+func applyHostActions(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string,
+	h infra.HostResource, actions bulkHostActions) bulkHostResult {
+	result := bulkHostResult{name: h.Name, resourceID: derefString(h.ResourceId)}
+	updated, failed := false, false
+	fail := func(action string, err error) {
+		result.details = append(result.details, fmt.Sprintf("%s failed: %v", action, err))
+		failed = true
+	}
+
+	if actions.power != nil || actions.policy != nil {
+		if h.CurrentAmtState == nil || *h.CurrentAmtState != infra.AMTSTATEPROVISIONED {
+			result.details = append(result.details, "power/policy skipped (AMT not provisioned)")
+		} else {
+			resp, err := client.HostServicePatchHostWithResponse(ctx, projectName, result.resourceID, &infra.HostServicePatchHostParams{}, infra.HostServicePatchHostJSONRequestBody{
+				PowerCommandPolicy: actions.policy,
+				DesiredPowerState:  actions.power,
+				Name:               h.Name,
+			}, auth.AddAuthHeader)
+			if err != nil {
+				fail("power/policy", processError(err))
+			} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting power state/policy"); err != nil {
+				fail("power/policy", err)
+			} else {
+				updated = true
+			}
+		}
+	}
+
+	if actions.amtState != nil || actions.amtMode != nil {
+		if actions.amtMode == nil && h.DesiredAmtState != nil && *h.DesiredAmtState == *actions.amtState {
+			result.details = append(result.details, "amt skipped (already "+string(*actions.amtState)+")")
+		} else {
+			resp, err := client.HostServicePatchHostWithResponse(ctx, projectName, result.resourceID, &infra.HostServicePatchHostParams{}, infra.HostServicePatchHostJSONRequestBody{
+				DesiredAmtState: actions.amtState,
+				AmtControlMode:  actions.amtMode,
+				Name:            h.Name,
+			}, auth.AddAuthHeader)
+			if err != nil {
+				fail("amt", processError(err))
+			} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting AMT state"); err != nil {
+				fail("amt", err)
+			} else {
+				updated = true
+			}
+		}
+	}
+
+	if actions.osUpdatePolicy != "" {
+		if h.Instance == nil || h.Instance.InstanceID == nil {
+			result.details = append(result.details, "osupdatepolicy skipped (no instance)")
+		} else {
+			resp, err := client.InstanceServicePatchInstanceWithResponse(ctx, projectName, *h.Instance.InstanceID, &infra.InstanceServicePatchInstanceParams{}, infra.InstanceServicePatchInstanceJSONRequestBody{
+				OsUpdatePolicyID: &actions.osUpdatePolicy,
+			}, auth.AddAuthHeader)
+			if err != nil {
+				fail("osupdatepolicy", processError(err))
+			} else if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting OS update policy"); err != nil {
+				fail("osupdatepolicy", err)
+			} else {
+				updated = true
+			}
+		}
+	}
+
+	switch {
+	case failed:
+		result.result = "failed"
+	case updated:
+		result.result = "updated"
+	default:
+		result.result = "skipped"
+	}
+	return result
+}
+
+// Prints a table of the results and their totals; an error is returned if any host failed
+func printBulkHostResults(cmd *cobra.Command, w io.Writer, results []bulkHostResult) error {
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "NAME\tRESOURCE ID\tRESULT\tDETAILS\n")
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.result]++
+		details := strings.Join(r.details, "; ")
+		if details == "" {
+			details = "-"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", r.name, r.resourceID, r.result, details)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Done: %d updated, %d skipped, %d failed\n", counts["updated"], counts["skipped"], counts["failed"])
+	if counts["failed"] > 0 {
+		return fmt.Errorf("failed to update %d of %d hosts", counts["failed"], len(results))
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const hostCacheDirName = "cache"
+
+// Flags of list host that change which hosts are listed; a snapshot is only reused for the same values
+var hostSnapshotFlags = []string{"filter", "site", "region", "workload", "status", "os-profile", "serial", "uuid", "has-update", "secure", "order-by", "page-size", "offset", "limit"}
+
+// hostSnapshot is the result of the last host listing, stored in the CLI config directory
+type hostSnapshot struct {
+	Taken   time.Time            `json:"taken"`
+	OrderBy *string              `json:"orderBy,omitempty"`
+	Hosts   []infra.HostResource `json:"hosts"`
+}
+
+type hostFetchResult struct {
+	hosts   []infra.HostResource
+	orderBy *string
+	err     error
+}
+
+// Shows the last snapshot right away while the hosts are fetched, then replaces it with the fresh list
+func runCachedListHost(cmd *cobra.Command, outputFilter string, verbose bool) error {
+	if outputType, _ := cmd.Flags().GetString("output-type"); outputType != "table" {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--cached is only supported with table output"))
+	}
+
+	done := make(chan hostFetchResult, 1)
+	go func() {
+		hosts, orderBy, err := fetchHosts(cmd)
+		done <- hostFetchResult{hosts: hosts, orderBy: orderBy, err: err}
+	}()
+
+	out := cmd.OutOrStdout()
+	shownLines := 0
+	snapshot, err := loadHostSnapshot(cmd)
+	if err != nil {
+		log.Debugf("Unable to read cached hosts: %v", err)
+	}
+	if snapshot != nil {
+		table, err := renderHosts(cmd, snapshot.Hosts, snapshot.OrderBy, outputFilter, verbose)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Showing hosts cached %s ago, refreshing...\n", snapshotAge(snapshot))
+		if _, err := out.Write(table); err != nil {
+			return err
+		}
+		shownLines = bytes.Count(table, []byte("\n"))
+	}
+
+	result := <-done
+	if result.err != nil {
+		if snapshot != nil {
+			return fmt.Errorf("failed to refresh hosts cached %s ago: %w", snapshotAge(snapshot), result.err)
+		}
+		return result.err
+	}
+	saveHostSnapshot(cmd, result.hosts, result.orderBy)
+
+	table, err := renderHosts(cmd, result.hosts, result.orderBy, outputFilter, verbose)
+	if err != nil {
+		return err
+	}
+	if shownLines > 0 {
+		if isTerminal(out) {
+			// Move the cursor back to the start of the cached table and clear it
+			fmt.Fprintf(out, "\033[%dA\033[J", shownLines)
+		} else {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Refreshed hosts:")
+		}
+	}
+	_, err = out.Write(table)
+	return err
+}
+
+func renderHosts(cmd *cobra.Command, hosts []infra.HostResource, orderBy *string, outputFilter string, verbose bool) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := newOutputWriter(cmd, &buf)
+	if err := printHosts(cmd, writer, &hosts, orderBy, &outputFilter, verbose); err != nil {
+		return nil, err
+	}
+	if err := writer.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func snapshotAge(snapshot *hostSnapshot) time.Duration {
+	return time.Since(snapshot.Taken).Round(time.Second)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// The snapshot file name is derived from the orchestrator, the project and the listing flags
+func hostSnapshotPath(cmd *cobra.Command) (string, error) {
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return "", errors.New("no config directory")
+	}
+
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	projectName, _ := cmd.Flags().GetString(project)
+	key := []string{endpoint, projectName}
+	for _, name := range hostSnapshotFlags {
+		if flag := cmd.Flags().Lookup(name); flag != nil {
+			key = append(key, name+"="+flag.Value.String())
+		}
+	}
+	sum := sha256.Sum256([]byte(strings.Join(key, "\n")))
+	return filepath.Join(filepath.Dir(configFile), hostCacheDirName, "hosts-"+hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// Returns the last snapshot for the command's flags, or nil if there is none
+func loadHostSnapshot(cmd *cobra.Command) (*hostSnapshot, error) {
+	path, err := hostSnapshotPath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snapshot := &hostSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Stores the hosts for the next cached listing; failing to do so does not fail the listing
+func saveHostSnapshot(cmd *cobra.Command, hosts []infra.HostResource, orderBy *string) {
+	if err := writeHostSnapshot(cmd, &hostSnapshot{Taken: time.Now(), OrderBy: orderBy, Hosts: hosts}); err != nil {
+		log.Debugf("Unable to cache hosts: %v", err)
+	}
+}
+
+func writeHostSnapshot(cmd *cobra.Command, snapshot *hostSnapshot) error {
+	path, err := hostSnapshotPath(cmd)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// Write to a temporary file first so a concurrent listing never reads a partial snapshot
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
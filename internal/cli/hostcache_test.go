@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func (s *CLITestSuite) TestListHostCached() {
+	configFile := viper.ConfigFileUsed()
+	defer viper.SetConfigFile(configFile)
+	cacheDir := s.T().TempDir()
+	viper.SetConfigFile(filepath.Join(cacheDir, "orch-cli.yaml"))
+
+	// Nothing cached yet: the hosts are listed once
+	out, err := s.listHost(project, commandArgs{"cached": ""})
+	s.NoError(err)
+	s.NotContains(out, "Showing hosts cached")
+	s.Contains(out, "edge-host-001")
+	s.Equal(1, strings.Count(out, "edge-host-001"))
+
+	entries, err := os.ReadDir(filepath.Join(cacheDir, hostCacheDirName))
+	s.NoError(err)
+	s.Len(entries, 1)
+	snapshotPath := filepath.Join(cacheDir, hostCacheDirName, entries[0].Name())
+
+	// Replace the snapshot by an older one to see it being shown first and then replaced
+	s.NoError(os.WriteFile(snapshotPath, []byte(`{"taken":"`+time.Now().Add(-5*time.Minute).Format(time.RFC3339Nano)+`","hosts":[{"name":"stale-host","resourceId":"host-0000abcd"}]}`), 0600))
+	out, err = s.listHost(project, commandArgs{"cached": ""})
+	s.NoError(err)
+	s.Contains(out, "Showing hosts cached 5m0s ago, refreshing...")
+	s.Contains(out, "Refreshed hosts:")
+	s.Less(strings.Index(out, "stale-host"), strings.Index(out, "edge-host-001"))
+
+	snapshot := &hostSnapshot{}
+	data, err := os.ReadFile(snapshotPath)
+	s.NoError(err)
+	s.NoError(json.Unmarshal(data, snapshot))
+	s.WithinDuration(time.Now(), snapshot.Taken, time.Minute)
+	s.NotEmpty(snapshot.Hosts)
+	for _, host := range snapshot.Hosts {
+		s.NotEqual("stale-host", host.Name)
+	}
+
+	// Snapshots are kept per set of listing flags
+	out, err = s.listHost(project, commandArgs{"cached": "", "filter": "provisioned"})
+	s.NoError(err)
+	s.NotContains(out, "Showing hosts cached")
+
+	_, err = s.listHost(project, commandArgs{"cached": "", "output-type": "json"})
+	s.EqualError(err, "--cached is only supported with table output")
+}
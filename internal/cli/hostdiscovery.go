@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const fromDiscoveryFlag = "from-discovery"
+
+// Number of times an invalid choice is asked again before giving up
+const maxPromptAttempts = 3
+
+func isDiscoveryManifest(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".json"
+}
+
+// Reports whether the input of the command is a terminal, where the user can be asked for missing values
+func isInteractiveInput(cmd *cobra.Command) bool {
+	f, ok := cmd.InOrStdin().(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// Prints the hosts of a discovery manifest about to be registered
+func printPrebootHosts(cmd *cobra.Command, w io.Writer, path string, hosts []files.PrebootHost) error {
+	fmt.Fprintf(w, "%d hosts discovered in %s:\n", len(hosts), path)
+	tw := newOutputWriter(cmd, w)
+	fmt.Fprintln(tw, "Serial\tUUID\tMACs\tDisks")
+	for _, host := range hosts {
+		macs := make([]string, 0, len(host.Nics))
+		for _, nic := range host.Nics {
+			macs = append(macs, nic.MAC)
+		}
+		disks := make([]string, 0, len(host.Disks))
+		for _, disk := range host.Disks {
+			disks = append(disks, fmt.Sprintf("%s %d GB", disk.Name, disk.SizeBytes/bytesPerGB))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", valueOrNone(&host.SerialNumber), valueOrNone(&host.UUID),
+			strings.Join(macs, ", "), strings.Join(disks, ", "))
+	}
+	return tw.Flush()
+}
+
+// Asks the user to pick one of the options by number and returns its index
+func promptChoice(in *bufio.Reader, out io.Writer, what string, options []string) (int, error) {
+	if len(options) == 0 {
+		return 0, e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("no %s to select in the project", what))
+	}
+	fmt.Fprintf(out, "Available %ss:\n", what)
+	for i, option := range options {
+		fmt.Fprintf(out, "  %d) %s\n", i+1, option)
+	}
+	for range maxPromptAttempts {
+		fmt.Fprintf(out, "Select the %s of the hosts [1-%d]: ", what, len(options))
+		line, err := in.ReadString('\n')
+		if choice, convErr := strconv.Atoi(strings.TrimSpace(line)); convErr == nil && choice >= 1 && choice <= len(options) {
+			return choice - 1, nil
+		}
+		if err != nil {
+			return 0, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("no %s selected", what))
+		}
+		fmt.Fprintf(out, "Invalid choice %q\n", strings.TrimSpace(line))
+	}
+	return 0, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("no %s selected", what))
+}
+
+// Asks for the site and the OS profile of the hosts of a discovery manifest not given by --site and --os-profile,
+// which apply to the whole batch
+func selectDiscoveryBatchAttributes(cmd *cobra.Command, globalAttr *types.HostRecord) error {
+	if !isFeatureEnabled(ProvisioningFeature) || (globalAttr.Site != "" && globalAttr.OSProfile != "") {
+		return nil
+	}
+	if !isInteractiveInput(cmd) {
+		return e.WithCode(e.CodeInvalidArgument,
+			fmt.Errorf("--site and --os-profile are required with --%s when the input is not a terminal", fromDiscoveryFlag))
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	in := bufio.NewReader(cmd.InOrStdin())
+	out := cmd.ErrOrStderr()
+
+	if globalAttr.Site == "" {
+		resp, err := hostClient.SiteServiceListSitesWithResponse(ctx, projectName, "",
+			&infra.SiteServiceListSitesParams{}, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while listing sites"); err != nil {
+			return err
+		}
+		sites := resp.JSON200.Sites
+		options := make([]string, 0, len(sites))
+		for _, site := range sites {
+			options = append(options, fmt.Sprintf("%s (%s)", derefString(site.Name), derefString(site.ResourceId)))
+		}
+		choice, err := promptChoice(in, out, "site", options)
+		if err != nil {
+			return err
+		}
+		globalAttr.Site = derefString(sites[choice].ResourceId)
+	}
+
+	if globalAttr.OSProfile == "" {
+		profiles, err := listAllOSProfiles(ctx, hostClient, projectName)
+		if err != nil {
+			return err
+		}
+		options := make([]string, 0, len(profiles))
+		for _, profile := range profiles {
+			options = append(options, fmt.Sprintf("%s (%s)", derefString(profile.Name), derefString(profile.ResourceId)))
+		}
+		choice, err := promptChoice(in, out, "OS profile", options)
+		if err != nil {
+			return err
+		}
+		globalAttr.OSProfile = derefString(profiles[choice].ResourceId)
+	}
+	return nil
+}
+
+// Reads the discovery manifest to import, prints its hosts to w and completes the site and OS profile of the batch
+func prepareDiscoveryImport(cmd *cobra.Command, w io.Writer, path string, globalAttr *types.HostRecord) error {
+	if !isDiscoveryManifest(path) {
+		return errors.New("the discovery manifest must be a .json file")
+	}
+	hosts, err := files.ReadDiscoveryManifest(path)
+	if err != nil {
+		return err
+	}
+	if err := printPrebootHosts(cmd, w, path, hosts); err != nil {
+		return err
+	}
+	return selectDiscoveryBatchAttributes(cmd, globalAttr)
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestCreateHostFromDiscovery() {
+	out, err := s.createHost(project, commandArgs{
+		"from-discovery": "./testdata/discovery.json",
+		"site":           "site-7ceae560",
+		"os-profile":     "Edge Microvisor Toolkit 3.0.20250504",
+		"dry-run":        "true",
+	})
+	s.NoError(err)
+	s.Contains(out, "1 hosts discovered in ./testdata/discovery.json:\n")
+	s.Regexp(`SN123456789\s*\|550e8400-e29b-41d4-a716-446655440000\s*\|00:1a:2b:3c:4d:5e, 00:1a:2b:3c:4d:5f\s*\|nvme0n1 512 GB`, out)
+
+	out, err = s.createHost(project, commandArgs{
+		"from-discovery": "./testdata/discovery.json",
+		"site":           "site-7ceae560",
+		"os-profile":     "Edge Microvisor Toolkit 3.0.20250504",
+	})
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
+
+	// The site and the OS profile are asked for on a terminal only
+	_, err = s.createHost(project, commandArgs{"from-discovery": "./testdata/discovery.json"})
+	s.EqualError(err, "--site and --os-profile are required with --from-discovery when the input is not a terminal")
+
+	_, err = s.createHost(project, commandArgs{
+		"from-discovery":  "./testdata/discovery.json",
+		"import-from-csv": "./testdata/mock.csv",
+	})
+	s.EqualError(err, "cannot use --from-discovery with a host name or --import-from-csv")
+
+	_, err = s.createHost(project, commandArgs{"from-discovery": "./testdata/mock.csv", "site": "site", "os-profile": "os"})
+	s.EqualError(err, "the discovery manifest must be a .json file")
+}
+
+func TestPromptChoice(t *testing.T) {
+	var out bytes.Buffer
+	choice, err := promptChoice(bufio.NewReader(strings.NewReader("3\n0\n2\n")), &out, "site", []string{"store-041 (site-1)", "store-042 (site-2)"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, choice)
+	assert.Equal(t, "Available sites:\n  1) store-041 (site-1)\n  2) store-042 (site-2)\n"+
+		"Select the site of the hosts [1-2]: Invalid choice \"3\"\n"+
+		"Select the site of the hosts [1-2]: Invalid choice \"0\"\n"+
+		"Select the site of the hosts [1-2]: ", out.String())
+
+	_, err = promptChoice(bufio.NewReader(strings.NewReader("")), &out, "OS profile", []string{"ubuntu (os-1)"})
+	assert.EqualError(t, err, "no OS profile selected")
+
+	_, err = promptChoice(bufio.NewReader(strings.NewReader("1\n")), &out, "site", nil)
+	assert.EqualError(t, err, "no site to select in the project")
+}
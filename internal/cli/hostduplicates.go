@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+// Number of records whose serial numbers and UUIDs are looked up by a single list hosts call, keeping the filter
+// short enough for the query string
+const duplicateLookupBatchSize = 25
+
+// Returns the filter matching the hosts registered with the serial number or the UUID of one of the records
+func registeredHostsFilter(records []types.HostRecord) string {
+	var conditions []string
+	for _, record := range records {
+		if record.Serial != "" {
+			conditions = append(conditions, fmt.Sprintf("serialNumber='%s'", record.Serial))
+		}
+		if record.UUID != "" {
+			conditions = append(conditions, fmt.Sprintf("uuid='%s'", record.UUID))
+		}
+	}
+	return strings.Join(conditions, " OR ")
+}
+
+// Lists the hosts registered with the serial number or the UUID of one of the records, a few records at a time
+func findRegisteredHosts(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, records []types.HostRecord) ([]infra.HostResource, error) {
+	var registered []infra.HostResource
+	seen := map[string]bool{}
+	for start := 0; start < len(records); start += duplicateLookupBatchSize {
+		end := min(start+duplicateLookupBatchSize, len(records))
+		filter := registeredHostsFilter(records[start:end])
+		if filter == "" {
+			continue
+		}
+		hosts, err := listHostsMatching(ctx, hostClient, projectName, &filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			if id := derefString(host.ResourceId); !seen[id] {
+				seen[id] = true
+				registered = append(registered, host)
+			}
+		}
+	}
+	return registered, nil
+}
+
+// Returns the records matching a registered host, with the match in their Error column. A host registered with
+// the serial number and the UUID of a record is reused by the import; a host registered with only one of them
+// makes the registration of the record fail.
+func markDuplicateHosts(records []types.HostRecord, registered []infra.HostResource) []types.HostRecord {
+	var duplicates []types.HostRecord
+	for _, record := range records {
+		var messages []string
+		for _, host := range registered {
+			serial, uuid := derefString(host.SerialNumber), derefString(host.Uuid)
+			sameSerial := record.Serial != "" && serial == record.Serial
+			sameUUID := record.UUID != "" && strings.EqualFold(uuid, record.UUID)
+			hostRef := fmt.Sprintf("%s (%s)", derefString(host.ResourceId), host.Name)
+			switch {
+			case sameSerial && (sameUUID || record.UUID == ""), sameUUID && record.Serial == "":
+				messages = append(messages, fmt.Sprintf("Warning: already registered as host %s, the host will be reused", hostRef))
+			case sameSerial:
+				messages = append(messages, fmt.Sprintf("Duplicate: serial number %s is registered to host %s with UUID %s", record.Serial, hostRef, uuid))
+			case sameUUID:
+				messages = append(messages, fmt.Sprintf("Duplicate: UUID %s is registered to host %s with serial number %s", record.UUID, hostRef, serial))
+			}
+		}
+		if len(messages) > 0 {
+			record.Error = strings.Join(messages, "; ")
+			duplicates = append(duplicates, record)
+		}
+	}
+	return duplicates
+}
+
+// Looks up the serial numbers and UUIDs of the records among the registered hosts and returns the records
+// matching one of them, marked in their Error column
+func checkDuplicateHosts(cmd *cobra.Command, records []types.HostRecord) ([]types.HostRecord, error) {
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := findRegisteredHosts(ctx, hostClient, projectName, records)
+	if err != nil {
+		return nil, err
+	}
+	return markDuplicateHosts(records, registered), nil
+}
+
+// Prints the records matching a registered host to the dry-run report
+func printDuplicateHosts(w io.Writer, duplicates []types.HostRecord) {
+	if len(duplicates) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "%d hosts are already registered or share a serial number or UUID with a registered host:\n", len(duplicates))
+	for _, d := range duplicates {
+		fmt.Fprintf(w, "  Serial %q, UUID %q: %s\n", d.Serial, d.UUID, d.Error)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestCreateHostDryRunDuplicates() {
+	out, err := s.createHost(project, map[string]string{
+		"import-from-csv": "./testdata/mock.csv",
+		"dry-run":         "true",
+	})
+	s.NoError(err)
+	s.Contains(out, "1 hosts are already registered or share a serial number or UUID with a registered host:\n"+
+		`  Serial "SN123456789", UUID "550e8400-e29b-41d4-a716-446655440000": Duplicate: UUID 550e8400-e29b-41d4-a716-446655440000 `+
+		"is registered to host host-abc12345 (edge-host-001) with serial number 1234567890\n")
+}
+
+func TestRegisteredHostsFilter(t *testing.T) {
+	records := []types.HostRecord{
+		{Serial: "SN0001", UUID: "4c4c4544-2046-5310-8052-cac04f515233"},
+		{Serial: "SN0002"},
+		{UUID: "550e8400-e29b-41d4-a716-446655440000"},
+	}
+	assert.Equal(t, "serialNumber='SN0001' OR uuid='4c4c4544-2046-5310-8052-cac04f515233' OR serialNumber='SN0002' OR uuid='550e8400-e29b-41d4-a716-446655440000'",
+		registeredHostsFilter(records))
+	assert.Empty(t, registeredHostsFilter(nil))
+}
+
+func TestMarkDuplicateHosts(t *testing.T) {
+	registered := []infra.HostResource{
+		{ResourceId: stringPtr("host-abcd1003"), Name: "edge-host-003", SerialNumber: stringPtr("2500JF3"), Uuid: stringPtr("4c4c4544-2046-5310-8052-cac04f515233")},
+		{ResourceId: stringPtr("host-abc12345"), Name: "edge-host-001", SerialNumber: stringPtr("1234567890"), Uuid: stringPtr("550e8400-e29b-41d4-a716-446655440000")},
+	}
+	records := []types.HostRecord{
+		{Serial: "2500JF3", UUID: strings.ToUpper("4c4c4544-2046-5310-8052-cac04f515233")},
+		{Serial: "1234567890", UUID: "11111111-2222-3333-4444-555555555555"},
+		{Serial: "NEW0001", UUID: "550e8400-e29b-41d4-a716-446655440000"},
+		{Serial: "2500JF3"},
+		{Serial: "NEW0002", UUID: "22222222-2222-3333-4444-555555555555"},
+	}
+
+	duplicates := markDuplicateHosts(records, registered)
+	errors := make([]string, 0, len(duplicates))
+	for _, d := range duplicates {
+		errors = append(errors, d.Error)
+	}
+	assert.Equal(t, []string{
+		"Warning: already registered as host host-abcd1003 (edge-host-003), the host will be reused",
+		"Duplicate: serial number 1234567890 is registered to host host-abc12345 (edge-host-001) with UUID 550e8400-e29b-41d4-a716-446655440000",
+		"Duplicate: UUID 550e8400-e29b-41d4-a716-446655440000 is registered to host host-abc12345 (edge-host-001) with serial number 1234567890",
+		"Warning: already registered as host host-abcd1003 (edge-host-003), the host will be reused",
+	}, errors)
+	assert.Empty(t, records[0].Error, "the records are not changed")
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	hostGroupsKey = "host-groups"
+	hostGroupFlag = "group"
+)
+
+const createHostGroupExamples = `# Name the hosts of a site
+orch-cli group create edge-lab --filter "site.resourceId='site-1234abcd'"
+
+# Name a static set of hosts
+orch-cli group create canary --hosts host-1234abcd,host-5678abcd
+
+# Replace the hosts of an existing group
+orch-cli group create canary --hosts host-1234abcd --overwrite
+
+# Use a group wherever the host commands take --filter
+orch-cli list host --group edge-lab --project some-project
+orch-cli set host --group canary --power on --project some-project
+orch-cli summary host --group edge-lab --project some-project`
+
+const listHostGroupsExamples = `# List the saved host groups
+orch-cli group list`
+
+const deleteHostGroupExamples = `# Delete a saved host group
+orch-cli group delete edge-lab`
+
+var hostGroupNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// hostGroup is a named set of hosts, selected either by a host filter or by the resource IDs of the hosts.
+// Groups are kept as a list in the viper config, like the contexts, so that they are available to every
+// command and project.
+type hostGroup struct {
+	Name   string   `mapstructure:"name"`
+	Filter string   `mapstructure:"filter"`
+	Hosts  []string `mapstructure:"hosts"`
+}
+
+func (g hostGroup) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":   g.Name,
+		"filter": g.Filter,
+		"hosts":  g.Hosts,
+	}
+}
+
+// hostFilter is the filter of the group; a static set of hosts selects them by resource ID
+func (g hostGroup) hostFilter() string {
+	if len(g.Hosts) == 0 {
+		return g.Filter
+	}
+	terms := make([]string, 0, len(g.Hosts))
+	for _, id := range g.Hosts {
+		terms = append(terms, fmt.Sprintf("resourceId=%q", id))
+	}
+	return strings.Join(terms, " OR ")
+}
+
+func getGroupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group",
+		Short: "Manage named groups of hosts",
+		Long: "Saves named sets of hosts, selected by a filter or by resource ID, in the configuration. The host " +
+			"commands taking --filter accept --group <name> instead.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getCreateHostGroupCommand(),
+		getListHostGroupsCommand(),
+		getDeleteHostGroupCommand(),
+	)
+	return cmd
+}
+
+func getCreateHostGroupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create <name> [flags]",
+		Short:   "Save a named group of hosts",
+		Example: createHostGroupExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCreateHostGroupCommand,
+	}
+	cmd.Flags().String("filter", "", "Filter selecting the hosts of the group, as given to list host --filter")
+	cmd.Flags().StringSlice("hosts", []string{}, "Resource IDs of the hosts of the group")
+	cmd.Flags().Bool("overwrite", false, "Replace the group if it already exists")
+	return cmd
+}
+
+func getListHostGroupsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the saved host groups",
+		Example: listHostGroupsExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runListHostGroupsCommand,
+	}
+	return cmd
+}
+
+func getDeleteHostGroupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <name>",
+		Short:             "Delete a saved host group",
+		Example:           deleteHostGroupExamples,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeHostGroupNames,
+		RunE:              runDeleteHostGroupCommand,
+	}
+	return cmd
+}
+
+// Loads the saved host groups from the configuration
+func loadHostGroups() ([]hostGroup, error) {
+	groups := []hostGroup{}
+	if err := viper.UnmarshalKey(hostGroupsKey, &groups); err != nil {
+		return nil, fmt.Errorf("unable to read host groups from configuration: %w", err)
+	}
+	return groups, nil
+}
+
+// Stores the host groups in the configuration and writes it out
+func saveHostGroups(groups []hostGroup) error {
+	list := make([]interface{}, 0, len(groups))
+	for _, g := range groups {
+		list = append(list, g.toMap())
+	}
+	viper.Set(hostGroupsKey, list)
+	return viper.WriteConfig()
+}
+
+func findHostGroup(groups []hostGroup, name string) int {
+	for i, g := range groups {
+		if g.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func runCreateHostGroupCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	filter, _ := cmd.Flags().GetString("filter")
+	hosts, _ := cmd.Flags().GetStringSlice("hosts")
+	overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+	if !hostGroupNamePattern.MatchString(name) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid host group name %q: must start with a lowercase letter or digit and contain only lowercase letters, digits, '-' or '_'", name))
+	}
+	filter = strings.TrimSpace(filter)
+	switch {
+	case filter == "" && len(hosts) == 0:
+		return e.WithCode(e.CodeInvalidArgument, errors.New("give the hosts of the group with --filter or --hosts"))
+	case filter != "" && len(hosts) > 0:
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--filter and --hosts cannot be combined"))
+	}
+	for _, id := range hosts {
+		if !isHostResourceID(id) {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid host resource ID %q in --hosts", id))
+		}
+	}
+
+	groups, err := loadHostGroups()
+	if err != nil {
+		return err
+	}
+	group := hostGroup{Name: name, Filter: filter, Hosts: hosts}
+	if idx := findHostGroup(groups, name); idx < 0 {
+		groups = append(groups, group)
+	} else if overwrite {
+		groups[idx] = group
+	} else {
+		return e.WithCode(e.CodeAlreadyExists, fmt.Errorf("host group %q already exists, use --overwrite to replace it", name))
+	}
+	if err := saveHostGroups(groups); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Host group %q saved.\n", name)
+	return nil
+}
+
+func runListHostGroupsCommand(cmd *cobra.Command, _ []string) error {
+	writer, _ := getOutputContext(cmd)
+
+	groups, err := loadHostGroups()
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No host groups saved")
+		return nil
+	}
+
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Filter", "Hosts")
+	for _, g := range groups {
+		hosts := strings.Join(g.Hosts, ", ")
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", g.Name, valueOrNone(&g.Filter), valueOrNone(&hosts))
+	}
+	return writer.Flush()
+}
+
+func runDeleteHostGroupCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	groups, err := loadHostGroups()
+	if err != nil {
+		return err
+	}
+	idx := findHostGroup(groups, name)
+	if idx < 0 {
+		return e.WithCode(e.CodeNotFound, fmt.Errorf("host group %q not found", name))
+	}
+	groups = append(groups[:idx], groups[idx+1:]...)
+	if err := saveHostGroups(groups); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Host group %q deleted.\n", name)
+	return nil
+}
+
+// Completes host group names from the configuration
+func completeHostGroupNames(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	groups, err := loadHostGroups()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var matches []string
+	for _, g := range groups {
+		if strings.HasPrefix(g.Name, toComplete) {
+			matches = append(matches, g.Name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Adds --group to the host commands taking --filter; the group is expanded to its filter when the command runs
+func applyHostGroups(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil && cmd.Name() == "host" && hasFilterFlag(cmd) {
+		cmd.Flags().String(hostGroupFlag, "", "Select the hosts of a group saved with 'orch-cli group create' instead of --filter")
+		_ = cmd.RegisterFlagCompletionFunc(hostGroupFlag, completeHostGroupNames)
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			if err := expandHostGroup(c); err != nil {
+				return err
+			}
+			return run(c, args)
+		}
+	}
+	for _, child := range cmd.Commands() {
+		applyHostGroups(child)
+	}
+}
+
+func hasFilterFlag(cmd *cobra.Command) bool {
+	return cmd.Flags().Lookup("filter") != nil || cmd.PersistentFlags().Lookup("filter") != nil
+}
+
+// Sets --filter to the filter of the group given by --group
+func expandHostGroup(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString(hostGroupFlag)
+	if name == "" {
+		return nil
+	}
+	if cmd.Flags().Changed("filter") {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--group and --filter cannot be combined"))
+	}
+	groups, err := loadHostGroups()
+	if err != nil {
+		return err
+	}
+	idx := findHostGroup(groups, name)
+	if idx < 0 {
+		return e.WithCode(e.CodeNotFound, fmt.Errorf("host group %q not found, see 'orch-cli group list'", name))
+	}
+	return cmd.Flags().Set("filter", groups[idx].hostFilter())
+}
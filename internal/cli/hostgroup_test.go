@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestHostGroups() {
+	defer func() {
+		viper.Set(hostGroupsKey, []interface{}{})
+		s.NoError(viper.WriteConfig())
+	}()
+
+	out, err := s.runCommand("group list")
+	s.NoError(err)
+	s.Equal("No host groups saved\n", out)
+
+	out, err = s.runCommand(`group create edge-lab --filter "site.resourceId='site-7ceae560'"`)
+	s.NoError(err)
+	s.Equal("Host group \"edge-lab\" saved.\n", out)
+	_, err = s.runCommand("group create canary --hosts host-1234abcd,host-5678abcd")
+	s.NoError(err)
+
+	_, err = s.runCommand("group create canary --hosts host-1234abcd")
+	s.EqualError(err, `host group "canary" already exists, use --overwrite to replace it`)
+	_, err = s.runCommand("group create canary --hosts host-abcd1234 --overwrite")
+	s.NoError(err)
+
+	out, err = s.runCommand("group list")
+	s.NoError(err)
+	s.Equal(`Name       |Filter                            |Hosts
+edge-lab   |site.resourceId='site-7ceae560'   |<none>
+canary     |<none>                            |host-abcd1234
+`, out)
+
+	_, err = s.runCommand("group create Lab --filter provisioned")
+	s.ErrorContains(err, `invalid host group name "Lab"`)
+	_, err = s.runCommand("group create lab")
+	s.EqualError(err, "give the hosts of the group with --filter or --hosts")
+	_, err = s.runCommand("group create lab --filter provisioned --hosts host-abcd1234")
+	s.EqualError(err, "--filter and --hosts cannot be combined")
+	_, err = s.runCommand("group create lab --hosts myhost")
+	s.EqualError(err, `invalid host resource ID "myhost" in --hosts`)
+
+	out, err = s.runCommand("list host --group canary --project " + project)
+	s.NoError(err)
+	s.Contains(out, "edge-host-001")
+	_, err = s.runCommand("list host --group canary --filter provisioned --project " + project)
+	s.EqualError(err, "--group and --filter cannot be combined")
+	_, err = s.runCommand("list host --group unknown --project " + project)
+	s.EqualError(err, `host group "unknown" not found, see 'orch-cli group list'`)
+	_, err = s.runCommand("summary host --group edge-lab --project " + project)
+	s.NoError(err)
+
+	out, err = s.runCommand("group delete canary")
+	s.NoError(err)
+	s.Equal("Host group \"canary\" deleted.\n", out)
+	_, err = s.runCommand("group delete canary")
+	s.EqualError(err, `host group "canary" not found`)
+}
+
+func TestHostGroupFilter(t *testing.T) {
+	assert.Equal(t, "provisioned", hostGroup{Name: "g", Filter: "provisioned"}.hostFilter())
+	assert.Equal(t, `resourceId="host-1234abcd" OR resourceId="host-5678abcd"`,
+		hostGroup{Name: "g", Hosts: []string{"host-1234abcd", "host-5678abcd"}}.hostFilter())
+}
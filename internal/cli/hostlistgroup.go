@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/filter"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sortByFlag  = "sort-by"
+	groupByFlag = "group-by"
+)
+
+var (
+	hostListSortKeys  = []string{"name", "status", "site", "os"}
+	hostListGroupings = []string{"site"}
+)
+
+// Adds --sort-by and --group-by, which order and group the listed hosts client-side, to a command
+func addHostListLayoutFlags(cmd *cobra.Command) {
+	cmd.Flags().String(sortByFlag, "", fmt.Sprintf("Sort the hosts client-side by one of: %s", strings.Join(hostListSortKeys, ", ")))
+	cmd.Flags().String(groupByFlag, "", fmt.Sprintf("Group the hosts of the table by one of: %s, each group followed by its subtotal", strings.Join(hostListGroupings, ", ")))
+}
+
+// Reads --sort-by and --group-by; the grouping is of the table output only, and the sort replaces --order-by
+func getHostListLayout(cmd *cobra.Command) (string, string, error) {
+	sortBy, _ := cmd.Flags().GetString(sortByFlag)
+	groupBy, _ := cmd.Flags().GetString(groupByFlag)
+	sortBy, groupBy = strings.ToLower(sortBy), strings.ToLower(groupBy)
+
+	if sortBy != "" && !slices.Contains(hostListSortKeys, sortBy) {
+		return "", "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s %q, must be one of: %s", sortByFlag, sortBy, strings.Join(hostListSortKeys, ", ")))
+	}
+	if groupBy != "" && !slices.Contains(hostListGroupings, groupBy) {
+		return "", "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s %q, must be one of: %s", groupByFlag, groupBy, strings.Join(hostListGroupings, ", ")))
+	}
+	if orderBy, _ := cmd.Flags().GetString("order-by"); sortBy != "" && orderBy != "" {
+		return "", "", e.WithCode(e.CodeInvalidArgument, errors.New("--sort-by and --order-by cannot be combined"))
+	}
+	if outputType, _ := cmd.Flags().GetString("output-type"); groupBy != "" && outputType != "" && outputType != "table" {
+		return "", "", e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s is only supported with table output", groupByFlag))
+	}
+	return sortBy, groupBy, nil
+}
+
+// Sorts hosts by a --sort-by key ignoring case, hosts of the same key by name
+func sortHosts(hosts []infra.HostResource, sortBy string) {
+	sort.SliceStable(hosts, func(i, j int) bool {
+		ki, kj := strings.ToLower(hostSortKey(hosts[i], sortBy)), strings.ToLower(hostSortKey(hosts[j], sortBy))
+		if ki != kj {
+			return ki < kj
+		}
+		return strings.ToLower(hosts[i].Name) < strings.ToLower(hosts[j].Name)
+	})
+}
+
+func hostSortKey(h infra.HostResource, sortBy string) string {
+	switch sortBy {
+	case "status":
+		return outfmt.HostStatus(h)
+	case "site":
+		return hostSummaryGroupName(h, "site")
+	case "os":
+		return hostOperatingSystem(h)
+	default:
+		return h.Name
+	}
+}
+
+// Prints the rows of a host table per group, each group headed by its name and followed by its number of hosts,
+// then the total. The output filter is applied before grouping so that the subtotals count the rows shown.
+func printHostGroups(writer io.Writer, result CommandResult, rows []outfmt.HostRow, groupBy string) error {
+	if result.Filter != "" {
+		f, err := filter.Parse(result.Filter)
+		if err != nil {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("unable to parse specified output filter '%s': %w", result.Filter, err))
+		}
+		data, err := f.Normalize(rows).Process(rows)
+		if err != nil {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid output-filter: %w", err))
+		}
+		matched, _ := data.([]interface{})
+		rows = make([]outfmt.HostRow, 0, len(matched))
+		for _, row := range matched {
+			rows = append(rows, row.(outfmt.HostRow))
+		}
+		result.Filter = ""
+	}
+
+	groups := map[string][]outfmt.HostRow{}
+	names := make([]string, 0)
+	for _, row := range rows {
+		name := hostSummaryGroupName(row.Source().(infra.HostResource), groupBy)
+		if _, ok := groups[name]; !ok {
+			names = append(names, name)
+		}
+		groups[name] = append(groups[name], row)
+	}
+	// Hosts without a group come last
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == "<none>") != (names[j] == "<none>") {
+			return names[j] == "<none>"
+		}
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+
+	label := strings.ToUpper(groupBy[:1]) + groupBy[1:]
+	for _, name := range names {
+		fmt.Fprintf(writer, "%s: %s\n", label, name)
+		group := result
+		group.Data = groups[name]
+		GenerateOutput(writer, &group)
+		fmt.Fprintf(writer, "Subtotal: %d host(s)\n\n", len(groups[name]))
+	}
+	fmt.Fprintf(writer, "Total: %d host(s) in %d %s(s)\n", len(rows), len(names), groupBy)
+	return nil
+}
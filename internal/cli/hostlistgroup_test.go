@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestListHostSortAndGroup() {
+	out, err := s.runCommand("list host --project " + project + " --group-by site --sort-by status")
+	s.NoError(err)
+	s.Regexp(`^Site: site\nRESOURCE ID +\|NAME .*\nhost-abc12345 +\|edge-host-001 .*\nSubtotal: 1 host\(s\)\n\nTotal: 1 host\(s\) in 1 site\(s\)\n`, out)
+
+	_, err = s.runCommand("list host --project " + project + " --sort-by serial")
+	s.EqualError(err, `invalid --sort-by "serial", must be one of: name, status, site, os`)
+	_, err = s.runCommand("list host --project " + project + " --group-by region")
+	s.EqualError(err, `invalid --group-by "region", must be one of: site`)
+	_, err = s.runCommand("list host --project " + project + " --sort-by name --order-by name")
+	s.EqualError(err, "--sort-by and --order-by cannot be combined")
+	_, err = s.runCommand("list host --project " + project + " --group-by site -o json")
+	s.EqualError(err, "--group-by is only supported with table output")
+}
+
+func TestSortHosts(t *testing.T) {
+	hosts := []infra.HostResource{
+		{Name: "edge-3", HostStatus: stringPtr("Running"), Site: &infra.SiteResource{Name: stringPtr("store-b")}},
+		{Name: "edge-1", HostStatus: stringPtr("error")},
+		{Name: "Edge-2", HostStatus: stringPtr("Running"), Site: &infra.SiteResource{Name: stringPtr("store-a")}},
+	}
+	names := func() []string {
+		var out []string
+		for _, h := range hosts {
+			out = append(out, h.Name)
+		}
+		return out
+	}
+
+	sortHosts(hosts, "name")
+	assert.Equal(t, []string{"edge-1", "Edge-2", "edge-3"}, names())
+	sortHosts(hosts, "status")
+	assert.Equal(t, []string{"edge-1", "Edge-2", "edge-3"}, names())
+	sortHosts(hosts, "site")
+	assert.Equal(t, []string{"edge-1", "Edge-2", "edge-3"}, names())
+	sortHosts(hosts, "os")
+	assert.Equal(t, []string{"edge-1", "Edge-2", "edge-3"}, names())
+
+	hosts[0].Site = &infra.SiteResource{Name: stringPtr("store-c")}
+	sortHosts(hosts, "site")
+	assert.Equal(t, []string{"Edge-2", "edge-3", "edge-1"}, names())
+}
+
+func TestPrintHostGroups(t *testing.T) {
+	rows := outfmt.HostRows([]infra.HostResource{
+		{ResourceId: stringPtr("host-00000001"), Name: "edge-1", Site: &infra.SiteResource{Name: stringPtr("store-b")}},
+		{ResourceId: stringPtr("host-00000002"), Name: "edge-2"},
+		{ResourceId: stringPtr("host-00000003"), Name: "edge-3", Site: &infra.SiteResource{Name: stringPtr("store-a")}},
+		{ResourceId: stringPtr("host-00000004"), Name: "edge-4", Site: &infra.SiteResource{Name: stringPtr("store-b")}},
+	})
+	result := CommandResult{
+		Format:    format.Format("table{{.ResourceId}}\t{{.Name}}"),
+		OutputAs:  OUTPUT_TABLE,
+		NameLimit: -1,
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, printHostGroups(&buf, result, rows, "site"))
+	assert.Equal(t, `Site: store-a
+RESOURCE ID      NAME
+host-00000003    edge-3
+Subtotal: 1 host(s)
+
+Site: store-b
+RESOURCE ID      NAME
+host-00000001    edge-1
+host-00000004    edge-4
+Subtotal: 2 host(s)
+
+Site: <none>
+RESOURCE ID      NAME
+host-00000002    edge-2
+Subtotal: 1 host(s)
+
+Total: 4 host(s) in 3 site(s)
+`, buf.String())
+
+	// The subtotals count the rows of the output filter
+	buf.Reset()
+	result.Filter = "name=edge-4"
+	assert.NoError(t, printHostGroups(&buf, result, rows, "site"))
+	assert.Equal(t, `Site: store-b
+RESOURCE ID      NAME
+host-00000004    edge-4
+Subtotal: 1 host(s)
+
+Total: 1 host(s) in 1 site(s)
+`, buf.String())
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+)
+
+const (
+	lvmSizeFlag = "lvm-size"
+
+	// Smallest user LVM accepted by the provisioning of a host
+	minUserLvmSizeGB = 1
+)
+
+// Returns the largest user LVM a host can hold in GB, the capacity of its largest disk as the LVM is created on a
+// single disk, and false when the host has not reported its disks yet, e.g. before it is onboarded
+func maxUserLvmSizeGB(host infra.HostResource) (int, bool) {
+	if host.HostStorages == nil {
+		return 0, false
+	}
+	var largest uint64
+	for _, s := range *host.HostStorages {
+		capacity, err := strconv.ParseUint(derefString(s.CapacityBytes), 10, 64)
+		if err == nil && capacity > largest {
+			largest = capacity
+		}
+	}
+	if largest == 0 {
+		return 0, false
+	}
+	return int(largest / bytesPerGB), true
+}
+
+// Checks the requested LVM size against the disks of the host and the state of its instance: the LVM of a host
+// being provisioned or deleted cannot change and the LVM of a provisioned host can only grow
+func validateHostLvmSize(host infra.HostResource, size int) error {
+	hostID := derefString(host.ResourceId)
+	if size < minUserLvmSizeGB {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --lvm-size %d: the LVM size must be at least %d GB", size, minUserLvmSizeGB))
+	}
+	if maxSize, ok := maxUserLvmSizeGB(host); ok && size > maxSize {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --lvm-size %d: host %s has no disk larger than %d GB", size, hostID, maxSize))
+	}
+
+	instance := host.Instance
+	if instance == nil {
+		return nil
+	}
+	if instance.ProvisioningStatusIndicator != nil && *instance.ProvisioningStatusIndicator == infra.STATUSINDICATIONINPROGRESS {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("host %s is being provisioned (%s), wait for the provisioning to end before resizing its LVM", hostID, derefString(instance.ProvisioningStatus)))
+	}
+	if instance.DesiredState != nil && *instance.DesiredState == infra.INSTANCESTATEDELETED {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("the instance of host %s is being deleted, its LVM cannot be resized", hostID))
+	}
+	if instance.CurrentState != nil && *instance.CurrentState == infra.INSTANCESTATEUNTRUSTED {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("the instance of host %s is untrusted, its LVM cannot be resized", hostID))
+	}
+	if instance.CurrentState != nil && *instance.CurrentState == infra.INSTANCESTATERUNNING && host.UserLvmSize != nil && size < *host.UserLvmSize {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("the LVM of host %s is %d GB and its instance is running, the LVM of a provisioned host can only grow", hostID, *host.UserLvmSize))
+	}
+	return nil
+}
+
+// Sets the user LVM size of a host
+func setHostLvmSize(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource, size int) error {
+	fieldMask := "userLvmSize"
+	resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, derefString(host.ResourceId),
+		&infra.HostServicePatchHostParams{FieldMask: &fieldMask},
+		infra.HostServicePatchHostJSONRequestBody{
+			Name:        host.Name,
+			UserLvmSize: &size,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body, "error while setting host LVM size")
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+// Cluster labels of a cluster template declaring the minimum machine its hosts need
+const (
+	minCPUCoresLabel  = "min-cpu-cores"
+	minMemoryGBLabel  = "min-memory-gb"
+	minStorageGBLabel = "min-storage-gb"
+)
+
+const bytesPerGB = 1024 * 1024 * 1024
+
+// machineRequirements is the minimum machine of the hosts of a cluster template, zero for no requirement
+type machineRequirements struct {
+	CPUCores  int
+	MemoryGB  int
+	StorageGB int
+}
+
+// Reads the machine requirements declared by the cluster labels of a template
+func templateMachineRequirements(template cluster.TemplateInfo) (machineRequirements, error) {
+	var req machineRequirements
+	if template.ClusterLabels == nil {
+		return req, nil
+	}
+	for label, value := range map[string]*int{
+		minCPUCoresLabel:  &req.CPUCores,
+		minMemoryGBLabel:  &req.MemoryGB,
+		minStorageGBLabel: &req.StorageGB,
+	} {
+		s, ok := (*template.ClusterLabels)[label]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || n < 0 {
+			return machineRequirements{}, fmt.Errorf("invalid %s label %q of cluster template %s:%s", label, s, template.Name, template.Version)
+		}
+		*value = n
+	}
+	return req, nil
+}
+
+// Returns the requirements the specs reported by a host do not meet; a spec the host has not reported yet,
+// e.g. before it is onboarded, is not checked
+func unmetMachineRequirements(host infra.HostResource, req machineRequirements) []string {
+	var unmet []string
+	if host.CpuCores != nil && *host.CpuCores < req.CPUCores {
+		unmet = append(unmet, fmt.Sprintf("%d CPU cores, %d required", *host.CpuCores, req.CPUCores))
+	}
+	if memory, err := strconv.ParseUint(derefString(host.MemoryBytes), 10, 64); err == nil && memory < uint64(req.MemoryGB)*bytesPerGB {
+		unmet = append(unmet, fmt.Sprintf("%d GB of memory, %d GB required", memory/bytesPerGB, req.MemoryGB))
+	}
+	if host.HostStorages != nil && len(*host.HostStorages) > 0 {
+		var storage uint64
+		for _, s := range *host.HostStorages {
+			capacity, _ := strconv.ParseUint(derefString(s.CapacityBytes), 10, 64)
+			storage += capacity
+		}
+		if storage < uint64(req.StorageGB)*bytesPerGB {
+			unmet = append(unmet, fmt.Sprintf("%d GB of storage, %d GB required", storage/bytesPerGB, req.StorageGB))
+		}
+	}
+	return unmet
+}
+
+// Checks the hosts of the records deploying a cluster against the machine requirements of their cluster template
+// and returns the records whose host does not meet them, with a warning in their Error column. Only the hosts
+// already registered have specs to check.
+func checkMachineRequirements(cmd *cobra.Command, records []types.HostRecord, globalAttr *types.HostRecord) ([]types.HostRecord, error) {
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	ctx2, clusterClient, _, err := ClusterFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := map[string]machineRequirements{}
+	var warned []types.HostRecord
+	for _, record := range records {
+		if resolveCluster(record.K8sEnable, globalAttr.K8sEnable) != "true" {
+			continue
+		}
+		templateRef := valueOrDefault(globalAttr.K8sClusterTemplate, record.K8sClusterTemplate)
+		if templateRef == "" {
+			continue
+		}
+		req, ok := requirements[templateRef]
+		if !ok {
+			req, err = clusterTemplateRequirements(ctx2, clusterClient, projectName, templateRef)
+			if err != nil {
+				record.Error = fmt.Sprintf("Warning: cannot check the requirements of cluster template %s: %v", templateRef, err)
+				warned = append(warned, record)
+				continue
+			}
+			requirements[templateRef] = req
+		}
+		if req == (machineRequirements{}) {
+			continue
+		}
+
+		host, err := findHostForRecord(ctx, hostClient, projectName, record)
+		if err != nil {
+			return nil, err
+		}
+		if host == nil {
+			continue
+		}
+		if unmet := unmetMachineRequirements(*host, req); len(unmet) > 0 {
+			record.Error = fmt.Sprintf("Warning: host %s does not meet the requirements of cluster template %s: %s",
+				derefString(host.ResourceId), templateRef, strings.Join(unmet, "; "))
+			warned = append(warned, record)
+		}
+	}
+	return warned, nil
+}
+
+// Fetches a cluster template given as name:version and reads its machine requirements
+func clusterTemplateRequirements(ctx context.Context, client cluster.ClientWithResponsesInterface, projectName string, templateRef string) (machineRequirements, error) {
+	name, version, err := decodeK8sTemplate(templateRef)
+	if err != nil {
+		return machineRequirements{}, err
+	}
+	resp, err := client.GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx, projectName, name, version, nil, auth.AddAuthHeader)
+	if err != nil {
+		return machineRequirements{}, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting cluster template"); err != nil {
+		return machineRequirements{}, err
+	}
+	return templateMachineRequirements(*resp.JSON200)
+}
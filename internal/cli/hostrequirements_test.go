@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestCreateHostDryRunMachineRequirements() {
+	// Host host-abc12345, serial 1234567890, has 8 CPU cores and 16 GB of memory; SN123456789 is not registered
+	out, err := s.createHost(project, map[string]string{
+		"import-from-csv":  "./testdata/diff.csv",
+		"dry-run":          "true",
+		"cluster-deploy":   "true",
+		"cluster-template": "edge-large:v1.0.0",
+	})
+	s.NoError(err)
+	s.Contains(out, "1 hosts may not meet the machine requirements of their cluster template\n")
+
+	warningFiles, err := filepath.Glob("preflight_warning_*_diff.csv")
+	s.Require().NoError(err)
+	s.Require().Len(warningFiles, 1)
+	defer os.Remove(warningFiles[0])
+	content, err := os.ReadFile(warningFiles[0])
+	s.NoError(err)
+	s.Contains(string(content), "1234567890,550e8400-e29b-41d4-a716-446655440000,")
+	s.Contains(string(content), "Warning: host host-abc12345 does not meet the requirements of cluster template edge-large:v1.0.0: "+
+		"8 CPU cores, 16 required; 16 GB of memory, 32 GB required")
+	s.NotContains(string(content), "SN123456789")
+
+	out, err = s.createHostSingle(project, "edge-host-001", map[string]string{
+		"serial":           "1234567890",
+		"site":             "site-7ceae560",
+		"os-profile":       "\"Edge Microvisor Toolkit 3.0.20250504\"",
+		"dry-run":          "true",
+		"cluster-deploy":   "true",
+		"cluster-template": "edge-large:v1.0.0",
+	})
+	s.NoError(err)
+	s.Contains(out, "Warning: host host-abc12345 does not meet the requirements of cluster template edge-large:v1.0.0")
+
+	// A template without requirements has nothing to check
+	out, err = s.createHostSingle(project, "edge-host-001", map[string]string{
+		"serial":           "1234567890",
+		"site":             "site-7ceae560",
+		"os-profile":       "\"Edge Microvisor Toolkit 3.0.20250504\"",
+		"dry-run":          "true",
+		"cluster-deploy":   "true",
+		"cluster-template": "baseline:v2.0.2",
+	})
+	s.NoError(err)
+	s.NotContains(out, "Warning")
+}
+
+func TestTemplateMachineRequirements(t *testing.T) {
+	req, err := templateMachineRequirements(cluster.TemplateInfo{Name: "edge", Version: "v1.0.0",
+		ClusterLabels: &map[string]string{"min-cpu-cores": "4", "min-storage-gb": " 256 ", "app": "pos"}})
+	require.NoError(t, err)
+	assert.Equal(t, machineRequirements{CPUCores: 4, StorageGB: 256}, req)
+
+	req, err = templateMachineRequirements(cluster.TemplateInfo{Name: "edge", Version: "v1.0.0"})
+	require.NoError(t, err)
+	assert.Zero(t, req)
+
+	_, err = templateMachineRequirements(cluster.TemplateInfo{Name: "edge", Version: "v1.0.0",
+		ClusterLabels: &map[string]string{"min-memory-gb": "16Gi"}})
+	assert.EqualError(t, err, `invalid min-memory-gb label "16Gi" of cluster template edge:v1.0.0`)
+}
+
+func TestUnmetMachineRequirements(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	cores := 8
+	host := infra.HostResource{
+		CpuCores:    &cores,
+		MemoryBytes: stringPtr("34359738368"),
+		HostStorages: &[]infra.HoststorageResource{
+			{CapacityBytes: stringPtr("64424509440")},
+			{CapacityBytes: stringPtr("42949672960")},
+		},
+	}
+	assert.Empty(t, unmetMachineRequirements(host, machineRequirements{CPUCores: 8, MemoryGB: 32, StorageGB: 100}))
+	assert.Equal(t, []string{"8 CPU cores, 12 required", "100 GB of storage, 120 GB required"},
+		unmetMachineRequirements(host, machineRequirements{CPUCores: 12, MemoryGB: 32, StorageGB: 120}))
+
+	// The specs of a host not onboarded yet are unknown
+	assert.Empty(t, unmetMachineRequirements(infra.HostResource{}, machineRequirements{CPUCores: 12, MemoryGB: 32, StorageGB: 120}))
+}
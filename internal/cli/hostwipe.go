@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	wipeFlag        = "wipe"
+	wipeTimeoutFlag = "wipe-timeout"
+
+	defaultWipeTimeout = 15 * time.Minute
+	// Time between two polls of a host being wiped
+	wipePollInterval = 10 * time.Second
+)
+
+// Entry appended to the note of a host wiped before its deletion, kept in the audit trail of the host
+const hostWipeNote = "secure wipe before deletion: powered off, AMT unprovisioned"
+
+// Returns the note recording the wipe of the host. A note too long to take another entry is replaced by the entry.
+func hostWipeNoteValue(host infra.HostResource, now time.Time) (string, error) {
+	note, err := hostNoteValue(derefString(host.Note), hostWipeNote, true, now)
+	if err != nil {
+		return hostNoteValue("", hostWipeNote, true, now)
+	}
+	return note, nil
+}
+
+// Sanitizes a host before its deletion: the host is powered off through AMT so that it stops its workloads, and
+// AMT is unprovisioned, erasing the AMT configuration and credentials of the device. The host must have AMT
+// provisioned. Each change is only requested once the host reports the previous one, since powering off needs
+// AMT, and the wipe fails when the host does not report them before the deadline of ctx. The states confirmed
+// by the host are printed, and the wipe is recorded in the note of the host once both are.
+func wipeHost(ctx context.Context, cmd *cobra.Command, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource,
+	now time.Time, interval time.Duration) error {
+	hostID := derefString(host.ResourceId)
+	if host.CurrentAmtState == nil || *host.CurrentAmtState != infra.AMTSTATEPROVISIONED {
+		return e.WithCode(e.CodeFailedPrecondition,
+			fmt.Errorf("host %s does not have AMT provisioned, it cannot be wiped, delete it without --wipe and sanitize it out of band", hostID))
+	}
+
+	note, err := hostWipeNoteValue(host, now)
+	if err != nil {
+		return err
+	}
+
+	powerOff := infra.POWERSTATEOFF
+	if err := patchHostForWipe(ctx, hostClient, projectName, hostID, "desiredPowerState",
+		infra.HostServicePatchHostJSONRequestBody{Name: host.Name, DesiredPowerState: &powerOff},
+		"error while powering off host for wipe"); err != nil {
+		return err
+	}
+	if err := waitHostWipeState(ctx, cmd, hostClient, projectName, hostID, "powered off", interval, func(h infra.HostResource) bool {
+		return h.CurrentPowerState != nil && *h.CurrentPowerState == powerOff
+	}); err != nil {
+		return err
+	}
+
+	unprovisioned := infra.AMTSTATEUNPROVISIONED
+	if err := patchHostForWipe(ctx, hostClient, projectName, hostID, "desiredAmtState",
+		infra.HostServicePatchHostJSONRequestBody{Name: host.Name, DesiredAmtState: &unprovisioned},
+		"error while unprovisioning AMT of host for wipe"); err != nil {
+		return err
+	}
+	if err := waitHostWipeState(ctx, cmd, hostClient, projectName, hostID, "AMT unprovisioned", interval, func(h infra.HostResource) bool {
+		return h.CurrentAmtState != nil && *h.CurrentAmtState == unprovisioned
+	}); err != nil {
+		return err
+	}
+	return setHostNote(ctx, hostClient, projectName, host, note)
+}
+
+func patchHostForWipe(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, hostID string,
+	fieldMask string, body infra.HostServicePatchHostJSONRequestBody, errMsg string) error {
+	resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, hostID,
+		&infra.HostServicePatchHostParams{FieldMask: &fieldMask}, body, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body, errMsg)
+}
+
+// Polls a host being wiped until it reports the state requested last; the host is not deleted if it does not
+func waitHostWipeState(ctx context.Context, cmd *cobra.Command, hostClient infra.ClientWithResponsesInterface, projectName string, hostID string,
+	condition string, interval time.Duration, reached func(infra.HostResource) bool) error {
+	err := pollUntilContext(ctx, cmd, "host "+hostID, condition, interval, func() (waitState, error) {
+		resp, err := hostClient.HostServiceGetHostWithResponse(ctx, projectName, hostID, auth.AddAuthHeader)
+		if err != nil {
+			return waitState{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving host"); err != nil {
+			return waitState{}, err
+		}
+		return waitState{
+			Status: waitStatusSummary("power", safeString((*string)(resp.JSON200.CurrentPowerState)),
+				"AMT", safeString((*string)(resp.JSON200.CurrentAmtState))),
+			Met: reached(*resp.JSON200),
+		}, nil
+	})
+	if err == nil {
+		return nil
+	}
+	err = fmt.Errorf("%w; host %s was not deleted", err, hostID)
+	if ctx.Err() != nil {
+		return e.WithCode(e.CodeDeadlineExceeded, err)
+	}
+	return err
+}
@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	icalDateFormat     = "20060102"
+	icalDateTimeFormat = "20060102T150405"
+)
+
+// Weekdays as used by BYDAY, mapped to cron day of week numbers
+var icalWeekdays = map[string]int{"SU": 0, "MO": 1, "TU": 2, "WE": 3, "TH": 4, "FR": 5, "SA": 6}
+
+var icalDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// icalEvent is a VEVENT of an iCalendar file, reduced to what a maintenance schedule needs
+type icalEvent struct {
+	Line      int
+	UID       string
+	Summary   string
+	Start     time.Time
+	End       *time.Time
+	AllDay    bool
+	RRule     map[string]string
+	Cancelled bool
+	Override  bool
+	HasExDate bool
+}
+
+// icalSchedule is the single or repeated schedule an event converts to
+type icalSchedule struct {
+	Name     string
+	Repeated bool
+
+	// Single schedule
+	StartSeconds int
+	EndSeconds   *int
+
+	// Repeated schedule, cron fields in UTC
+	CronMinutes     string
+	CronHours       string
+	CronDayMonth    string
+	CronMonth       string
+	CronDayWeek     string
+	DurationSeconds int32
+
+	Warnings []string
+}
+
+// icalProperty is a content line: NAME;PARAM=VALUE:value
+type icalProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Reads the content lines of an iCalendar stream, joining folded lines
+func readICalLines(r io.Reader) ([]string, []int, error) {
+	var lines []string
+	var numbers []int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+		numbers = append(numbers, n)
+	}
+	return lines, numbers, scanner.Err()
+}
+
+func parseICalProperty(line string) (icalProperty, error) {
+	// The value starts at the first colon outside of a quoted parameter value
+	inQuotes := false
+	split := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == ':' && !inQuotes {
+			split = i
+			break
+		}
+	}
+	if split <= 0 {
+		return icalProperty{}, fmt.Errorf("malformed content line %q", line)
+	}
+
+	parts := strings.Split(line[:split], ";")
+	prop := icalProperty{Name: strings.ToUpper(parts[0]), Params: map[string]string{}, Value: line[split+1:]}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+func unescapeICalText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
+
+// Parses a DATE or DATE-TIME value; floating times are interpreted in defaultLoc
+func parseICalTime(prop icalProperty, defaultLoc *time.Location) (time.Time, bool, error) {
+	loc := defaultLoc
+	if tzid := prop.Params["TZID"]; tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone %q in %s, only IANA time zone names are supported", tzid, prop.Name)
+		}
+	}
+
+	value := prop.Value
+	if prop.Params["VALUE"] == "DATE" || len(value) == len(icalDateFormat) {
+		t, err := time.ParseInLocation(icalDateFormat, value, loc)
+		return t, true, err
+	}
+	if utc, ok := strings.CutSuffix(value, "Z"); ok {
+		t, err := time.ParseInLocation(icalDateTimeFormat, utc, time.UTC)
+		return t, false, err
+	}
+	t, err := time.ParseInLocation(icalDateTimeFormat, value, loc)
+	return t, false, err
+}
+
+func parseICalDuration(value string) (time.Duration, error) {
+	m := icalDurationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+2] != "" {
+			n, _ := strconv.Atoi(m[i+2])
+			d += time.Duration(n) * unit
+		}
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+func parseICalRRule(value string) (map[string]string, error) {
+	rule := map[string]string{}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed RRULE part %q", part)
+		}
+		rule[strings.ToUpper(key)] = strings.ToUpper(val)
+	}
+	if rule["FREQ"] == "" {
+		return nil, errors.New("RRULE without FREQ")
+	}
+	return rule, nil
+}
+
+// parseICal returns the events of an iCalendar stream; floating times are interpreted in defaultLoc
+func parseICal(r io.Reader, defaultLoc *time.Location) ([]icalEvent, error) {
+	lines, numbers, err := readICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []icalEvent
+	var event *icalEvent
+	var duration *time.Duration
+	// Nested components such as VALARM carry properties which do not belong to the event
+	nested := 0
+	for i, line := range lines {
+		prop, err := parseICalProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", numbers[i], err)
+		}
+
+		switch {
+		case prop.Name == "BEGIN" && strings.EqualFold(prop.Value, "VEVENT"):
+			event = &icalEvent{Line: numbers[i]}
+			duration = nil
+			continue
+		case prop.Name == "END" && strings.EqualFold(prop.Value, "VEVENT") && event != nil:
+			if event.Start.IsZero() {
+				return nil, fmt.Errorf("line %d: event without DTSTART", event.Line)
+			}
+			if event.End == nil && duration != nil {
+				end := event.Start.Add(*duration)
+				event.End = &end
+			}
+			if event.End == nil && event.AllDay {
+				end := event.Start.AddDate(0, 0, 1)
+				event.End = &end
+			}
+			events = append(events, *event)
+			event = nil
+			continue
+		case event == nil:
+			continue
+		case prop.Name == "BEGIN":
+			nested++
+			continue
+		case prop.Name == "END":
+			nested--
+			continue
+		case nested > 0:
+			continue
+		}
+
+		switch prop.Name {
+		case "UID":
+			event.UID = prop.Value
+		case "SUMMARY":
+			event.Summary = strings.TrimSpace(unescapeICalText(prop.Value))
+		case "DTSTART":
+			if event.Start, event.AllDay, err = parseICalTime(prop, defaultLoc); err != nil {
+				return nil, fmt.Errorf("line %d: invalid DTSTART: %w", numbers[i], err)
+			}
+		case "DTEND":
+			end, _, err := parseICalTime(prop, defaultLoc)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid DTEND: %w", numbers[i], err)
+			}
+			event.End = &end
+		case "DURATION":
+			d, err := parseICalDuration(prop.Value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", numbers[i], err)
+			}
+			duration = &d
+		case "RRULE":
+			if event.RRule, err = parseICalRRule(prop.Value); err != nil {
+				return nil, fmt.Errorf("line %d: %w", numbers[i], err)
+			}
+		case "STATUS":
+			event.Cancelled = strings.EqualFold(prop.Value, "CANCELLED")
+		case "RECURRENCE-ID":
+			event.Override = true
+		case "EXDATE", "RDATE":
+			event.HasExDate = true
+		}
+	}
+	if event != nil {
+		return nil, fmt.Errorf("line %d: event is not terminated by END:VEVENT", event.Line)
+	}
+	return events, nil
+}
+
+// name returns the schedule name for the event: its summary, or its UID if it has none
+func (ev *icalEvent) name(index int) string {
+	switch {
+	case ev.Summary != "":
+		return ev.Summary
+	case ev.UID != "":
+		return ev.UID
+	}
+	return fmt.Sprintf("ical-event-%d", index+1)
+}
+
+// toSchedule converts the event into a single schedule, or into a repeated one if it has an RRULE.
+// It returns nil for events that need no schedule: cancelled ones and single events that already ended.
+func (ev *icalEvent) toSchedule(index int, now time.Time) (*icalSchedule, error) {
+	schedule := &icalSchedule{Name: ev.name(index)}
+	if ev.Cancelled || ev.Override {
+		return nil, nil
+	}
+	if ev.End != nil && !ev.End.After(ev.Start) {
+		return nil, fmt.Errorf("event %q ends before it starts", schedule.Name)
+	}
+
+	if ev.RRule == nil {
+		if ev.End != nil && !ev.End.After(now) {
+			return nil, nil
+		}
+		schedule.StartSeconds = int(ev.Start.Unix())
+		if ev.End != nil {
+			end := int(ev.End.Unix())
+			schedule.EndSeconds = &end
+		}
+		return schedule, nil
+	}
+
+	if ev.End == nil {
+		return nil, fmt.Errorf("recurring event %q needs a DTEND or DURATION", schedule.Name)
+	}
+	if err := ev.toCron(schedule); err != nil {
+		return nil, fmt.Errorf("recurring event %q: %w", schedule.Name, err)
+	}
+	return schedule, nil
+}
+
+func (ev *icalEvent) toCron(schedule *icalSchedule) error {
+	rule := ev.RRule
+	for _, unsupported := range []string{"BYSETPOS", "BYWEEKNO", "BYYEARDAY", "BYHOUR", "BYMINUTE", "BYSECOND"} {
+		if _, ok := rule[unsupported]; ok {
+			return fmt.Errorf("%s is not supported", unsupported)
+		}
+	}
+	if interval, ok := rule["INTERVAL"]; ok && interval != "1" {
+		return fmt.Errorf("INTERVAL=%s is not supported, only every occurrence can be scheduled", interval)
+	}
+	if _, ok := rule["UNTIL"]; ok {
+		schedule.Warnings = append(schedule.Warnings, "UNTIL is ignored, the repeated schedule does not end")
+	}
+	if _, ok := rule["COUNT"]; ok {
+		schedule.Warnings = append(schedule.Warnings, "COUNT is ignored, the repeated schedule does not end")
+	}
+	if ev.HasExDate {
+		schedule.Warnings = append(schedule.Warnings, "EXDATE and RDATE are ignored")
+	}
+
+	// Cron fields are in UTC; converting the start time may move the occurrence to the previous or next day
+	start := ev.Start.UTC()
+	localDay := time.Date(ev.Start.Year(), ev.Start.Month(), ev.Start.Day(), 0, 0, 0, 0, time.UTC)
+	dayShift := int(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC).Sub(localDay).Hours() / 24)
+
+	schedule.Repeated = true
+	schedule.CronMinutes = strconv.Itoa(start.Minute())
+	schedule.CronHours = strconv.Itoa(start.Hour())
+	schedule.DurationSeconds = int32(ev.End.Sub(ev.Start).Seconds())
+	schedule.CronDayMonth = "*"
+	schedule.CronDayWeek = "*"
+	schedule.CronMonth = "*"
+
+	var err error
+	if months, ok := rule["BYMONTH"]; ok {
+		if schedule.CronMonth, err = convertMonthToCron(months); err != nil {
+			return err
+		}
+	}
+
+	byDay, hasByDay := rule["BYDAY"]
+	byMonthDay, hasByMonthDay := rule["BYMONTHDAY"]
+	switch rule["FREQ"] {
+	case "DAILY":
+		if hasByDay {
+			schedule.CronDayWeek, err = icalWeekdaysToCron(byDay, dayShift)
+		}
+	case "WEEKLY":
+		if !hasByDay {
+			byDay = strings.ToUpper(ev.Start.Weekday().String()[:2])
+		}
+		schedule.CronDayWeek, err = icalWeekdaysToCron(byDay, dayShift)
+	case "MONTHLY", "YEARLY":
+		if hasByDay {
+			return errors.New("BYDAY is only supported with FREQ=DAILY or FREQ=WEEKLY")
+		}
+		if dayShift != 0 {
+			return errors.New("the start time falls on another day in UTC, which cannot be expressed for days of the month")
+		}
+		if !hasByMonthDay {
+			byMonthDay = strconv.Itoa(ev.Start.Day())
+		}
+		if strings.Contains(byMonthDay, "-") {
+			return errors.New("negative BYMONTHDAY values are not supported")
+		}
+		if schedule.CronDayMonth, err = convertDayOfMonthToCron(byMonthDay); err != nil {
+			return err
+		}
+		if _, ok := rule["BYMONTH"]; !ok && rule["FREQ"] == "YEARLY" {
+			schedule.CronMonth = strconv.Itoa(int(ev.Start.Month()))
+		}
+	default:
+		return fmt.Errorf("FREQ=%s is not supported", rule["FREQ"])
+	}
+	return err
+}
+
+// Converts a BYDAY list to cron days of week, moved by the given number of days
+func icalWeekdaysToCron(byDay string, dayShift int) (string, error) {
+	days := map[int]bool{}
+	for _, day := range strings.Split(byDay, ",") {
+		n, ok := icalWeekdays[day]
+		if !ok {
+			return "", fmt.Errorf("BYDAY value %q is not supported", day)
+		}
+		days[(n+dayShift+7)%7] = true
+	}
+	list := make([]int, 0, len(days))
+	for day := range days {
+		list = append(list, day)
+	}
+	sort.Ints(list)
+	cron := make([]string, len(list))
+	for i, day := range list {
+		cron[i] = strconv.Itoa(day)
+	}
+	return strings.Join(cron, ","), nil
+}
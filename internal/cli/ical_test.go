@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func icalCalendar(event ...string) string {
+	return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:test@example.com\r\n" +
+		strings.Join(event, "\r\n") + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+}
+
+func TestParseICal(t *testing.T) {
+	input := icalCalendar(
+		"SUMMARY:Patch window\\, lab",
+		"DESCRIPTION:long text folded",
+		" across lines",
+		`DTSTART;TZID="America/New_York":20260302T230000`,
+		"DURATION:PT1H30M",
+		"RRULE:FREQ=WEEKLY;BYDAY=TU",
+	)
+	events, err := parseICal(strings.NewReader(input), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	ev := events[0]
+	assert.Equal(t, "Patch window, lab", ev.Summary)
+	assert.Equal(t, "test@example.com", ev.UID)
+	newYork, _ := time.LoadLocation("America/New_York")
+	assert.True(t, ev.Start.Equal(time.Date(2026, 3, 2, 23, 0, 0, 0, newYork)))
+	require.NotNil(t, ev.End)
+	assert.Equal(t, 90*time.Minute, ev.End.Sub(ev.Start))
+	assert.Equal(t, map[string]string{"FREQ": "WEEKLY", "BYDAY": "TU"}, ev.RRule)
+
+	_, err = parseICal(strings.NewReader(icalCalendar("SUMMARY:No start")), time.UTC)
+	assert.ErrorContains(t, err, "event without DTSTART")
+
+	_, err = parseICal(strings.NewReader(icalCalendar("DTSTART;TZID=W. Europe Standard Time:20260302T230000")), time.UTC)
+	assert.ErrorContains(t, err, `unknown time zone "W. Europe Standard Time"`)
+
+	_, err = parseICal(strings.NewReader("BEGIN:VEVENT\nDTSTART:20260302T230000Z\n"), time.UTC)
+	assert.ErrorContains(t, err, "not terminated")
+}
+
+func TestICalEventToSchedule(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		event    []string
+		expected *icalSchedule
+		err      string
+	}{
+		{
+			name:  "single",
+			event: []string{"SUMMARY:Outage", "DTSTART:20260302T200000Z", "DTEND:20260302T220000Z"},
+			expected: &icalSchedule{Name: "Outage", StartSeconds: 1772481600,
+				EndSeconds: func() *int { end := 1772488800; return &end }()},
+		},
+		{
+			name:     "all day",
+			event:    []string{"SUMMARY:Freeze", "DTSTART;VALUE=DATE:20260302"},
+			expected: &icalSchedule{Name: "Freeze", StartSeconds: 1772409600, EndSeconds: func() *int { end := 1772496000; return &end }()},
+		},
+		{
+			name:  "weekly moved to previous day in UTC",
+			event: []string{"SUMMARY:Patch", "DTSTART;TZID=Europe/Berlin:20260105T003000", "DTEND;TZID=Europe/Berlin:20260105T013000", "RRULE:FREQ=WEEKLY;BYDAY=MO,SU;UNTIL=20261231T000000Z"},
+			expected: &icalSchedule{Name: "Patch", Repeated: true, CronMinutes: "30", CronHours: "23", CronDayMonth: "*", CronMonth: "*", CronDayWeek: "0,6",
+				DurationSeconds: 3600, Warnings: []string{"UNTIL is ignored, the repeated schedule does not end"}},
+		},
+		{
+			name:  "weekly without days",
+			event: []string{"SUMMARY:Patch", "DTSTART:20260107T100000Z", "DURATION:PT2H", "RRULE:FREQ=WEEKLY"},
+			expected: &icalSchedule{Name: "Patch", Repeated: true, CronMinutes: "0", CronHours: "10", CronDayMonth: "*", CronMonth: "*", CronDayWeek: "3",
+				DurationSeconds: 7200},
+		},
+		{
+			name:  "monthly",
+			event: []string{"SUMMARY:Monthly", "DTSTART:20260115T100000Z", "DURATION:PT1H", "RRULE:FREQ=MONTHLY;BYMONTHDAY=1,15;BYMONTH=1,2,3"},
+			expected: &icalSchedule{Name: "Monthly", Repeated: true, CronMinutes: "0", CronHours: "10", CronDayMonth: "1,15", CronMonth: "1,2,3", CronDayWeek: "*",
+				DurationSeconds: 3600},
+		},
+		{
+			name:  "yearly",
+			event: []string{"DTSTART:20260704T060000Z", "DURATION:P1D", "RRULE:FREQ=YEARLY"},
+			expected: &icalSchedule{Name: "test@example.com", Repeated: true, CronMinutes: "0", CronHours: "6", CronDayMonth: "4", CronMonth: "7", CronDayWeek: "*",
+				DurationSeconds: 86400},
+		},
+		{name: "past event", event: []string{"DTSTART:20250101T100000Z", "DTEND:20250101T110000Z"}},
+		{name: "cancelled", event: []string{"DTSTART:20260301T100000Z", "STATUS:CANCELLED"}},
+		{name: "interval", event: []string{"SUMMARY:Biweekly", "DTSTART:20260107T100000Z", "DURATION:PT2H", "RRULE:FREQ=WEEKLY;INTERVAL=2"}, err: `recurring event "Biweekly": INTERVAL=2 is not supported`},
+		{name: "monthly by weekday", event: []string{"SUMMARY:Second Tuesday", "DTSTART:20260113T100000Z", "DURATION:PT2H", "RRULE:FREQ=MONTHLY;BYDAY=2TU"}, err: "BYDAY is only supported with FREQ=DAILY or FREQ=WEEKLY"},
+		{name: "monthly across midnight", event: []string{"SUMMARY:Late", "DTSTART;TZID=Asia/Tokyo:20260115T050000", "DURATION:PT2H", "RRULE:FREQ=MONTHLY"}, err: "falls on another day in UTC"},
+		{name: "recurring without end", event: []string{"SUMMARY:Open", "DTSTART:20260115T050000Z", "RRULE:FREQ=DAILY"}, err: `recurring event "Open" needs a DTEND or DURATION`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := parseICal(strings.NewReader(icalCalendar(tt.event...)), time.UTC)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+
+			schedule, err := events[0].toSchedule(0, now)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule)
+		})
+	}
+}
@@ -17,7 +17,7 @@ func getImportCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "import",
 		Short:             "Create orchestrator resources by importing from an external source",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		Example:           "orch-cli import helm-chart oci:/path/to/chart:1.0.0 --project some-project",
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -30,17 +30,16 @@ func getImportCommand() *cobra.Command {
 			return c.Usage()
 		},
 	}
-	cmd.AddCommand(
-		getImportHelmChartCommand(),
-	)
+	addCommandIfFeatureEnabled(cmd, getImportHelmChartCommand(), AppOrchFeature)
+	addCommandIfFeatureEnabled(cmd, getImportDeploymentPackageCommand(), AppOrchFeature)
+	addCommandIfFeatureEnabled(cmd, getImportMaintenanceCommand(), Day2Feature)
 	return cmd
 }
 
 /*
  * getImportHelmCharCommand implements a command that imports helm charts into the catalog.
  *
- * As this is the only "import" subcommand, it's located here alongside the import command.
- * If more import commands are added in the future, they can be organized into a separate file.
+ * The import of deployment packages lives with the other deployment package commands.
  */
 
 func getImportHelmChartCommand() *cobra.Command {
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	importProgressBarWidth = 30
+
+	importOutputText = "text"
+	importOutputJSON = "json"
+
+	// Number of failures listed in the import summary, the error file holds all of them
+	maxSummarizedFailures = 20
+)
+
+// importProgress reports the progress of a host import as a live progress bar when stderr is a terminal;
+// the registered hosts are only reported by the summary, --output-ids and --output-type json
+type importProgress struct {
+	bar       io.Writer
+	live      bool
+	total     int
+	succeeded int
+	failed    int
+	start     time.Time
+	now       func() time.Time
+}
+
+func newImportProgress(cmd *cobra.Command, total int) *importProgress {
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	if outputType, _ := cmd.Flags().GetString("output-type"); outputType == importOutputJSON {
+		quiet = true
+	}
+	p := &importProgress{
+		bar:   cmd.ErrOrStderr(),
+		total: total,
+		now:   time.Now,
+	}
+	p.live = !quiet && total > 1 && isTerminal(p.bar)
+	p.start = p.now()
+	if p.live {
+		p.render()
+	}
+	return p
+}
+
+func (p *importProgress) processed() int {
+	return p.succeeded + p.failed
+}
+
+func (p *importProgress) recordSuccess() {
+	p.succeeded++
+	if p.live {
+		p.render()
+	}
+}
+
+func (p *importProgress) recordFailure() {
+	p.failed++
+	if p.live {
+		p.render()
+	}
+}
+
+// Ends the progress bar line so that following output starts on a new line
+func (p *importProgress) done() {
+	if p.live {
+		fmt.Fprintln(p.bar)
+	}
+}
+
+func (p *importProgress) render() {
+	fmt.Fprintf(p.bar, "\r\033[K%s", p.status())
+}
+
+func (p *importProgress) status() string {
+	processed := p.processed()
+	filled := 0
+	if p.total > 0 {
+		filled = processed * importProgressBarWidth / p.total
+	}
+	bar := strings.Repeat("#", filled) + strings.Repeat(".", importProgressBarWidth-filled)
+	return fmt.Sprintf("[%s] %d/%d rows | %d succeeded | %d failed | ETA %s", bar, processed, p.total, p.succeeded, p.failed, p.eta())
+}
+
+// Estimates the remaining time from the average time spent on the rows processed so far
+func (p *importProgress) eta() string {
+	processed := p.processed()
+	if processed == 0 {
+		return "--"
+	}
+	elapsed := p.now().Sub(p.start)
+	remaining := elapsed / time.Duration(processed) * time.Duration(p.total-processed)
+	return remaining.Round(time.Second).String()
+}
+
+// Prints the outcome of an import, listing the first failures
+func printImportSummary(w io.Writer, total int, erringRecords []types.HostRecord) {
+	fmt.Fprintf(w, "%d of %d host(s) imported, %d failed\n", total-len(erringRecords), total, len(erringRecords))
+	for i, record := range erringRecords {
+		if i == maxSummarizedFailures {
+			fmt.Fprintf(w, "  ... and %d more\n", len(erringRecords)-maxSummarizedFailures)
+			break
+		}
+		fmt.Fprintf(w, "  Serial number : %s  UUID : %s - %s\n", record.Serial, record.UUID, record.Error)
+	}
+}
+
+// Notes the hosts skipped because a previous run of the import registered them
+func printResumedImportSummary(w io.Writer, registrations []types.HostRegistration) {
+	resumed := 0
+	for _, registration := range registrations {
+		if registration.Resumed {
+			resumed++
+		}
+	}
+	if resumed > 0 {
+		fmt.Fprintf(w, "%d host(s) registered by a previous run of the import were skipped\n", resumed)
+	}
+}
+
+// hostImportReport is the outcome of an import as printed with --output-type json
+type hostImportReport struct {
+	Total     int                      `json:"total"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Sites     []siteImportSummary      `json:"sites"`
+	Hosts     []types.HostRegistration `json:"hosts"`
+}
+
+// siteImportSummary is the outcome of an import for the hosts of one site
+type siteImportSummary struct {
+	// Site is the site as given in the CSV file or by --site, empty for hosts without a site
+	Site      string `json:"site"`
+	Attempted int    `json:"attempted"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	// SucceededPercent is the share of the attempted hosts that succeeded
+	SucceededPercent float64 `json:"succeededPercent"`
+	// AverageRegistrationSeconds, P50RegistrationSeconds and P95RegistrationSeconds are the average,
+	// median and 95th percentile of the time spent registering the hosts that succeeded
+	AverageRegistrationSeconds float64 `json:"averageRegistrationSeconds"`
+	P50RegistrationSeconds     float64 `json:"p50RegistrationSeconds"`
+	P95RegistrationSeconds     float64 `json:"p95RegistrationSeconds"`
+	ClustersCreated            int     `json:"clustersCreated"`
+
+	// Registration times of the hosts that succeeded, sorted once the summary is complete
+	registrationTimes []time.Duration
+}
+
+func (s siteImportSummary) averageRegistrationTime() time.Duration {
+	if len(s.registrationTimes) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range s.registrationTimes {
+		total += d
+	}
+	return total / time.Duration(len(s.registrationTimes))
+}
+
+// Returns the p-th percentile of the registration times, using the nearest-rank method as bench does
+func (s siteImportSummary) registrationTimePercentile(p int) time.Duration {
+	return benchPercentile(s.registrationTimes, p)
+}
+
+// Groups the outcome of an import by site, sorted by site
+func summarizeImportBySite(registrations []types.HostRegistration) []siteImportSummary {
+	bySite := map[string]*siteImportSummary{}
+	for _, registration := range registrations {
+		site, ok := bySite[registration.Site]
+		if !ok {
+			site = &siteImportSummary{Site: registration.Site}
+			bySite[registration.Site] = site
+		}
+		site.Attempted++
+		if registration.Status != types.RegistrationRegistered {
+			site.Failed++
+			continue
+		}
+		site.Succeeded++
+		if !registration.Resumed {
+			site.registrationTimes = append(site.registrationTimes, registration.Duration)
+		}
+		if registration.ClusterCreated {
+			site.ClustersCreated++
+		}
+	}
+
+	sites := make([]siteImportSummary, 0, len(bySite))
+	for _, site := range bySite {
+		slices.Sort(site.registrationTimes)
+		site.SucceededPercent = math.Round(float64(site.Succeeded)*1000/float64(site.Attempted)) / 10
+		site.AverageRegistrationSeconds = site.averageRegistrationTime().Round(time.Millisecond).Seconds()
+		site.P50RegistrationSeconds = site.registrationTimePercentile(50).Round(time.Millisecond).Seconds()
+		site.P95RegistrationSeconds = site.registrationTimePercentile(95).Round(time.Millisecond).Seconds()
+		sites = append(sites, *site)
+	}
+	sort.Slice(sites, func(i, j int) bool { return sites[i].Site < sites[j].Site })
+	return sites
+}
+
+// Prints the per-site table of an import; nothing is printed when no host was given a site
+func printSiteImportSummary(w io.Writer, sites []siteImportSummary) {
+	if len(sites) == 0 || (len(sites) == 1 && sites[0].Site == "") {
+		return
+	}
+	writer := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(writer, "Site\tAttempted\tSucceeded\tFailed\tSuccess Rate\tAvg Registration Time\tP50\tP95\tClusters Created")
+	for _, site := range sites {
+		average, p50, p95 := "-", "-", "-"
+		if len(site.registrationTimes) > 0 {
+			average = site.averageRegistrationTime().Round(time.Millisecond).String()
+			p50 = site.registrationTimePercentile(50).Round(time.Millisecond).String()
+			p95 = site.registrationTimePercentile(95).Round(time.Millisecond).String()
+		}
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%d\t%.1f%%\t%s\t%s\t%s\t%d\n", valueOrDefault(site.Site, "-"), site.Attempted, site.Succeeded,
+			site.Failed, site.SucceededPercent, average, p50, p95, site.ClustersCreated)
+	}
+	_ = writer.Flush()
+}
+
+// Writes the per-site summary of an import to a JSON file, as for --output-site-summary
+func writeSiteImportSummary(path string, sites []siteImportSummary) error {
+	data, err := json.MarshalIndent(sites, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("cannot write the site summary: %w", err)
+	}
+	return nil
+}
+
+// Prints the outcome of an import as JSON, with the host ID or error of every row
+func printImportReportJSON(w io.Writer, registrations []types.HostRegistration) error {
+	report := hostImportReport{Total: len(registrations), Sites: summarizeImportBySite(registrations), Hosts: registrations}
+	for _, registration := range registrations {
+		if registration.Status == types.RegistrationRegistered {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+	if report.Hosts == nil {
+		report.Hosts = []types.HostRegistration{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
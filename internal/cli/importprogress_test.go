@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportProgressLive(t *testing.T) {
+	var bar bytes.Buffer
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &importProgress{bar: &bar, live: true, total: 4, start: clock, now: func() time.Time { return clock }}
+
+	assert.Equal(t, "[..............................] 0/4 rows | 0 succeeded | 0 failed | ETA --", p.status())
+
+	clock = clock.Add(10 * time.Second)
+	p.recordSuccess()
+	assert.Equal(t, "[#######.......................] 1/4 rows | 1 succeeded | 0 failed | ETA 30s", p.status())
+
+	clock = clock.Add(10 * time.Second)
+	p.recordFailure()
+	p.done()
+	assert.Equal(t, "[###############...............] 2/4 rows | 1 succeeded | 1 failed | ETA 20s", p.status())
+
+	// The bar is redrawn in place
+	assert.Equal(t, 2, strings.Count(bar.String(), "\r"))
+	assert.True(t, strings.HasSuffix(bar.String(), "\n"))
+}
+
+func TestImportProgressNotLive(t *testing.T) {
+	var bar bytes.Buffer
+	p := &importProgress{bar: &bar, total: 2, now: time.Now}
+	p.recordSuccess()
+	p.recordFailure()
+	p.done()
+	assert.Empty(t, bar.String(), "registered hosts are only reported by the summary")
+}
+
+func TestPrintImportSummary(t *testing.T) {
+	var out bytes.Buffer
+	printImportSummary(&out, 3, []types.HostRecord{{Serial: "2500JF3", Error: "Host already registered"}})
+	assert.Equal(t, "2 of 3 host(s) imported, 1 failed\n  Serial number : 2500JF3  UUID :  - Host already registered\n", out.String())
+
+	failures := make([]types.HostRecord, maxSummarizedFailures+5)
+	for i := range failures {
+		failures[i] = types.HostRecord{Serial: fmt.Sprintf("SN%d", i), Error: "Invalid Site"}
+	}
+	out.Reset()
+	printImportSummary(&out, 100, failures)
+	assert.Contains(t, out.String(), "75 of 100 host(s) imported, 25 failed\n")
+	assert.Contains(t, out.String(), "  ... and 5 more\n")
+	assert.NotContains(t, out.String(), "SN20 ")
+}
+
+func TestPrintImportReportJSON(t *testing.T) {
+	var out bytes.Buffer
+	err := printImportReportJSON(&out, []types.HostRegistration{
+		{Serial: "2500JF3", UUID: "4c4c4544-2046-5310-8052-cac04f515233", HostID: "host-1234abcd", Status: types.RegistrationRegistered},
+		{Serial: "2500JF4", Status: types.RegistrationFailed, ErrorCode: "already_exists", Error: "Host already registered"},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total":2,"succeeded":1,"failed":1,
+		"sites":[{"site":"","attempted":2,"succeeded":1,"failed":1,"succeededPercent":50,"averageRegistrationSeconds":0,
+		"p50RegistrationSeconds":0,"p95RegistrationSeconds":0,"clustersCreated":0}],
+		"hosts":[
+		{"serial":"2500JF3","uuid":"4c4c4544-2046-5310-8052-cac04f515233","hostId":"host-1234abcd","status":"registered"},
+		{"serial":"2500JF4","uuid":"","status":"failed","errorCode":"already_exists","error":"Host already registered"}]}`, out.String())
+
+	out.Reset()
+	assert.NoError(t, printImportReportJSON(&out, nil))
+	assert.JSONEq(t, `{"total":0,"succeeded":0,"failed":0,"sites":[],"hosts":[]}`, out.String())
+}
+
+func TestSiteImportSummary(t *testing.T) {
+	sites := summarizeImportBySite([]types.HostRegistration{
+		{Serial: "SN1", Site: "site-b", Status: types.RegistrationRegistered, Duration: 2 * time.Second, ClusterCreated: true},
+		{Serial: "SN2", Site: "site-a", Status: types.RegistrationFailed, Duration: time.Second},
+		{Serial: "SN3", Site: "site-b", Status: types.RegistrationRegistered, Duration: 1500 * time.Millisecond},
+		{Serial: "SN4", Site: "site-b", Status: types.RegistrationFailed, Duration: 10 * time.Second},
+	})
+	assert.Equal(t, []siteImportSummary{
+		{Site: "site-a", Attempted: 1, Failed: 1},
+		{Site: "site-b", Attempted: 3, Succeeded: 2, Failed: 1, SucceededPercent: 66.7, AverageRegistrationSeconds: 1.75,
+			P50RegistrationSeconds: 1.5, P95RegistrationSeconds: 2, ClustersCreated: 1,
+			registrationTimes: []time.Duration{1500 * time.Millisecond, 2 * time.Second}},
+	}, sites)
+
+	var out bytes.Buffer
+	printSiteImportSummary(&out, sites)
+	assert.Equal(t, "Site     Attempted   Succeeded   Failed   Success Rate   Avg Registration Time   P50    P95   Clusters Created\n"+
+		"site-a   1           0           1        0.0%           -                       -      -     0\n"+
+		"site-b   3           2           1        66.7%          1.75s                   1.5s   2s    1\n", out.String())
+
+	out.Reset()
+	printSiteImportSummary(&out, summarizeImportBySite([]types.HostRegistration{{Serial: "SN1", Status: types.RegistrationRegistered}}))
+	assert.Empty(t, out.String(), "hosts onboarded without a site have no per-site breakdown")
+}
+
+func TestWriteSiteImportSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sites.json")
+	err := writeSiteImportSummary(path, []siteImportSummary{
+		{Site: "site-a", Attempted: 2, Succeeded: 2, SucceededPercent: 100, AverageRegistrationSeconds: 1.2,
+			P50RegistrationSeconds: 1.1, P95RegistrationSeconds: 1.3, ClustersCreated: 2},
+	})
+	assert.NoError(t, err)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"site":"site-a","attempted":2,"succeeded":2,"failed":0,"succeededPercent":100,"averageRegistrationSeconds":1.2,`+
+		`"p50RegistrationSeconds":1.1,"p95RegistrationSeconds":1.3,"clustersCreated":2}]`, string(data))
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	waitForHostReadyFlag    = "wait-for-host-ready"
+	hostReadyTimeoutFlag    = "host-ready-timeout"
+	defaultHostReadyTimeout = 30 * time.Minute
+)
+
+// deferredCluster is the cluster of a registered host, created by --wait-for-host-ready once the instance of the
+// host is provisioned rather than right after the registration
+type deferredCluster struct {
+	HostID string
+	// Record is the sanitized record the cluster is created from, Row the record as read from the CSV file
+	Record *types.HostRecord
+	Row    types.HostRecord
+	// Registration is the index of the outcome of the host in the registrations of the import
+	Registration int
+}
+
+// Adds the flags deferring the cluster creation of a CSV import until the hosts are provisioned to a command
+func addHostReadyFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(waitForHostReadyFlag, false, "Create the clusters requested by the CSV file only once the instances of their hosts are running, so that no cluster is left behind when provisioning fails")
+	cmd.PersistentFlags().Duration(hostReadyTimeoutFlag, defaultHostReadyTimeout, "Maximum time to wait for the hosts to be running with --wait-for-host-ready, their clusters are not created past it")
+}
+
+// Reads --wait-for-host-ready and its timeout; a zero timeout means the cluster creation is not deferred
+func getHostReadyTimeout(cmd *cobra.Command) (time.Duration, error) {
+	wait, _ := cmd.Flags().GetBool(waitForHostReadyFlag)
+	if !wait {
+		return 0, nil
+	}
+	timeout, _ := cmd.Flags().GetDuration(hostReadyTimeoutFlag)
+	if timeout <= 0 {
+		return 0, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s must be a positive duration, got %s", hostReadyTimeoutFlag, timeout))
+	}
+	return timeout, nil
+}
+
+// Polls the instances of the hosts of the deferred clusters and creates the cluster of each host once its instance
+// is running. Returns the error of each deferred cluster, nil when it was created; the cluster is not created when
+// the provisioning of its host failed or the host was not running within the timeout.
+func createDeferredClusters(cmd *cobra.Command, ctx context.Context, ctx2 context.Context, hClient infra.ClientWithResponsesInterface,
+	cClient cluster.ClientWithResponsesInterface, respCache ResponseCache, projectName string, deferred []deferredCluster,
+	timeout time.Duration, interval time.Duration) []error {
+
+	failed := make([]error, len(deferred))
+	if len(deferred) == 0 {
+		return failed
+	}
+	if quiet, _ := cmd.Flags().GetBool("quiet"); !quiet {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Waiting up to %s for %d host(s) to be running before creating their clusters\n", timeout, len(deferred))
+	}
+
+	deadline, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// Indices of the deferred clusters whose host is not running yet
+	pending := make([]int, len(deferred))
+	for i := range deferred {
+		pending[i] = i
+	}
+	last := make([]string, len(deferred))
+	for {
+		waiting := pending[:0:0]
+		for _, i := range pending {
+			d := deferred[i]
+			state, err := probeHostReady(ctx, hClient, projectName, d.HostID)
+			switch {
+			case err != nil:
+				failed[i] = err
+			case state.Final:
+				failed[i] = e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("cluster not created, provisioning of host %s failed: %s", d.HostID, state.Status))
+			case state.Met:
+				if err := createCluster(ctx2, cClient, respCache, projectName, d.HostID, d.Record); err != nil {
+					failed[i] = err
+				}
+			default:
+				last[i] = state.Status
+				waiting = append(waiting, i)
+			}
+		}
+		pending = waiting
+		if len(pending) == 0 {
+			return failed
+		}
+		select {
+		case <-deadline.Done():
+			for _, i := range pending {
+				failed[i] = e.WithCode(e.CodeDeadlineExceeded, fmt.Errorf("cluster not created, host %s was not running within %s, last status: %s", deferred[i].HostID, timeout, last[i]))
+			}
+			return failed
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Reads whether the instance of a host is running; a host without an instance yet is not ready
+func probeHostReady(ctx context.Context, hClient infra.ClientWithResponsesInterface, projectName string, hostID string) (waitState, error) {
+	resp, err := hClient.HostServiceGetHostWithResponse(ctx, projectName, hostID, auth.AddAuthHeader)
+	if err != nil {
+		return waitState{}, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving host"); err != nil {
+		return waitState{}, err
+	}
+	if resp.JSON200.Instance == nil || resp.JSON200.Instance.ResourceId == nil {
+		return waitState{Status: "no instance"}, nil
+	}
+
+	iresp, err := hClient.InstanceServiceGetInstanceWithResponse(ctx, projectName, *resp.JSON200.Instance.ResourceId, auth.AddAuthHeader)
+	if err != nil {
+		return waitState{}, processError(err)
+	}
+	if err := checkResponse(iresp.HTTPResponse, iresp.Body, "error getting instance"); err != nil {
+		return waitState{}, err
+	}
+	instance := iresp.JSON200
+	current := safeString((*string)(instance.CurrentState))
+	provisioning := safeString(instance.ProvisioningStatus)
+	return waitState{
+		Status: waitStatusSummary("state", current, "provisioning", provisioning),
+		Met: statusMatchesCondition(current, "running") || statusMatchesCondition(provisioning, "completed") ||
+			statusMatchesCondition(provisioning, "provisioned"),
+		Final: instance.ProvisioningStatusIndicator != nil && *instance.ProvisioningStatusIndicator == infra.STATUSINDICATIONERROR,
+	}, nil
+}
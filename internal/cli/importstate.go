@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	stateFileFlag = "state-file"
+	rateLimitFlag = "rate-limit"
+)
+
+// importStateEntry is a host registered by a CSV import
+type importStateEntry struct {
+	HostID     string    `json:"hostId"`
+	Registered time.Time `json:"registered"`
+}
+
+// importStateFile is the checkpoint of a CSV import, the hosts it registered keyed by serial number and UUID
+type importStateFile struct {
+	Project string                      `json:"project"`
+	Hosts   map[string]importStateEntry `json:"hosts"`
+}
+
+// importState checkpoints the rows of a CSV import as they are registered so that an interrupted import can be
+// resumed without registering the same hosts again
+type importState struct {
+	path string
+	file importStateFile
+}
+
+// Adds the flags controlling the pace and the resumption of a CSV import to a command
+func addImportBatchFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(stateFileFlag, "", "File recording the rows registered by a CSV import, rows already recorded are skipped when the import is run again")
+	cmd.PersistentFlags().Float64(rateLimitFlag, 0, "Maximum number of hosts registered per second during a CSV import, 0 for no limit")
+}
+
+// Loads the --state-file of the command, which is created by the first import; returns nil when no state file is given
+func loadImportState(cmd *cobra.Command, projectName string) (*importState, error) {
+	path, _ := cmd.Flags().GetString(stateFileFlag)
+	if path == "" {
+		return nil, nil
+	}
+	state := &importState{path: path, file: importStateFile{Project: projectName, Hosts: map[string]importStateEntry{}}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the import state: %w", err)
+	}
+	var file importStateFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("import state %s is not valid: %w", path, err))
+	}
+	if file.Project != projectName {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("import state %s belongs to project %s, not %s", path, file.Project, projectName))
+	}
+	for key, entry := range file.Hosts {
+		state.file.Hosts[key] = entry
+	}
+	return state, nil
+}
+
+func importStateKey(record types.HostRecord) string {
+	return record.Serial + "/" + record.UUID
+}
+
+// Returns the ID of the host registered for the record by a previous run of the import
+func (s *importState) registered(record types.HostRecord) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	entry, ok := s.file.Hosts[importStateKey(record)]
+	return entry.HostID, ok
+}
+
+// Records the host registered for the record; the state is written after every row so that
+// an import interrupted at any point resumes after the last registered host
+func (s *importState) record(record types.HostRecord, hostID string) error {
+	if s == nil {
+		return nil
+	}
+	s.file.Hosts[importStateKey(record)] = importStateEntry{HostID: hostID, Registered: time.Now().UTC()}
+	data, err := json.MarshalIndent(s.file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("failed to write the import state: %w", err)
+		}
+	}
+	// Write to a temporary file first so an interrupted import never leaves a partial state
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write the import state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write the import state: %w", err)
+	}
+	return nil
+}
+
+// importPacer spaces the registrations of an import to at most --rate-limit hosts per second
+type importPacer struct {
+	interval time.Duration
+	next     time.Time
+}
+
+func newImportPacer(cmd *cobra.Command) (*importPacer, error) {
+	rate, _ := cmd.Flags().GetFloat64(rateLimitFlag)
+	if rate < 0 {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s must not be negative, got %g", rateLimitFlag, rate))
+	}
+	if rate == 0 {
+		return &importPacer{}, nil
+	}
+	return &importPacer{interval: time.Duration(float64(time.Second) / rate)}, nil
+}
+
+// Waits for the next registration slot, or until the context is done
+func (p *importPacer) wait(ctx context.Context) error {
+	if p.interval == 0 {
+		return nil
+	}
+	if delay := time.Until(p.next); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	p.next = time.Now().Add(p.interval)
+	return nil
+}
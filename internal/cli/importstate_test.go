@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestCreateHostImportState() {
+	statePath := filepath.Join(s.T().TempDir(), "import.state")
+
+	// The registered rows are checkpointed
+	out, err := s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", stateFileFlag: statePath})
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
+	s.NotContains(out, "previous run")
+	data, err := os.ReadFile(statePath)
+	s.NoError(err)
+	var state importStateFile
+	s.NoError(json.Unmarshal(data, &state))
+	s.Equal(project, state.Project)
+	s.Contains(state.Hosts, "SN123456789/550e8400-e29b-41d4-a716-446655440000")
+	hostID := state.Hosts["SN123456789/550e8400-e29b-41d4-a716-446655440000"].HostID
+	s.NotEmpty(hostID)
+
+	// Resuming skips the rows already registered
+	out, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", stateFileFlag: statePath, "output-type": "json"})
+	s.NoError(err)
+	s.Contains(out, `"succeeded": 1`)
+	s.Contains(out, `"hostId": "`+hostID+`"`)
+	s.Contains(out, `"resumed": true`)
+
+	// A host registered by an interrupted run is not registered again, which would fail as a duplicate
+	duplicatePath := filepath.Join(s.T().TempDir(), "duplicate.state")
+	s.NoError(os.WriteFile(duplicatePath, []byte(`{"project": "duplicate-host-project", "hosts": {"SN123456789/550e8400-e29b-41d4-a716-446655440000": {"hostId": "host-abc12345"}}}`), 0600))
+	out, err = s.createHost("duplicate-host-project", commandArgs{"import-from-csv": "./testdata/mock.csv", stateFileFlag: duplicatePath})
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed\n1 host(s) registered by a previous run of the import were skipped\n")
+
+	_, err = s.createHost("duplicate-host-project", commandArgs{"import-from-csv": "./testdata/mock.csv", stateFileFlag: statePath})
+	s.EqualError(err, "import state "+statePath+" belongs to project "+project+", not duplicate-host-project")
+
+	s.NoError(os.WriteFile(statePath, []byte("{"), 0600))
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", stateFileFlag: statePath})
+	s.ErrorContains(err, "import state "+statePath+" is not valid")
+
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", rateLimitFlag: "-1"})
+	s.EqualError(err, "--rate-limit must not be negative, got -1")
+
+	_, err = s.createHostSingle(project, "edge-host-001", commandArgs{"uuid": "550e8400-e29b-41d4-a716-446655440000",
+		"serial": "1234567890", "site": "site-abcd1111", "os-profile": "Edge Microvisor Toolkit 3.0.20250504", stateFileFlag: statePath})
+	s.EqualError(err, "--state-file can only be used with --import-from-csv")
+}
+
+func TestImportPacer(t *testing.T) {
+	pacer := &importPacer{interval: 20 * time.Millisecond}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, pacer.wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, pacer.wait(ctx), context.Canceled)
+
+	// Without a rate limit registrations are not delayed
+	assert.NoError(t, (&importPacer{}).wait(ctx))
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const setInstanceExamples = `# Attach two Cloud Init custom configs to a provisioned instance
+orch-cli set instance inst-1234abcd --add-cloud-init "nginx-config&ntp-config" --project some-project
+
+# Replace a Cloud Init custom config of an instance with another one
+orch-cli set instance inst-1234abcd --add-cloud-init ntp-config-v2 --remove-cloud-init ntp-config --project some-project
+
+--add-cloud-init - Custom configs given by name or resource ID to attach to the instance, separated by '&'
+--remove-cloud-init - Custom configs given by name or resource ID to detach from the instance, separated by '&'
+`
+
+func getSetInstanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance <resourceID> [flags]",
+		Short: "Updates the Cloud Init custom configs of an instance",
+		Long: "Attaches custom configs to and detaches custom configs from an existing instance, without recreating it. " +
+			"The custom configs currently attached to the instance and not removed are kept.",
+		Example: setInstanceExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: instanceAliases,
+		RunE:    runSetInstanceCommand,
+	}
+	cmd.Flags().String("add-cloud-init", "", "Custom configs to attach to the instance, given by name or resource ID and separated by '&'")
+	cmd.Flags().String("remove-cloud-init", "", "Custom configs to detach from the instance, given by name or resource ID and separated by '&'")
+	cmd.MarkFlagsOneRequired("add-cloud-init", "remove-cloud-init")
+	return cmd
+}
+
+func runSetInstanceCommand(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	addFlag, _ := cmd.Flags().GetString("add-cloud-init")
+	removeFlag, _ := cmd.Flags().GetString("remove-cloud-init")
+
+	ctx, instanceClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	resp, err := instanceClient.InstanceServiceGetInstanceWithResponse(ctx, projectName, instanceID, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting instance"); err != nil {
+		return err
+	}
+	current := instanceCustomConfigs(*resp.JSON200)
+
+	var added []infra.CustomConfigResource
+	for _, query := range *breakupCloudInitMetadata(addFlag) {
+		config, err := getCustomConfigByNameOrID(ctx, instanceClient, projectName, strings.TrimSpace(query))
+		if err != nil {
+			return err
+		}
+		added = append(added, *config)
+	}
+
+	configIDs, err := updatedCustomConfigIDs(current, added, *breakupCloudInitMetadata(removeFlag))
+	if err != nil {
+		return err
+	}
+	currentIDs := make([]string, 0, len(current))
+	for _, config := range current {
+		currentIDs = append(currentIDs, derefString(config.ResourceId))
+	}
+	if slices.Equal(configIDs, currentIDs) {
+		fmt.Fprintf(cmd.OutOrStdout(), "Custom configs of instance %s are unchanged\n", instanceID)
+		return nil
+	}
+
+	fieldMask := "customConfigID"
+	presp, err := instanceClient.InstanceServicePatchInstanceWithResponse(ctx, projectName, instanceID,
+		&infra.InstanceServicePatchInstanceParams{FieldMask: &fieldMask},
+		infra.InstanceServicePatchInstanceJSONRequestBody{
+			CustomConfigID: &configIDs,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(presp.HTTPResponse, presp.Body, "error while setting custom configs of instance"); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Instance %s now has %d custom config(s)\n", instanceID, len(configIDs))
+	return nil
+}
+
+// Returns the custom configs attached to an instance; an instance may only give the resource IDs of its custom configs
+func instanceCustomConfigs(instance infra.InstanceResource) []infra.CustomConfigResource {
+	if instance.CustomConfig != nil && len(*instance.CustomConfig) > 0 {
+		return *instance.CustomConfig
+	}
+	var configs []infra.CustomConfigResource
+	if instance.CustomConfigID != nil {
+		for _, id := range *instance.CustomConfigID {
+			configs = append(configs, infra.CustomConfigResource{ResourceId: &id})
+		}
+	}
+	return configs
+}
+
+// Returns the resource IDs of the custom configs of an instance once the added ones are attached and the removed
+// ones, given by name or resource ID, are detached; custom configs already attached are not attached twice
+func updatedCustomConfigIDs(current []infra.CustomConfigResource, added []infra.CustomConfigResource, removed []string) ([]string, error) {
+	removedIDs := map[string]bool{}
+	for _, query := range removed {
+		query = strings.TrimSpace(query)
+		found := false
+		for _, config := range current {
+			if derefString(config.ResourceId) == query || (config.Name != "" && config.Name == query) {
+				removedIDs[derefString(config.ResourceId)] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("custom config %s is not attached to the instance", query))
+		}
+	}
+
+	ids := make([]string, 0, len(current)+len(added))
+	attached := map[string]bool{}
+	for _, config := range slices.Concat(current, added) {
+		id := derefString(config.ResourceId)
+		if id == "" {
+			return nil, errors.New("custom config without a resource ID")
+		}
+		if removedIDs[id] || attached[id] {
+			continue
+		}
+		attached[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) setInstance(project string, instanceID string, args commandArgs) (string, error) {
+	commandString := addCommandArgs(args, fmt.Sprintf(`set instance %s --project %s`, instanceID, project))
+	return s.runCommand(commandString)
+}
+
+func (s *CLITestSuite) TestSetInstance() {
+	instanceID := "inst-abc12345"
+
+	out, err := s.setInstance(project, instanceID, map[string]string{
+		"remove-cloud-init": "haproxy-config",
+	})
+	s.NoError(err)
+	s.Contains(out, "Instance inst-abc12345 now has 0 custom config(s)")
+
+	// The custom config is already attached to the instance
+	out, err = s.setInstance(project, instanceID, map[string]string{
+		"add-cloud-init": "haproxy-config",
+	})
+	s.NoError(err)
+	s.Contains(out, "Custom configs of instance inst-abc12345 are unchanged")
+
+	_, err = s.setInstance(project, instanceID, map[string]string{
+		"remove-cloud-init": "ntp-config",
+	})
+	s.EqualError(err, "custom config ntp-config is not attached to the instance")
+
+	_, err = s.setInstance(project, instanceID, map[string]string{})
+	s.Error(err)
+
+	_, err = s.setInstance("instance-not-found-project", instanceID, map[string]string{
+		"remove-cloud-init": "haproxy-config",
+	})
+	s.Error(err)
+}
+
+func TestUpdatedCustomConfigIDs(t *testing.T) {
+	nginxID, ntpID, haproxyID := "config-nginx", "config-ntp", "config-haproxy"
+	current := []infra.CustomConfigResource{
+		{Name: "nginx-config", ResourceId: &nginxID},
+		{Name: "ntp-config", ResourceId: &ntpID},
+	}
+	added := []infra.CustomConfigResource{
+		{Name: "haproxy-config", ResourceId: &haproxyID},
+		{Name: "nginx-config", ResourceId: &nginxID},
+	}
+
+	ids, err := updatedCustomConfigIDs(current, added, []string{"ntp-config"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{nginxID, haproxyID}, ids)
+
+	ids, err = updatedCustomConfigIDs(current, nil, []string{nginxID, " ntp-config"})
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	_, err = updatedCustomConfigIDs(current, nil, []string{"haproxy-config"})
+	assert.EqualError(t, err, "custom config haproxy-config is not attached to the instance")
+}
+
+func TestInstanceCustomConfigs(t *testing.T) {
+	ids := []string{"config-nginx", "config-ntp"}
+	configs := instanceCustomConfigs(infra.InstanceResource{CustomConfigID: &ids})
+	require.Len(t, configs, 2)
+	assert.Equal(t, "config-nginx", *configs[0].ResourceId)
+	assert.Equal(t, "config-ntp", *configs[1].ResourceId)
+
+	assert.Empty(t, instanceCustomConfigs(infra.InstanceResource{}))
+}
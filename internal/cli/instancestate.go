@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+// Stopped state of an instance; it is not part of the infra API definition used by the client yet, Edge
+// Orchestrators that do not support stopping instances reject it
+const instanceStateStopped infra.InstanceState = "INSTANCE_STATE_STOPPED"
+
+const startInstanceExamples = `# Start an instance after a maintenance window
+orch-cli start instance inst-1234abcd --project some-project
+
+# Start an instance and wait up to 10 minutes for it to be running
+orch-cli start instance inst-1234abcd --wait --timeout 10m --project some-project
+`
+
+const stopInstanceExamples = `# Stop an instance before a maintenance of its host
+orch-cli stop instance inst-1234abcd --project some-project
+
+# Stop an instance and wait for it to be stopped, checking every 30 seconds
+orch-cli stop instance inst-1234abcd --wait --interval 30s --timeout 15m --project some-project
+`
+
+func getStartCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "start",
+		Short:             "Start Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getStartInstanceCommand(),
+	)
+	return cmd
+}
+
+func getStopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "stop",
+		Short:             "Stop Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getStopInstanceCommand(),
+	)
+	return cmd
+}
+
+func getStartInstanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance <resourceID> [flags]",
+		Short: "Starts an instance",
+		Long: "Sets the desired state of an instance to running. The instance is started by the Edge Orchestrator " +
+			"asynchronously; with --wait the command returns once its current state is running, bounded by --timeout.",
+		Example: startInstanceExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: instanceAliases,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSetInstanceStateCommand(cmd, args[0], infra.INSTANCESTATERUNNING, "start")
+		},
+	}
+	addInstanceStateFlags(cmd)
+	return cmd
+}
+
+func getStopInstanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance <resourceID> [flags]",
+		Short: "Stops an instance",
+		Long: "Sets the desired state of an instance to stopped, e.g. before a maintenance of its host. The instance " +
+			"is stopped by the Edge Orchestrator asynchronously; with --wait the command returns once its current " +
+			"state is stopped, bounded by --timeout. The power of the host is not changed, see set host --power.",
+		Example: stopInstanceExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: instanceAliases,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSetInstanceStateCommand(cmd, args[0], instanceStateStopped, "stop")
+		},
+	}
+	addInstanceStateFlags(cmd)
+	return cmd
+}
+
+func addInstanceStateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("wait", false, "Wait until the current state of the instance is the desired one, bounded by --timeout")
+	cmd.Flags().Duration("interval", defaultWaitInterval, "Time between two polls of the instance state with --wait")
+}
+
+// Patches the desired state of an instance and, with --wait, polls its current state until it is reached
+func runSetInstanceStateCommand(cmd *cobra.Command, instanceID string, state infra.InstanceState, verb string) error {
+	wait, _ := cmd.Flags().GetBool("wait")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if wait && interval <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be a positive duration, got %s", interval))
+	}
+
+	ctx, instanceClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	fieldMask := "desiredState"
+	resp, err := instanceClient.InstanceServicePatchInstanceWithResponse(ctx, projectName, instanceID,
+		&infra.InstanceServicePatchInstanceParams{FieldMask: &fieldMask},
+		infra.InstanceServicePatchInstanceJSONRequestBody{
+			DesiredState: &state,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while requesting to %s instance", verb)); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Instance %s desired state set to %s\n", instanceID, state)
+	if !wait {
+		return nil
+	}
+
+	return pollUntil(cmd, "instance "+instanceID, string(state), interval, func() (waitState, error) {
+		resp, err := instanceClient.InstanceServiceGetInstanceWithResponse(ctx, projectName, instanceID, auth.AddAuthHeader)
+		if err != nil {
+			return waitState{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting instance"); err != nil {
+			return waitState{}, err
+		}
+		current := safeString((*string)(resp.JSON200.CurrentState))
+		return waitState{
+			Status: waitStatusSummary("state", current),
+			Met:    current == string(state),
+			// The instance is gone, it will not reach any other state
+			Final: current == string(infra.INSTANCESTATEDELETED),
+		}, nil
+	})
+}
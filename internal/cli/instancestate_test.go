@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+func (s *CLITestSuite) TestInstanceState() {
+	out, err := s.runCommand("start instance instance-abcd1234 --project " + project)
+	s.NoError(err)
+	s.Equal("Instance instance-abcd1234 desired state set to INSTANCE_STATE_RUNNING\n", out)
+
+	out, err = s.runCommand("start instance instance-abcd1234 --wait --project " + project)
+	s.NoError(err)
+	s.Contains(out, "instance instance-abcd1234 condition INSTANCE_STATE_RUNNING met: state INSTANCE_STATE_RUNNING\n")
+
+	out, err = s.runCommand("stop instance instance-abcd1234 --project " + project)
+	s.NoError(err)
+	s.Equal("Instance instance-abcd1234 desired state set to INSTANCE_STATE_STOPPED\n", out)
+
+	// The mocked instance keeps running, waiting for it to stop ends at the timeout
+	out, err = s.runCommand("stop instance instance-abcd1234 --wait --interval 10ms --timeout 50ms --project " + project)
+	s.EqualError(err, "command timed out after 50ms: instance instance-abcd1234 did not meet condition INSTANCE_STATE_STOPPED, last status: state INSTANCE_STATE_RUNNING")
+	s.Contains(out, "instance instance-abcd1234: state INSTANCE_STATE_RUNNING, waiting for condition INSTANCE_STATE_STOPPED\n")
+
+	_, err = s.runCommand("stop instance instance-abcd1234 --wait --interval 0s --project " + project)
+	s.EqualError(err, "--interval must be a positive duration, got 0s")
+}
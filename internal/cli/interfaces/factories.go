@@ -6,6 +6,7 @@ package interfaces // nolint:revive
 import (
 	"context"
 
+	alertapi "github.com/open-edge-platform/cli/pkg/rest/alerting"
 	catapi "github.com/open-edge-platform/cli/pkg/rest/catalog"
 	catutilapi "github.com/open-edge-platform/cli/pkg/rest/catalogutilities"
 	cluster "github.com/open-edge-platform/cli/pkg/rest/cluster"
@@ -33,3 +34,4 @@ type TenancyFactoryFunc func(cmd *cobra.Command) (context.Context, tenantapi.Cli
 type OrchestratorFactoryFunc func(cmd *cobra.Command) (context.Context, orchapi.ClientWithResponsesInterface, error)
 type KeycloakAdminFactoryFunc func(cmd *cobra.Command) (context.Context, kcapi.ClientInterface, string, error)
 type PrometheusFactoryFunc func(cmd *cobra.Command) (promapi.Client, error)
+type AlertingFactoryFunc func(cmd *cobra.Command) (context.Context, alertapi.ClientWithResponsesInterface, string, error)
@@ -0,0 +1,415 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	asyncFlag = "async"
+	// Set in the environment of the process running a job to the ID of the job
+	jobIDEnv       = "ORCH_CLI_JOB_ID"
+	jobsDirName    = "jobs"
+	defaultJobTail = 20
+
+	jobRunning   = "running"
+	jobSucceeded = "succeeded"
+	jobFailed    = "failed"
+	// A running job whose process is gone without recording its result, e.g. killed or rebooted
+	jobLost = "lost"
+)
+
+const listJobsExamples = `# List the jobs started with --async, the most recent first
+orch-cli jobs list`
+
+const jobStatusExamples = `# Show the status of a job and the last lines of its output
+orch-cli jobs status job-1234abcd
+
+# Show the last 100 lines of its output
+orch-cli jobs status job-1234abcd --lines 100`
+
+// cliJob is a command run in the background with --async, stored under the config directory
+type cliJob struct {
+	ID      string     `json:"id" yaml:"id"`
+	Command string     `json:"command" yaml:"command"`
+	Args    []string   `json:"args" yaml:"args"`
+	Dir     string     `json:"dir" yaml:"dir"`
+	PID     int        `json:"pid,omitempty" yaml:"pid,omitempty"`
+	Status  string     `json:"status" yaml:"status"`
+	Started time.Time  `json:"started" yaml:"started"`
+	Ended   *time.Time `json:"ended,omitempty" yaml:"ended,omitempty"`
+	// Exit code of the command, see the --error-format documentation
+	ExitCode *int   `json:"exitCode,omitempty" yaml:"exitCode,omitempty"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+	LogFile  string `json:"logFile" yaml:"logFile"`
+}
+
+// Starts the process of a job; replaced in tests
+var startJobProcess = func(c *exec.Cmd) error {
+	detachJobProcess(c)
+	return c.Start()
+}
+
+func getJobsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Check the progress of the commands run in the background with --async",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getListJobsCommand(),
+		getJobStatusCommand(),
+	)
+	return cmd
+}
+
+func getListJobsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "Lists the jobs started with --async",
+		Example: listJobsExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runListJobsCommand,
+	}
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	return cmd
+}
+
+func getJobStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status <jobID> [flags]",
+		Short:   "Shows the status of a job and the last lines of its output",
+		Example: jobStatusExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runJobStatusCommand,
+	}
+	cmd.Flags().Int("lines", defaultJobTail, "Number of lines of the output of the job to show, 0 for none")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	return cmd
+}
+
+// Adds --async to a long-running command, which then calls startAsyncJob first
+func addAsyncFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(asyncFlag, false, "Run the command in the background and print the ID of its job, whose progress is shown by 'orch-cli jobs status <jobID>'")
+}
+
+// Runs the command again in a background process when --async is set and prints the ID of its job.
+// Returns true when the job was started, the command must then return without running.
+func startAsyncJob(cmd *cobra.Command, args []string) (bool, error) {
+	async, _ := cmd.Flags().GetBool(asyncFlag)
+	if !async || os.Getenv(jobIDEnv) != "" {
+		return false, nil
+	}
+	dir, err := jobsDir()
+	if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return false, fmt.Errorf("failed to create the jobs directory: %w", err)
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return false, err
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return false, err
+	}
+	id, err := newJobID()
+	if err != nil {
+		return false, err
+	}
+
+	jobArgs := asyncJobArgs(cmd, args)
+	job := &cliJob{
+		ID:      id,
+		Command: strings.Join(append([]string{cmd.Root().Name()}, jobArgs...), " "),
+		Args:    jobArgs,
+		Dir:     workDir,
+		Status:  jobRunning,
+		Started: time.Now().UTC(),
+		LogFile: filepath.Join(dir, id+".log"),
+	}
+	logFile, err := os.OpenFile(job.LogFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return false, fmt.Errorf("failed to create the output file of the job: %w", err)
+	}
+	defer logFile.Close()
+	// Recorded before the process starts, which records its own PID and result
+	if err := saveJob(job); err != nil {
+		return false, err
+	}
+
+	process := exec.Command(executable, jobArgs...)
+	process.Dir = workDir
+	process.Env = append(os.Environ(), jobIDEnv+"="+id)
+	process.Stdout = logFile
+	process.Stderr = logFile
+	if err := startJobProcess(process); err != nil {
+		_ = os.Remove(jobPath(dir, id))
+		_ = os.Remove(job.LogFile)
+		return false, fmt.Errorf("failed to start the job: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Job %s started, check its progress with '%s jobs status %s'\n", id, cmd.Root().Name(), id)
+	return true, nil
+}
+
+// Returns the arguments running the command again: its path, its arguments and the flags set, without --async
+func asyncJobArgs(cmd *cobra.Command, args []string) []string {
+	jobArgs := strings.Fields(cmd.CommandPath())[1:]
+	jobArgs = append(jobArgs, args...)
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Name == asyncFlag {
+			return
+		}
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			for _, value := range slice.GetSlice() {
+				jobArgs = append(jobArgs, fmt.Sprintf("--%s=%s", f.Name, value))
+			}
+			return
+		}
+		jobArgs = append(jobArgs, fmt.Sprintf("--%s=%s", f.Name, f.Value.String()))
+	})
+	return jobArgs
+}
+
+// Records the PID of the process running the job of the environment, if any
+func markJobStarted() {
+	id := os.Getenv(jobIDEnv)
+	if id == "" {
+		return
+	}
+	job, err := loadJob(id)
+	if err != nil {
+		return
+	}
+	job.PID = os.Getpid()
+	_ = saveJob(job)
+}
+
+// Records the result of the job of the environment, if any
+func markJobEnded(code int, cmdErr error) {
+	id := os.Getenv(jobIDEnv)
+	if id == "" {
+		return
+	}
+	job, err := loadJob(id)
+	if err != nil {
+		return
+	}
+	ended := time.Now().UTC()
+	job.Ended = &ended
+	job.ExitCode = &code
+	job.Status = jobSucceeded
+	if cmdErr != nil {
+		job.Status = jobFailed
+		job.Error = cmdErr.Error()
+	}
+	_ = saveJob(job)
+}
+
+func runListJobsCommand(cmd *cobra.Command, _ []string) error {
+	outputType, _ := cmd.Flags().GetString("output-type")
+	jobs, err := loadJobs()
+	if err != nil {
+		return err
+	}
+	if outputType == "json" || outputType == "yaml" {
+		GenerateOutput(cmd.OutOrStdout(), &CommandResult{OutputAs: toOutputType(outputType), Data: jobs})
+		return nil
+	}
+	if len(jobs) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No jobs found")
+		return nil
+	}
+	writer := newOutputWriter(cmd, cmd.OutOrStdout())
+	fmt.Fprintf(writer, "JOB ID\tSTATUS\tSTARTED\tDURATION\tCOMMAND\n")
+	for _, job := range jobs {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", job.ID, job.Status, job.Started.Format(scheduleDisplayTimeFormat), jobDuration(job), job.Command)
+	}
+	return writer.Flush()
+}
+
+func runJobStatusCommand(cmd *cobra.Command, args []string) error {
+	lines, _ := cmd.Flags().GetInt("lines")
+	outputType, _ := cmd.Flags().GetString("output-type")
+	if lines < 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--lines cannot be negative"))
+	}
+	job, err := loadJob(args[0])
+	if err != nil {
+		return err
+	}
+	refreshJobStatus(job)
+	if outputType == "json" || outputType == "yaml" {
+		GenerateOutput(cmd.OutOrStdout(), &CommandResult{OutputAs: toOutputType(outputType), Data: job})
+		return nil
+	}
+
+	writer := newOutputWriter(cmd, cmd.OutOrStdout())
+	fmt.Fprintf(writer, "Job ID: \t%s\n", job.ID)
+	fmt.Fprintf(writer, "Status: \t%s\n", job.Status)
+	fmt.Fprintf(writer, "Command: \t%s\n", job.Command)
+	fmt.Fprintf(writer, "Directory: \t%s\n", job.Dir)
+	fmt.Fprintf(writer, "Started: \t%s\n", job.Started.Format(scheduleDisplayTimeFormat))
+	if job.Ended != nil {
+		fmt.Fprintf(writer, "Ended: \t%s\n", job.Ended.Format(scheduleDisplayTimeFormat))
+	}
+	fmt.Fprintf(writer, "Duration: \t%s\n", jobDuration(*job))
+	if job.ExitCode != nil {
+		fmt.Fprintf(writer, "Exit Code: \t%d\n", *job.ExitCode)
+	}
+	if job.Error != "" {
+		fmt.Fprintf(writer, "Error: \t%s\n", job.Error)
+	}
+	fmt.Fprintf(writer, "Output File: \t%s\n", job.LogFile)
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if lines == 0 {
+		return nil
+	}
+	tail, err := tailFile(job.LogFile, lines)
+	if err != nil {
+		return fmt.Errorf("failed to read the output of job %s: %w", job.ID, err)
+	}
+	if len(tail) > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "\nLast lines of the output:\n%s\n", strings.Join(tail, "\n"))
+	}
+	return nil
+}
+
+// The job directory is next to the config file, like the host cache
+func jobsDir() (string, error) {
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return "", errors.New("no config directory to store the jobs in")
+	}
+	return filepath.Join(filepath.Dir(configFile), jobsDirName), nil
+}
+
+func jobPath(dir string, id string) string {
+	return filepath.Join(dir, id+".json")
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "job-" + hex.EncodeToString(b), nil
+}
+
+func saveJob(job *cliJob) error {
+	dir, err := jobsDir()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(jobPath(dir, job.ID), data, 0600)
+}
+
+func loadJob(id string) (*cliJob, error) {
+	dir, err := jobsDir()
+	if err != nil {
+		return nil, err
+	}
+	// The ID names a file, it must not reach out of the jobs directory
+	if id == "" || filepath.Base(id) != id {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid job ID %q", id))
+	}
+	data, err := os.ReadFile(jobPath(dir, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, e.WithCode(e.CodeNotFound, fmt.Errorf("job %s not found", id))
+	} else if err != nil {
+		return nil, err
+	}
+	job := &cliJob{}
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("job %s is not valid: %w", id, err)
+	}
+	return job, nil
+}
+
+// Loads the jobs, the most recent first; jobs that cannot be read are skipped
+func loadJobs() ([]cliJob, error) {
+	dir, err := jobsDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "job-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]cliJob, 0, len(files))
+	for _, file := range files {
+		job, err := loadJob(strings.TrimSuffix(filepath.Base(file), ".json"))
+		if err != nil {
+			continue
+		}
+		refreshJobStatus(job)
+		jobs = append(jobs, *job)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].Started.After(jobs[j].Started) })
+	return jobs, nil
+}
+
+// A running job whose process is gone did not record its result
+func refreshJobStatus(job *cliJob) {
+	if job.Status == jobRunning && job.PID != 0 && !processAlive(job.PID) {
+		job.Status = jobLost
+	}
+}
+
+func jobDuration(job cliJob) string {
+	end := time.Now()
+	if job.Ended != nil {
+		end = *job.Ended
+	}
+	return end.Sub(job.Started).Round(time.Second).String()
+}
+
+// Returns the last n lines of a file
+func tailFile(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestAsyncJobs() {
+	configFile := viper.ConfigFileUsed()
+	defer viper.SetConfigFile(configFile)
+	configDir := s.T().TempDir()
+	viper.SetConfigFile(filepath.Join(configDir, "orch-cli.yaml"))
+
+	defaultStart := startJobProcess
+	defer func() { startJobProcess = defaultStart }()
+	var started *exec.Cmd
+	startJobProcess = func(c *exec.Cmd) error {
+		started = c
+		return nil
+	}
+
+	out, err := s.runCommand("jobs list")
+	s.NoError(err)
+	s.Equal("No jobs found\n", out)
+
+	// The command is run again in the background without --async
+	out, err = s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --region region-abcd1234 --batch-size 2 --async --project " + project)
+	s.NoError(err)
+	s.Regexp(`^Job job-[0-9a-f]{8} started, check its progress with 'orch-cli jobs status job-[0-9a-f]{8}'\n$`, out)
+	jobID := regexp.MustCompile(`job-[0-9a-f]{8}`).FindString(out)
+	s.Require().NotNil(started)
+	s.Equal([]string{"rollout", "osupdate"}, started.Args[1:3])
+	s.Contains(started.Args, "--batch-size=2")
+	s.Contains(started.Args, "--policy=osupdatepolicy-1234abcd")
+	s.False(slices.ContainsFunc(started.Args, func(arg string) bool { return arg == "--async" || arg == "--async=true" }))
+	s.Contains(started.Env, jobIDEnv+"="+jobID)
+
+	out, err = s.runCommand("jobs list")
+	s.NoError(err)
+	s.Regexp(`(?m)^`+jobID+`\s+\|running\s+\|.*\|orch-cli rollout osupdate --`, out)
+
+	// The process of the job records its PID and its result
+	s.T().Setenv(jobIDEnv, jobID)
+	markJobStarted()
+	job, err := loadJob(jobID)
+	s.NoError(err)
+	s.Equal(os.Getpid(), job.PID)
+	s.NoError(os.WriteFile(job.LogFile, []byte("batch 1 of 1\nRollout failed\n"), 0600))
+	markJobEnded(1, errors.New("OS update of 1 host failed"))
+	s.T().Setenv(jobIDEnv, "")
+
+	out, err = s.runCommand("jobs status " + jobID + " --lines 1")
+	s.NoError(err)
+	s.Regexp(`(?m)^Status:\s+\|failed`, out)
+	s.Regexp(`(?m)^Exit Code:\s+\|1`, out)
+	s.Regexp(`(?m)^Error:\s+\|OS update of 1 host failed`, out)
+	s.Contains(out, "\nLast lines of the output:\nRollout failed\n")
+	s.NotContains(out, "batch 1 of 1")
+
+	// A running job whose process is gone is lost
+	job.Status, job.Ended, job.ExitCode, job.PID = jobRunning, nil, nil, 1<<30
+	s.NoError(saveJob(job))
+	out, err = s.runCommand("jobs status " + jobID + " --lines 0")
+	s.NoError(err)
+	s.Regexp(`(?m)^Status:\s+\|lost`, out)
+
+	_, err = s.runCommand("jobs status job-0000abcd")
+	s.EqualError(err, "job job-0000abcd not found")
+	_, err = s.runCommand("jobs status ../orch-cli")
+	s.EqualError(err, `invalid job ID "../orch-cli"`)
+}
+
+func TestProcessAlive(t *testing.T) {
+	assert.True(t, processAlive(os.Getpid()))
+
+	exited := exec.Command(os.Args[0], "-test.run=^$")
+	require.NoError(t, exited.Run())
+	assert.False(t, processAlive(exited.Process.Pid))
+}
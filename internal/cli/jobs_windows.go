@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package cli
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+const (
+	// Access right to query the exit code of a process, granted on more processes than PROCESS_QUERY_INFORMATION
+	processQueryLimitedInformation = 0x1000
+	// Exit code of a process which has not exited
+	stillActive = 259
+)
+
+// Runs the process of a job in its own process group, so that Ctrl+C in the console does not stop it
+func detachJobProcess(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// Reports whether a process runs, from the exit code of its handle since Windows processes cannot be sent signal 0
+func processAlive(pid int) bool {
+	handle, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
+	if err != nil {
+		// The process exists but belongs to another user
+		return errors.Is(err, syscall.ERROR_ACCESS_DENIED)
+	}
+	defer func() { _ = syscall.CloseHandle(handle) }()
+	var exitCode uint32
+	if err := syscall.GetExitCodeProcess(handle, &exitCode); err != nil {
+		return false
+	}
+	return exitCode == stillActive
+}
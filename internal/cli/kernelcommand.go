@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Maximum kernel command line length accepted by the x86 boot protocol
+	maxKernelCommandLength = 2048
+
+	// Config key holding a comma separated list of parameters that are never allowed, for all projects.
+	// Per-project lists are stored under kernel-command.projects.<project>.forbidden-params
+	kernelCommandForbiddenKey = "kernel-command.forbidden-params"
+)
+
+// Parameters that stop the boot at an interactive shell or replace init; a typo-free command
+// line containing any of them still takes the whole fleet out of service
+var defaultForbiddenKernelParams = []string{"init", "rdinit", "rd.break", "rd.shell", "single", "emergency", "rescue"}
+
+var kernelParamKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.\-]*$`)
+
+// kernelCommandFindings holds the result of a kernel command validation: errors deny the
+// command, warnings are reported but allow it
+type kernelCommandFindings struct {
+	Errors   []string
+	Warnings []string
+}
+
+func (f *kernelCommandFindings) err() error {
+	if len(f.Errors) == 0 {
+		return nil
+	}
+	return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid kernel command: %s", strings.Join(f.Errors, "; ")))
+}
+
+// Splits a kernel command line into parameters the same way the kernel does: on whitespace,
+// except within double quotes
+func splitKernelCommand(command string) ([]string, bool) {
+	var params []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range command {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case (r == ' ' || r == '\t') && !inQuotes:
+			if current.Len() > 0 {
+				params = append(params, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		params = append(params, current.String())
+	}
+	return params, !inQuotes
+}
+
+// Returns the forbidden parameters for the given project: the built-in list, the global
+// configured list and the project specific configured list
+func getForbiddenKernelParams(projectName string) []string {
+	forbidden := append([]string{}, defaultForbiddenKernelParams...)
+	forbidden = append(forbidden, configStringList(kernelCommandForbiddenKey)...)
+	if projectName != "" {
+		forbidden = append(forbidden, configStringList(fmt.Sprintf("kernel-command.projects.%s.forbidden-params", projectName))...)
+	}
+	return forbidden
+}
+
+// Reads a config value that is either a YAML list or a comma separated string
+func configStringList(key string) []string {
+	var values []string
+	switch v := viper.Get(key).(type) {
+	case string:
+		values = strings.Split(v, ",")
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+	case []string:
+		values = v
+	}
+
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// Validates a kernel command line against a known-safe pattern set
+func validateKernelCommand(command string, forbidden []string) kernelCommandFindings {
+	findings := kernelCommandFindings{}
+	if strings.TrimSpace(command) == "" {
+		return findings
+	}
+
+	if len(command) > maxKernelCommandLength {
+		findings.Errors = append(findings.Errors, fmt.Sprintf("longer than %d characters", maxKernelCommandLength))
+	}
+	for _, r := range command {
+		if r < ' ' && r != '\t' || r == 0x7f {
+			findings.Errors = append(findings.Errors, "contains control characters or line breaks")
+			break
+		}
+	}
+	if strings.ContainsAny(command, "'`") {
+		findings.Errors = append(findings.Errors, "contains single quotes or backticks, which the kernel does not interpret")
+	}
+
+	params, balanced := splitKernelCommand(command)
+	if !balanced {
+		findings.Errors = append(findings.Errors, "contains an unterminated double quote")
+	}
+
+	forbiddenSet := make(map[string]bool, len(forbidden))
+	for _, f := range forbidden {
+		forbiddenSet[f] = true
+	}
+
+	seen := map[string][]string{}
+	var order []string
+	for _, param := range params {
+		// Everything after "--" is handed to init unchanged
+		if param == "--" {
+			break
+		}
+		key, value, _ := strings.Cut(param, "=")
+		if !kernelParamKeyPattern.MatchString(key) {
+			findings.Errors = append(findings.Errors, fmt.Sprintf("malformed parameter %q", param))
+			continue
+		}
+		if forbiddenSet[key] || forbiddenSet[param] {
+			findings.Errors = append(findings.Errors, fmt.Sprintf("parameter %q is not allowed", param))
+		}
+		if _, ok := seen[key]; !ok {
+			order = append(order, key)
+		}
+		seen[key] = append(seen[key], value)
+	}
+
+	for _, key := range order {
+		values := seen[key]
+		if len(values) < 2 {
+			continue
+		}
+		if key == "console" {
+			// Several different consoles are legitimate; the same console twice is a typo
+			unique := map[string]bool{}
+			for _, v := range values {
+				if unique[v] {
+					findings.Warnings = append(findings.Warnings, fmt.Sprintf("console parameter %q is duplicated", "console="+v))
+				}
+				unique[v] = true
+			}
+			continue
+		}
+		findings.Warnings = append(findings.Warnings, fmt.Sprintf("parameter %q is specified %d times", key, len(values)))
+	}
+	return findings
+}
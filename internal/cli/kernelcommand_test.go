@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateKernelCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		errors   []string
+		warnings []string
+	}{
+		{name: "empty", command: ""},
+		{name: "valid", command: `console=ttyS0,115200 console=tty0 intel_iommu=on quiet dyndbg="file drm.c +p"`},
+		{name: "init arguments are not checked", command: "quiet -- init=/bin/sh single"},
+		{name: "forbidden parameter", command: "quiet init=/bin/sh", errors: []string{`parameter "init=/bin/sh" is not allowed`}},
+		{name: "forbidden flag", command: "quiet rd.break", errors: []string{`parameter "rd.break" is not allowed`}},
+		{name: "unterminated quote", command: `dyndbg="file drm.c`, errors: []string{"contains an unterminated double quote"}},
+		{name: "single quotes", command: "dyndbg='file drm.c'", errors: []string{
+			"contains single quotes or backticks, which the kernel does not interpret",
+			`malformed parameter "drm.c'"`,
+		}},
+		{name: "line break", command: "quiet\nsplash", errors: []string{"contains control characters or line breaks", `malformed parameter "quiet\nsplash"`}},
+		{name: "malformed parameter", command: "=on", errors: []string{`malformed parameter "=on"`}},
+		{name: "too long", command: strings.Repeat("a", maxKernelCommandLength+1), errors: []string{"longer than 2048 characters"}},
+		{name: "duplicated console", command: "console=ttyS0 console=tty0 console=ttyS0", warnings: []string{`console parameter "console=ttyS0" is duplicated`}},
+		{name: "duplicated parameter", command: "hugepages=16 quiet hugepages=32", warnings: []string{`parameter "hugepages" is specified 2 times`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := validateKernelCommand(tt.command, defaultForbiddenKernelParams)
+			assert.Equal(t, tt.errors, findings.Errors)
+			assert.Equal(t, tt.warnings, findings.Warnings)
+			assert.Equal(t, len(tt.errors) > 0, findings.err() != nil)
+		})
+	}
+}
+
+func TestGetForbiddenKernelParams(t *testing.T) {
+	defer viper.Set(kernelCommandForbiddenKey, nil)
+	defer viper.Set("kernel-command.projects.some-project.forbidden-params", nil)
+
+	viper.Set(kernelCommandForbiddenKey, "nomodeset, selinux,")
+	viper.Set("kernel-command.projects.some-project.forbidden-params", []interface{}{"iommu"})
+
+	assert.Equal(t, append(append([]string{}, defaultForbiddenKernelParams...), "nomodeset", "selinux", "iommu"),
+		getForbiddenKernelParams("some-project"))
+	assert.Equal(t, append(append([]string{}, defaultForbiddenKernelParams...), "nomodeset", "selinux"),
+		getForbiddenKernelParams("other-project"))
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const getKubeconfigExamples = `# Print the kubeconfig of a cluster
+orch-cli get kubeconfig cli-cluster --project some-project
+
+# Write the kubeconfig of a cluster to a file
+orch-cli get kubeconfig cli-cluster --project some-project --file ./cli-cluster.yaml
+
+# Merge the kubeconfig of a cluster into ~/.kube/config and switch kubectl to its context
+orch-cli get kubeconfig cli-cluster --project some-project --merge ~/.kube/config`
+
+func getGetKubeconfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kubeconfig <cluster-name> [flags]",
+		Short: "Get the kubeconfig of a cluster",
+		Long: "Retrieves the kubeconfig of a cluster and prints it, writes it to a file with --file, or merges its " +
+			"cluster, context and user into an existing kubeconfig with --merge and makes its context the current one.",
+		Example: getKubeconfigExamples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    runGetKubeconfigCommand,
+	}
+	cmd.Flags().String("file", "", "Write the kubeconfig to this file instead of printing it")
+	cmd.Flags().String("merge", "", "Merge the kubeconfig into this kubeconfig file, created if it does not exist")
+	cmd.MarkFlagsMutuallyExclusive("file", "merge")
+	return cmd
+}
+
+func runGetKubeconfigCommand(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	merge, _ := cmd.Flags().GetString("merge")
+
+	ctx, clusterClient, projectName, err := ClusterFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	clusterName := args[0]
+	resp, err := clusterClient.GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx, projectName, clusterName,
+		&coapi.GetV2ProjectsProjectNameClustersNameKubeconfigsParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error getting kubeconfig of cluster %s", clusterName)); err != nil {
+		return err
+	}
+	if resp.JSON200 == nil || safeString(resp.JSON200.Kubeconfig) == "" {
+		return e.WithCode(e.CodeNotFound, fmt.Errorf("no kubeconfig available for cluster %s in project %s", clusterName, projectName))
+	}
+	kubeconfig := []byte(*resp.JSON200.Kubeconfig)
+
+	switch {
+	case merge != "":
+		path, err := expandHome(merge)
+		if err != nil {
+			return err
+		}
+		currentContext, err := mergeKubeconfigFile(path, kubeconfig)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Kubeconfig of cluster %s merged into %s, current context is now %s\n", clusterName, path, currentContext)
+	case file != "":
+		if err := writeKubeconfigFile(file, kubeconfig); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Kubeconfig of cluster %s written to %s\n", clusterName, file)
+	default:
+		fmt.Fprint(cmd.OutOrStdout(), string(kubeconfig))
+	}
+	return nil
+}
+
+// Expands a leading ~, for paths given as --merge=~/.kube/config that the shell leaves as is
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+// Kubeconfigs hold credentials, they are only readable by their owner
+func writeKubeconfigFile(path string, kubeconfig []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, kubeconfig, 0o600); err != nil {
+		return fmt.Errorf("error writing kubeconfig %s: %w", path, err)
+	}
+	return nil
+}
+
+// kubeconfigEntry is a named cluster, context or user of a kubeconfig; the fields not needed to merge are kept as is
+type kubeconfigEntry struct {
+	Name string                 `yaml:"name"`
+	Rest map[string]interface{} `yaml:",inline"`
+}
+
+type kubeconfigFile struct {
+	APIVersion     string                 `yaml:"apiVersion,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Clusters       []kubeconfigEntry      `yaml:"clusters"`
+	Contexts       []kubeconfigEntry      `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Users          []kubeconfigEntry      `yaml:"users"`
+	Rest           map[string]interface{} `yaml:",inline"`
+}
+
+// Merges a kubeconfig into the kubeconfig file at path, creating it if needed, and returns the new current context
+func mergeKubeconfigFile(path string, kubeconfig []byte) (string, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("error reading kubeconfig %s: %w", path, err)
+	}
+	merged, err := mergeKubeconfigs(existing, kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	if err := writeKubeconfigFile(path, out); err != nil {
+		return "", err
+	}
+	return merged.CurrentContext, nil
+}
+
+// Adds the clusters, contexts and users of a kubeconfig to an existing one, replacing the entries with the same name,
+// and makes the current context of the added kubeconfig the current one
+func mergeKubeconfigs(existing, added []byte) (*kubeconfigFile, error) {
+	var base, update kubeconfigFile
+	if err := yaml.Unmarshal(existing, &base); err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("existing kubeconfig is not valid: %w", err))
+	}
+	if err := yaml.Unmarshal(added, &update); err != nil {
+		return nil, fmt.Errorf("kubeconfig of the cluster is not valid: %w", err)
+	}
+	if base.APIVersion == "" {
+		base.APIVersion = "v1"
+	}
+	if base.Kind == "" {
+		base.Kind = "Config"
+	}
+	base.Clusters = mergeKubeconfigEntries(base.Clusters, update.Clusters)
+	base.Contexts = mergeKubeconfigEntries(base.Contexts, update.Contexts)
+	base.Users = mergeKubeconfigEntries(base.Users, update.Users)
+	if update.CurrentContext != "" {
+		base.CurrentContext = update.CurrentContext
+	} else if len(update.Contexts) > 0 {
+		base.CurrentContext = update.Contexts[0].Name
+	}
+	return &base, nil
+}
+
+func mergeKubeconfigEntries(base, added []kubeconfigEntry) []kubeconfigEntry {
+	for _, entry := range added {
+		replaced := false
+		for i := range base {
+			if base[i].Name == entry.Name {
+				base[i], replaced = entry, true
+				break
+			}
+		}
+		if !replaced {
+			base = append(base, entry)
+		}
+	}
+	return base
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestGetKubeconfig() {
+	out, err := s.runCommand("get kubeconfig cli-cluster --project " + project)
+	s.NoError(err)
+	s.Contains(out, "current-context: cli-cluster-admin@cli-cluster\n")
+	s.Contains(out, "server: https://connect-gateway.example.com/kubernetes/"+project+"-cli-cluster\n")
+
+	dir := s.T().TempDir()
+	file := filepath.Join(dir, "cli-cluster.yaml")
+	out, err = s.runCommand("get kubeconfig cli-cluster --file " + file + " --project " + project)
+	s.NoError(err)
+	s.Equal("Kubeconfig of cluster cli-cluster written to "+file+"\n", out)
+	info, err := os.Stat(file)
+	s.NoError(err)
+	s.Equal(os.FileMode(0o600), info.Mode().Perm())
+
+	// Merging creates the kubeconfig when it does not exist yet, then adds further clusters to it
+	config := filepath.Join(dir, ".kube", "config")
+	out, err = s.runCommand("get kubeconfig cli-cluster --merge " + config + " --project " + project)
+	s.NoError(err)
+	s.Equal("Kubeconfig of cluster cli-cluster merged into "+config+", current context is now cli-cluster-admin@cli-cluster\n", out)
+	_, err = s.runCommand("get kubeconfig other-cluster --merge " + config + " --project " + project)
+	s.NoError(err)
+	merged, err := os.ReadFile(config)
+	s.NoError(err)
+	s.Contains(string(merged), "- name: cli-cluster-admin@cli-cluster\n")
+	s.Contains(string(merged), "- name: other-cluster-admin@other-cluster\n")
+	s.Contains(string(merged), "current-context: other-cluster-admin@other-cluster\n")
+
+	_, err = s.runCommand("get kubeconfig nonexistent-cluster --project " + project)
+	s.Error(err)
+
+	_, err = s.runCommand("get kubeconfig cli-cluster --file " + file + " --merge " + config + " --project " + project)
+	s.Error(err)
+}
+
+func TestMergeKubeconfigs(t *testing.T) {
+	existing := []byte(`apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: kind
+  cluster:
+    server: https://127.0.0.1:6443
+- name: edge
+  cluster:
+    server: https://old.example.com
+contexts:
+- name: kind
+  context:
+    cluster: kind
+    user: kind
+current-context: kind
+users:
+- name: kind
+  user:
+    token: kind-token
+`)
+	added := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: edge
+  cluster:
+    server: https://new.example.com
+contexts:
+- name: edge-admin@edge
+  context:
+    cluster: edge
+    user: edge-admin
+current-context: edge-admin@edge
+users:
+- name: edge-admin
+  user:
+    token: edge-token
+`)
+	merged, err := mergeKubeconfigs(existing, added)
+	require.NoError(t, err)
+	assert.Equal(t, "edge-admin@edge", merged.CurrentContext)
+	require.Len(t, merged.Clusters, 2)
+	assert.Equal(t, "kind", merged.Clusters[0].Name)
+	assert.Equal(t, "edge", merged.Clusters[1].Name)
+	assert.Equal(t, map[string]interface{}{"server": "https://new.example.com"}, merged.Clusters[1].Rest["cluster"])
+	assert.Len(t, merged.Contexts, 2)
+	assert.Len(t, merged.Users, 2)
+	assert.Contains(t, merged.Rest, "preferences")
+
+	// A kubeconfig without a current context switches to its first context
+	merged, err = mergeKubeconfigs(nil, []byte("contexts:\n- name: edge\n  context: {}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "edge", merged.CurrentContext)
+	assert.Equal(t, "v1", merged.APIVersion)
+	assert.Equal(t, "Config", merged.Kind)
+
+	_, err = mergeKubeconfigs([]byte("clusters: {"), added)
+	assert.Error(t, err)
+}
@@ -110,7 +110,7 @@ func login(cmd *cobra.Command, args []string) error {
 	// Check first that this is a keycloak instance before we start sending our password over
 	responseWellKnown, errWellKnown := kcClient.GetWellKnownOpenidConfigurationWithResponse(cmd.Context())
 	if errWellKnown != nil {
-		return errWellKnown
+		return processError(errWellKnown)
 	}
 	if responseWellKnown.JSON200 == nil {
 		return fmt.Errorf("invalid response from Identity Povider. Cannot login. Check Keycloak")
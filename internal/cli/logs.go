@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const logsHostExamples = `# Show why a host failed to onboard or provision
+orch-cli logs host host-1234abcd --project some-project
+
+# Show the troubleshooting report of a host given by name
+orch-cli logs host edge-host-001 --project some-project
+
+# Sample output
+Host edge-host-001 (host-1234abcd)
+
+STATUS         INDICATOR   SINCE                  VALUE
+registration   idle        2025-03-02 09:12 UTC   Registered
+onboarding     idle        2025-03-02 09:20 UTC   Onboarded
+host           idle        2025-03-02 09:45 UTC   Running
+provisioning   error       2025-03-02 09:44 UTC   Provisioning Failed: failed to download image: 404 Not Found
+instance       error       2025-03-02 09:44 UTC   Error
+
+OS update runs
+No OS update runs
+
+Problems
+provisioning: Provisioning Failed: failed to download image: 404 Not Found
+  check the image URL of the OS profile of the instance and that the host can reach it
+`
+
+// hostStatusReport is a status of a host or of its instance as shown by logs host
+type hostStatusReport struct {
+	Name      string
+	Value     string
+	Indicator string
+	Since     *time.Time
+}
+
+// knownHostError maps an error string found in a status to a remediation
+type knownHostError struct {
+	patterns    []string
+	remediation string
+}
+
+// The known error strings are matched ignoring case, the first match wins
+var knownHostErrors = []knownHostError{
+	{[]string{"x509", "certificate"}, "check the system clock of the host and that it trusts the CA certificate of the orchestrator"},
+	{[]string{"secure boot", "secureboot"}, "make sure Secure Boot is enabled in the BIOS when the OS profile requires it, or use an OS profile without it"},
+	{[]string{"failed to download", "download failed", "404 not found", "image not found"}, "check the image URL of the OS profile of the instance and that the host can reach it"},
+	{[]string{"sha256", "checksum"}, "the downloaded OS image does not match the checksum of the OS profile, check the OS profile or re-upload the image"},
+	{[]string{"no space left", "disk full", "insufficient storage"}, "free disk space on the host or use a smaller LVM size"},
+	{[]string{"no such host", "dns"}, "check the DNS configuration of the host network, it must resolve the orchestrator domain"},
+	{[]string{"timeout", "timed out", "deadline exceeded", "connection refused", "unreachable"}, "check that the host can reach the orchestrator through the network and proxy"},
+	{[]string{"tpm"}, "check that the TPM of the host is enabled in the BIOS"},
+	{[]string{"uuid", "serial number"}, "check that the serial number and UUID of the registered host match the hardware"},
+	{[]string{"unauthorized", "authentication", "invalidated", "deauthorized"}, "the credentials of the host are not valid anymore, delete the host and onboard it again"},
+	{[]string{"not connected", "connection lost"}, "the host stopped reporting to the orchestrator, check that it is powered on and connected"},
+}
+
+func getLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "logs",
+		Short:             "Troubleshoot the failures of Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getLogsHostCommand(),
+	)
+	return cmd
+}
+
+func getLogsHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> [flags]",
+		Short: "Show a troubleshooting report of a host",
+		Long: "Gathers the registration, onboarding and host statuses of a host, the provisioning, instance and update " +
+			"statuses of its instance along with the instance status detail, and the status details of the OS update runs " +
+			"of its instance. The statuses in error are listed with a suggested remediation when their error is a known one.",
+		Example: logsHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runLogsHostCommand,
+	}
+	cmd.Flags().String("timezone", "", "Timezone of the times shown, e.g. Europe/Berlin (default UTC)")
+	return cmd
+}
+
+func runLogsHostCommand(cmd *cobra.Command, args []string) error {
+	timezone, _ := cmd.Flags().GetString("timezone")
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid timezone '%s': %w", timezone, err))
+		}
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	host, err := getHostByNameOrID(ctx, client, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	var runs []infra.OSUpdateRun
+	if isFeatureEnabled(Day2Feature) && host.Instance != nil && derefString(host.Instance.ResourceId) != "" {
+		runs, err = listInstanceOSUpdateRuns(ctx, client, projectName, *host.Instance.ResourceId)
+		if err != nil {
+			return err
+		}
+	}
+	return printHostTroubleshootingReport(cmd, cmd.OutOrStdout(), host, runs, loc)
+}
+
+// Returns the statuses of a host and of its instance, in the order a host goes through them
+func hostStatusReports(host infra.HostResource) []hostStatusReport {
+	reports := []hostStatusReport{
+		newHostStatusReport("registration", host.RegistrationStatus, host.RegistrationStatusIndicator, host.RegistrationStatusTimestamp),
+		newHostStatusReport("onboarding", host.OnboardingStatus, host.OnboardingStatusIndicator, host.OnboardingStatusTimestamp),
+		newHostStatusReport("host", host.HostStatus, host.HostStatusIndicator, host.HostStatusTimestamp),
+	}
+	if instance := host.Instance; instance != nil {
+		reports = append(reports,
+			newHostStatusReport("provisioning", instance.ProvisioningStatus, instance.ProvisioningStatusIndicator, instance.ProvisioningStatusTimestamp),
+			newHostStatusReport("instance", instance.InstanceStatus, instance.InstanceStatusIndicator, instance.InstanceStatusTimestamp),
+			// The detail shares the indicator of the instance status it explains
+			newHostStatusReport("instance detail", instance.InstanceStatusDetail, instance.InstanceStatusIndicator, instance.InstanceStatusTimestamp),
+			newHostStatusReport("update", instance.UpdateStatus, instance.UpdateStatusIndicator, instance.UpdateStatusTimestamp),
+		)
+	}
+	filtered := reports[:0]
+	for _, report := range reports {
+		if report.Value != "" {
+			filtered = append(filtered, report)
+		}
+	}
+	return filtered
+}
+
+func newHostStatusReport(name string, value *string, indicator *infra.StatusIndication, timestamp *int) hostStatusReport {
+	report := hostStatusReport{Name: name, Value: derefString(value), Indicator: statusIndicatorDisplay(indicator)}
+	if timestamp != nil && *timestamp > 0 {
+		since := time.Unix(int64(*timestamp), 0)
+		report.Since = &since
+	}
+	return report
+}
+
+// Shows a status indicator without its STATUS_INDICATION_ prefix, e.g. in progress
+func statusIndicatorDisplay(indicator *infra.StatusIndication) string {
+	if indicator == nil || *indicator == infra.STATUSINDICATIONUNSPECIFIED {
+		return ""
+	}
+	display := strings.TrimPrefix(string(*indicator), "STATUS_INDICATION_")
+	return strings.ToLower(strings.ReplaceAll(display, "_", " "))
+}
+
+// Returns the remediation of the first known error found in a status, empty when the error is not a known one
+func suggestHostRemediation(status string) string {
+	lower := strings.ToLower(status)
+	for _, known := range knownHostErrors {
+		for _, pattern := range known.patterns {
+			if strings.Contains(lower, pattern) {
+				return known.remediation
+			}
+		}
+	}
+	return ""
+}
+
+func printHostTroubleshootingReport(cmd *cobra.Command, w io.Writer, host infra.HostResource, runs []infra.OSUpdateRun, loc *time.Location) error {
+	fmt.Fprintf(w, "Host %s (%s)\n\n", host.Name, derefString(host.ResourceId))
+
+	type problem struct{ source, status string }
+	var problems []problem
+
+	reports := hostStatusReports(host)
+	hasDetail := false
+	for _, report := range reports {
+		hasDetail = hasDetail || report.Name == "instance detail"
+	}
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "STATUS\tINDICATOR\tSINCE\tVALUE\n")
+	for _, report := range reports {
+		since := ""
+		if report.Since != nil {
+			since = report.Since.In(loc).Format(scheduleDisplayTimeFormat)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", report.Name, valueOrDefault(report.Indicator, "-"), valueOrDefault(since, "-"), report.Value)
+		// The instance detail says more than the instance status it explains
+		if report.Indicator == "error" && (report.Name != "instance" || !hasDetail) {
+			problems = append(problems, problem{report.Name, report.Value})
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if isFeatureEnabled(Day2Feature) {
+		fmt.Fprintf(w, "\nOS update runs\n")
+		if len(runs) == 0 {
+			fmt.Fprintln(w, "No OS update runs")
+		} else {
+			writer = newOutputWriter(cmd, w)
+			fmt.Fprintf(writer, "RESOURCE ID\tNAME\tSTATUS\tENDED\tDETAILS\n")
+			for _, run := range runs {
+				ended := "-"
+				if run.EndTime != nil && *run.EndTime > 0 {
+					ended = time.Unix(int64(*run.EndTime), 0).In(loc).Format(scheduleDisplayTimeFormat)
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", derefString(run.ResourceId), valueOrNone(run.Name), valueOrNone(run.Status), ended, valueOrNone(run.StatusDetails))
+				if run.EndTime == nil || *run.EndTime == 0 {
+					continue
+				}
+				if result, detail := rolloutRunResult(run); result == rolloutResultFailed {
+					problems = append(problems, problem{"OS update run " + derefString(run.ResourceId), detail})
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\nProblems\n")
+	if len(problems) == 0 {
+		fmt.Fprintln(w, "No status of the host is in error")
+		return nil
+	}
+	for _, p := range problems {
+		fmt.Fprintf(w, "%s: %s\n", p.source, p.status)
+		if remediation := suggestHostRemediation(p.status); remediation != "" {
+			fmt.Fprintf(w, "  %s\n", remediation)
+		} else {
+			fmt.Fprintln(w, "  no known remediation, check the logs of the host")
+		}
+	}
+	return nil
+}
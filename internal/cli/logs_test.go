@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestLogsHost() {
+	out, err := s.runCommand("logs host host-abcd1005 --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Host edge-host-005 (host-abcd1005)\n")
+	s.Regexp(`host[ |]+error[ |]+2025-01-15 10:30 UTC[ |]+Error\n`, out)
+	s.Regexp(`provisioning[ |]+error[ |]+2025-01-15 10:30 UTC[ |]+Provisioning Failed: failed to download image: 404 Not Found\n`, out)
+	s.Regexp(`instance detail[ |]+error[ |]+-[ |]+tpm device not found\n`, out)
+	s.Contains(out, "\nOS update runs\nNo OS update runs\n")
+	s.Contains(out, "\nProblems\nhost: Error\n  no known remediation, check the logs of the host\n"+
+		"provisioning: Provisioning Failed: failed to download image: 404 Not Found\n"+
+		"  check the image URL of the OS profile of the instance and that the host can reach it\n"+
+		"instance detail: tpm device not found\n  check that the TPM of the host is enabled in the BIOS\n")
+	s.NotContains(out, "instance: Error")
+
+	out, err = s.runCommand("logs host host-abc12345 --timezone Europe/Berlin --project " + project)
+	s.NoError(err)
+	s.Regexp(`onboarding[ |]+idle[ |]+-[ |]+Onboarded successfully\n`, out)
+	s.Contains(out, "\nProblems\nNo status of the host is in error\n")
+
+	_, err = s.runCommand("logs host host-abcd1005 --timezone Mars/Olympus --project " + project)
+	s.ErrorContains(err, "invalid timezone 'Mars/Olympus'")
+
+	_, err = s.runCommand("logs host non-existent-host --project " + project)
+	s.Error(err)
+}
+
+func TestSuggestHostRemediation(t *testing.T) {
+	assert.Equal(t, "check the system clock of the host and that it trusts the CA certificate of the orchestrator",
+		suggestHostRemediation("Onboarding failed: x509: certificate has expired or is not yet valid"))
+	assert.Equal(t, "free disk space on the host or use a smaller LVM size", suggestHostRemediation("write /var/lib: No space left on device"))
+	assert.Equal(t, "check that the host can reach the orchestrator through the network and proxy", suggestHostRemediation("context deadline exceeded"))
+	assert.Empty(t, suggestHostRemediation("Error"))
+}
+
+func TestStatusIndicatorDisplay(t *testing.T) {
+	inProgress := infra.STATUSINDICATIONINPROGRESS
+	unspecified := infra.STATUSINDICATIONUNSPECIFIED
+	assert.Equal(t, "in progress", statusIndicatorDisplay(&inProgress))
+	assert.Empty(t, statusIndicatorDisplay(&unspecified))
+	assert.Empty(t, statusIndicatorDisplay(nil))
+}
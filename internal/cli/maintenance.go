@@ -0,0 +1,570 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	maintenanceBundleVersion = "apps/v1"
+	maintenanceBundleKind    = "MaintenanceBundle"
+	maintenanceBundleMaxSize = 1 << 20
+)
+
+const exportMaintenanceExamples = `# Export the OS update policies and the schedules of a project to maintenance.yaml
+orch-cli export maintenance --project some-project
+
+# Export them to a given file
+orch-cli export maintenance --project some-project -o bundle.yaml
+`
+
+const importMaintenanceExamples = `# Replicate the maintenance configuration of a project into another project
+orch-cli export maintenance --project staging -o bundle.yaml
+orch-cli import maintenance bundle.yaml --project production
+
+# Show what would be created without creating anything
+orch-cli import maintenance bundle.yaml --project production --dry-run
+
+Sample maintenance bundle file format; OS profiles and schedule targets are referred to by name
+appVersion: apps/v1
+kind: MaintenanceBundle
+project: staging
+osUpdatePolicies:
+  - name: security-policy
+    description: "Monthly security update policy"
+    updatePolicy: UPDATE_POLICY_TARGET
+    targetOs: "Ubuntu 22.04"
+    updatePackages: |-
+      curl
+      openssh-server
+    updateKernelCommand: "console=ttyS0"
+    updateSources:
+      - "deb http://archive.ubuntu.com/ubuntu jammy main"
+repeatedSchedules:
+  - name: weekly-patch
+    scheduleStatus: SCHEDULE_STATUS_OS_UPDATE
+    target: region:europe                 # host:name, site:name or region:name
+    cronMinutes: "0"
+    cronHours: "2"
+    cronDayMonth: "*"
+    cronMonth: "*"
+    cronDayWeek: "6"
+    durationSeconds: 7200
+singleSchedules:
+  - name: site-outage
+    scheduleStatus: SCHEDULE_STATUS_MAINTENANCE
+    target: site:store-42
+    startSeconds: 1775271600
+    endSeconds: 1775278800
+
+Policies and schedules whose name already exists in the project are skipped.
+`
+
+// MaintenanceBundle is the maintenance configuration of a project: its OS update policies and its schedules
+type MaintenanceBundle struct {
+	AppVersion        string                      `yaml:"appVersion"`
+	Kind              string                      `yaml:"kind"`
+	Project           string                      `yaml:"project,omitempty"`
+	OSUpdatePolicies  []OSUpdatePolicy            `yaml:"osUpdatePolicies"`
+	RepeatedSchedules []MaintenanceRepeatedWindow `yaml:"repeatedSchedules"`
+	SingleSchedules   []MaintenanceSingleWindow   `yaml:"singleSchedules"`
+}
+
+// MaintenanceRepeatedWindow is a repeated schedule of a maintenance bundle, its target given as kind:name
+type MaintenanceRepeatedWindow struct {
+	Name            string `yaml:"name"`
+	ScheduleStatus  string `yaml:"scheduleStatus"`
+	Target          string `yaml:"target"`
+	CronMinutes     string `yaml:"cronMinutes"`
+	CronHours       string `yaml:"cronHours"`
+	CronDayMonth    string `yaml:"cronDayMonth"`
+	CronMonth       string `yaml:"cronMonth"`
+	CronDayWeek     string `yaml:"cronDayWeek"`
+	DurationSeconds int32  `yaml:"durationSeconds"`
+}
+
+// MaintenanceSingleWindow is a single schedule of a maintenance bundle, its target given as kind:name
+type MaintenanceSingleWindow struct {
+	Name           string `yaml:"name"`
+	ScheduleStatus string `yaml:"scheduleStatus"`
+	Target         string `yaml:"target"`
+	StartSeconds   int    `yaml:"startSeconds"`
+	EndSeconds     *int   `yaml:"endSeconds,omitempty"`
+}
+
+func getExportMaintenanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance [flags]",
+		Short: "Export the OS update policies and schedules of a project as a maintenance bundle",
+		Long: "Writes the OS update policies and the repeated and single schedules of a project to a YAML bundle, " +
+			"OS profiles and schedule targets referred to by name, so that a tested maintenance configuration can be " +
+			"imported into other projects or Edge Orchestrators with import maintenance.",
+		Example: exportMaintenanceExamples,
+		Args:    cobra.NoArgs,
+		Aliases: maintenanceAliases,
+		RunE:    runExportMaintenanceCommand,
+	}
+	cmd.Flags().StringP("output-file", "o", "maintenance.yaml", "File the maintenance bundle is written to")
+	return cmd
+}
+
+func getImportMaintenanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance <bundle-file> [flags]",
+		Short: "Create the OS update policies and schedules of a maintenance bundle",
+		Long: "Creates in the project the OS update policies and the schedules of a bundle written by export maintenance. " +
+			"OS profiles and schedule targets are resolved by name in the project; policies and schedules whose name " +
+			"already exists are skipped, so that a bundle can be imported again after a partial failure.",
+		Example: importMaintenanceExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: maintenanceAliases,
+		RunE:    runImportMaintenanceCommand,
+	}
+	cmd.Flags().Bool("dry-run", false, "Resolve the bundle and print what would be created without creating it")
+	return cmd
+}
+
+func runExportMaintenanceCommand(cmd *cobra.Command, _ []string) error {
+	outputFile, _ := cmd.Flags().GetString("output-file")
+	if outputFile == "" {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--output-file must not be empty"))
+	}
+
+	ctx, infraClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	policies, err := listAllOSUpdatePolicies(ctx, infraClient, projectName)
+	if err != nil {
+		return err
+	}
+	repeated, single, err := listAllSchedules(ctx, infraClient, projectName)
+	if err != nil {
+		return err
+	}
+
+	bundle := MaintenanceBundle{
+		AppVersion: maintenanceBundleVersion,
+		Kind:       maintenanceBundleKind,
+		Project:    projectName,
+	}
+	for _, policy := range policies {
+		targetOS := ""
+		if policy.TargetOs != nil {
+			targetOS = derefString(policy.TargetOs.Name)
+		}
+		if targetOS == "" && derefString(policy.TargetOsId) != "" {
+			resp, err := infraClient.OperatingSystemServiceGetOperatingSystemWithResponse(ctx, projectName,
+				*policy.TargetOsId, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS profile"); err != nil {
+				return err
+			}
+			targetOS = derefString(resp.JSON200.Name)
+		}
+		exported := OSUpdatePolicy{
+			Name:                policy.Name,
+			Description:         derefString(policy.Description),
+			UpdatePackages:      OSUpdatePackages(derefString(policy.UpdatePackages)),
+			UpdateKernelCommand: derefString(policy.UpdateKernelCommand),
+			TargetOS:            targetOS,
+		}
+		if policy.UpdateSources != nil {
+			exported.UpdateSources = *policy.UpdateSources
+		}
+		if policy.UpdatePolicy != nil {
+			exported.UpdatePolicy = string(*policy.UpdatePolicy)
+		}
+		bundle.OSUpdatePolicies = append(bundle.OSUpdatePolicies, exported)
+	}
+
+	targets := scheduleTargetNames{ctx: ctx, client: infraClient, projectName: projectName, names: map[string]string{}}
+	for _, schedule := range repeated {
+		target, err := targets.name(schedule.TargetHost, schedule.TargetHostId, schedule.TargetSite,
+			schedule.TargetSiteId, schedule.TargetRegion, schedule.TargetRegionId)
+		if err != nil {
+			return err
+		}
+		bundle.RepeatedSchedules = append(bundle.RepeatedSchedules, MaintenanceRepeatedWindow{
+			Name:            derefString(schedule.Name),
+			ScheduleStatus:  string(schedule.ScheduleStatus),
+			Target:          target,
+			CronMinutes:     schedule.CronMinutes,
+			CronHours:       schedule.CronHours,
+			CronDayMonth:    schedule.CronDayMonth,
+			CronMonth:       schedule.CronMonth,
+			CronDayWeek:     schedule.CronDayWeek,
+			DurationSeconds: schedule.DurationSeconds,
+		})
+	}
+	for _, schedule := range single {
+		target, err := targets.name(schedule.TargetHost, schedule.TargetHostId, schedule.TargetSite,
+			schedule.TargetSiteId, schedule.TargetRegion, schedule.TargetRegionId)
+		if err != nil {
+			return err
+		}
+		bundle.SingleSchedules = append(bundle.SingleSchedules, MaintenanceSingleWindow{
+			Name:           derefString(schedule.Name),
+			ScheduleStatus: string(schedule.ScheduleStatus),
+			Target:         target,
+			StartSeconds:   schedule.StartSeconds,
+			EndSeconds:     schedule.EndSeconds,
+		})
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return fmt.Errorf("error encoding maintenance bundle: %w", err)
+	}
+	if err := os.WriteFile(outputFile, data, 0600); err != nil {
+		return fmt.Errorf("error writing maintenance bundle %s: %w", outputFile, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Exported %d OS update policies, %d repeated and %d single schedules of project %s to %s\n",
+		len(bundle.OSUpdatePolicies), len(bundle.RepeatedSchedules), len(bundle.SingleSchedules), projectName, outputFile)
+	return nil
+}
+
+func runImportMaintenanceCommand(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	bundle, err := readMaintenanceBundle(args[0])
+	if err != nil {
+		return err
+	}
+	projectFlag, _ := cmd.Flags().GetString(project)
+	for _, policy := range bundle.OSUpdatePolicies {
+		findings := validateKernelCommand(policy.UpdateKernelCommand, getForbiddenKernelParams(projectFlag))
+		for _, warning := range findings.Warnings {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: kernel command of OS update policy %s %s\n", policy.Name, warning)
+		}
+		if err := findings.err(); err != nil {
+			return fmt.Errorf("OS update policy %s: %w", policy.Name, err)
+		}
+	}
+
+	ctx, infraClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	action := "Created"
+	if dryRun {
+		action = "Would create"
+	}
+
+	existingPolicies, err := listAllOSUpdatePolicies(ctx, infraClient, projectName)
+	if err != nil {
+		return err
+	}
+	existingRepeated, existingSingle, err := listAllSchedules(ctx, infraClient, projectName)
+	if err != nil {
+		return err
+	}
+
+	created, skipped := 0, 0
+	var profiles []infra.OperatingSystemResource
+	for _, policy := range bundle.OSUpdatePolicies {
+		if slices.ContainsFunc(existingPolicies, func(p infra.OSUpdatePolicy) bool { return p.Name == policy.Name }) {
+			fmt.Fprintf(out, "Skipped OS update policy %s, it already exists\n", policy.Name)
+			skipped++
+			continue
+		}
+		body := infra.OSUpdatePolicyCreateOSUpdatePolicyJSONRequestBody{
+			Name:        policy.Name,
+			Description: &policy.Description,
+		}
+		if policy.TargetOS != "" {
+			if profiles == nil {
+				if profiles, err = listAllOSProfiles(ctx, infraClient, projectName); err != nil {
+					return err
+				}
+			}
+			profile, err := filterProfilesByName(profiles, policy.TargetOS)
+			if err != nil {
+				return e.WithCode(e.CodeNotFound, fmt.Errorf("target OS %q of OS update policy %s: %w", policy.TargetOS, policy.Name, err))
+			}
+			body.TargetOsId = profile.ResourceId
+		}
+		if policy.UpdatePackages != "" {
+			packages := string(policy.UpdatePackages)
+			body.UpdatePackages = &packages
+		}
+		if policy.UpdateKernelCommand != "" {
+			body.UpdateKernelCommand = &policy.UpdateKernelCommand
+		}
+		if policy.UpdateSources != nil {
+			body.UpdateSources = &policy.UpdateSources
+		}
+		updatePolicy := infra.UpdatePolicy(policy.UpdatePolicy)
+		body.UpdatePolicy = &updatePolicy
+		if !dryRun {
+			resp, err := infraClient.OSUpdatePolicyCreateOSUpdatePolicyWithResponse(ctx, projectName, body, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating OS update policy %s", policy.Name)); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(out, "%s OS update policy %s\n", action, policy.Name)
+		created++
+	}
+
+	for _, schedule := range bundle.RepeatedSchedules {
+		if slices.ContainsFunc(existingRepeated, func(r infra.RepeatedScheduleResource) bool {
+			return derefString(r.Name) == schedule.Name
+		}) {
+			fmt.Fprintf(out, "Skipped repeated schedule %s, it already exists\n", schedule.Name)
+			skipped++
+			continue
+		}
+		hostID, regionID, siteID, err := resolveTargetForSchedule(ctx, infraClient, projectName, schedule.Target)
+		if err != nil {
+			return fmt.Errorf("target of repeated schedule %s: %w", schedule.Name, err)
+		}
+		if !dryRun {
+			resp, err := infraClient.ScheduleServiceCreateRepeatedScheduleWithResponse(ctx, projectName,
+				infra.ScheduleServiceCreateRepeatedScheduleJSONRequestBody{
+					Name:            &schedule.Name,
+					ScheduleStatus:  infra.ScheduleStatus(schedule.ScheduleStatus),
+					CronMinutes:     schedule.CronMinutes,
+					CronHours:       schedule.CronHours,
+					CronDayMonth:    schedule.CronDayMonth,
+					CronMonth:       schedule.CronMonth,
+					CronDayWeek:     schedule.CronDayWeek,
+					DurationSeconds: schedule.DurationSeconds,
+					TargetHostId:    hostID,
+					TargetRegionId:  regionID,
+					TargetSiteId:    siteID,
+				}, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", schedule.Name)); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(out, "%s repeated schedule %s on %s\n", action, schedule.Name, schedule.Target)
+		created++
+	}
+
+	for _, schedule := range bundle.SingleSchedules {
+		if slices.ContainsFunc(existingSingle, func(s infra.SingleScheduleResource) bool {
+			return derefString(s.Name) == schedule.Name
+		}) {
+			fmt.Fprintf(out, "Skipped single schedule %s, it already exists\n", schedule.Name)
+			skipped++
+			continue
+		}
+		hostID, regionID, siteID, err := resolveTargetForSchedule(ctx, infraClient, projectName, schedule.Target)
+		if err != nil {
+			return fmt.Errorf("target of single schedule %s: %w", schedule.Name, err)
+		}
+		if !dryRun {
+			resp, err := infraClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName,
+				infra.ScheduleServiceCreateSingleScheduleJSONRequestBody{
+					Name:           &schedule.Name,
+					ScheduleStatus: infra.ScheduleStatus(schedule.ScheduleStatus),
+					StartSeconds:   schedule.StartSeconds,
+					EndSeconds:     schedule.EndSeconds,
+					TargetHostId:   hostID,
+					TargetRegionId: regionID,
+					TargetSiteId:   siteID,
+				}, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", schedule.Name)); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(out, "%s single schedule %s on %s\n", action, schedule.Name, schedule.Target)
+		created++
+	}
+
+	fmt.Fprintf(out, "%s %d and skipped %d existing policies and schedules in project %s\n",
+		action, created, skipped, projectName)
+	return nil
+}
+
+// Reads and checks a maintenance bundle; unknown fields are rejected
+func readMaintenanceBundle(path string) (*MaintenanceBundle, error) {
+	if ext := strings.ToLower(filepath.Ext(path)); ext != ".yaml" && ext != ".yml" {
+		return nil, e.WithCode(e.CodeInvalidArgument, errors.New("maintenance bundle must be a yaml file"))
+	}
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maintenanceBundleMaxSize {
+		return nil, fmt.Errorf("maintenance bundle %s is larger than %d MiB", path, maintenanceBundleMaxSize>>20)
+	}
+
+	var bundle MaintenanceBundle
+	if err := yaml.UnmarshalStrict(data, &bundle); err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid maintenance bundle %s: %w", path, err))
+	}
+	if bundle.Kind != maintenanceBundleKind {
+		return nil, e.WithCode(e.CodeInvalidArgument,
+			fmt.Errorf("%s is not a maintenance bundle, its kind is %q instead of %q", path, bundle.Kind, maintenanceBundleKind))
+	}
+
+	var problems []string
+	for i, policy := range bundle.OSUpdatePolicies {
+		if policy.Name == "" {
+			problems = append(problems, fmt.Sprintf("osUpdatePolicies[%d] has no name", i))
+		}
+		if policy.UpdatePolicy != string(infra.UPDATEPOLICYLATEST) && policy.UpdatePolicy != string(infra.UPDATEPOLICYTARGET) {
+			problems = append(problems, fmt.Sprintf("osUpdatePolicies[%d] has an invalid updatePolicy %q", i, policy.UpdatePolicy))
+		}
+	}
+	for i, schedule := range bundle.RepeatedSchedules {
+		problems = append(problems, checkMaintenanceWindow(fmt.Sprintf("repeatedSchedules[%d]", i),
+			schedule.Name, schedule.ScheduleStatus, schedule.Target)...)
+	}
+	for i, schedule := range bundle.SingleSchedules {
+		problems = append(problems, checkMaintenanceWindow(fmt.Sprintf("singleSchedules[%d]", i),
+			schedule.Name, schedule.ScheduleStatus, schedule.Target)...)
+	}
+	if len(problems) > 0 {
+		return nil, e.WithCode(e.CodeInvalidArgument,
+			fmt.Errorf("invalid maintenance bundle %s:\n  %s", path, strings.Join(problems, "\n  ")))
+	}
+	return &bundle, nil
+}
+
+func checkMaintenanceWindow(field string, name string, status string, target string) []string {
+	var problems []string
+	if name == "" {
+		problems = append(problems, fmt.Sprintf("%s has no name", field))
+	}
+	if status != string(infra.SCHEDULESTATUSMAINTENANCE) && status != string(infra.SCHEDULESTATUSOSUPDATE) {
+		problems = append(problems, fmt.Sprintf("%s has an invalid scheduleStatus %q", field, status))
+	}
+	if kind, targetName, ok := strings.Cut(target, ":"); !ok || targetName == "" ||
+		(kind != "host" && kind != "site" && kind != "region") {
+		problems = append(problems, fmt.Sprintf("%s target %q is not host:name, site:name or region:name", field, target))
+	}
+	return problems
+}
+
+// Names the targets of the schedules as kind:name, getting the resources not embedded in the schedules once
+type scheduleTargetNames struct {
+	ctx         context.Context
+	client      infra.ClientWithResponsesInterface
+	projectName string
+	names       map[string]string
+}
+
+func (t scheduleTargetNames) name(host *infra.HostResource, hostID *string, site *infra.SiteResource, siteID *string,
+	region *infra.RegionResource, regionID *string) (string, error) {
+	switch {
+	case derefString(hostID) != "":
+		if host != nil && host.Name != "" {
+			return "host:" + host.Name, nil
+		}
+		return t.lookup(*hostID, "host", func() (string, error) {
+			h, err := getHostByNameOrID(t.ctx, t.client, t.projectName, *hostID)
+			return h.Name, err
+		})
+	case derefString(siteID) != "":
+		if site != nil && derefString(site.Name) != "" {
+			return "site:" + *site.Name, nil
+		}
+		return t.lookup(*siteID, "site", func() (string, error) {
+			s, err := getSiteByNameOrID(t.ctx, t.client, t.projectName, *siteID)
+			return derefString(s.Name), err
+		})
+	case derefString(regionID) != "":
+		if region != nil && derefString(region.Name) != "" {
+			return "region:" + *region.Name, nil
+		}
+		return t.lookup(*regionID, "region", func() (string, error) {
+			resp, err := t.client.RegionServiceGetRegionWithResponse(t.ctx, t.projectName, *regionID, auth.AddAuthHeader)
+			if err != nil {
+				return "", processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting region"); err != nil {
+				return "", err
+			}
+			return derefString(resp.JSON200.Name), nil
+		})
+	}
+	return "", nil
+}
+
+func (t scheduleTargetNames) lookup(id string, kind string, get func() (string, error)) (string, error) {
+	if name, ok := t.names[id]; ok {
+		return name, nil
+	}
+	name, err := get()
+	if err != nil {
+		return "", fmt.Errorf("error naming schedule target %s: %w", id, err)
+	}
+	if name == "" {
+		return "", fmt.Errorf("schedule target %s has no name, it cannot be exported", id)
+	}
+	t.names[id] = kind + ":" + name
+	return t.names[id], nil
+}
+
+func listAllOSUpdatePolicies(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.OSUpdatePolicy, error) {
+	policies := make([]infra.OSUpdatePolicy, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.OSUpdatePolicyListOSUpdatePolicyWithResponse(ctx, projectName,
+			&infra.OSUpdatePolicyListOSUpdatePolicyParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving OS Update policies"); err != nil {
+			return 0, false, err
+		}
+		policies = append(policies, resp.JSON200.OsUpdatePolicies...)
+		return len(resp.JSON200.OsUpdatePolicies), resp.JSON200.HasNext, nil
+	})
+	return policies, err
+}
+
+func listAllSchedules(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.RepeatedScheduleResource, []infra.SingleScheduleResource, error) {
+	repeated := make([]infra.RepeatedScheduleResource, 0)
+	single := make([]infra.SingleScheduleResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+			&infra.ScheduleServiceListSchedulesParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving schedules"); err != nil {
+			return 0, false, err
+		}
+		repeated = append(repeated, resp.JSON200.RepeatedSchedules...)
+		single = append(single, resp.JSON200.SingleSchedules...)
+		return len(resp.JSON200.RepeatedSchedules) + len(resp.JSON200.SingleSchedules), resp.JSON200.HasNext, nil
+	})
+	return repeated, single, err
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func (s *CLITestSuite) TestExportImportMaintenance() {
+	bundlePath := filepath.Join(s.T().TempDir(), "bundle.yaml")
+	out, err := s.runCommand("export maintenance -o " + bundlePath + " --project " + project)
+	s.NoError(err)
+	s.Equal("Exported 1 OS update policies, 1 repeated and 1 single schedules of project project to "+bundlePath+"\n", out)
+	data, err := os.ReadFile(bundlePath)
+	s.NoError(err)
+	bundle := string(data)
+	s.Contains(bundle, "kind: MaintenanceBundle\n")
+	s.Contains(bundle, "- name: security-policy-v1.2\n")
+	s.Contains(bundle, "targetOs: Edge Microvisor Toolkit 3.0.20250504\n")
+	s.Contains(bundle, "target: site:site\n")
+	s.Contains(bundle, "durationSeconds: 1\n")
+	s.Contains(bundle, "startSeconds: 10000\n")
+
+	// Everything of the bundle exists in the project it was exported from
+	out, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.NoError(err)
+	s.Equal(`Skipped OS update policy security-policy-v1.2, it already exists
+Skipped repeated schedule schedule, it already exists
+Skipped single schedule schedule, it already exists
+Created 0 and skipped 3 existing policies and schedules in project project
+`, out)
+
+	renamed := strings.NewReplacer("name: security-policy-v1.2", "name: security-policy-v1.3",
+		"name: schedule", "name: weekly").Replace(bundle)
+	s.NoError(os.WriteFile(bundlePath, []byte(renamed), 0600))
+	out, err = s.runCommand("import maintenance " + bundlePath + " --dry-run --project " + project)
+	s.NoError(err)
+	s.Equal(`Would create OS update policy security-policy-v1.3
+Would create repeated schedule weekly on site:site
+Would create single schedule weekly on site:site
+Would create 3 and skipped 0 existing policies and schedules in project project
+`, out)
+
+	out, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Created OS update policy security-policy-v1.3\n")
+	s.Contains(out, "Created 3 and skipped 0 existing policies and schedules in project project\n")
+
+	s.NoError(os.WriteFile(bundlePath, []byte(strings.Replace(renamed, "target: site:site", "target: site:unknown", 1)), 0600))
+	_, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.ErrorContains(err, "target of repeated schedule weekly:")
+
+	s.NoError(os.WriteFile(bundlePath, []byte(strings.Replace(renamed, "target: site:site", "target: site-abcd1234", 1)), 0600))
+	_, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.EqualError(err, "invalid maintenance bundle "+bundlePath+
+		":\n  repeatedSchedules[0] target \"site-abcd1234\" is not host:name, site:name or region:name")
+
+	s.NoError(os.WriteFile(bundlePath, []byte(strings.Replace(renamed, "durationSeconds", "duration", 1)), 0600))
+	_, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.ErrorContains(err, "field duration not found")
+
+	s.NoError(os.WriteFile(bundlePath, []byte("appVersion: apps/v1\nspec:\n  name: policy\n"), 0600))
+	_, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.ErrorContains(err, "invalid maintenance bundle")
+
+	s.NoError(os.WriteFile(bundlePath, []byte("appVersion: apps/v1\nkind: Policy\n"), 0600))
+	_, err = s.runCommand("import maintenance " + bundlePath + " --project " + project)
+	s.EqualError(err, bundlePath+` is not a maintenance bundle, its kind is "Policy" instead of "MaintenanceBundle"`)
+
+	_, err = s.runCommand("export maintenance -o " + bundlePath + " --project invalid-project")
+	s.Error(err)
+}
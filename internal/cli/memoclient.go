@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+)
+
+// memoInfraClient decorates an infra client with the memoization of its list calls, so that the subroutines
+// of a command listing the same pages, e.g. to map workloads or resolve sites, fetch them only once.
+//
+// A memoized client is created by the run function of a command and lives as long as it; it must not be used
+// by loops waiting for resources to change, which would keep seeing the first page they fetched.
+type memoInfraClient struct {
+	infra.ClientWithResponsesInterface
+
+	mu        sync.Mutex
+	responses map[string]any
+}
+
+func newMemoInfraClient(client infra.ClientWithResponsesInterface) *memoInfraClient {
+	if memo, ok := client.(*memoInfraClient); ok {
+		return memo
+	}
+	return &memoInfraClient{ClientWithResponsesInterface: client, responses: map[string]any{}}
+}
+
+// Returns the memoized response of a call, or makes the call and memoizes its response if it succeeded;
+// the calls are identified by their operation, project and parameters
+func memoizeCall[T any](m *memoInfraClient, operation string, projectName string, params any,
+	status func(*T) *http.Response, call func() (*T, error)) (*T, error) {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return call()
+	}
+	key := fmt.Sprintf("%s/%s/%s", operation, projectName, encoded)
+
+	m.mu.Lock()
+	cached, ok := m.responses[key]
+	m.mu.Unlock()
+	if ok {
+		return cached.(*T), nil
+	}
+
+	resp, err := call()
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	if httpResp := status(resp); httpResp != nil && httpResp.StatusCode == http.StatusOK {
+		m.mu.Lock()
+		m.responses[key] = resp
+		m.mu.Unlock()
+	}
+	return resp, nil
+}
+
+func (m *memoInfraClient) HostServiceListHostsWithResponse(ctx context.Context, projectName string,
+	params *infra.HostServiceListHostsParams, reqEditors ...infra.RequestEditorFn) (*infra.HostServiceListHostsResponse, error) {
+	return memoizeCall(m, "hosts", projectName, params,
+		func(r *infra.HostServiceListHostsResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.HostServiceListHostsResponse, error) {
+			return m.ClientWithResponsesInterface.HostServiceListHostsWithResponse(ctx, projectName, params, reqEditors...)
+		})
+}
+
+func (m *memoInfraClient) InstanceServiceListInstancesWithResponse(ctx context.Context, projectName string,
+	params *infra.InstanceServiceListInstancesParams, reqEditors ...infra.RequestEditorFn) (*infra.InstanceServiceListInstancesResponse, error) {
+	return memoizeCall(m, "instances", projectName, params,
+		func(r *infra.InstanceServiceListInstancesResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.InstanceServiceListInstancesResponse, error) {
+			return m.ClientWithResponsesInterface.InstanceServiceListInstancesWithResponse(ctx, projectName, params, reqEditors...)
+		})
+}
+
+func (m *memoInfraClient) SiteServiceListSitesWithResponse(ctx context.Context, projectName string, regionID string,
+	params *infra.SiteServiceListSitesParams, reqEditors ...infra.RequestEditorFn) (*infra.SiteServiceListSitesResponse, error) {
+	return memoizeCall(m, "regions/"+regionID+"/sites", projectName, params,
+		func(r *infra.SiteServiceListSitesResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.SiteServiceListSitesResponse, error) {
+			return m.ClientWithResponsesInterface.SiteServiceListSitesWithResponse(ctx, projectName, regionID, params, reqEditors...)
+		})
+}
+
+func (m *memoInfraClient) RegionServiceListRegionsWithResponse(ctx context.Context, projectName string,
+	params *infra.RegionServiceListRegionsParams, reqEditors ...infra.RequestEditorFn) (*infra.RegionServiceListRegionsResponse, error) {
+	return memoizeCall(m, "regions", projectName, params,
+		func(r *infra.RegionServiceListRegionsResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.RegionServiceListRegionsResponse, error) {
+			return m.ClientWithResponsesInterface.RegionServiceListRegionsWithResponse(ctx, projectName, params, reqEditors...)
+		})
+}
+
+func (m *memoInfraClient) WorkloadServiceListWorkloadsWithResponse(ctx context.Context, projectName string,
+	params *infra.WorkloadServiceListWorkloadsParams, reqEditors ...infra.RequestEditorFn) (*infra.WorkloadServiceListWorkloadsResponse, error) {
+	return memoizeCall(m, "workloads", projectName, params,
+		func(r *infra.WorkloadServiceListWorkloadsResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.WorkloadServiceListWorkloadsResponse, error) {
+			return m.ClientWithResponsesInterface.WorkloadServiceListWorkloadsWithResponse(ctx, projectName, params, reqEditors...)
+		})
+}
+
+func (m *memoInfraClient) OSUpdatePolicyListOSUpdatePolicyWithResponse(ctx context.Context, projectName string,
+	params *infra.OSUpdatePolicyListOSUpdatePolicyParams, reqEditors ...infra.RequestEditorFn) (*infra.OSUpdatePolicyListOSUpdatePolicyResponse, error) {
+	return memoizeCall(m, "os_update_policies", projectName, params,
+		func(r *infra.OSUpdatePolicyListOSUpdatePolicyResponse) *http.Response { return r.HTTPResponse },
+		func() (*infra.OSUpdatePolicyListOSUpdatePolicyResponse, error) {
+			return m.ClientWithResponsesInterface.OSUpdatePolicyListOSUpdatePolicyWithResponse(ctx, projectName, params, reqEditors...)
+		})
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMemoInfraClient(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	client := infra.NewMockClientWithResponsesInterface(mctrl)
+	ctx := context.Background()
+
+	// A page is fetched once per project and parameters
+	client.EXPECT().InstanceServiceListInstancesWithResponse(gomock.Any(), "project", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, params *infra.InstanceServiceListInstancesParams, _ ...infra.RequestEditorFn) (*infra.InstanceServiceListInstancesResponse, error) {
+			return &infra.InstanceServiceListInstancesResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				JSON200:      &infra.ListInstancesResponse{Instances: []infra.InstanceResource{{ResourceId: stringPtr("instance-" + string(rune('a'+*params.Offset)))}}},
+			}, nil
+		}).Times(2)
+	// Failed calls are not memoized
+	client.EXPECT().InstanceServiceListInstancesWithResponse(gomock.Any(), "other-project", gomock.Any()).Return(
+		&infra.InstanceServiceListInstancesResponse{HTTPResponse: &http.Response{StatusCode: http.StatusInternalServerError}}, nil).Times(2)
+
+	memo := newMemoInfraClient(client)
+	assert.Same(t, memo, newMemoInfraClient(memo))
+
+	pageSize, first, second := 10, 0, 1
+	resp, err := memo.InstanceServiceListInstancesWithResponse(ctx, "project", &infra.InstanceServiceListInstancesParams{PageSize: &pageSize, Offset: &first})
+	require.NoError(t, err)
+	assert.Equal(t, "instance-a", *resp.JSON200.Instances[0].ResourceId)
+
+	again := 0
+	resp, err = memo.InstanceServiceListInstancesWithResponse(ctx, "project", &infra.InstanceServiceListInstancesParams{PageSize: &pageSize, Offset: &again})
+	require.NoError(t, err)
+	assert.Equal(t, "instance-a", *resp.JSON200.Instances[0].ResourceId)
+
+	resp, err = memo.InstanceServiceListInstancesWithResponse(ctx, "project", &infra.InstanceServiceListInstancesParams{PageSize: &pageSize, Offset: &second})
+	require.NoError(t, err)
+	assert.Equal(t, "instance-b", *resp.JSON200.Instances[0].ResourceId)
+
+	for i := 0; i < 2; i++ {
+		resp, err = memo.InstanceServiceListInstancesWithResponse(ctx, "other-project", &infra.InstanceServiceListInstancesParams{PageSize: &pageSize, Offset: &first})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.HTTPResponse.StatusCode)
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const getMetadataExamples = `# Show the effective metadata of a host with the site or region each entry comes from
+orch-cli get metadata --host host-1234abcd --project some-project
+
+# Show the effective metadata of a site given by name
+orch-cli get metadata --site "Store 42" --project some-project
+
+# Show the effective metadata of a region as JSON
+orch-cli get metadata --region region-1234abcd --project some-project -o json
+
+# Sample output
+KEY           VALUE        SOURCE                        RESOURCE ID
+environment   production   host                          host-1234abcd
+city          portland     site                          site-1234abcd
+country       us           region                        region-1234abcd
+city          seattle      region (overridden by site)   region-1234abcd
+`
+
+// MetadataEntry is a metadata item of a host, site or region along with the resource it is set on
+type MetadataEntry struct {
+	Key        string `json:"key" yaml:"key"`
+	Value      string `json:"value" yaml:"value"`
+	Source     string `json:"source" yaml:"source"`
+	ResourceId string `json:"resourceId" yaml:"resourceId"` //nolint:revive
+	// Source of the closer entry overriding this one, empty when the entry is effective
+	OverriddenBy string `json:"overriddenBy,omitempty" yaml:"overriddenBy,omitempty"`
+}
+
+// metadataLevel is the metadata set on a resource, the resources are ordered from the closest to the farthest
+type metadataLevel struct {
+	source     string
+	resourceID string
+	items      *[]infra.MetadataItem
+}
+
+func getGetMetadataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata [flags]",
+		Short: "Get the effective metadata of a host, site or region",
+		Long: "Shows the metadata of a host, site or region along with the metadata it inherits from its site and " +
+			"regions, with the resource each entry is set on. An entry set closer to the resource overrides the entries " +
+			"with the same key set on its site or regions, which are shown as overridden.",
+		Example: getMetadataExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runGetMetadataCommand,
+	}
+	cmd.Flags().String("host", "", "Host given by name or resource ID")
+	cmd.Flags().String("site", "", "Site given by name or resource ID")
+	cmd.Flags().String("region", "", "Region given by name or resource ID")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	cmd.MarkFlagsOneRequired("host", "site", "region")
+	cmd.MarkFlagsMutuallyExclusive("host", "site", "region")
+	return cmd
+}
+
+func runGetMetadataCommand(cmd *cobra.Command, _ []string) error {
+	hostFlag, _ := cmd.Flags().GetString("host")
+	siteFlag, _ := cmd.Flags().GetString("site")
+	regionFlag, _ := cmd.Flags().GetString("region")
+	outputType, _ := cmd.Flags().GetString("output-type")
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	var levels []metadataLevel
+	regionID := ""
+	switch {
+	case hostFlag != "":
+		host, err := getHostByNameOrID(ctx, client, projectName, hostFlag)
+		if err != nil {
+			return err
+		}
+		levels = append(levels, metadataLevel{"host", derefString(host.ResourceId), host.Metadata})
+		if siteID := derefString(host.SiteId); siteID != "" {
+			resp, err := client.SiteServiceGetSiteWithResponse(ctx, projectName, "empty", siteID, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting site of host"); err != nil {
+				return err
+			}
+			site := *resp.JSON200
+			levels = append(levels, metadataLevel{"site", derefString(site.ResourceId), site.Metadata})
+			regionID = siteRegionID(site)
+		}
+	case siteFlag != "":
+		site, err := getSiteByNameOrID(ctx, client, projectName, siteFlag)
+		if err != nil {
+			return err
+		}
+		levels = append(levels, metadataLevel{"site", derefString(site.ResourceId), site.Metadata})
+		regionID = siteRegionID(site)
+	default:
+		regionID, err = resolveRegionID(ctx, client, projectName, regionFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	regionLevels, err := regionMetadataLevels(ctx, client, projectName, regionID)
+	if err != nil {
+		return err
+	}
+	entries := effectiveMetadata(append(levels, regionLevels...))
+
+	if outputType == "json" || outputType == "yaml" {
+		result := CommandResult{
+			OutputAs: toOutputType(outputType),
+			Data:     entries,
+		}
+		GenerateOutput(cmd.OutOrStdout(), &result)
+		return nil
+	}
+	return printMetadataEntries(cmd, cmd.OutOrStdout(), entries)
+}
+
+func siteRegionID(site infra.SiteResource) string {
+	if regionID := derefString(site.RegionId); regionID != "" {
+		return regionID
+	}
+	if site.Region != nil {
+		return derefString(site.Region.ResourceId)
+	}
+	return ""
+}
+
+// Returns the metadata of a region and of its parent regions, from the region up to the root region
+func regionMetadataLevels(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, regionID string) ([]metadataLevel, error) {
+	var levels []metadataLevel
+	visited := map[string]bool{}
+	for regionID != "" && !visited[regionID] {
+		visited[regionID] = true
+		resp, err := client.RegionServiceGetRegionWithResponse(ctx, projectName, regionID, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error getting region %s", regionID)); err != nil {
+			return nil, err
+		}
+		levels = append(levels, metadataLevel{"region", regionID, resp.JSON200.Metadata})
+		regionID = derefString(resp.JSON200.ParentId)
+	}
+	return levels, nil
+}
+
+// Flattens the metadata levels, an entry is overridden by the entry with the same key of a closer level
+func effectiveMetadata(levels []metadataLevel) []MetadataEntry {
+	entries := []MetadataEntry{}
+	setBy := map[string]string{}
+	for _, level := range levels {
+		if level.items == nil {
+			continue
+		}
+		for _, item := range *level.items {
+			entry := MetadataEntry{Key: item.Key, Value: item.Value, Source: level.source, ResourceId: level.resourceID}
+			if source, ok := setBy[item.Key]; ok {
+				entry.OverriddenBy = source
+			} else {
+				setBy[item.Key] = level.source
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func printMetadataEntries(cmd *cobra.Command, w io.Writer, entries []MetadataEntry) error {
+	if len(entries) == 0 {
+		fmt.Fprintln(w, "No metadata")
+		return nil
+	}
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "KEY\tVALUE\tSOURCE\tRESOURCE ID\n")
+	for _, entry := range entries {
+		source := entry.Source
+		if entry.OverriddenBy != "" {
+			source = fmt.Sprintf("%s (overridden by %s)", entry.Source, entry.OverriddenBy)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", entry.Key, entry.Value, source, entry.ResourceId)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestGetMetadata() {
+	out, err := s.runCommand("get metadata --host host-abc12345 --project " + project)
+	s.NoError(err)
+	s.Regexp(`environment[ |]+production[ |]+host[ |]+host-abc12345\n`, out)
+	s.Regexp(`environment[ |]+production[ |]+site \(overridden by host\)[ |]+site-abc123\n`, out)
+	s.Regexp(`datacenter[ |]+nyc-east-1[ |]+site[ |]+site-abc123\n`, out)
+	s.Regexp(`region[ |]+us-east[ |]+region[ |]+region-abcd1234\n`, out)
+	s.Regexp(`region[ |]+us-east[ |]+region \(overridden by region\)[ |]+region-abcd1111\n`, out)
+
+	out, err = s.runCommand("get metadata --region region-abcd1234 -o json --project " + project)
+	s.NoError(err)
+	s.Contains(out, `"resourceId":"region-abcd1234"`)
+	s.Contains(out, `"overriddenBy":"region"`)
+	s.NotContains(out, `"source":"site"`)
+
+	_, err = s.runCommand("get metadata --project " + project)
+	s.ErrorContains(err, "at least one of the flags in the group [host site region] is required")
+
+	_, err = s.runCommand("get metadata --host host-abc12345 --region region-abcd1234 --project " + project)
+	s.Error(err)
+
+	_, err = s.runCommand("get metadata --region region-11111111 --project " + project)
+	s.Error(err)
+}
+
+func TestEffectiveMetadata(t *testing.T) {
+	entries := effectiveMetadata([]metadataLevel{
+		{"host", "host-1", &[]infra.MetadataItem{{Key: "city", Value: "portland"}}},
+		{"site", "site-1", nil},
+		{"region", "region-1", &[]infra.MetadataItem{{Key: "country", Value: "us"}, {Key: "city", Value: "seattle"}}},
+	})
+	assert.Equal(t, []MetadataEntry{
+		{Key: "city", Value: "portland", Source: "host", ResourceId: "host-1"},
+		{Key: "country", Value: "us", Source: "region", ResourceId: "region-1"},
+		{Key: "city", Value: "seattle", Source: "region", ResourceId: "region-1", OverriddenBy: "host"},
+	}, entries)
+
+	assert.Empty(t, effectiveMetadata(nil))
+}
@@ -11,7 +11,6 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 	"time"
 
 	"github.com/open-edge-platform/cli/pkg/auth"
@@ -182,7 +181,7 @@ func getListMetricsOutputFormat(cmd *cobra.Command) (string, error) {
 }
 
 // printMetricNames renders a list of metric names in the selected output format.
-func printMetricNames(cmd *cobra.Command, writer *tabwriter.Writer, metricNames []string) error {
+func printMetricNames(cmd *cobra.Command, writer *format.TableWriter, metricNames []string) error {
 	rows := make([]metricListRow, 0, len(metricNames))
 	for i, metricName := range metricNames {
 		name := metricName
@@ -208,6 +207,9 @@ func printMetricNames(cmd *cobra.Command, writer *tabwriter.Writer, metricNames
 		NameLimit: -1,
 		Data:      rows,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -221,7 +223,7 @@ func runListMetricNamesCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), defaultMetricsTimeout)
+	ctx, cancel := context.WithTimeout(commandContext(cmd), defaultMetricsTimeout)
 	defer cancel()
 
 	client, err := PrometheusClientFactory(cmd)
@@ -352,7 +354,7 @@ func extractMetricItemFields(metric map[string]string, hostnameLabel string) (ho
 }
 
 // printMetricResult formats non-range get metric responses and keeps the last sample for matrix results.
-func printMetricResult(cmd *cobra.Command, writer *tabwriter.Writer, metricName string, hostnameLabel string, body []byte, verbose bool) error {
+func printMetricResult(cmd *cobra.Command, writer *format.TableWriter, metricName string, hostnameLabel string, body []byte, verbose bool) error {
 	resp, err := parsePrometheusResponse(body)
 	if err != nil {
 		return err
@@ -390,6 +392,10 @@ func printMetricResult(cmd *cobra.Command, writer *tabwriter.Writer, metricName
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, rows); selected {
+		return err
+	}
+
 	result := CommandResult{
 		Format:    format.Format(outputFormat),
 		OutputAs:  toOutputType(outputType),
@@ -403,7 +409,7 @@ func printMetricResult(cmd *cobra.Command, writer *tabwriter.Writer, metricName
 
 // printMetricRangeResult formats range-query responses for `get metric --range`.
 // For matrix results, each sample in `values` becomes a separate output row.
-func printMetricRangeResult(cmd *cobra.Command, writer *tabwriter.Writer, metricName string, hostnameLabel string, body []byte, verbose bool) error {
+func printMetricRangeResult(cmd *cobra.Command, writer *format.TableWriter, metricName string, hostnameLabel string, body []byte, verbose bool) error {
 	resp, err := parsePrometheusResponse(body)
 	if err != nil {
 		return err
@@ -449,6 +455,10 @@ func printMetricRangeResult(cmd *cobra.Command, writer *tabwriter.Writer, metric
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, rows); selected {
+		return err
+	}
+
 	result := CommandResult{
 		Format:    format.Format(outputFormat),
 		OutputAs:  toOutputType(outputType),
@@ -522,7 +532,7 @@ func getMetricQueryModes(cmd *cobra.Command) (metricQueryModes, int, error) {
 }
 
 // runMetricQuery executes a selected metric query mode.
-func runMetricQuery(ctx context.Context, cmd *cobra.Command, writer *tabwriter.Writer, client promapi.Client, request metricQueryInput, mode string, verbose bool) error {
+func runMetricQuery(ctx context.Context, cmd *cobra.Command, writer *format.TableWriter, client promapi.Client, request metricQueryInput, mode string, verbose bool) error {
 	now := time.Now()
 	startTime, endTime, err := resolveWindowInputs(mode, request.startTimeStr, request.endTimeStr, request.durationSec, now)
 	if err != nil {
@@ -645,7 +655,7 @@ func runGetMetricCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), defaultMetricsTimeout)
+	ctx, cancel := context.WithTimeout(commandContext(cmd), defaultMetricsTimeout)
 	defer cancel()
 
 	client, err := PrometheusClientFactory(cmd)
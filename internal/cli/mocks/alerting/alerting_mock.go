@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/open-edge-platform/cli/internal/cli/interfaces"
+	alertapi "github.com/open-edge-platform/cli/pkg/rest/alerting"
+	"github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
+)
+
+// CreateAlertingMock creates a mock alerting monitor factory function
+func CreateAlertingMock(mctrl *gomock.Controller) interfaces.AlertingFactoryFunc {
+	return func(cmd *cobra.Command) (context.Context, alertapi.ClientWithResponsesInterface, string, error) {
+		mockAlertClient := alertapi.NewMockClientWithResponsesInterface(mctrl)
+
+		projectName, err := cmd.Flags().GetString("project")
+		if err != nil || projectName == "" {
+			projectName = "test-project" // Default fallback
+		}
+
+		timePtr := func(s string) *time.Time {
+			t, _ := time.Parse(time.RFC3339, s)
+			return &t
+		}
+
+		alerts := []alertapi.Alert{
+			{
+				AlertDefinitionId: "64d3b2a1-8f1e-4c1b-9d7a-1a2b3c4d5e6f",
+				Fingerprint:       "5f2c8d1e9b7a3c60",
+				Labels: map[string]string{
+					"alertname":    "ClusterCPUUsageExceedsThreshold",
+					"severity":     "warning",
+					"cluster_name": "cluster-1",
+				},
+				Annotations: map[string]string{"summary": "CPU usage of the cluster exceeds 80%"},
+				StartsAt:    timePtr("2026-10-15T09:00:00Z"),
+				UpdatedAt:   timePtr("2026-10-15T09:05:00Z"),
+				Status:      &alertapi.AlertStatus{State: alertapi.AlertStateActive},
+			},
+			{
+				AlertDefinitionId: "0c5d4e3f-2a1b-4c9d-8e7f-6a5b4c3d2e1f",
+				Fingerprint:       "0a6e1b3c7f2d9e41",
+				Labels: map[string]string{
+					"alertname": "HostStatusError",
+					"severity":  "critical",
+					"host_uuid": "4c4c4544-2046-5310-8052-cac04f515233",
+				},
+				Annotations: map[string]string{
+					"summary":     "Host is in error state",
+					"description": "The host reports an error status for more than 5 minutes",
+				},
+				StartsAt:  timePtr("2026-10-15T08:00:00Z"),
+				UpdatedAt: timePtr("2026-10-15T08:10:00Z"),
+				Status:    &alertapi.AlertStatus{State: alertapi.AlertStateActive},
+			},
+			{
+				AlertDefinitionId: "9a8b7c6d-5e4f-4a3b-2c1d-0e9f8a7b6c5d",
+				Fingerprint:       "9d4b7e2a1c6f8035",
+				Labels: map[string]string{
+					"alertname":     "DeploymentDown",
+					"severity":      "major",
+					"deployment_id": "deployment-1a2b3c4d",
+				},
+				Annotations: map[string]string{"summary": "Deployment is down"},
+				StartsAt:    timePtr("2026-10-14T22:00:00Z"),
+				Status:      &alertapi.AlertStatus{State: alertapi.AlertStateSuppressed},
+			},
+		}
+
+		mockAlertClient.EXPECT().ListProjectAlertsWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, projectName string, params *alertapi.ListAlertsParams, _ ...alertapi.RequestEditorFn) (*alertapi.ListAlertsResponse, error) {
+				if projectName == "invalid-project" {
+					return &alertapi.ListAlertsResponse{
+						HTTPResponse: &http.Response{StatusCode: 500, Status: "Internal Server Error"},
+						Body:         []byte(`{"message":"internal error"}`),
+					}, nil
+				}
+				var listed []alertapi.Alert
+				for _, alert := range alerts {
+					if params != nil && params.Active != nil && *params.Active && alert.Status.State != alertapi.AlertStateActive {
+						continue
+					}
+					if params != nil && params.Host != nil && alert.Labels["host_uuid"] != *params.Host {
+						continue
+					}
+					listed = append(listed, alert)
+				}
+				return &alertapi.ListAlertsResponse{
+					HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+					JSON200:      &alertapi.AlertList{Alerts: listed},
+				}, nil
+			},
+		).AnyTimes()
+
+		return context.Background(), mockAlertClient, projectName, nil
+	}
+}
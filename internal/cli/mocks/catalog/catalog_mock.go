@@ -184,6 +184,22 @@ func CreateCatalogMock(mctrl *gomock.Controller) interfaces.CatalogFactoryFunc {
 			},
 		).AnyTimes()
 
+		// Mock UploadCatalogEntities; a file named invalid.yaml is rejected
+		mockClient.EXPECT().CatalogServiceUploadCatalogEntitiesWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, _ string, params *catapi.CatalogServiceUploadCatalogEntitiesParams, body catapi.CatalogServiceUploadCatalogEntitiesJSONRequestBody, _ ...catapi.RequestEditorFn) (*catapi.CatalogServiceUploadCatalogEntitiesResponse, error) {
+				resp := &catapi.CatalogV3UploadCatalogEntitiesResponse{SessionId: "upload-session", UploadNumber: *params.UploadNumber}
+				if body.FileName == "invalid.yaml" {
+					resp.ErrorMessages = &[]string{"invalid.yaml: unknown specSchema"}
+				}
+				return &catapi.CatalogServiceUploadCatalogEntitiesResponse{
+					HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+					JSON200:      resp,
+				}, nil
+			},
+		).AnyTimes()
+
 		// Mock DeleteRegistry
 		mockClient.EXPECT().CatalogServiceDeleteRegistryWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -54,6 +54,20 @@ func CreateClusterMock(mctrl *gomock.Controller) interfaces.ClusterFactoryFunc {
 								Message: stringPtr("Template not found"),
 							},
 						}, nil
+					case "edge-large":
+						// Declares the minimum machine of its hosts
+						return &cluster.GetV2ProjectsProjectNameTemplatesNameVersionResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &cluster.TemplateInfo{
+								Name:    templateName,
+								Version: version,
+								ClusterLabels: &map[string]string{
+									"min-cpu-cores":  "16",
+									"min-memory-gb":  "32",
+									"min-storage-gb": "100",
+								},
+							},
+						}, nil
 					default:
 						return &cluster.GetV2ProjectsProjectNameTemplatesNameVersionResponse{
 							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
@@ -67,6 +81,31 @@ func CreateClusterMock(mctrl *gomock.Controller) interfaces.ClusterFactoryFunc {
 			},
 		).AnyTimes()
 
+		// Mock PostV2ProjectsProjectNameTemplatesWithResponse (used by create template command)
+		mockClusterClient.EXPECT().PostV2ProjectsProjectNameTemplatesWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, projectName string, _ *cluster.PostV2ProjectsProjectNameTemplatesParams, body cluster.PostV2ProjectsProjectNameTemplatesJSONRequestBody, _ ...cluster.RequestEditorFn) (*cluster.PostV2ProjectsProjectNameTemplatesResponse, error) {
+				switch {
+				case projectName == "nonexistent-project":
+					return &cluster.PostV2ProjectsProjectNameTemplatesResponse{
+						HTTPResponse: &http.Response{StatusCode: 500, Status: "Internal Server Error"},
+						Body:         []byte(`{"message":"Project not found"}`),
+					}, nil
+				case body.Name == "existing-template":
+					return &cluster.PostV2ProjectsProjectNameTemplatesResponse{
+						HTTPResponse: &http.Response{StatusCode: 409, Status: "Conflict"},
+						Body:         []byte(`{"message":"template already exists"}`),
+					}, nil
+				default:
+					return &cluster.PostV2ProjectsProjectNameTemplatesResponse{
+						HTTPResponse: &http.Response{StatusCode: 201, Status: "Created"},
+						JSON201:      stringPtr(body.Name + "-" + body.Version),
+					}, nil
+				}
+			},
+		).AnyTimes()
+
 		// Mock GetV2ProjectsProjectNameTemplatesWithResponse (used by list templates command)
 		mockClusterClient.EXPECT().GetV2ProjectsProjectNameTemplatesWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -386,6 +425,49 @@ func CreateClusterMock(mctrl *gomock.Controller) interfaces.ClusterFactoryFunc {
 			},
 		).AnyTimes()
 
+		// Mock GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse (used by get kubeconfig command)
+		mockClusterClient.EXPECT().GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(ctx context.Context, projectName, clusterName string, params *cluster.GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...cluster.RequestEditorFn) (*cluster.GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+				_ = ctx        // Acknowledge we're not using it
+				_ = params     // Acknowledge we're not using it
+				_ = reqEditors // Acknowledge we're not using it
+				if projectName == "nonexistent-project" || clusterName == "nonexistent-cluster" {
+					return &cluster.GetV2ProjectsProjectNameClustersNameKubeconfigsResponse{
+						HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
+						JSON404: &cluster.N404NotFound{
+							Message: stringPtr("Cluster not found"),
+						},
+					}, nil
+				}
+				kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://connect-gateway.example.com/kubernetes/%[2]s-%[1]s
+contexts:
+- name: %[1]s-admin@%[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s-admin
+current-context: %[1]s-admin@%[1]s
+users:
+- name: %[1]s-admin
+  user:
+    token: test-token
+`, clusterName, projectName)
+				return &cluster.GetV2ProjectsProjectNameClustersNameKubeconfigsResponse{
+					HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+					JSON200: &cluster.KubeconfigInfo{
+						Id:         stringPtr(clusterName),
+						Kubeconfig: &kubeconfig,
+					},
+				}, nil
+			},
+		).AnyTimes()
+
 		// Mock PostV2ProjectsProjectNameClustersWithResponse (used by create cluster command)
 		mockClusterClient.EXPECT().PostV2ProjectsProjectNameClustersWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
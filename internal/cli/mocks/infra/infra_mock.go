@@ -7,6 +7,7 @@ package infra
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/open-edge-platform/cli/internal/cli/interfaces"
@@ -81,6 +82,32 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 			},
 		).AnyTimes()
 
+		// Mock CustomConfigServiceGetCustomConfigWithResponse (used by get and delete custom config commands given a resource ID)
+		mockInfraClient.EXPECT().CustomConfigServiceGetCustomConfigWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, _ string, resourceID string, _ ...infra.RequestEditorFn) (*infra.CustomConfigServiceGetCustomConfigResponse, error) {
+				if resourceID != "customconfig-abc12345" {
+					return &infra.CustomConfigServiceGetCustomConfigResponse{
+						HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
+					}, nil
+				}
+				return &infra.CustomConfigServiceGetCustomConfigResponse{
+					HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+					JSON200: &infra.CustomConfigResource{
+						Name:        "haproxy-config",
+						Config:      "#cloud-config\nwrite_files:\n- path: /tmp/testfile\n  content: TEST",
+						Description: stringPtr("haproxy configuration for web services"),
+						ResourceId:  stringPtr(resourceID),
+						Timestamps: &infra.Timestamps{
+							CreatedAt: timestampPtr(timestamp),
+							UpdatedAt: timestampPtr(timestamp),
+						},
+					},
+				}, nil
+			},
+		).AnyTimes()
+
 		// Mock CustomConfigServiceCreateCustomConfigWithResponse (used by create custom config command)
 		mockInfraClient.EXPECT().CustomConfigServiceCreateCustomConfigWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -146,6 +173,12 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		).DoAndReturn(
 			func(_ context.Context, _ string, body infra.LocalAccountServiceCreateLocalAccountJSONRequestBody, _ ...infra.RequestEditorFn) (*infra.LocalAccountServiceCreateLocalAccountResponse, error) {
+				if body.Username == "existing-user" {
+					return &infra.LocalAccountServiceCreateLocalAccountResponse{
+						HTTPResponse: &http.Response{StatusCode: 409, Status: "Conflict"},
+						Body:         []byte(`{"message":"local account already exists"}`),
+					}, nil
+				}
 				return &infra.LocalAccountServiceCreateLocalAccountResponse{
 					HTTPResponse: &http.Response{StatusCode: 201, Status: "Created"},
 					JSON200: &infra.LocalAccountResource{
@@ -283,6 +316,36 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 			},
 		).AnyTimes()
 
+		// Mock GetOperatingSystem (used by get and delete commands given a resource ID)
+		mockInfraClient.EXPECT().OperatingSystemServiceGetOperatingSystemWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, _ string, resourceID string, _ ...infra.RequestEditorFn) (*infra.OperatingSystemServiceGetOperatingSystemResponse, error) {
+				if resourceID != "os-1234abcd" {
+					return &infra.OperatingSystemServiceGetOperatingSystemResponse{
+						HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
+					}, nil
+				}
+				return &infra.OperatingSystemServiceGetOperatingSystemResponse{
+					HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+					JSON200: &infra.OperatingSystemResource{
+						Name:            stringPtr("Edge Microvisor Toolkit 3.0.20250504"),
+						Architecture:    stringPtr("x86_64"),
+						SecurityFeature: (*infra.SecurityFeature)(stringPtr("SECURITY_FEATURE_NONE")),
+						ProfileName:     stringPtr("microvisor-nonrt"),
+						OsResourceID:    stringPtr(resourceID),
+						ResourceId:      stringPtr(resourceID),
+						Sha256:          "abc123def456",
+						ProfileVersion:  stringPtr("3.0.20250504"),
+						Timestamps: &infra.Timestamps{
+							CreatedAt: timestampPtr(timestamp),
+							UpdatedAt: timestampPtr(timestamp),
+						},
+					},
+				}, nil
+			},
+		).AnyTimes()
+
 		// Mock CreateOperatingSystem (used by create command)
 		mockInfraClient.EXPECT().OperatingSystemServiceCreateOperatingSystemWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -699,6 +762,118 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 								Hostname:   stringPtr("edge-host-002.example.com"),
 							},
 						}, nil
+					case "host-abcd1003":
+						// Provisioned host with a site, an OS and metadata, as moved by transfer host
+						return &infra.HostServiceGetHostResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &infra.HostResource{
+								ResourceId:   stringPtr(hostId),
+								Name:         "edge-host-003",
+								SerialNumber: stringPtr("2500JF3"),
+								Uuid:         stringPtr("4c4c4544-2046-5310-8052-cac04f515233"),
+								CurrentState: (*infra.HostState)(stringPtr("HOST_STATE_ONBOARDED")),
+								SiteId:       stringPtr("site-abcd1234"),
+								Site: &infra.SiteResource{
+									ResourceId: stringPtr("site-abcd1234"),
+									Name:       stringPtr("store-042"),
+								},
+								UserLvmSize: func() *int { i := 10; return &i }(),
+								Instance: &infra.InstanceResource{
+									ResourceId:      stringPtr("instance-abcd1003"),
+									InstanceID:      stringPtr("instance-abcd1003"),
+									OsID:            stringPtr("os-abcd1003"),
+									SecurityFeature: (*infra.SecurityFeature)(stringPtr("SECURITY_FEATURE_NONE")),
+									Os: &infra.OperatingSystemResource{
+										ResourceId: stringPtr("os-abcd1003"),
+										Name:       stringPtr("Edge Microvisor Toolkit 3.0.20250101"),
+									},
+								},
+								Metadata: &[]infra.MetadataItem{
+									{Key: "environment", Value: "production"},
+									{Key: "rack", Value: "r12"},
+								},
+							},
+						}, nil
+					case "host-abcd1005":
+						// Host whose provisioning failed, as troubleshot by logs host
+						return &infra.HostServiceGetHostResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &infra.HostResource{
+								ResourceId:                  stringPtr(hostId),
+								Name:                        "edge-host-005",
+								RegistrationStatus:          stringPtr("Registered"),
+								RegistrationStatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_IDLE")),
+								OnboardingStatus:            stringPtr("Onboarded"),
+								OnboardingStatusIndicator:   (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_IDLE")),
+								HostStatus:                  stringPtr("Error"),
+								HostStatusIndicator:         (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_ERROR")),
+								HostStatusTimestamp:         func() *int { t := int(timestamp.Unix()); return &t }(),
+								Instance: &infra.InstanceResource{
+									ResourceId:                  stringPtr("instance-abcd1005"),
+									InstanceID:                  stringPtr("instance-abcd1005"),
+									ProvisioningStatus:          stringPtr("Provisioning Failed: failed to download image: 404 Not Found"),
+									ProvisioningStatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_ERROR")),
+									ProvisioningStatusTimestamp: func() *int { t := int(timestamp.Unix()); return &t }(),
+									InstanceStatus:              stringPtr("Error"),
+									InstanceStatusDetail:        stringPtr("tpm device not found"),
+									InstanceStatusIndicator:     (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_ERROR")),
+								},
+							},
+						}, nil
+					case "host-abcd1004":
+						// Provisioned host whose instance is a cluster node, as checked by verify host
+						return &infra.HostServiceGetHostResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &infra.HostResource{
+								ResourceId:          stringPtr(hostId),
+								Name:                "edge-host-004",
+								HostStatus:          stringPtr("Running"),
+								HostStatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_IDLE")),
+								HostNics: &[]infra.HostnicResource{
+									{
+										DeviceName:  stringPtr("eth0"),
+										Ipaddresses: &[]infra.IPAddressResource{{Address: stringPtr("192.168.1.104/24")}},
+									},
+								},
+								Instance: &infra.InstanceResource{
+									ResourceId: stringPtr("instance-abcd1004"),
+									InstanceID: stringPtr("instance-abcd1004"),
+								},
+							},
+						}, nil
+					case "host-abcd1006":
+						// Provisioned cluster node with a local account and a custom config, as replaced by replace host
+						return &infra.HostServiceGetHostResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &infra.HostResource{
+								ResourceId:   stringPtr(hostId),
+								Name:         "edge-host-006",
+								SerialNumber: stringPtr("2500JF6"),
+								Uuid:         stringPtr("4c4c4544-2046-5310-8052-cac04f515236"),
+								CurrentState: (*infra.HostState)(stringPtr("HOST_STATE_ONBOARDED")),
+								SiteId:       stringPtr("site-abcd1234"),
+								UserLvmSize:  func() *int { i := 20; return &i }(),
+								Metadata: &[]infra.MetadataItem{
+									{Key: "rack", Value: "r12"},
+								},
+								Instance: &infra.InstanceResource{
+									ResourceId:      stringPtr("instance-abcd1006"),
+									InstanceID:      stringPtr("instance-abcd1006"),
+									OsID:            stringPtr("os-1234abcd"),
+									SecurityFeature: (*infra.SecurityFeature)(stringPtr("SECURITY_FEATURE_NONE")),
+									Localaccount:    &infra.LocalAccountResource{Username: "admin"},
+									CustomConfig: &[]infra.CustomConfigResource{
+										{Name: "haproxy-config", ResourceId: stringPtr("config-abc12345")},
+									},
+									WorkloadMembers: &[]infra.WorkloadMember{
+										{
+											Kind:     infra.WORKLOADMEMBERKINDCLUSTERNODE,
+											Workload: &infra.WorkloadResource{Kind: infra.WORKLOADKINDCLUSTER, Name: stringPtr("cluster-edge")},
+										},
+									},
+								},
+							},
+						}, nil
 					default:
 						return &infra.HostServiceGetHostResponse{
 							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
@@ -1702,7 +1877,45 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 					return &infra.InstanceServiceGetInstanceResponse{
 						HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
 					}, nil
+				case "provisioning-pending-project":
+					return &infra.InstanceServiceGetInstanceResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &infra.InstanceResource{
+							ResourceId:                  stringPtr(instanceId),
+							CurrentState:                (*infra.InstanceState)(stringPtr("INSTANCE_STATE_UNSPECIFIED")),
+							ProvisioningStatus:          stringPtr("Provisioning In Progress"),
+							ProvisioningStatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_IN_PROGRESS")),
+						},
+					}, nil
+				case "provisioning-failed-project":
+					return &infra.InstanceServiceGetInstanceResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &infra.InstanceResource{
+							ResourceId:                  stringPtr(instanceId),
+							CurrentState:                (*infra.InstanceState)(stringPtr("INSTANCE_STATE_UNSPECIFIED")),
+							ProvisioningStatus:          stringPtr("Provisioning Failed: failed to download image: 404 Not Found"),
+							ProvisioningStatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_ERROR")),
+						},
+					}, nil
 				default:
+					if instanceId == "instance-abcd1004" {
+						return &infra.InstanceServiceGetInstanceResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200: &infra.InstanceResource{
+								ResourceId:           stringPtr(instanceId),
+								InstanceStatusDetail: stringPtr("10 of 10 components running"),
+								CurrentState:         (*infra.InstanceState)(stringPtr("INSTANCE_STATE_RUNNING")),
+								LocalAccountID:       stringPtr("localaccount-abcd1004"),
+								Localaccount:         &infra.LocalAccountResource{Username: "admin"},
+								WorkloadMembers: &[]infra.WorkloadMember{
+									{
+										Kind:     infra.WORKLOADMEMBERKINDCLUSTERNODE,
+										Workload: &infra.WorkloadResource{Kind: infra.WORKLOADKINDCLUSTER, Name: stringPtr("cluster-edge")},
+									},
+								},
+							},
+						}, nil
+					}
 					return &infra.InstanceServiceGetInstanceResponse{
 						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
 						JSON200: &infra.InstanceResource{
@@ -1828,6 +2041,66 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 			},
 		).AnyTimes()
 
+		// Mock WorkloadServiceListWorkloadsWithResponse (used by list workload command)
+		workloads := []infra.WorkloadResource{
+			{
+				ResourceId: stringPtr("workload-abcd1234"),
+				WorkloadId: stringPtr("workload-abcd1234"),
+				Name:       stringPtr("Edge Kubernetes Cluster"),
+				Kind:       infra.WORKLOADKINDCLUSTER,
+				Status:     stringPtr("Running"),
+				ExternalId: stringPtr("k8s-cluster-east-001"),
+			},
+			{
+				ResourceId: stringPtr("workload-abcd5678"),
+				WorkloadId: stringPtr("workload-abcd5678"),
+				Name:       stringPtr("empty-workload"),
+				Kind:       infra.WORKLOADKINDCLUSTER,
+				Status:     stringPtr("Pending"),
+			},
+		}
+		mockInfraClient.EXPECT().WorkloadServiceListWorkloadsWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, projectName string, _ *infra.WorkloadServiceListWorkloadsParams, _ ...infra.RequestEditorFn) (*infra.WorkloadServiceListWorkloadsResponse, error) {
+				switch projectName {
+				case "nonexistent-project":
+					return &infra.WorkloadServiceListWorkloadsResponse{
+						HTTPResponse: &http.Response{StatusCode: 500, Status: "Internal Server Error"},
+					}, nil
+				default:
+					return &infra.WorkloadServiceListWorkloadsResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &infra.ListWorkloadsResponse{
+							Workloads:     workloads,
+							HasNext:       false,
+							TotalElements: int32(len(workloads)),
+						},
+					}, nil
+				}
+			},
+		).AnyTimes()
+
+		// Mock WorkloadServiceGetWorkloadWithResponse (used by get workload command)
+		mockInfraClient.EXPECT().WorkloadServiceGetWorkloadWithResponse(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).DoAndReturn(
+			func(_ context.Context, _ string, resourceID string, _ ...infra.RequestEditorFn) (*infra.WorkloadServiceGetWorkloadResponse, error) {
+				for _, w := range workloads {
+					if *w.ResourceId == resourceID {
+						return &infra.WorkloadServiceGetWorkloadResponse{
+							HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+							JSON200:      &w,
+						}, nil
+					}
+				}
+				return &infra.WorkloadServiceGetWorkloadResponse{
+					HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
+					Body:         []byte(`{"message":"workload_resource not found"}`),
+				}, nil
+			},
+		).AnyTimes()
+
 		// Mock OSUpdateRunListOSUpdateRunWithResponse (used by list os update runs command)
 		mockInfraClient.EXPECT().OSUpdateRunListOSUpdateRunWithResponse(
 			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -1836,6 +2109,31 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 				_ = ctx        // Acknowledge we're not using it
 				_ = params     // Acknowledge we're not using it
 				_ = reqEditors // Acknowledge we're not using it
+				// The runs of a single instance, as monitored by rollout osupdate, have just finished
+				if params != nil && params.Filter != nil && strings.HasPrefix(*params.Filter, "instance.resourceId=") && projectName != "nonexistent-project" {
+					now := int(time.Now().Unix())
+					run := infra.OSUpdateRun{
+						Name:            stringPtr("rollout-run"),
+						ResourceId:      stringPtr("osupdaterun-abcd5678"),
+						Status:          stringPtr("completed"),
+						StatusIndicator: (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_IDLE")),
+						StartTime:       &now,
+						EndTime:         &now,
+					}
+					if projectName == "rollout-failure-project" {
+						run.Status = stringPtr("failed")
+						run.StatusDetails = stringPtr("package download failed")
+						run.StatusIndicator = (*infra.StatusIndication)(stringPtr("STATUS_INDICATION_ERROR"))
+					}
+					return &infra.OSUpdateRunListOSUpdateRunResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &infra.ListOSUpdateRunResponse{
+							OsUpdateRuns:  []infra.OSUpdateRun{run},
+							TotalElements: 1,
+							HasNext:       false,
+						},
+					}, nil
+				}
 				switch projectName {
 				case "nonexistent-project":
 					return &infra.OSUpdateRunListOSUpdateRunResponse{
@@ -2387,6 +2685,53 @@ func CreateInfraMock(mctrl *gomock.Controller, timestamp time.Time) interfaces.I
 					return &infra.ScheduleServiceListSchedulesResponse{
 						HTTPResponse: &http.Response{StatusCode: 500, Status: "Internal Server Error"},
 					}, nil
+				case "maintenance-schedules":
+					return &infra.ScheduleServiceListSchedulesResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &infra.ListSchedulesResponse{
+							RepeatedSchedules: []infra.RepeatedScheduleResource{
+								{
+									// Saturdays 02:00-04:00 UTC on the parent region
+									CronDayMonth:    "*",
+									CronDayWeek:     "6",
+									CronHours:       "2",
+									CronMinutes:     "0",
+									CronMonth:       "*",
+									DurationSeconds: 7200,
+									Name:            stringPtr("weekly-patch"),
+									ResourceId:      stringPtr("repeatedsche-abcd1111"),
+									ScheduleStatus:  infra.SCHEDULESTATUSOSUPDATE,
+									TargetRegionId:  stringPtr("region-abcd1111"),
+								},
+								{
+									// Same window on a site the host is not in
+									CronDayMonth:    "*",
+									CronDayWeek:     "6",
+									CronHours:       "2",
+									CronMinutes:     "0",
+									CronMonth:       "*",
+									DurationSeconds: 7200,
+									Name:            stringPtr("other-site"),
+									ResourceId:      stringPtr("repeatedsche-abcd2222"),
+									ScheduleStatus:  infra.SCHEDULESTATUSMAINTENANCE,
+									TargetSiteId:    stringPtr("site-abcd2222"),
+								},
+							},
+							SingleSchedules: []infra.SingleScheduleResource{
+								{
+									// 2026-04-04 03:00-05:00 UTC on the site of the host
+									Name:           stringPtr("site-outage"),
+									ResourceId:     stringPtr("singlesche-abcd1111"),
+									ScheduleStatus: infra.SCHEDULESTATUSMAINTENANCE,
+									TargetSiteId:   stringPtr("site-abc123"),
+									StartSeconds:   1775271600,
+									EndSeconds:     func() *int { i := 1775278800; return &i }(),
+								},
+							},
+							TotalElements: 3,
+							HasNext:       false,
+						},
+					}, nil
 				case "duplicate-schedule":
 					return &infra.ScheduleServiceListSchedulesResponse{
 						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
@@ -212,6 +212,30 @@ func CreateTenancyMock(mctrl *gomock.Controller) interfaces.TenancyFactoryFunc {
 					return &tenancyapi.GETV1ProjectsProjectProjectResponse{
 						HTTPResponse: &http.Response{StatusCode: 404, Status: "Not Found"},
 					}, nil
+				case "failed-project":
+					return &tenancyapi.GETV1ProjectsProjectProjectResponse{
+						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
+						JSON200: &tenancyapi.ProjectProjectGet{
+							Status: &struct {
+								ProjectStatus *struct {
+									Message         *string `json:"message,omitempty"`
+									StatusIndicator *string `json:"statusIndicator,omitempty"`
+									TimeStamp       *int64  `json:"timeStamp,omitempty"`
+									UID             *string `json:"uID,omitempty"`
+								} `json:"projectStatus,omitempty"`
+							}{
+								ProjectStatus: &struct {
+									Message         *string `json:"message,omitempty"`
+									StatusIndicator *string `json:"statusIndicator,omitempty"`
+									TimeStamp       *int64  `json:"timeStamp,omitempty"`
+									UID             *string `json:"uID,omitempty"`
+								}{
+									Message:         stringPtr("Project failed-project CREATE failed"),
+									StatusIndicator: stringPtr("STATUS_INDICATION_ERROR"),
+								},
+							},
+						},
+					}, nil
 				default:
 					return &tenancyapi.GETV1ProjectsProjectProjectResponse{
 						HTTPResponse: &http.Response{StatusCode: 200, Status: "OK"},
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/offline"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	offlineFlag = "offline"
+	// Subdirectory of the cache directory keeping the responses served by --offline
+	offlineCacheDirName = "responses"
+	// Responses kept for --offline, older or further ones are removed
+	offlineCacheMaxAge     = 7 * 24 * time.Hour
+	offlineCacheMaxEntries = 1000
+)
+
+// Runs every command of the tree with a context telling the API calls not to fetch an access token with --offline,
+// since Keycloak cannot be reached either
+func applyOffline(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			if isOffline, _ := c.Flags().GetBool(offlineFlag); isOffline {
+				c.SetContext(auth.WithOffline(commandContext(c)))
+			}
+			return run(c, args)
+		}
+	}
+	for _, child := range cmd.Commands() {
+		applyOffline(child)
+	}
+}
+
+// Returns the cache of the responses of the API calls of a command: the list and get commands keep the responses
+// of their reads, and every command is served from them with --offline. The second value is false for the
+// commands which neither keep nor read the cache.
+func offlineConfig(cmd *cobra.Command) (offline.Config, bool) {
+	isOffline, _ := cmd.Flags().GetBool(offlineFlag)
+	if !isOffline && !isPagedCommand(cmd) {
+		return offline.Config{}, false
+	}
+	cfg := offline.Config{
+		Offline:    isOffline,
+		Skip:       isSecretRead,
+		MaxAge:     offlineCacheMaxAge,
+		MaxEntries: offlineCacheMaxEntries,
+	}
+	if configFile := viper.ConfigFileUsed(); configFile != "" {
+		cfg.Dir = filepath.Join(filepath.Dir(configFile), hostCacheDirName, offlineCacheDirName)
+	}
+	if isOffline {
+		report := cmd.ErrOrStderr()
+		cfg.Report = func(path string, cachedAt time.Time) {
+			fmt.Fprintf(report, "Offline: %s as cached at %s (%s ago)\n", path, cachedAt.Local().Format(time.RFC3339),
+				time.Since(cachedAt).Round(time.Second))
+		}
+	}
+	return cfg, true
+}
+
+// Reports whether the response of a read carries credentials, which are never written to the cache: the kubeconfigs
+// of the clusters hold their access tokens
+func isSecretRead(req *http.Request) bool {
+	return strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/kubeconfigs")
+}
@@ -107,6 +107,9 @@ func printOrganizations(cmd *cobra.Command, writer io.Writer, organizations *ten
 		NameLimit: -1,
 		Data:      items,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -215,6 +218,10 @@ func runGetOrganizationCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, resp.JSON200); selected {
+		return err
+	}
+
 	if err := printOrganization(cmd, writer, name, resp.JSON200); err != nil {
 		return err
 	}
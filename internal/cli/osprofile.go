@@ -12,8 +12,11 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/open-edge-platform/cli/internal/validator"
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
@@ -34,10 +37,22 @@ const listOSProfileExamples = `# List all OS Profiles
 orch-cli list osprofile --project some-project
 
 # List OS Profiles using a custom filter (see: https://google.aip.dev/160 and API spec @ https://github.com/open-edge-platform/orch-utils/blob/main/tenancy-api-mapping/openapispecs/generated/amc-infra-core-edge-infrastructure-manager-openapi-all.yaml )
-orch-cli list osprofile --project some-project --filter "osType=OS_TYPE_IMMUTABLE"`
+orch-cli list osprofile --project some-project --filter "osType=OS_TYPE_IMMUTABLE"
+
+# List the first 10 OS Profiles in name order, sorted by the server
+orch-cli list osprofile --project some-project --order-by name --limit 10 -o json
+
+# Show how many hosts run each OS Profile and how many CVEs it has and fixes
+orch-cli list osprofile --project some-project --show-usage
+
+# List the hosts that do not run the OS their update policy targets, or that have an update available
+orch-cli list osprofile --project some-project --outdated-only`
 
 const getOSProfileExamples = `# Get detailed information about specific OS Profile using the os profile name
-orch-cli get osprofile osprofilename --project some-project`
+orch-cli get osprofile osprofilename --project some-project
+
+# Get an OS Profile using its resource ID
+orch-cli get osprofile os-1234abcd --project some-project`
 
 const createOSProfileExamples = `# Create an OS Profile using a valid .yaml manifest as an input.
 orch-cli create osprofile ./microvisor-nonrt.yaml  --project some-project
@@ -61,10 +76,39 @@ spec:
 	key2: value2
 
 See 
-https://github.com/open-edge-platform/infra-core/tree/main/os-profiles`
+https://github.com/open-edge-platform/infra-core/tree/main/os-profiles
+
+# Create an OS Profile from a release manifest, downloaded from a URL or read from a file
+orch-cli create osprofile --from-manifest https://example.com/microvisor/non_rt/release-manifest.json --project some-project
+orch-cli create osprofile --from-manifest ./release-manifest.yaml --project some-project
+
+Example release manifest (JSON or YAML):
+
+{
+  "name": "Edge Microvisor Toolkit 3.0.20250504",
+  "profileName": "microvisor-nonrt",
+  "version": "3.0.20250504",
+  "architecture": "x86_64",
+  "type": "OS_TYPE_IMMUTABLE",
+  "provider": "OS_PROVIDER_KIND_INFRA",
+  "securityFeature": "SECURITY_FEATURE_NONE",
+  "kernelCommand": "console=ttyS0,115200 quiet",
+  "image": {
+    "url": "files-edge-orch/repository/microvisor/non_rt/<artifact.raw.gz>",
+    "sha256": "<sha>"
+  }
+}
+
+Only profileName, version, image.url and image.sha256 are mandatory. The name defaults to
+"<profileName> <version>", the type to immutable, the provider to infra, the architecture to x86_64
+and the security feature to none. The OS profile API has no kernel command field, the kernel command
+is appended to the profile description instead.`
 
 const deleteOSProfileExamples = `#Delete an OS Profile using it's name
-orch-cli delete osprofile "Edge Microvisor Toolkit 3.0.20250504" --project some-project`
+orch-cli delete osprofile "Edge Microvisor Toolkit 3.0.20250504" --project some-project
+
+#Delete an OS Profile using it's resource ID
+orch-cli delete osprofile os-1234abcd --project some-project`
 
 var osProfileSchema = `
 {
@@ -141,6 +185,10 @@ func getOSProfileOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (s
 
 // Prints OS Profiles in tabular format
 func printOSProfiles(cmd *cobra.Command, writer io.Writer, OSProfiles []infra.OperatingSystemResource, orderBy *string, outputFilter *string, verbose bool) error {
+	OSProfiles, err := selectItems(cmd, OSProfiles)
+	if err != nil {
+		return err
+	}
 	outputFormat, err := getOSProfileOutputFormat(cmd, verbose, true)
 	if err != nil {
 		return err
@@ -165,6 +213,9 @@ func printOSProfiles(cmd *cobra.Command, writer io.Writer, OSProfiles []infra.Op
 		NameLimit: -1,
 		Data:      OSProfiles,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -257,6 +308,38 @@ func readOSProfileFromYaml(path string) (*NestedSpec, error) {
 }
 
 // Filters list of profiles to find one with specific name
+// osProfileResourceIDPattern matches OS profile resource IDs: "os-" followed by 8 hex chars.
+var osProfileResourceIDPattern = regexp.MustCompile(`^os-[0-9a-f]{8}$`)
+
+func isOSProfileResourceID(s string) bool {
+	return osProfileResourceIDPattern.MatchString(s)
+}
+
+// Retrieves an OS profile given by resource ID or by name, failed calls are reported with errMsg
+func getOSProfileByNameOrID(ctx context.Context, OSProfileClient infra.ClientWithResponsesInterface, projectName string, query string, errMsg string) (*infra.OperatingSystemResource, error) {
+	if isOSProfileResourceID(query) {
+		resp, err := OSProfileClient.OperatingSystemServiceGetOperatingSystemWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, errMsg); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	}
+
+	nameFilter := fmt.Sprintf("name=%q", query)
+	resp, err := OSProfileClient.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
+		&infra.OperatingSystemServiceListOperatingSystemsParams{Filter: &nameFilter}, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, errMsg); err != nil {
+		return nil, err
+	}
+	return filterProfilesByName(resp.JSON200.OperatingSystemResources, query)
+}
+
 func filterProfilesByName(OSProfiles []infra.OperatingSystemResource, name string) (*infra.OperatingSystemResource, error) {
 	for _, profile := range OSProfiles {
 		if *profile.Name == name {
@@ -268,7 +351,7 @@ func filterProfilesByName(OSProfiles []infra.OperatingSystemResource, name strin
 
 func getGetOSProfileCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "osprofile <name> [flags]",
+		Use:     "osprofile <name|resourceID> [flags]",
 		Short:   "Get an OS profile",
 		Example: getOSProfileExamples,
 		Args:    cobra.ExactArgs(1),
@@ -288,26 +371,31 @@ func getListOSProfileCommand() *cobra.Command {
 		RunE:    runListOSProfileCommand,
 	}
 	cmd.Flags().StringP("filter", "f", "", "API filter (see https://google.aip.dev/160)")
-	cmd.Flags().String("order-by", "", "order results by field (table output only)")
+	cmd.Flags().String("order-by", "", "order results by field; sorted by the server for json and yaml output")
+	addListPaginationFlags(cmd, "OS profile")
+	addListLimitFlag(cmd, "OS profile")
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
+	addOSProfileUsageFlags(cmd)
 	return cmd
 }
 
 func getCreateOSProfileCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "osprofile </path/to/profile.yaml> [flags]",
+		Use:     "osprofile [</path/to/profile.yaml> | --from-manifest <url|file>] [flags]",
 		Short:   "Creates OS profile",
 		Example: createOSProfileExamples,
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.MaximumNArgs(1),
 		Aliases: osProfileAliases,
 		RunE:    runCreateOSProfileCommand,
 	}
+	cmd.Flags().String("from-manifest", "", "Create the OS profile from a JSON or YAML release manifest, given as an http(s) URL or a file path")
 	return cmd
 }
 
 func getDeleteOSProfileCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "osprofile <name> [flags]",
+		Use:     "osprofile <name|resourceID> [flags]",
 		Short:   "Delete an OS profile",
 		Example: deleteOSProfileExamples,
 		Args:    cobra.ExactArgs(1),
@@ -326,19 +414,12 @@ func runGetOSProfileCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	resp, err := OSProfileClient.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
-		&infra.OperatingSystemServiceListOperatingSystemsParams{}, auth.AddAuthHeader)
+	profile, err := getOSProfileByNameOrID(ctx, OSProfileClient, projectName, args[0], "error getting OS Profile")
 	if err != nil {
-		return processError(err)
-	}
-
-	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Profile"); err != nil {
 		return err
 	}
 
-	name := args[0]
-	profile, err := filterProfilesByName(resp.JSON200.OperatingSystemResources, name)
-	if err != nil {
+	if selected, err := printSelectedOutput(cmd, profile); selected {
 		return err
 	}
 
@@ -455,21 +536,54 @@ func runListOSProfileCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	resp, err := OSProfileClient.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
-		&infra.OperatingSystemServiceListOperatingSystemsParams{
-			Filter:  validatedFilter,
-			OrderBy: apiOrderBy,
-		}, auth.AddAuthHeader)
+	pagination, err := getListPagination(cmd)
 	if err != nil {
-		return processError(err)
+		return err
 	}
-
-	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Profiles"); err != nil {
+	profiles := make([]infra.OperatingSystemResource, 0)
+	err = pagination.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := OSProfileClient.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
+			&infra.OperatingSystemServiceListOperatingSystemsParams{
+				Filter:   validatedFilter,
+				OrderBy:  apiOrderBy,
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Profiles"); err != nil {
+			return 0, false, err
+		}
+		profiles = append(profiles, resp.JSON200.OperatingSystemResources...)
+		return len(resp.JSON200.OperatingSystemResources), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
 		return err
 	}
+	profiles = limitListItems(profiles, pagination)
 
 	outputFilter, _ := cmd.Flags().GetString("output-filter")
-	if err := printOSProfiles(cmd, writer, resp.JSON200.OperatingSystemResources, validatedOrderBy, &outputFilter, verbose); err != nil {
+	showUsage, _ := cmd.Flags().GetBool(showUsageFlag)
+	outdatedOnly, _ := cmd.Flags().GetBool(outdatedOnlyFlag)
+	if showUsage || outdatedOnly {
+		// The usage of the profiles is joined from the instances of the hosts
+		hosts, err := listHostsMatching(ctx, OSProfileClient, projectName, nil)
+		if err != nil {
+			return err
+		}
+		if outdatedOnly {
+			err = printOutdatedHosts(cmd, writer, findOutdatedHosts(profiles, hosts))
+		} else {
+			err = printOSProfileUsages(cmd, writer, toOSProfileUsages(profiles, hosts), validatedOrderBy, &outputFilter)
+		}
+		if err != nil {
+			return err
+		}
+		return writer.Flush()
+	}
+
+	if err := printOSProfiles(cmd, writer, profiles, validatedOrderBy, &outputFilter, verbose); err != nil {
 		return err
 	}
 
@@ -477,17 +591,36 @@ func runListOSProfileCommand(cmd *cobra.Command, _ []string) error {
 }
 
 // Creates OS Profile - checks if a profile already exists and the creates it if it does not using the input .yaml file
+// or the release manifest given with --from-manifest
 func runCreateOSProfileCommand(cmd *cobra.Command, args []string) error {
-	path := args[0]
-
-	err := verifyOSProfileInput(path)
-	if err != nil {
-		return err
+	fromManifest, _ := cmd.Flags().GetString("from-manifest")
+	if fromManifest != "" && len(args) > 0 {
+		return errors.New("an OS profile file cannot be used with --from-manifest")
+	}
+	if fromManifest == "" && len(args) == 0 {
+		return errors.New("an OS profile file or --from-manifest must be specified")
 	}
 
-	spec, err := readOSProfileFromYaml(path)
-	if err != nil {
-		return err
+	var spec *OSProfileSpec
+	source := fromManifest
+	if fromManifest != "" {
+		manifest, err := loadOSReleaseManifest(fromManifest)
+		if err != nil {
+			return err
+		}
+		if spec, err = manifest.toOSProfileSpec(); err != nil {
+			return fmt.Errorf("invalid manifest %s: %w", fromManifest, err)
+		}
+	} else {
+		source = args[0]
+		if err := verifyOSProfileInput(source); err != nil {
+			return err
+		}
+		nested, err := readOSProfileFromYaml(source)
+		if err != nil {
+			return err
+		}
+		spec = &nested.Spec
 	}
 
 	ctx, OSProfileClient, projectName, err := InfraFactory(cmd)
@@ -506,39 +639,39 @@ func runCreateOSProfileCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, err = filterProfilesByName(gresp.JSON200.OperatingSystemResources, spec.Spec.Name)
+	_, err = filterProfilesByName(gresp.JSON200.OperatingSystemResources, spec.Name)
 	if err == nil {
-		return fmt.Errorf("OS Profile %s already exists", spec.Spec.Name)
+		return fmt.Errorf("OS Profile %s already exists", spec.Name)
 	}
 	// End TODO
 
-	metadataJSON, err := convertMetadataToAPIString(spec.Spec.Metadata)
+	metadataJSON, err := convertMetadataToAPIString(spec.Metadata)
 	if err != nil {
 		return fmt.Errorf("metadata validation failed: %v", err)
 	}
 
 	resp, err := OSProfileClient.OperatingSystemServiceCreateOperatingSystemWithResponse(ctx, projectName,
 		infra.OperatingSystemServiceCreateOperatingSystemJSONRequestBody{
-			Name:            &spec.Spec.Name,
-			Architecture:    &spec.Spec.Architecture,
-			ImageUrl:        &spec.Spec.OsImageURL,
-			ImageId:         &spec.Spec.OsImageVersion,
-			OsType:          (*infra.OsType)(&spec.Spec.Type),
-			OsProvider:      (*infra.OsProviderKind)(&spec.Spec.Provider),
-			ProfileName:     &spec.Spec.ProfileName,
-			RepoUrl:         &spec.Spec.OsImageURL,
-			SecurityFeature: (*infra.SecurityFeature)(&spec.Spec.SecurityFeature),
-			Sha256:          spec.Spec.OsImageSha256,
-			FixedCvesUrl:    &spec.Spec.OsFixedCvesURL,
-			ExistingCvesUrl: &spec.Spec.OsExistingCvesURL,
-			TlsCaCert:       &spec.Spec.TLSCaCert,
-			Description:     &spec.Spec.Description,
+			Name:            &spec.Name,
+			Architecture:    &spec.Architecture,
+			ImageUrl:        &spec.OsImageURL,
+			ImageId:         &spec.OsImageVersion,
+			OsType:          (*infra.OsType)(&spec.Type),
+			OsProvider:      (*infra.OsProviderKind)(&spec.Provider),
+			ProfileName:     &spec.ProfileName,
+			RepoUrl:         &spec.OsImageURL,
+			SecurityFeature: (*infra.SecurityFeature)(&spec.SecurityFeature),
+			Sha256:          spec.OsImageSha256,
+			FixedCvesUrl:    &spec.OsFixedCvesURL,
+			ExistingCvesUrl: &spec.OsExistingCvesURL,
+			TlsCaCert:       &spec.TLSCaCert,
+			Description:     &spec.Description,
 			Metadata:        metadataJSON,
 		}, auth.AddAuthHeader)
 	if err != nil {
 		return processError(err)
 	}
-	return checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating OS Profile from %s", path))
+	return checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating OS Profile from %s", source))
 }
 
 // Deletes OS Profile - checks if a profile already exists and then deletes it if it does
@@ -548,18 +681,8 @@ func runDeleteOSProfileCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	gresp, err := OSProfileClient.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
-		&infra.OperatingSystemServiceListOperatingSystemsParams{}, auth.AddAuthHeader)
-	if err != nil {
-		return processError(err)
-	}
-
-	if err = checkResponse(gresp.HTTPResponse, gresp.Body, "Error getting OS profiles"); err != nil {
-		return err
-	}
-
 	name := args[0]
-	profile, err := filterProfilesByName(gresp.JSON200.OperatingSystemResources, name)
+	profile, err := getOSProfileByNameOrID(ctx, OSProfileClient, projectName, name, "Error getting OS profiles")
 	if err != nil {
 		return err
 	}
@@ -595,6 +718,15 @@ func convertMetadataToAPIString(metadata map[string]interface{}) (*string, error
 		return nil, nil
 	}
 
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if err := validator.ValidateMetadataKeys(keys); err != nil {
+		return nil, err
+	}
+
 	// Convert metadata map to JSON string
 	jsonBytes, err := json.Marshal(metadata)
 	if err != nil {
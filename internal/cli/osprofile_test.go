@@ -6,6 +6,9 @@ package cli
 import (
 	"fmt"
 	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
 )
 
 func (s *CLITestSuite) createOSProfile(project string, path string, args commandArgs) (string, error) {
@@ -138,6 +141,16 @@ func (s *CLITestSuite) TestOSProfile() {
 	_, err = s.getOSProfile(project, "random", OSPArgs)
 	s.EqualError(err, "no os profile matches the given name")
 
+	//Get os profile by resource ID
+	getOutput, err := s.getOSProfile(project, "os-1234abcd", OSPArgs)
+	s.NoError(err)
+	s.Regexp(`(?m)^OS Resource ID:\s+\|os-1234abcd$`, getOutput)
+	s.Regexp(`(?m)^Name:\s+\|Edge Microvisor Toolkit 3.0.20250504$`, getOutput)
+
+	//Get non existing os profile by resource ID
+	_, err = s.getOSProfile(project, "os-00000000", OSPArgs)
+	s.EqualError(err, "error getting OS Profile: Not Found")
+
 	//Server error sim
 	_, err = s.getOSProfile("nonexistent-project", name, OSPArgs)
 	s.EqualError(err, "error getting OS Profile: Internal Server Error")
@@ -148,6 +161,10 @@ func (s *CLITestSuite) TestOSProfile() {
 	_, err = s.deleteOSProfile(project, name, OSPArgs)
 	s.NoError(err)
 
+	//Delete profile by resource ID
+	_, err = s.deleteOSProfile(project, "os-1234abcd", OSPArgs)
+	s.NoError(err)
+
 	//Non existing profile deletion
 	_, err = s.deleteOSProfile(project, "random", OSPArgs)
 	s.EqualError(err, "no os profile matches the given name")
@@ -184,6 +201,64 @@ func (s *CLITestSuite) TestOSProfile() {
 
 }
 
+func (s *CLITestSuite) TestListOSProfileUsage() {
+	out, err := s.listOSProfile(project, commandArgs{"show-usage": ""})
+	s.NoError(err)
+	s.Regexp(`(?m)^NAME\s+\|ARCHITECTURE\s+\|SECURITY FEATURE\s+\|HOST COUNT\s+\|EXISTING CVE COUNT\s+\|FIXED CVE COUNT$`, out)
+	s.Regexp(`(?m)^Edge Microvisor Toolkit 3.0.20250504\s+\|x86_64\s+\|SECURITY_FEATURE_NONE\s+\|1\s+\|1\s+\|1$`, out)
+
+	out, err = s.listOSProfile(project, commandArgs{"outdated-only": ""})
+	s.NoError(err)
+	s.Equal("No outdated hosts found\n", out)
+
+	_, err = s.listOSProfile(project, commandArgs{"outdated-only": "", "show-usage": ""})
+	s.ErrorContains(err, "if any flags in the group [show-usage outdated-only] are set none of the others can be")
+}
+
+func TestFindOutdatedHosts(t *testing.T) {
+	target := infra.UPDATEPOLICYTARGET
+	latest := infra.UPDATEPOLICYLATEST
+	profiles := []infra.OperatingSystemResource{
+		{ResourceId: stringPtr("os-1234abcd"), Name: stringPtr("EMT 3.0.20250504")},
+		{ResourceId: stringPtr("os-abcd1003"), Name: stringPtr("EMT 3.0.20250101")},
+	}
+	hosts := []infra.HostResource{
+		// Runs an older OS than the target of its policy
+		{ResourceId: stringPtr("host-00000001"), Name: "behind", Instance: &infra.InstanceResource{
+			OsID: stringPtr("os-abcd1003"),
+			UpdatePolicy: &infra.OSUpdatePolicy{Name: "pin", UpdatePolicy: &target, TargetOsId: stringPtr("os-1234abcd"),
+				TargetOs: &infra.OperatingSystemResource{Name: stringPtr("EMT 3.0.20250504")}},
+		}},
+		// Runs the target of its policy
+		{ResourceId: stringPtr("host-00000002"), Name: "current", Instance: &infra.InstanceResource{
+			OsID:         stringPtr("os-1234abcd"),
+			UpdatePolicy: &infra.OSUpdatePolicy{Name: "pin", UpdatePolicy: &target, TargetOsId: stringPtr("os-1234abcd")},
+		}},
+		// Has an update available
+		{ResourceId: stringPtr("host-00000003"), Name: "available", Instance: &infra.InstanceResource{
+			Os:                &infra.OperatingSystemResource{Name: stringPtr("EMT 3.0.20250504")},
+			OsUpdateAvailable: stringPtr("EMT 3.0.20250601"),
+			UpdatePolicy:      &infra.OSUpdatePolicy{Name: "follow", UpdatePolicy: &latest},
+		}},
+		// Runs an OS that is not listed
+		{ResourceId: stringPtr("host-00000004"), Name: "other", Instance: &infra.InstanceResource{
+			OsID: stringPtr("os-ffffffff"), OsUpdateAvailable: stringPtr("EMT 3.0.20250601"),
+		}},
+		{ResourceId: stringPtr("host-00000005"), Name: "unprovisioned"},
+	}
+
+	assert.Equal(t, []outdatedHost{
+		{ResourceId: "host-00000001", Name: "behind", CurrentOs: "EMT 3.0.20250101 (os-abcd1003)",
+			DesiredOs: "EMT 3.0.20250504 (os-1234abcd)", UpdatePolicy: "pin (UPDATE_POLICY_TARGET)"},
+		{ResourceId: "host-00000003", Name: "available", CurrentOs: "EMT 3.0.20250504 (os-1234abcd)",
+			DesiredOs: "EMT 3.0.20250601", UpdatePolicy: "follow (UPDATE_POLICY_LATEST)"},
+	}, findOutdatedHosts(profiles, hosts))
+
+	usages := toOSProfileUsages(profiles, hosts)
+	assert.Equal(t, 2, usages[0].HostCount)
+	assert.Equal(t, 1, usages[1].HostCount)
+}
+
 func FuzzOSProfile(f *testing.F) {
 	// Initial corpus with valid and invalid input
 	f.Add("project", "./testdata/osprofile.yaml", "Edge Microvisor Toolkit 3.0.20250504")
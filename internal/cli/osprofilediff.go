@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+)
+
+const diffOSProfileExamples = `# Show what changes between two OS profiles, e.g. before moving the fleet to a new EMT release
+orch-cli diff osprofile os-1234abcd os-5678abcd --project some-project
+
+# Compare OS profiles by name, with a single unchanged line shown around each change
+orch-cli diff osprofile "Edge Microvisor Toolkit 3.0.20250504" "Edge Microvisor Toolkit 3.0.20250718" --project some-project --context 1
+
+# Sample output
+--- os-1234abcd (Edge Microvisor Toolkit 3.0.20250504)
++++ os-5678abcd (Edge Microvisor Toolkit 3.0.20250718)
+@@ -1,4 +1,4 @@
+-Name: Edge Microvisor Toolkit 3.0.20250504
++Name: Edge Microvisor Toolkit 3.0.20250718
+ Profile Name: microvisor-nonrt
+-Version: 3.0.20250504
++Version: 3.0.20250718
+ Architecture: x86_64
+@@ -9,5 +9,5 @@
+ Description:
+-Image URL: files-edge-orch/repository/microvisor/non_rt/edge-readonly-3.0.20250504.raw.gz
++Image URL: files-edge-orch/repository/microvisor/non_rt/edge-readonly-3.0.20250718.raw.gz
+ Image ID:
+-Sha256: 9a0c41
++Sha256: 5be2d7
+ Repository URL:
+@@ -19,2 +19,3 @@
+ Fixed CVE: CVE-2025-1111 (HIGH)
+-Installed Package: curl 8.8.0-2.emt3
++Fixed CVE: CVE-2025-2222 (CRITICAL)
++Installed Package: curl 8.9.1-1.emt3
+`
+
+func getDiffOSProfileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "osprofile <name|resourceID> <name|resourceID> [flags]",
+		Short: "Shows what changes between two OS profiles",
+		Long: "Prints a unified diff of two OS profiles: their image and version, security feature, kernel command, " +
+			"update sources, fixed and existing CVEs and installed packages, one per line.",
+		Example: diffOSProfileExamples,
+		Aliases: osProfileAliases,
+		Args:    cobra.ExactArgs(2),
+		RunE:    runDiffOSProfileCommand,
+	}
+	cmd.Flags().Int("context", 3, "Number of unchanged lines shown around each change")
+	return cmd
+}
+
+func runDiffOSProfileCommand(cmd *cobra.Command, args []string) error {
+	contextLines, _ := cmd.Flags().GetInt("context")
+	if contextLines < 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --context %d, must not be negative", contextLines))
+	}
+
+	ctx, OSProfileClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	profiles := make([]*infra.OperatingSystemResource, 0, len(args))
+	for _, query := range args {
+		profile, err := getOSProfileByNameOrID(ctx, OSProfileClient, projectName, query, "error getting OS Profile")
+		if err != nil {
+			return fmt.Errorf("OS profile %s: %w", query, err)
+		}
+		profiles = append(profiles, profile)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        osProfileDiffLines(profiles[0]),
+		B:        osProfileDiffLines(profiles[1]),
+		FromFile: osProfileDiffLabel(profiles[0]),
+		ToFile:   osProfileDiffLabel(profiles[1]),
+		Context:  contextLines,
+	})
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "OS profiles %s and %s do not differ\n", osProfileDiffLabel(profiles[0]), osProfileDiffLabel(profiles[1]))
+		return nil
+	}
+	fmt.Fprint(cmd.OutOrStdout(), diff)
+	return nil
+}
+
+func osProfileDiffLabel(profile *infra.OperatingSystemResource) string {
+	id := safeString(profile.ResourceId)
+	if id == "" {
+		id = safeString(profile.OsResourceID)
+	}
+	return fmt.Sprintf("%s (%s)", id, safeString(profile.Name))
+}
+
+// Renders an OS profile as the lines compared by diff osprofile; list fields are sorted with one item per line
+// so that a single added package or CVE shows as a single changed line
+func osProfileDiffLines(profile *infra.OperatingSystemResource) []string {
+	description, kernelCommand := safeString(profile.Description), ""
+	if before, after, found := strings.Cut(description, "Kernel command: "); found {
+		// Kept with the description by create osprofile --from-manifest, the OS resource has no kernel command field
+		description, kernelCommand = strings.TrimSpace(before), strings.TrimSpace(after)
+	}
+
+	lines := []string{
+		"Name: " + safeString(profile.Name),
+		"Profile Name: " + safeString(profile.ProfileName),
+		"Version: " + safeString(profile.ProfileVersion),
+		"Architecture: " + safeString(profile.Architecture),
+		"OS Type: " + safeString((*string)(profile.OsType)),
+		"OS Provider: " + safeString((*string)(profile.OsProvider)),
+		"Security Feature: " + safeString((*string)(profile.SecurityFeature)),
+		"Kernel Command: " + kernelCommand,
+		"Description: " + description,
+		"Image URL: " + safeString(profile.ImageUrl),
+		"Image ID: " + safeString(profile.ImageId),
+		"Sha256: " + profile.Sha256,
+		"Repository URL: " + safeString(profile.RepoUrl),
+		"Installed Packages URL: " + safeString(profile.InstalledPackagesUrl),
+		"Fixed CVEs URL: " + safeString(profile.FixedCvesUrl),
+		"Existing CVEs URL: " + safeString(profile.ExistingCvesUrl),
+		"Platform Bundle: " + safeString(profile.PlatformBundle),
+		"Metadata: " + safeString(profile.Metadata),
+	}
+	for _, cve := range osProfileCves(safeString(profile.FixedCves)) {
+		lines = append(lines, "Fixed CVE: "+cve)
+	}
+	for _, cve := range osProfileCves(safeString(profile.ExistingCves)) {
+		lines = append(lines, "Existing CVE: "+cve)
+	}
+	for _, pkg := range osProfilePackages(safeString(profile.InstalledPackages)) {
+		lines = append(lines, "Installed Package: "+pkg)
+	}
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ") + "\n"
+	}
+	return lines
+}
+
+// Decodes the JSON list of CVEs of an OS profile as sorted "<id> (<priority>)" items
+func osProfileCves(encoded string) []string {
+	if strings.TrimSpace(encoded) == "" {
+		return nil
+	}
+	var entries []outfmt.CVEEntry
+	if err := json.Unmarshal([]byte(encoded), &entries); err != nil {
+		return []string{encoded}
+	}
+	cves := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		cves = append(cves, fmt.Sprintf("%s (%s)", entry.CVEID, entry.Priority))
+	}
+	sort.Strings(cves)
+	return cves
+}
+
+// osPackage is an installed package of an OS manifest
+type osPackage struct {
+	Name         string `json:"Name"`
+	Version      string `json:"Version"`
+	Distribution string `json:"Distribution"`
+}
+
+// Decodes the installed packages of an OS profile as sorted "<name> <version>" items; the packages are either
+// the JSON OS manifest, {"Repo": [{"Name": ..., "Version": ...}]}, a JSON list or one package per line
+func osProfilePackages(encoded string) []string {
+	if strings.TrimSpace(encoded) == "" {
+		return nil
+	}
+	var manifest struct {
+		Repo []osPackage `json:"Repo"`
+	}
+	var list []osPackage
+	var names []string
+	packages := make([]string, 0)
+	switch {
+	case json.Unmarshal([]byte(encoded), &manifest) == nil && manifest.Repo != nil:
+		for _, pkg := range manifest.Repo {
+			packages = append(packages, strings.TrimSpace(pkg.Name+" "+pkg.Version))
+		}
+	case json.Unmarshal([]byte(encoded), &names) == nil:
+		packages = append(packages, names...)
+	case json.Unmarshal([]byte(encoded), &list) == nil:
+		for _, pkg := range list {
+			packages = append(packages, strings.TrimSpace(pkg.Name+" "+pkg.Version))
+		}
+	default:
+		for _, line := range strings.Split(encoded, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				packages = append(packages, line)
+			}
+		}
+	}
+	sort.Strings(packages)
+	return packages
+}
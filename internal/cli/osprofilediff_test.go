@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) diffOSProfile(project string, a string, b string, args commandArgs) (string, error) {
+	commandString := addCommandArgs(args, fmt.Sprintf(`diff osprofile "%s" "%s" --project %s`, a, b, project))
+	return s.runCommand(commandString)
+}
+
+func (s *CLITestSuite) TestDiffOSProfile() {
+	out, err := s.diffOSProfile(project, "os-1234abcd", "Edge Microvisor Toolkit 3.0.20250504", map[string]string{"context": "0"})
+	s.NoError(err)
+	s.Contains(out, "--- os-1234abcd (Edge Microvisor Toolkit 3.0.20250504)\n+++ os-1234abcd (Edge Microvisor Toolkit 3.0.20250504)\n")
+	s.Contains(out, "+Fixed CVE: CVE-2021-5678 (MEDIUM)\n")
+	s.Contains(out, "+Existing CVE: CVE-2021-1234 (HIGH)\n")
+	s.Contains(out, "+Installed Package: curl\n+Installed Package: vim\n+Installed Package: wget\n")
+	s.NotContains(out, "Profile Name:")
+
+	out, err = s.diffOSProfile(project, "os-1234abcd", "os-1234abcd", map[string]string{})
+	s.NoError(err)
+	s.Equal("OS profiles os-1234abcd (Edge Microvisor Toolkit 3.0.20250504) and os-1234abcd (Edge Microvisor Toolkit 3.0.20250504) do not differ\n", out)
+
+	_, err = s.diffOSProfile(project, "os-1234abcd", "no-such-profile", map[string]string{})
+	s.EqualError(err, "OS profile no-such-profile: no os profile matches the given name")
+
+	_, err = s.diffOSProfile(project, "os-1234abcd", "os-1234abcd", map[string]string{"context": "-1"})
+	s.EqualError(err, "invalid --context -1, must not be negative")
+}
+
+func TestOSProfilePackages(t *testing.T) {
+	assert.Nil(t, osProfilePackages(""))
+	assert.Equal(t, []string{"curl", "vim", "wget"}, osProfilePackages("wget\ncurl\n\nvim\n"))
+	assert.Equal(t, []string{"curl 8.8.0-2.emt3", "wget 1.21-1.emt3"},
+		osProfilePackages(`{"Repo":[{"Name":"wget","Version":"1.21-1.emt3","Distribution":"emt3"},{"Name":"curl","Version":"8.8.0-2.emt3","Distribution":"emt3"}]}`))
+	assert.Equal(t, []string{"curl 8.8.0"}, osProfilePackages(`[{"Name":"curl","Version":"8.8.0"}]`))
+	assert.Equal(t, []string{"curl", "wget"}, osProfilePackages(`["wget","curl"]`))
+}
+
+func TestOSProfileCves(t *testing.T) {
+	assert.Nil(t, osProfileCves(""))
+	assert.Equal(t, []string{"CVE-2021-1234 (HIGH)", "CVE-2025-0001 (LOW)"},
+		osProfileCves(`[{"cve_id":"CVE-2025-0001","priority":"LOW"},{"cve_id":"CVE-2021-1234","priority":"HIGH"}]`))
+	assert.Equal(t, []string{"not json"}, osProfileCves("not json"))
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// Largest release manifest that will be read, as for OS profile files
+	maxOSReleaseManifestSize = 1 << 20
+
+	osReleaseManifestTimeout = 30 * time.Second
+
+	defaultManifestArchitecture = "x86_64"
+)
+
+var (
+	sha256Pattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+	// Characters accepted by the API in the description of an OS resource
+	osDescriptionPattern = regexp.MustCompile("^[a-zA-Z-_0-9.:;=@?!#,<>*(){}&%$`^+\\- ]{0,1000}$")
+)
+
+// osReleaseManifest is the part of an OS release manifest needed to create an OS profile
+type osReleaseManifest struct {
+	Name            string                 `json:"name" yaml:"name"`
+	ProfileName     string                 `json:"profileName" yaml:"profileName"`
+	Version         string                 `json:"version" yaml:"version"`
+	Architecture    string                 `json:"architecture" yaml:"architecture"`
+	Type            string                 `json:"type" yaml:"type"`
+	Provider        string                 `json:"provider" yaml:"provider"`
+	SecurityFeature string                 `json:"securityFeature" yaml:"securityFeature"`
+	KernelCommand   string                 `json:"kernelCommand" yaml:"kernelCommand"`
+	Description     string                 `json:"description" yaml:"description"`
+	PlatformBundle  string                 `json:"platformBundle" yaml:"platformBundle"`
+	Metadata        map[string]interface{} `json:"metadata" yaml:"metadata"`
+	Image           struct {
+		URL    string `json:"url" yaml:"url"`
+		Sha256 string `json:"sha256" yaml:"sha256"`
+	} `json:"image" yaml:"image"`
+}
+
+// Reads a release manifest from an http(s) URL or a local file
+func loadOSReleaseManifest(location string) (*osReleaseManifest, error) {
+	var data []byte
+	var err error
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		data, err = downloadOSReleaseManifest(location)
+	} else {
+		data, err = readOSReleaseManifest(location)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseOSReleaseManifest(data)
+}
+
+func downloadOSReleaseManifest(url string) ([]byte, error) {
+	client := &http.Client{Timeout: osReleaseManifestTimeout}
+	resp, err := client.Get(url) //nolint:gosec // the URL is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download manifest: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxOSReleaseManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest: %w", err)
+	}
+	if len(data) > maxOSReleaseManifestSize {
+		return nil, errors.New("manifest too large")
+	}
+	return data, nil
+}
+
+func readOSReleaseManifest(path string) ([]byte, error) {
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxOSReleaseManifestSize {
+		return nil, errors.New("manifest too large")
+	}
+	return os.ReadFile(path)
+}
+
+// Parses a JSON or YAML release manifest
+func parseOSReleaseManifest(data []byte) (*osReleaseManifest, error) {
+	var manifest osReleaseManifest
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("error unmarshalling JSON manifest: %v", err)
+		}
+		return &manifest, nil
+	}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshalling YAML manifest: %v", err)
+	}
+	manifest.Metadata, _ = toStringKeyMap(manifest.Metadata).(map[string]interface{})
+	return &manifest, nil
+}
+
+// Converts a release manifest to an OS profile spec, filling in defaults for the optional fields
+func (m *osReleaseManifest) toOSProfileSpec() (*OSProfileSpec, error) {
+	var missing []string
+	for _, field := range []struct{ name, value string }{
+		{"profileName", m.ProfileName},
+		{"version", m.Version},
+		{"image.url", m.Image.URL},
+		{"image.sha256", m.Image.Sha256},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing %s", strings.Join(missing, ", "))
+	}
+	if !sha256Pattern.MatchString(m.Image.Sha256) {
+		return nil, fmt.Errorf("image.sha256 %q is not a sha256 digest", m.Image.Sha256)
+	}
+
+	spec := &OSProfileSpec{
+		Name:            valueOrDefault(m.Name, m.ProfileName+" "+m.Version),
+		Type:            valueOrDefault(m.Type, string(infra.OSTYPEIMMUTABLE)),
+		Provider:        valueOrDefault(m.Provider, string(infra.OSPROVIDERKINDINFRA)),
+		Architecture:    valueOrDefault(m.Architecture, defaultManifestArchitecture),
+		ProfileName:     m.ProfileName,
+		OsImageURL:      m.Image.URL,
+		OsImageSha256:   strings.ToLower(m.Image.Sha256),
+		OsImageVersion:  m.Version,
+		SecurityFeature: valueOrDefault(m.SecurityFeature, string(infra.SECURITYFEATURENONE)),
+		PlatformBundle:  m.PlatformBundle,
+		Description:     m.Description,
+		Metadata:        m.Metadata,
+	}
+	// The OS resource has no kernel command field, keep it with the profile description
+	if m.KernelCommand != "" {
+		spec.Description = strings.TrimSpace(spec.Description + " Kernel command: " + m.KernelCommand)
+		if !osDescriptionPattern.MatchString(spec.Description) {
+			return nil, fmt.Errorf("kernelCommand %q contains characters that are not allowed in an OS profile description", m.KernelCommand)
+		}
+	}
+	return spec, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if strings.TrimSpace(value) == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOSReleaseManifestToSpec(t *testing.T) {
+	manifest, err := loadOSReleaseManifest("./testdata/osmanifest.json")
+	require.NoError(t, err)
+	spec, err := manifest.toOSProfileSpec()
+	require.NoError(t, err)
+	assert.Equal(t, &OSProfileSpec{
+		Name:            "Edge Microvisor Toolkit 3.0.20251001",
+		Type:            "OS_TYPE_IMMUTABLE",
+		Provider:        "OS_PROVIDER_KIND_INFRA",
+		Architecture:    "x86_64",
+		ProfileName:     "microvisor-nonrt",
+		OsImageURL:      "files-edge-orch/repository/microvisor/non_rt/edge-readonly-3.0.20251001.raw.gz",
+		OsImageSha256:   "133975d949e3de495048afd55eb484475e311a19898c2744608cf0f69fe39502",
+		OsImageVersion:  "3.0.20251001",
+		SecurityFeature: "SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION",
+		Description:     "Kernel command: console=ttyS0,115200 quiet",
+	}, spec)
+
+	manifest, err = loadOSReleaseManifest("./testdata/osmanifest.yaml")
+	require.NoError(t, err)
+	spec, err = manifest.toOSProfileSpec()
+	require.NoError(t, err)
+	assert.Equal(t, "ubuntu-22.04-lts-generic 22.04.5", spec.Name)
+	assert.Equal(t, "OS_TYPE_MUTABLE", spec.Type)
+	assert.Equal(t, "SECURITY_FEATURE_NONE", spec.SecurityFeature)
+	assert.Equal(t, map[string]interface{}{"release": "22.04"}, spec.Metadata)
+
+	manifest, err = parseOSReleaseManifest([]byte(`{"profileName": "p", "image": {"sha256": "abc"}}`))
+	require.NoError(t, err)
+	_, err = manifest.toOSProfileSpec()
+	assert.EqualError(t, err, "missing version, image.url")
+
+	manifest.Version, manifest.Image.URL = "1.0", "image.raw.gz"
+	_, err = manifest.toOSProfileSpec()
+	assert.EqualError(t, err, `image.sha256 "abc" is not a sha256 digest`)
+
+	manifest.Image.Sha256 = "133975d949e3de495048afd55eb484475e311a19898c2744608cf0f69fe39502"
+	manifest.KernelCommand = "root=/dev/sda1"
+	_, err = manifest.toOSProfileSpec()
+	assert.EqualError(t, err, `kernelCommand "root=/dev/sda1" contains characters that are not allowed in an OS profile description`)
+
+	_, err = parseOSReleaseManifest([]byte(`{"profileName": `))
+	assert.Error(t, err)
+}
+
+func (s *CLITestSuite) TestCreateOSProfileFromManifest() {
+	_, err := s.runCommand("create osprofile --from-manifest ./testdata/osmanifest.json --project " + project)
+	s.NoError(err)
+
+	manifest, err := os.ReadFile("./testdata/osmanifest.yaml")
+	s.Require().NoError(err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/release/manifest.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(manifest)
+	}))
+	defer server.Close()
+
+	_, err = s.runCommand("create osprofile --from-manifest " + server.URL + "/release/manifest.yaml --project " + project)
+	s.NoError(err)
+
+	_, err = s.runCommand("create osprofile --from-manifest " + server.URL + "/missing.yaml --project " + project)
+	s.EqualError(err, "failed to download manifest: 404 Not Found")
+
+	_, err = s.runCommand("create osprofile --from-manifest ./testdata/osmanifest.yaml --project invalid-project")
+	s.EqualError(err, "error while creating OS Profile from ./testdata/osmanifest.yaml: Internal Server Error")
+
+	_, err = s.runCommand("create osprofile ./testdata/osprofile.yaml --from-manifest ./testdata/osmanifest.json --project " + project)
+	s.EqualError(err, "an OS profile file cannot be used with --from-manifest")
+
+	_, err = s.runCommand("create osprofile --project " + project)
+	s.EqualError(err, "an OS profile file or --from-manifest must be specified")
+
+	_, err = s.runCommand("create osprofile --from-manifest ./testdata/osprofile.yaml --project " + project)
+	s.EqualError(err, "invalid manifest ./testdata/osprofile.yaml: missing profileName, version, image.url, image.sha256")
+}
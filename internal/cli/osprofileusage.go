@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/open-edge-platform/cli/pkg/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	showUsageFlag    = "show-usage"
+	outdatedOnlyFlag = "outdated-only"
+
+	DEFAULT_OSPROFILE_USAGE_FORMAT = "table{{str .Name}}\t{{str .Architecture}}\t{{.SecurityFeature}}\t{{.HostCount}}\t{{.ExistingCveCount}}\t{{.FixedCveCount}}"
+	DEFAULT_OUTDATED_HOST_FORMAT   = "table{{.ResourceId}}\t{{.Name}}\t{{.CurrentOs}}\t{{.DesiredOs}}\t{{.UpdatePolicy}}"
+)
+
+// osProfileUsage is an OS profile with the number of hosts running it and the number of its known CVEs
+type osProfileUsage struct {
+	infra.OperatingSystemResource
+	HostCount        int `json:"hostCount" yaml:"hostCount"`
+	ExistingCveCount int `json:"existingCveCount" yaml:"existingCveCount"`
+	FixedCveCount    int `json:"fixedCveCount" yaml:"fixedCveCount"`
+}
+
+// outdatedHost is a host whose instance does not run the OS it should run
+type outdatedHost struct {
+	ResourceId   string `json:"resourceId" yaml:"resourceId"`
+	Name         string `json:"name" yaml:"name"`
+	CurrentOs    string `json:"currentOs" yaml:"currentOs"`
+	DesiredOs    string `json:"desiredOs" yaml:"desiredOs"`
+	UpdatePolicy string `json:"updatePolicy" yaml:"updatePolicy"`
+}
+
+func addOSProfileUsageFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(showUsageFlag, false, "Show the number of hosts running each OS profile and the number of its existing and fixed CVEs")
+	cmd.Flags().Bool(outdatedOnlyFlag, false, "List the hosts running one of the OS profiles instead of the OS their update policy or an available update asks for")
+	cmd.MarkFlagsMutuallyExclusive(showUsageFlag, outdatedOnlyFlag)
+}
+
+// Resource ID of the OS an instance runs, falling back to its name when the instance only names it
+func instanceOSKey(instance *infra.InstanceResource) string {
+	if id := derefString(instance.OsID); id != "" {
+		return id
+	}
+	if instance.Os != nil {
+		if id := derefString(instance.Os.ResourceId); id != "" {
+			return id
+		}
+		return derefString(instance.Os.Name)
+	}
+	return ""
+}
+
+// Reports whether an instance runs the OS profile, matched by resource ID or by name
+func instanceRunsOSProfile(instance *infra.InstanceResource, profile infra.OperatingSystemResource) bool {
+	key := instanceOSKey(instance)
+	return key != "" && (key == derefString(profile.ResourceId) || key == derefString(profile.Name))
+}
+
+// Counts the hosts running each profile and the CVEs of the profiles
+func toOSProfileUsages(profiles []infra.OperatingSystemResource, hosts []infra.HostResource) []osProfileUsage {
+	usages := make([]osProfileUsage, 0, len(profiles))
+	for _, profile := range profiles {
+		usage := osProfileUsage{
+			OperatingSystemResource: profile,
+			ExistingCveCount:        len(osProfileCves(derefString(profile.ExistingCves))),
+			FixedCveCount:           len(osProfileCves(derefString(profile.FixedCves))),
+		}
+		for _, host := range hosts {
+			if host.Instance != nil && instanceRunsOSProfile(host.Instance, profile) {
+				usage.HostCount++
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// Returns the OS an instance should run when it differs from the one it runs: the target OS of a target
+// update policy, or the update reported as available otherwise
+func instanceDesiredOS(instance *infra.InstanceResource) (string, bool) {
+	policy := instance.UpdatePolicy
+	if policy != nil && policy.UpdatePolicy != nil && *policy.UpdatePolicy == infra.UPDATEPOLICYTARGET {
+		target := derefString(policy.TargetOsId)
+		if target == "" && policy.TargetOs != nil {
+			target = derefString(policy.TargetOs.ResourceId)
+		}
+		if target == "" || target == instanceOSKey(instance) {
+			return "", false
+		}
+		if policy.TargetOs != nil && derefString(policy.TargetOs.Name) != "" {
+			return fmt.Sprintf("%s (%s)", derefString(policy.TargetOs.Name), target), true
+		}
+		return target, true
+	}
+	if available := derefString(instance.OsUpdateAvailable); available != "" {
+		return available, true
+	}
+	return "", false
+}
+
+// Selects the hosts running one of the profiles that should run another OS
+func findOutdatedHosts(profiles []infra.OperatingSystemResource, hosts []infra.HostResource) []outdatedHost {
+	outdated := make([]outdatedHost, 0)
+	for _, host := range hosts {
+		if host.Instance == nil {
+			continue
+		}
+		var current *infra.OperatingSystemResource
+		for i := range profiles {
+			if instanceRunsOSProfile(host.Instance, profiles[i]) {
+				current = &profiles[i]
+				break
+			}
+		}
+		if current == nil {
+			continue
+		}
+		desired, ok := instanceDesiredOS(host.Instance)
+		if !ok {
+			continue
+		}
+		policy := "none"
+		if p := host.Instance.UpdatePolicy; p != nil {
+			policy = p.Name
+			if p.UpdatePolicy != nil {
+				policy = fmt.Sprintf("%s (%s)", p.Name, *p.UpdatePolicy)
+			}
+		}
+		outdated = append(outdated, outdatedHost{
+			ResourceId:   derefString(host.ResourceId),
+			Name:         host.Name,
+			CurrentOs:    fmt.Sprintf("%s (%s)", derefString(current.Name), derefString(current.ResourceId)),
+			DesiredOs:    desired,
+			UpdatePolicy: policy,
+		})
+	}
+	return outdated
+}
+
+// Prints the OS profiles with their usage instead of the plain list of OS profiles
+func printOSProfileUsages(cmd *cobra.Command, writer io.Writer, usages []osProfileUsage, orderBy *string, outputFilter *string) error {
+	outputFormat, err := resolveTableOutputTemplate(cmd, DEFAULT_OSPROFILE_USAGE_FORMAT, OSPROFILE_OUTPUT_TEMPLATE_ENVVAR)
+	if err != nil {
+		return err
+	}
+	outputType, _ := cmd.Flags().GetString("output-type")
+	result := CommandResult{
+		Format:    format.Format(outputFormat),
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      usages,
+	}
+	if outputType == "table" && orderBy != nil {
+		result.OrderBy = *orderBy
+	}
+	if outputType == "table" && outputFilter != nil {
+		result.Filter = *outputFilter
+	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
+	GenerateOutput(writer, &result)
+	return nil
+}
+
+func printOutdatedHosts(cmd *cobra.Command, writer io.Writer, outdated []outdatedHost) error {
+	outputType, _ := cmd.Flags().GetString("output-type")
+	if outputType == "table" && len(outdated) == 0 {
+		_, err := fmt.Fprintln(writer, "No outdated hosts found")
+		return err
+	}
+	result := CommandResult{
+		Format:    format.Format(DEFAULT_OUTDATED_HOST_FORMAT),
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      outdated,
+	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
+	GenerateOutput(writer, &result)
+	return nil
+}
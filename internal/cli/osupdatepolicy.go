@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/open-edge-platform/cli/pkg/auth"
@@ -21,6 +22,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
 )
 
 const listOSUpdatePolicyExamples = `# List all OS Update Policies
@@ -35,6 +37,7 @@ orch-cli get osupdatepolicy myupdatepolicy --project some-project`
 
 const createOSUpdatePolicyExamples = `# Create an OS Update Policy.
 orch-cli create osupdatepolicy path/to/osupdatepolicy.yaml  --project some-project
+orch-cli create osupdatepolicy --from-file path/to/osupdatepolicy.yaml  --project some-project
 
 Sample OS update policy file format for immutable OS
 appVersion: apps/v1
@@ -42,6 +45,32 @@ spec:
   name: myupdate
   description: "an update profile"
   updatePolicy: "UPDATE_POLICY_LATEST"
+
+Sample OS update policy file format for mutable OS, updatePackages is either one package per line or a list
+appVersion: apps/v1
+spec:
+  name: myupdateubuntu
+  description: "an update profile"
+  updatePolicy: "UPDATE_POLICY_TARGET"      # UPDATE_POLICY_LATEST or UPDATE_POLICY_TARGET
+  targetOs: "Ubuntu 22.04"                  # name of the OS profile to update to
+  updatePackages:
+    - curl
+    - openssh-server
+  updateKernelCommand: "hugepages=2"
+  updateSources:
+    - "deb http://archive.ubuntu.com/ubuntu jammy main"
+
+Unknown fields, e.g. a misspelled updatePackage, are rejected with the line of the field in the file.
+
+# Create an OS Update Policy and set or override its kernel command
+orch-cli create osupdatepolicy path/to/osupdatepolicy.yaml --kernel-command "console=ttyS0,115200 intel_iommu=on" --project some-project
+
+The kernel command is validated before the policy is created: unbalanced or single quotes, malformed
+parameters and forbidden parameters are rejected, duplicated parameters produce a warning. Parameters
+that drop the boot into a shell or replace init (e.g. init, rd.break, single) are always forbidden;
+additional ones can be configured for all projects or per project:
+orch-cli config set kernel-command.forbidden-params "nomodeset,selinux"
+orch-cli config set kernel-command.projects.some-project.forbidden-params "iommu"
 `
 
 const deleteOSUpdatePolicyExamples = `# Delete an OS Update policy by resource ID
@@ -53,38 +82,59 @@ var osUpdatePolicySchema = `
 {
   "type": "object",
   "properties": {
+    "appVersion": { "type": "string" },
     "spec": {
       "type": "object",
       "properties": {
-        "name":                  { "type": "string" },
+        "name":                  { "type": "string", "minLength": 1 },
         "description":           { "type": "string" },
-        "updatePackages":        { "type": "string" },
+        "updatePackages":        { "type": ["string", "array"], "items": { "type": "string" } },
         "updateKernelCommand":   { "type": "string" },
         "targetOs":              { "type": "string" },
         "updateSources":         { "type": ["array", "null"], "items": { "type": "string" } },
-        "updatePolicy":          { "type": "string" }
+        "updatePolicy":          { "type": "string", "enum": ["UPDATE_POLICY_LATEST", "UPDATE_POLICY_TARGET"] }
       },
-      "required": ["name", "description", "updatePolicy"]
+      "required": ["name", "description", "updatePolicy"],
+      "additionalProperties": false
     }
   },
-  "required": ["spec"]
+  "required": ["spec"],
+  "additionalProperties": false
 }
 `
 
 type OSUpdatePolicy struct {
-	Name                string   `yaml:"name"`
-	Description         string   `yaml:"description"`
-	UpdatePackages      string   `yaml:"updatePackages"`
-	UpdateKernelCommand string   `yaml:"updateKernelCommand"`
-	TargetOS            string   `yaml:"targetOs"`
-	UpdateSources       []string `yaml:"updateSources"`
-	UpdatePolicy        string   `yaml:"updatePolicy"`
+	Name                string           `yaml:"name"`
+	Description         string           `yaml:"description"`
+	UpdatePackages      OSUpdatePackages `yaml:"updatePackages"`
+	UpdateKernelCommand string           `yaml:"updateKernelCommand"`
+	TargetOS            string           `yaml:"targetOs"`
+	UpdateSources       []string         `yaml:"updateSources"`
+	UpdatePolicy        string           `yaml:"updatePolicy"`
 }
 
 type UpdateNestedSpec struct {
 	Spec OSUpdatePolicy `yaml:"spec"`
 }
 
+// OSUpdatePackages are the packages of an update, given in the input file either as one
+// package per line or as a list
+type OSUpdatePackages string
+
+func (p *OSUpdatePackages) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var packages []string
+	if err := unmarshal(&packages); err == nil {
+		*p = OSUpdatePackages(strings.Join(packages, "\n"))
+		return nil
+	}
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+	*p = OSUpdatePackages(text)
+	return nil
+}
+
 // Template-based output constants for standardization
 const (
 	DEFAULT_OSUPDATEPOLICY_FORMAT = "table{{.Name}}\t{{str .ResourceId}}\t{{str .Description}}"
@@ -109,6 +159,10 @@ func getOSUpdatePolicyOutputFormat(cmd *cobra.Command, verbose bool, forList boo
 }
 
 func printOSUpdatePolicies(cmd *cobra.Command, writer io.Writer, policies []infra.OSUpdatePolicy, orderBy *string, outputFilter *string, verbose bool) error {
+	policies, err := selectItems(cmd, policies)
+	if err != nil {
+		return err
+	}
 	outputFormat, err := getOSUpdatePolicyOutputFormat(cmd, verbose, true)
 	if err != nil {
 		return err
@@ -130,11 +184,18 @@ func printOSUpdatePolicies(cmd *cobra.Command, writer io.Writer, policies []infr
 		NameLimit: -1,
 		Data:      policies,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
 
 func printOSUpdatePolicy(cmd *cobra.Command, writer io.Writer, policy *infra.OSUpdatePolicy) error {
+	if selected, err := printSelectedOutput(cmd, policy); selected {
+		return err
+	}
+
 	outputType, _ := cmd.Flags().GetString("output-type")
 	outputFormat, err := getOSUpdatePolicyOutputFormat(cmd, false, false)
 	if err != nil {
@@ -199,9 +260,15 @@ func readUpdateProfileFromYaml(path string) (*UpdateNestedSpec, error) {
 		return nil, fmt.Errorf("schema validation error: %v", err)
 	}
 	if !result.Valid() {
+		var document yamlv3.Node
+		_ = yamlv3.Unmarshal(data, &document)
 		var sb strings.Builder
 		for _, desc := range result.Errors() {
-			fmt.Fprintf(&sb, "- %s\n", desc)
+			if line := schemaErrorLine(&document, desc); line > 0 {
+				fmt.Fprintf(&sb, "- line %d: %s\n", line, desc)
+			} else {
+				fmt.Fprintf(&sb, "- %s\n", desc)
+			}
 		}
 		return nil, fmt.Errorf("YAML does not conform to schema:\n%s", sb.String())
 	}
@@ -214,6 +281,47 @@ func readUpdateProfileFromYaml(path string) (*UpdateNestedSpec, error) {
 	return &input, nil
 }
 
+// Returns the line of the YAML document where a schema error is, or 0 if it is not found. Errors
+// about a property, e.g. one that is not allowed, point at the property, others at the field
+func schemaErrorLine(document *yamlv3.Node, desc gojsonschema.ResultError) int {
+	var path []string
+	if field := desc.Field(); field != "" && field != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		path = strings.Split(field, ".")
+	}
+	if property, ok := desc.Details()["property"].(string); ok && desc.Type() != "required" {
+		path = append(path, property)
+	}
+
+	node := document
+	if node.Kind == yamlv3.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line := node.Line
+	for _, key := range path {
+		var next *yamlv3.Node
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == key {
+					line = node.Content[i].Line
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yamlv3.SequenceNode:
+			if index, err := strconv.Atoi(key); err == nil && index >= 0 && index < len(node.Content) {
+				next = node.Content[index]
+				line = next.Line
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return line
+}
+
 func getGetOSUpdatePolicyCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "osupdatepolicy <name|resourceID> [flags]",
@@ -238,18 +346,26 @@ func getListOSUpdatePolicyCommand() *cobra.Command {
 	cmd.Flags().StringP("filter", "f", viper.GetString("filter"), "API filter (see https://google.aip.dev/160)")
 	cmd.Flags().String("order-by", "", "order results by field (table output only)")
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
 	return cmd
 }
 
 func getCreateOSUpdatePolicyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "osupdatepolicy  [flags]",
+		Use:     "osupdatepolicy [<path>] [flags]",
 		Short:   "Creates OS Update policy",
 		Example: createOSUpdatePolicyExamples,
-		Args:    cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		Aliases: osUpdatePolicyAliases,
 		RunE:    runCreateOSUpdatePolicyCommand,
 	}
+	cmd.Flags().String("from-file", "", "YAML file of the OS update policy, instead of giving it as argument")
+	cmd.Flags().String("kernel-command", "", "Kernel command line options to apply on update - overrides updateKernelCommand in the input file")
 	return cmd
 }
 
@@ -387,7 +503,10 @@ func runListOSUpdatePolicyCommand(cmd *cobra.Command, _ []string) error {
 
 // Creates OS Update Policy - checks if a OS Update Policy already exists and then creates it if it does not
 func runCreateOSUpdatePolicyCommand(cmd *cobra.Command, args []string) error {
-	path := args[0]
+	path, _ := cmd.Flags().GetString("from-file")
+	if path == "" {
+		path = args[0]
+	}
 	writer, verbose := getOutputContext(cmd)
 
 	err := verifyUpdateProfileInput(path)
@@ -400,6 +519,18 @@ func runCreateOSUpdatePolicyCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed("kernel-command") {
+		spec.Spec.UpdateKernelCommand, _ = cmd.Flags().GetString("kernel-command")
+	}
+	projectFlag, _ := cmd.Flags().GetString(project)
+	findings := validateKernelCommand(spec.Spec.UpdateKernelCommand, getForbiddenKernelParams(projectFlag))
+	for _, warning := range findings.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: kernel command %s\n", warning)
+	}
+	if err := findings.err(); err != nil {
+		return err
+	}
+
 	ctx, OSUPolicyClient, projectName, err := InfraFactory(cmd)
 	if err != nil {
 		return err
@@ -435,7 +566,8 @@ func runCreateOSUpdatePolicyCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	if spec.Spec.UpdatePackages != "" {
-		packages = &spec.Spec.UpdatePackages
+		updatePackages := string(spec.Spec.UpdatePackages)
+		packages = &updatePackages
 	}
 
 	if spec.Spec.UpdateKernelCommand != "" {
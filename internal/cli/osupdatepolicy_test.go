@@ -5,7 +5,12 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func (s *CLITestSuite) listOSUpdatePolicy(publisher string, args commandArgs) (string, error) {
@@ -52,6 +57,20 @@ func (s *CLITestSuite) TestOSUpdatePolicy() {
 	_, err = s.createOSUpdatePolicy(project, "./testdata/immutableosupdateprofile.yaml", OArgs)
 	s.NoError(err)
 
+	//Create OS Update Policy with a kernel command override
+	OArgs = map[string]string{
+		"kernel-command": "console=ttyS0,115200 intel_iommu=on",
+	}
+	_, err = s.createOSUpdatePolicy(project, "./testdata/mutableosupdateprofile.yaml", OArgs)
+	s.NoError(err)
+
+	//Create OS Update Policy with a forbidden kernel parameter
+	OArgs = map[string]string{
+		"kernel-command": "console=ttyS0 init=/bin/sh",
+	}
+	_, err = s.createOSUpdatePolicy(project, "./testdata/mutableosupdateprofile.yaml", OArgs)
+	s.EqualError(err, `invalid kernel command: parameter "init=/bin/sh" is not allowed`)
+
 	/////////////////////////////
 	// Test OS Update Policy List
 	/////////////////////////////
@@ -237,3 +256,70 @@ func FuzzOSUpdatePolicy(f *testing.F) {
 		}
 	})
 }
+
+func (s *CLITestSuite) TestCreateOSUpdatePolicyFromFile() {
+	_, err := s.runCommand("create osupdatepolicy --from-file ./testdata/mutableosupdateprofile.yaml --project " + project)
+	s.NoError(err)
+
+	_, err = s.runCommand("create osupdatepolicy ./testdata/mutableosupdateprofile.yaml --from-file ./testdata/mutableosupdateprofile.yaml --project " + project)
+	s.Error(err)
+
+	_, err = s.runCommand("create osupdatepolicy --project " + project)
+	s.EqualError(err, "accepts 1 arg(s), received 0")
+}
+
+func TestReadUpdateProfileFromYaml(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "policy.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	// Packages can be given as a list
+	spec, err := readUpdateProfileFromYaml(write(`appVersion: apps/v1
+spec:
+  name: mypolicy
+  description: "packages as list"
+  updatePolicy: UPDATE_POLICY_TARGET
+  updatePackages:
+    - curl
+    - openssh-server
+`))
+	require.NoError(t, err)
+	assert.Equal(t, OSUpdatePackages("curl\nopenssh-server"), spec.Spec.UpdatePackages)
+
+	spec, err = readUpdateProfileFromYaml(write(`spec:
+  name: mypolicy
+  description: "packages as text"
+  updatePolicy: UPDATE_POLICY_LATEST
+  updatePackages: |
+    curl
+    vim
+`))
+	require.NoError(t, err)
+	assert.Equal(t, OSUpdatePackages("curl\nvim\n"), spec.Spec.UpdatePackages)
+
+	// Errors point at the offending field
+	_, err = readUpdateProfileFromYaml(write(`appVersion: apps/v1
+spec:
+  name: mypolicy
+  description: "an update profile"
+  updatePolicy: UPDATE_POLICY_NEWEST
+  updatePackage: curl
+  updateSources:
+    - "source1"
+    - 2
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "- line 5: spec.updatePolicy: spec.updatePolicy must be one of the following:")
+	assert.Contains(t, err.Error(), "- line 6: spec: Additional property updatePackage is not allowed")
+	assert.Contains(t, err.Error(), "- line 9: spec.updateSources.1: Invalid type. Expected: string, given: integer")
+
+	_, err = readUpdateProfileFromYaml(write(`spec:
+  description: "no name"
+  updatePolicy: UPDATE_POLICY_LATEST
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "- line 1: spec: name is required")
+}
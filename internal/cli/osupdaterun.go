@@ -57,6 +57,10 @@ func getOSUpdateRunOutputFormat(cmd *cobra.Command, verbose bool, forList bool)
 }
 
 func printOSUpdateRuns(cmd *cobra.Command, writer io.Writer, runs []infra.OSUpdateRun, orderBy *string, outputFilter *string, verbose bool) error {
+	runs, err := selectItems(cmd, runs)
+	if err != nil {
+		return err
+	}
 	outputFormat, err := getOSUpdateRunOutputFormat(cmd, verbose, true)
 	if err != nil {
 		return err
@@ -78,11 +82,18 @@ func printOSUpdateRuns(cmd *cobra.Command, writer io.Writer, runs []infra.OSUpda
 		NameLimit: -1,
 		Data:      runs,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
 
 func printOSUpdateRun(cmd *cobra.Command, writer io.Writer, run *infra.OSUpdateRun) error {
+	if selected, err := printSelectedOutput(cmd, run); selected {
+		return err
+	}
+
 	outputType, _ := cmd.Flags().GetString("output-type")
 	outputFormat, err := getOSUpdateRunOutputFormat(cmd, false, false)
 	if err != nil {
@@ -122,6 +133,7 @@ func getListOSUpdateRunCommand() *cobra.Command {
 	cmd.Flags().StringP("filter", "f", viper.GetString("filter"), "API filter (see https://google.aip.dev/160)")
 	cmd.Flags().String("order-by", "", "order results by field (table output only)")
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
 	return cmd
 }
 
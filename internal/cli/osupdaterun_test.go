@@ -34,6 +34,7 @@ func (s *CLITestSuite) TestOSUpdateRun() {
 	//List OS Update Runs --verbose
 	OArgs = map[string]string{
 		"verbose": "",
+		"wide":    "",
 	}
 	listOutput, err := s.listOSUpdateRun(project, OArgs)
 	s.NoError(err)
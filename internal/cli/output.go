@@ -4,16 +4,16 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
 
+	outfmt "github.com/open-edge-platform/cli/internal/format"
 	"github.com/open-edge-platform/cli/pkg/filter"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/order"
-	"gopkg.in/yaml.v2"
 )
 
 type OutputType uint8
@@ -34,6 +34,9 @@ type CommandResult struct {
 	OrderBy   string
 	OutputAs  OutputType
 	NameLimit int
+	// Columns replaces the table format with the fields named by --columns
+	Columns   []string
+	NoHeaders bool
 	Data      interface{}
 }
 
@@ -95,29 +98,25 @@ func GenerateOutput(writer io.Writer, result *CommandResult) {
 		}
 		switch result.OutputAs {
 		case OUTPUT_TABLE:
-			if err := result.Format.Execute(writer, true, result.NameLimit, data); err != nil {
+			if len(result.Columns) > 0 {
+				columns, err := resolveListColumns(reflect.TypeOf(result.Data), result.Columns)
+				if err != nil {
+					Fatalf("Invalid --%s: %s", columnsFlag, err.Error())
+				}
+				if err := printListColumns(writer, columns, !result.NoHeaders, data); err != nil {
+					Fatalf("Unexpected error while attempting to format results as table : %s", err.Error())
+				}
+				return
+			}
+			if err := outfmt.RenderTable(writer, string(result.Format), !result.NoHeaders, result.NameLimit, data); err != nil {
 				Fatalf("Unexpected error while attempting to format results as table : %s", err.Error())
 			}
 		case OUTPUT_JSON:
-			// first try to convert it as an array of protobufs
-			//asJson, err := ConvertJsonProtobufArray(data)
-			//if err != nil {
-			// if that fails, then just do a standard json conversion
-			asJSONB, err := json.Marshal(&data)
-			if err != nil {
-				Fatalf("Unexpected error while processing command results to JSON: %s", err.Error())
-			}
-			asJSON := string(asJSONB)
-			//}
-			if _, err = fmt.Fprintf(writer, "%s", asJSON); err != nil {
+			if err := outfmt.RenderJSON(writer, &data); err != nil {
 				Fatalf("Unexpected error while writing JSON output: %s", err.Error())
 			}
 		case OUTPUT_YAML:
-			asYaml, err := yaml.Marshal(&data)
-			if err != nil {
-				Fatalf("Unexpected error while processing command results to YAML: %s", err.Error())
-			}
-			if _, err = fmt.Fprintf(writer, "%s", asYaml); err != nil {
+			if err := outfmt.RenderYAML(writer, &data); err != nil {
 				Fatalf("Unexpected error while writing YAML output: %s", err.Error())
 			}
 		}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const (
+	noPagerFlag  = "no-pager"
+	pagerConfig  = "pager"
+	pagerEnv     = "PAGER"
+	defaultPager = "less -R"
+)
+
+// Commands whose output goes through the pager
+var pagedCommands = []string{"list", "get"}
+
+// Escape sequences of the colors and cursor moves, which take no room on the screen
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// pagerBuffer holds the output of a command until it is known whether it fits on the screen; it is shown on a
+// terminal either way, so the output written to it keeps its colors
+type pagerBuffer struct {
+	bytes.Buffer
+}
+
+// Pipes the output of the list and get commands through a pager when it is written to a terminal and does not fit
+// on the screen, like git does; --no-pager, or the no-pager configuration, writes it directly
+func applyPager(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil && isPagedCommand(cmd) {
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			out := c.OutOrStdout()
+			if noPager, _ := c.Flags().GetBool(noPagerFlag); noPager || !isTerminal(out) || redrawsOutput(c) {
+				return run(c, args)
+			}
+			buf := &pagerBuffer{}
+			c.SetOut(buf)
+			err := run(c, args)
+			c.SetOut(out)
+			if pageErr := writePaged(out, buf.Bytes()); err == nil {
+				err = pageErr
+			}
+			return err
+		}
+	}
+	for _, child := range cmd.Commands() {
+		applyPager(child)
+	}
+}
+
+// Reports whether the command redraws its output on the terminal as it runs, like list host --cached replacing the
+// cached table once the hosts are fetched; the pager would only show the output once complete, both tables included
+func redrawsOutput(cmd *cobra.Command) bool {
+	cached, _ := cmd.Flags().GetBool("cached")
+	return cached
+}
+
+// Reports whether the command is below one of the paged commands of the root command
+func isPagedCommand(cmd *cobra.Command) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if !c.Parent().HasParent() {
+			return c != cmd && slices.Contains(pagedCommands, c.Name())
+		}
+	}
+	return false
+}
+
+// Writes the output to the terminal, through the pager if it has more rows than the terminal; the output is
+// written directly if the pager cannot be started
+func writePaged(out io.Writer, output []byte) error {
+	f, ok := out.(*os.File)
+	if !ok {
+		_, err := out.Write(output)
+		return err
+	}
+	width, height, err := term.GetSize(int(f.Fd()))
+	if err != nil || outputRows(output, width) < height {
+		_, err := out.Write(output)
+		return err
+	}
+	args := strings.Fields(pagerCommand())
+	if len(args) == 0 {
+		_, err := out.Write(output)
+		return err
+	}
+	pager := exec.Command(args[0], args[1:]...) //nolint:gosec // the pager is chosen by the user
+	pager.Stdin = bytes.NewReader(output)
+	pager.Stdout = f
+	pager.Stderr = os.Stderr
+	if err := pager.Start(); err != nil {
+		_, err := out.Write(output)
+		return err
+	}
+	// The user may quit the pager before the end of the output, which is not an error of the command
+	_ = pager.Wait()
+	return nil
+}
+
+// The pager of the pager configuration, else of the PAGER environment variable, else less
+func pagerCommand() string {
+	if pager := strings.TrimSpace(viper.GetString(pagerConfig)); pager != "" {
+		return pager
+	}
+	if pager := strings.TrimSpace(os.Getenv(pagerEnv)); pager != "" {
+		return pager
+	}
+	return defaultPager
+}
+
+// Counts the rows the output takes on a terminal of the given width, long lines wrapping on several rows
+func outputRows(output []byte, width int) int {
+	text := strings.TrimSuffix(ansiEscape.ReplaceAllString(string(output), ""), "\n")
+	if text == "" {
+		return 0
+	}
+	rows := 0
+	for _, line := range strings.Split(text, "\n") {
+		n := utf8.RuneCountInString(line)
+		if width <= 0 || n <= width {
+			rows++
+			continue
+		}
+		rows += (n + width - 1) / width
+	}
+	return rows
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputRows(t *testing.T) {
+	assert.Equal(t, 0, outputRows(nil, 80))
+	assert.Equal(t, 2, outputRows([]byte("Name\tStatus\nhost-1\tRunning\n"), 80))
+	// Colors take no room and long lines wrap
+	assert.Equal(t, 1, outputRows([]byte("\033[32m✔ Running\033[0m\n"), 10))
+	assert.Equal(t, 3, outputRows([]byte("abcdefghijklmnopqrstuvwxy\n"), 10))
+	assert.Equal(t, 2, outputRows([]byte("abc\n\n"), 10))
+}
+
+func TestPagerCommand(t *testing.T) {
+	defer viper.Set(pagerConfig, "")
+
+	t.Setenv(pagerEnv, "")
+	assert.Equal(t, "less -R", pagerCommand())
+
+	t.Setenv(pagerEnv, "more")
+	assert.Equal(t, "more", pagerCommand())
+
+	viper.Set(pagerConfig, "less -RS")
+	assert.Equal(t, "less -RS", pagerCommand())
+}
+
+func TestIsPagedCommand(t *testing.T) {
+	root := getRootCmd()
+	for args, paged := range map[string]bool{
+		"list host":   true,
+		"get host":    true,
+		"list":        false,
+		"create host": false,
+		"version":     false,
+	} {
+		cmd, _, err := root.Find(parseArgs(args))
+		assert.NoError(t, err)
+		assert.Equal(t, paged, isPagedCommand(cmd), args)
+	}
+}
+
+func TestRedrawsOutput(t *testing.T) {
+	for args, redraws := range map[string]bool{
+		"list host --cached": true,
+		"list host":          false,
+		"get host host-1":    false,
+	} {
+		cmd, flags, err := getRootCmd().Find(parseArgs(args))
+		assert.NoError(t, err)
+		assert.NoError(t, cmd.ParseFlags(flags))
+		assert.Equal(t, redraws, redrawsOutput(cmd), args)
+	}
+}
@@ -0,0 +1,367 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const patchHostExamples = `# Patch the hosts listed in a file, restoring them all if one of them fails
+orch-cli patch host --from-file patch.yaml --project some-project
+
+# Show the changes without applying them
+orch-cli patch host --from-file patch.yaml --dry-run --project some-project
+
+# Sample patch file, in YAML or JSON
+hosts:                  # names or resource IDs of the hosts, or
+  - host-1234abcd
+  - edge-host-002
+# filter: 'site.name="store-042"'   # an AIP-160 filter selecting the hosts
+patch:
+  powerPolicy: ordered  # immediate|ordered, for hosts with AMT provisioned
+  site: store-042       # name or resource ID of the site
+  metadata:             # added or replaced keys, "" removes a key
+    rack: r12
+    environment: ""
+
+The hosts are patched one after the other. When one fails, the remaining ones are not patched and the ones
+already patched are restored to their previous site, metadata and power policy.
+`
+
+// hostPatchFile is the content of the file of patch host
+type hostPatchFile struct {
+	Hosts  []string  `yaml:"hosts"`
+	Filter string    `yaml:"filter"`
+	Patch  hostPatch `yaml:"patch"`
+}
+
+// hostPatch is the fields of the hosts a patch file changes
+type hostPatch struct {
+	PowerPolicy string            `yaml:"powerPolicy"`
+	Site        string            `yaml:"site"`
+	Metadata    map[string]string `yaml:"metadata"`
+}
+
+// hostPatchValues are the values a host patch sets, or the ones it replaced when it is reverted
+type hostPatchValues struct {
+	policy   *infra.PowerCommandPolicy
+	siteID   *string
+	metadata *[]infra.MetadataItem
+}
+
+func getPatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "patch",
+		Short:             "Change several Edge Orchestrator resources at once from a patch file",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getPatchHostCommand(),
+	)
+	return cmd
+}
+
+func getPatchHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "host --from-file <patch-file> [flags]",
+		Short:   "Patches the power policy, site and metadata of hosts, all of them or none",
+		Example: patchHostExamples,
+		Args:    cobra.NoArgs,
+		Aliases: hostAliases,
+		RunE:    runPatchHostCommand,
+	}
+	cmd.Flags().String("from-file", "", "YAML or JSON file listing the hosts, or a filter selecting them, and the fields to patch")
+	cmd.Flags().Bool("dry-run", false, "Show the changes to the hosts without applying them")
+	_ = cmd.MarkFlagRequired("from-file")
+	return cmd
+}
+
+func runPatchHostCommand(cmd *cobra.Command, _ []string) error {
+	path, _ := cmd.Flags().GetString("from-file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	var file hostPatchFile
+	if err := readPatchFile(path, &file); err != nil {
+		return err
+	}
+	if err := patchSelectionError("host", file.Hosts, file.Filter); err != nil {
+		return err
+	}
+	if file.Patch.PowerPolicy == "" && file.Patch.Site == "" && len(file.Patch.Metadata) == 0 {
+		return errEmptyPatch
+	}
+	var policy *infra.PowerCommandPolicy
+	if file.Patch.PowerPolicy != "" {
+		pol, err := resolvePowerPolicy(file.Patch.PowerPolicy)
+		if err != nil {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid powerPolicy %q in the patch file, use one of immediate|ordered", file.Patch.PowerPolicy))
+		}
+		policy = &pol
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	siteID := ""
+	if file.Patch.Site != "" {
+		if siteID, err = resolvePatchSite(ctx, hostClient, projectName, file.Patch.Site); err != nil {
+			return err
+		}
+	}
+	hosts, err := resolvePatchHosts(ctx, hostClient, projectName, file)
+	if err != nil {
+		return err
+	}
+
+	// Every host is checked before any of them is changed
+	targets := make([]bulkPatchTarget, 0, len(hosts))
+	for _, host := range hosts {
+		patched, changes, err := planHostPatch(host, file.Patch, policy, siteID)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		previous := hostPatchedValues(host, patched)
+		h := host
+		targets = append(targets, bulkPatchTarget{
+			name:       h.Name,
+			resourceID: derefString(h.ResourceId),
+			changes:    strings.Join(changes, ", "),
+			apply: func(ctx context.Context) error {
+				return patchHostValues(ctx, hostClient, projectName, h, patched)
+			},
+			revert: func(ctx context.Context) error {
+				return patchHostValues(ctx, hostClient, projectName, h, previous)
+			},
+		})
+	}
+
+	out := cmd.OutOrStdout()
+	if unchanged := len(hosts) - len(targets); unchanged > 0 {
+		fmt.Fprintf(out, "%d host(s) already match the patch\n", unchanged)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	if dryRun {
+		return printBulkPatchPlan(cmd, out, "host", targets)
+	}
+	return printBulkPatchResults(cmd, out, "host", applyBulkPatch(ctx, targets))
+}
+
+// Resolves the site of a patch file, given by name or resource ID
+func resolvePatchSite(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName, site string) (string, error) {
+	if isSiteResourceID(site) {
+		return site, nil
+	}
+	resp, err := hostClient.SiteServiceListSitesWithResponse(ctx, projectName, "",
+		&infra.SiteServiceListSitesParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return "", processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while listing sites"); err != nil {
+		return "", err
+	}
+	s, err := findSiteByName(resp.JSON200.Sites, site)
+	if err != nil {
+		return "", err
+	}
+	return derefString(s.ResourceId), nil
+}
+
+// Retrieves the hosts listed by a patch file, by name or resource ID, or matching its filter
+func resolvePatchHosts(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, file hostPatchFile) ([]infra.HostResource, error) {
+	if file.Filter != "" {
+		hosts, err := listHostsMatching(ctx, hostClient, projectName, &file.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts) == 0 {
+			return nil, e.WithCode(e.CodeNotFound, fmt.Errorf("no hosts match the filter %q", file.Filter))
+		}
+		return hosts, nil
+	}
+
+	hosts := make([]infra.HostResource, 0, len(file.Hosts))
+	seen := map[string]bool{}
+	for _, hostID := range file.Hosts {
+		if !isHostResourceID(hostID) {
+			nameFilter := fmt.Sprintf("name=%q", hostID)
+			resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
+				&infra.HostServiceListHostsParams{Filter: &nameFilter}, auth.AddAuthHeader)
+			if err != nil {
+				return nil, processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+				return nil, err
+			}
+			host, err := findHostByName(resp.JSON200.Hosts, hostID)
+			if err != nil {
+				return nil, err
+			}
+			hostID = derefString(host.ResourceId)
+		}
+		if seen[hostID] {
+			continue
+		}
+		seen[hostID] = true
+		resp, err := hostClient.HostServiceGetHostWithResponse(ctx, projectName, hostID, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while retrieving host %s", hostID)); err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, *resp.JSON200)
+	}
+	return hosts, nil
+}
+
+// Returns the values the patch sets on a host and the description of the changes; the fields the host already
+// has are left out
+func planHostPatch(host infra.HostResource, patch hostPatch, policy *infra.PowerCommandPolicy, siteID string) (hostPatchValues, []string, error) {
+	var values hostPatchValues
+	var changes []string
+
+	if policy != nil && (host.PowerCommandPolicy == nil || *host.PowerCommandPolicy != *policy) {
+		if host.CurrentAmtState == nil || *host.CurrentAmtState != infra.AMTSTATEPROVISIONED {
+			return values, nil, e.WithCode(e.CodeFailedPrecondition,
+				fmt.Errorf("host %s does not have AMT provisioned, its power policy cannot be patched", derefString(host.ResourceId)))
+		}
+		values.policy = policy
+		changes = append(changes, "power policy "+patch.PowerPolicy)
+	}
+
+	if siteID != "" && hostSiteID(host) != siteID {
+		values.siteID = &siteID
+		changes = append(changes, "site "+patch.Site)
+	}
+
+	if len(patch.Metadata) > 0 {
+		var current []infra.MetadataItem
+		if host.Metadata != nil {
+			current = *host.Metadata
+		}
+		metadata, metadataChanges := mergeMetadata(current, patch.Metadata)
+		if len(metadataChanges) > 0 {
+			values.metadata = &metadata
+			changes = append(changes, "metadata "+strings.Join(metadataChanges, " "))
+		}
+	}
+	return values, changes, nil
+}
+
+// Returns the current values of the fields of a host a patch sets, to revert it
+func hostPatchedValues(host infra.HostResource, patched hostPatchValues) hostPatchValues {
+	var previous hostPatchValues
+	if patched.policy != nil {
+		policy := infra.POWERCOMMANDPOLICYUNSPECIFIED
+		if host.PowerCommandPolicy != nil {
+			policy = *host.PowerCommandPolicy
+		}
+		previous.policy = &policy
+	}
+	if patched.siteID != nil {
+		siteID := hostSiteID(host)
+		previous.siteID = &siteID
+	}
+	if patched.metadata != nil {
+		metadata := []infra.MetadataItem{}
+		if host.Metadata != nil {
+			metadata = append(metadata, *host.Metadata...)
+		}
+		previous.metadata = &metadata
+	}
+	return previous
+}
+
+func hostSiteID(host infra.HostResource) string {
+	if id := derefString(host.SiteId); id != "" {
+		return id
+	}
+	if host.Site != nil {
+		return derefString(host.Site.ResourceId)
+	}
+	return ""
+}
+
+// Applies the patch to the metadata: keys with a value are added or replaced, keys with an empty value are
+// removed. The changes are described as key=value and -key.
+func mergeMetadata(current []infra.MetadataItem, patch map[string]string) ([]infra.MetadataItem, []string) {
+	merged := make([]infra.MetadataItem, 0, len(current)+len(patch))
+	var changes []string
+	present := map[string]bool{}
+	for _, item := range current {
+		present[item.Key] = true
+		value, ok := patch[item.Key]
+		switch {
+		case !ok || value == item.Value:
+			merged = append(merged, item)
+		case value == "":
+			changes = append(changes, "-"+item.Key)
+		default:
+			merged = append(merged, infra.MetadataItem{Key: item.Key, Value: value})
+			changes = append(changes, item.Key+"="+value)
+		}
+	}
+	keys := make([]string, 0, len(patch))
+	for key := range patch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if present[key] || patch[key] == "" {
+			continue
+		}
+		merged = append(merged, infra.MetadataItem{Key: key, Value: patch[key]})
+		changes = append(changes, key+"="+patch[key])
+	}
+	return merged, changes
+}
+
+// Sets the fields of a host given by the values, the others are left unchanged
+func patchHostValues(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource, values hostPatchValues) error {
+	var paths []string
+	body := infra.HostServicePatchHostJSONRequestBody{Name: host.Name}
+	if values.policy != nil {
+		paths = append(paths, "powerCommandPolicy")
+		body.PowerCommandPolicy = values.policy
+	}
+	if values.siteID != nil {
+		paths = append(paths, "siteId")
+		body.SiteId = values.siteID
+	}
+	if values.metadata != nil {
+		paths = append(paths, "metadata")
+		body.Metadata = values.metadata
+	}
+	fieldMask := strings.Join(paths, ",")
+	resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, derefString(host.ResourceId),
+		&infra.HostServicePatchHostParams{FieldMask: &fieldMask}, body, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body, "error while patching host")
+}
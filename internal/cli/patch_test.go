@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestPatchHost() {
+	dir := s.T().TempDir()
+	writePatch := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		s.NoError(os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	patch := writePatch("patch.yaml", `hosts:
+  - host-abcd1003
+  - host-abc12345
+patch:
+  site: site
+  metadata:
+    rack: r14
+    environment: ""
+`)
+	out, err := s.runCommand("patch host --from-file " + patch + " --dry-run --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Dry run: 2 host(s) would be patched\n")
+	s.Regexp(`(?m)^edge-host-003\s+\|host-abcd1003\s+\|site site, metadata -environment rack=r14\s*$`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|site site, metadata -environment rack=r14\s*$`, out)
+
+	out, err = s.runCommand("patch host --from-file " + patch + " --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^edge-host-003\s+\|host-abcd1003\s+\|patched\s+\|`, out)
+	s.Contains(out, "Done: 2 patched, 0 failed, 0 rolled back, 0 not rolled back, 0 not applied\n")
+
+	// The power policy of a host without AMT cannot change, so no host is patched
+	patch = writePatch("policy.json", `{"hosts": ["host-abc12345", "host-abcd1003"], "patch": {"powerPolicy": "ordered"}}`)
+	_, err = s.runCommand("patch host --from-file " + patch + " --project " + project)
+	s.EqualError(err, "host host-abcd1003 does not have AMT provisioned, its power policy cannot be patched")
+
+	patch = writePatch("both.yaml", "hosts: [host-abc12345]\nfilter: 'site.name=\"site\"'\npatch:\n  site: site\n")
+	_, err = s.runCommand("patch host --from-file " + patch + " --project " + project)
+	s.EqualError(err, "the patch file lists hosts and sets a filter, use one of them")
+
+	patch = writePatch("empty.yaml", "hosts: [host-abc12345]\n")
+	_, err = s.runCommand("patch host --from-file " + patch + " --project " + project)
+	s.EqualError(err, "the patch file changes nothing, set at least one field under patch")
+
+	patch = writePatch("typo.yaml", "hosts: [host-abc12345]\npatch:\n  sites: site\n")
+	_, err = s.runCommand("patch host --from-file " + patch + " --project " + project)
+	s.ErrorContains(err, "field sites not found")
+
+	_, err = s.runCommand("patch host --project " + project)
+	s.EqualError(err, `required flag(s) "from-file" not set`)
+}
+
+func TestApplyBulkPatch(t *testing.T) {
+	var calls []string
+	target := func(id string, applyErr, revertErr error) bulkPatchTarget {
+		return bulkPatchTarget{
+			name:       id,
+			resourceID: id,
+			changes:    "site store-042",
+			apply: func(context.Context) error {
+				calls = append(calls, "apply "+id)
+				return applyErr
+			},
+			revert: func(context.Context) error {
+				calls = append(calls, "revert "+id)
+				return revertErr
+			},
+		}
+	}
+
+	results := applyBulkPatch(context.Background(), []bulkPatchTarget{target("host-1", nil, nil), target("host-2", nil, nil)})
+	assert.Equal(t, []string{"apply host-1", "apply host-2"}, calls)
+	assert.Equal(t, patchResultPatched, results[1].result)
+
+	// A failure reverts the hosts already patched, the last first, and leaves the next ones alone
+	calls = nil
+	results = applyBulkPatch(context.Background(), []bulkPatchTarget{
+		target("host-1", nil, errors.New("error while patching host: 503 Service Unavailable")),
+		target("host-2", nil, nil),
+		target("host-3", errors.New("error while patching host: 400 Bad Request"), nil),
+		target("host-4", nil, nil),
+	})
+	assert.Equal(t, []string{"apply host-1", "apply host-2", "apply host-3", "revert host-2", "revert host-1"}, calls)
+	assert.Equal(t, []bulkPatchResult{
+		{name: "host-1", resourceID: "host-1", result: patchResultRollbackFailed, details: "error while patching host: 503 Service Unavailable"},
+		{name: "host-2", resourceID: "host-2", result: patchResultRolledBack, details: "site store-042"},
+		{name: "host-3", resourceID: "host-3", result: patchResultFailed, details: "error while patching host: 400 Bad Request"},
+		{name: "host-4", resourceID: "host-4", result: patchResultNotApplied, details: "site store-042"},
+	}, results)
+
+	// The hosts are still reverted when the patch failed because the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var revertErrs []error
+	results = applyBulkPatch(ctx, []bulkPatchTarget{
+		{name: "host-1", resourceID: "host-1",
+			apply: func(context.Context) error { return nil },
+			revert: func(ctx context.Context) error {
+				_, hasDeadline := ctx.Deadline()
+				assert.True(t, hasDeadline)
+				revertErrs = append(revertErrs, ctx.Err())
+				return ctx.Err()
+			}},
+		{name: "host-2", resourceID: "host-2",
+			apply:  func(ctx context.Context) error { return ctx.Err() },
+			revert: func(context.Context) error { return nil }},
+	})
+	assert.Equal(t, []error{nil}, revertErrs)
+	assert.Equal(t, patchResultRolledBack, results[0].result)
+	assert.Equal(t, patchResultFailed, results[1].result)
+}
+
+func TestMergeMetadata(t *testing.T) {
+	current := []infra.MetadataItem{{Key: "environment", Value: "production"}, {Key: "rack", Value: "r12"}}
+
+	merged, changes := mergeMetadata(current, map[string]string{"rack": "r14", "zone": "east", "environment": "", "missing": ""})
+	assert.Equal(t, []infra.MetadataItem{{Key: "rack", Value: "r14"}, {Key: "zone", Value: "east"}}, merged)
+	assert.Equal(t, []string{"-environment", "rack=r14", "zone=east"}, changes)
+
+	merged, changes = mergeMetadata(current, map[string]string{"rack": "r12"})
+	assert.Equal(t, current, merged)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// Executables on PATH named with this prefix become subcommands
+	pluginPrefix = CLIName + "-"
+
+	// Environment variables exported to plugins
+	pluginEndpointEnvVar    = "ORCH_CLI_API_ENDPOINT"
+	pluginProjectEnvVar     = "ORCH_CLI_PROJECT"
+	pluginAccessTokenEnvVar = "ORCH_CLI_ACCESS_TOKEN"
+	pluginExecutableEnvVar  = "ORCH_CLI_EXECUTABLE"
+
+	// Marks the subcommands running a plugin
+	pluginAnnotation = "plugin"
+)
+
+const pluginListExamples = `# List the plugins found on PATH
+orch-cli plugin list
+
+# Sample output
+NAME       PATH
+backup     /usr/local/bin/orch-cli-backup
+site-map   /home/user/bin/orch-cli-site-map
+`
+
+// orchPlugin is an executable on PATH providing the subcommand name
+type orchPlugin struct {
+	name string
+	path string
+	// Paths of the executables with the same name further down PATH, which are never run
+	shadowed []string
+}
+
+// pluginExitError carries the exit code of a plugin that failed; the plugin reported the failure itself
+type pluginExitError struct {
+	name string
+	code int
+}
+
+func (e *pluginExitError) Error() string {
+	return fmt.Sprintf("plugin %s exited with code %d", e.name, e.code)
+}
+
+// Finds the plugins in the directories of pathList; like a shell, the first executable of a name on PATH wins
+func findPlugins(pathList string) []orchPlugin {
+	index := map[string]int{}
+	var plugins []orchPlugin
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutableFile(path) {
+				continue
+			}
+			if i, found := index[name]; found {
+				plugins[i].shadowed = append(plugins[i].shadowed, path)
+				continue
+			}
+			index[name] = len(plugins)
+			plugins = append(plugins, orchPlugin{name: name, path: path})
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].name < plugins[j].name })
+	return plugins
+}
+
+// Returns the subcommand name of a plugin file name, e.g. backup for orch-cli-backup
+func pluginName(fileName string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		fileName = strings.TrimSuffix(strings.ToLower(fileName), ".exe")
+	}
+	name, ok := strings.CutPrefix(fileName, pluginPrefix)
+	if !ok || name == "" || strings.ContainsAny(name, " \t") {
+		return "", false
+	}
+	return name, true
+}
+
+func isExecutableFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(path), ".exe")
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// Finds the plugin of a subcommand name in the directories of pathList, the first executable on PATH wins
+func findPlugin(pathList string, name string) (orchPlugin, bool) {
+	if name == "" || strings.ContainsAny(name, " \t/\\") {
+		return orchPlugin{}, false
+	}
+	fileName := pluginPrefix + name
+	if runtime.GOOS == "windows" {
+		fileName += ".exe"
+	}
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		if path := filepath.Join(dir, fileName); isExecutableFile(path) {
+			return orchPlugin{name: name, path: path}, true
+		}
+	}
+	return orchPlugin{}, false
+}
+
+// Adds the plugin run by args as a subcommand. Like kubectl, PATH is only searched for a command cobra does not
+// know, so that the built-in commands neither list the directories of PATH nor can be replaced by a plugin.
+func addPluginCommand(rootCmd *cobra.Command, args []string, pathList string) {
+	if _, _, err := rootCmd.Find(args); err == nil {
+		return
+	}
+	name, ok := firstCommandArg(rootCmd, args)
+	if !ok {
+		return
+	}
+	if plugin, found := findPlugin(pathList, name); found {
+		rootCmd.AddCommand(getPluginCommand(plugin))
+	}
+}
+
+// Returns the first argument which is not a flag of the root command or the value of one
+func firstCommandArg(rootCmd *cobra.Command, args []string) (string, bool) {
+	flags := rootCmd.LocalFlags()
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return "", false
+		case !strings.HasPrefix(arg, "-"):
+			return arg, true
+		case strings.Contains(arg, "="):
+			continue
+		}
+		var flag *pflag.Flag
+		if name, long := strings.CutPrefix(arg, "--"); long {
+			flag = flags.Lookup(name)
+		} else if len(arg) == 2 {
+			flag = flags.ShorthandLookup(arg[1:])
+		}
+		// The value of a flag which needs one is the next argument
+		if flag != nil && flag.NoOptDefVal == "" {
+			i++
+		}
+	}
+	return "", false
+}
+
+func getPluginCommand(plugin orchPlugin) *cobra.Command {
+	return &cobra.Command{
+		Use:   plugin.name,
+		Short: fmt.Sprintf("Runs the %s plugin (%s)", plugin.name, plugin.path),
+		Long: "Runs the plugin with all the arguments given after its name. The plugin receives the API endpoint, the project " +
+			"and, when logged in, an access token in the " + pluginEndpointEnvVar + ", " + pluginProjectEnvVar + " and " +
+			pluginAccessTokenEnvVar + " environment variables, and the path of orch-cli in " + pluginExecutableEnvVar + ".",
+		Annotations:        map[string]string{pluginAnnotation: plugin.path},
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPlugin(cmd, plugin, args)
+		},
+	}
+}
+
+// Runs a plugin with the standard streams of the command; flag parsing is disabled so every argument is passed on,
+// --api-endpoint, --project and --noauth among them are also read to fill the environment of the plugin
+func runPlugin(cmd *cobra.Command, plugin orchPlugin, args []string) error {
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	projectName, _ := cmd.Flags().GetString(project)
+	noAuth, _ := cmd.Flags().GetBool("noauth")
+	if value, ok := pluginArgValue(args, apiEndpoint); ok {
+		endpoint = value
+	}
+	if value, ok := pluginArgValue(args, project); ok {
+		projectName = value
+	}
+	if pluginArgSet(args, "noauth") {
+		noAuth = true
+	}
+
+	env := append(os.Environ(),
+		pluginEndpointEnvVar+"="+endpoint,
+		pluginProjectEnvVar+"="+projectName,
+	)
+	if executable, err := os.Executable(); err == nil {
+		env = append(env, pluginExecutableEnvVar+"="+executable)
+	}
+	// A plugin may not need the API, it also runs when not logged in
+	if !noAuth && auth.CheckAuth(cmd, args) == nil {
+		token, err := auth.GetAccessToken(commandContext(cmd))
+		if err != nil {
+			return fmt.Errorf("failed to get access token for plugin %s: %w", plugin.name, err)
+		}
+		env = append(env, pluginAccessTokenEnvVar+"="+token)
+	}
+
+	c := exec.CommandContext(commandContext(cmd), plugin.path, args...)
+	c.Env = env
+	c.Stdin = cmd.InOrStdin()
+	c.Stdout = cmd.OutOrStdout()
+	c.Stderr = cmd.ErrOrStderr()
+	if err := c.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+			return &pluginExitError{name: plugin.name, code: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("failed to run plugin %s: %w", plugin.name, err)
+	}
+	return nil
+}
+
+// Returns the value of a long flag given as --name value or --name=value, if any
+func pluginArgValue(args []string, name string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			return value, true
+		}
+		if arg == "--"+name && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// Reports whether a long boolean flag is given as --name or --name=true
+func pluginArgSet(args []string, name string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--"+name || arg == "--"+name+"=true" {
+			return true
+		}
+	}
+	return false
+}
+
+func getPluginsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage orch-cli plugins",
+		Long: "Executables named " + pluginPrefix + "<name> found on PATH are run as the subcommand <name>, " +
+			"unless orch-cli has a built-in command of that name.",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:     "list",
+		Short:   "Lists the plugins found on PATH",
+		Example: pluginListExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runListPluginsCommand,
+	})
+	return cmd
+}
+
+func runListPluginsCommand(cmd *cobra.Command, _ []string) error {
+	plugins := findPlugins(os.Getenv("PATH"))
+	if len(plugins) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No plugins found on PATH, plugins are executables named %s<name>\n", pluginPrefix)
+		return nil
+	}
+	writer := newOutputWriter(cmd, cmd.OutOrStdout())
+	fmt.Fprintf(writer, "NAME\tPATH\n")
+	var warnings []string
+	for _, plugin := range plugins {
+		fmt.Fprintf(writer, "%s\t%s\n", plugin.name, plugin.path)
+		if builtin, _, err := cmd.Root().Find([]string{plugin.name}); err == nil && builtin != cmd.Root() && builtin.Annotations[pluginAnnotation] == "" {
+			warnings = append(warnings, fmt.Sprintf("Warning: %s is ignored, %s is a built-in command", plugin.path, plugin.name))
+		}
+		for _, path := range plugin.shadowed {
+			warnings = append(warnings, fmt.Sprintf("Warning: %s is ignored, it is shadowed by %s", path, plugin.path))
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(cmd.ErrOrStderr(), warning)
+	}
+	return nil
+}
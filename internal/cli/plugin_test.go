@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPluginScript = `#!/bin/sh
+echo "args: $*"
+echo "endpoint: $ORCH_CLI_API_ENDPOINT"
+echo "project: $ORCH_CLI_PROJECT"
+echo "token: ${ORCH_CLI_ACCESS_TOKEN:-none}"
+exit ${TEST_PLUGIN_EXIT:-0}
+`
+
+func writeTestPlugin(t *testing.T, dir, fileName string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, fileName)
+	require.NoError(t, os.WriteFile(path, []byte(testPluginScript), mode))
+	return path
+}
+
+func TestFindPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	hello := writeTestPlugin(t, first, "orch-cli-hello", 0o755)
+	shadowed := writeTestPlugin(t, second, "orch-cli-hello", 0o755)
+	writeTestPlugin(t, first, "orch-cli-notexecutable", 0o644)
+	writeTestPlugin(t, first, "other-tool", 0o755)
+	backup := writeTestPlugin(t, second, "orch-cli-backup", 0o755)
+
+	plugins := findPlugins(first + string(os.PathListSeparator) + second)
+	assert.Equal(t, []orchPlugin{
+		{name: "backup", path: backup},
+		{name: "hello", path: hello, shadowed: []string{shadowed}},
+	}, plugins)
+}
+
+func TestFindPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	writeTestPlugin(t, first, "orch-cli-backup", 0o644)
+	backup := writeTestPlugin(t, second, "orch-cli-backup", 0o755)
+	pathList := first + string(os.PathListSeparator) + second
+
+	plugin, ok := findPlugin(pathList, "backup")
+	assert.True(t, ok)
+	assert.Equal(t, orchPlugin{name: "backup", path: backup}, plugin)
+
+	for _, name := range []string{"missing", "", "../orch-cli-backup", "back up"} {
+		_, ok = findPlugin(pathList, name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestFirstCommandArg(t *testing.T) {
+	cmd := getRootCmd()
+	for args, expected := range map[string]string{
+		"hello extra":                      "hello",
+		"--project p1 hello":               "hello",
+		"--project=p1 --noauth hello":      "hello",
+		"--noauth --api-endpoint e1 hello": "hello",
+		"--project p1":                     "",
+		"-- hello":                         "",
+	} {
+		name, ok := firstCommandArg(cmd, strings.Fields(args))
+		assert.Equal(t, expected, name, args)
+		assert.Equal(t, expected != "", ok, args)
+	}
+}
+
+func TestRunPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+	dir := t.TempDir()
+	writeTestPlugin(t, dir, "orch-cli-hello", 0o755)
+	writeTestPlugin(t, dir, "orch-cli-list", 0o755)
+
+	run := func(args ...string) (string, error) {
+		cmd := getRootCmd()
+		addPluginCommand(cmd, args, dir)
+		cmd.SetArgs(args)
+		stdout := new(bytes.Buffer)
+		cmd.SetOut(stdout)
+		cmd.SetErr(stdout)
+		err := cmd.Execute()
+		return stdout.String(), err
+	}
+
+	out, err := run("hello", "--project", "some-project", "--api-endpoint=https://api.example.com/", "--noauth", "extra")
+	require.NoError(t, err)
+	assert.Equal(t, "args: --project some-project --api-endpoint=https://api.example.com/ --noauth extra\n"+
+		"endpoint: https://api.example.com/\nproject: some-project\ntoken: none\n", out)
+
+	// A built-in command is not replaced by a plugin of the same name
+	cmd := getRootCmd()
+	addPluginCommand(cmd, []string{"list", "hosts"}, dir)
+	listCmd, _, err := cmd.Find([]string{"list"})
+	require.NoError(t, err)
+	assert.Empty(t, listCmd.Annotations[pluginAnnotation])
+
+	// The root flags given before the plugin name are passed on too
+	out, err = run("--project", "some-project", "--noauth", "hello")
+	require.NoError(t, err)
+	assert.Contains(t, out, "project: some-project\n")
+
+	// Unknown commands without a plugin are still reported by cobra
+	_, err = run("missing")
+	assert.EqualError(t, err, `unknown command "missing" for "orch-cli"`)
+
+	t.Setenv("TEST_PLUGIN_EXIT", "7")
+	_, err = run("hello", "--noauth")
+	var exitErr *pluginExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 7, exitErr.code)
+
+	// The plugin reports its own failure, only its exit code is passed on
+	stderr := new(bytes.Buffer)
+	assert.Equal(t, 7, reportError(cmd, []string{"hello"}, err, stderr))
+	assert.Empty(t, stderr.String())
+}
+
+func TestPluginArgValue(t *testing.T) {
+	value, ok := pluginArgValue([]string{"sub", "--project", "p1"}, project)
+	assert.True(t, ok)
+	assert.Equal(t, "p1", value)
+
+	value, ok = pluginArgValue([]string{"--project=p2"}, project)
+	assert.True(t, ok)
+	assert.Equal(t, "p2", value)
+
+	_, ok = pluginArgValue([]string{"--", "--project", "p3"}, project)
+	assert.False(t, ok)
+
+	_, ok = pluginArgValue([]string{"--project"}, project)
+	assert.False(t, ok)
+
+	assert.True(t, pluginArgSet([]string{"sub", "--noauth"}, "noauth"))
+	assert.False(t, pluginArgSet([]string{"--", "--noauth"}, "noauth"))
+}
@@ -209,6 +209,9 @@ func printProfiles(cmd *cobra.Command, writer io.Writer, profileList *[]catapi.C
 		NameLimit: -1,
 		Data:      *profileList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -367,6 +370,10 @@ func runGetProfileCommand(cmd *cobra.Command, args []string) error {
 
 	for _, profile := range *resp.JSON200.Application.Profiles {
 		if profile.Name == profileName {
+			if selected, err := printSelectedOutput(cmd, profile); selected {
+				return err
+			}
+
 			if err := printProfiles(cmd, writer, &[]catapi.CatalogV3Profile{profile}, nil, nil, verbose); err != nil {
 				return err
 			}
@@ -486,36 +493,46 @@ func parseDeploymentRequirements(cmd *cobra.Command) (*[]catapi.CatalogV3Deploym
 
 	requirements := make([]catapi.CatalogV3DeploymentRequirement, 0, len(requirementStrs))
 	for _, reqStr := range requirementStrs {
-		parts := strings.Split(reqStr, ":")
-		if len(parts) < 2 || len(parts) > 3 {
-			return nil, fmt.Errorf("invalid deployment requirement format '%s': expected '<package-name>:<version>' or '<package-name>:<version>:<profile-name>'", reqStr)
+		req, err := parseDeploymentRequirement(reqStr)
+		if err != nil {
+			return nil, err
 		}
+		requirements = append(requirements, *req)
+	}
 
-		packageName := strings.TrimSpace(parts[0])
-		version := strings.TrimSpace(parts[1])
+	return &requirements, nil
+}
 
-		if packageName == "" || version == "" {
-			return nil, fmt.Errorf("invalid deployment requirement '%s': package name and version cannot be empty", reqStr)
-		}
+// parseDeploymentRequirement parses a single deployment requirement spec: "package:version[:profile]"
+func parseDeploymentRequirement(reqStr string) (*catapi.CatalogV3DeploymentRequirement, error) {
+	parts := strings.Split(reqStr, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid deployment requirement format '%s': expected '<package-name>:<version>' or '<package-name>:<version>:<profile-name>'", reqStr)
+	}
 
-		req := catapi.CatalogV3DeploymentRequirement{
-			Name:    packageName,
-			Version: version,
-		}
+	packageName := strings.TrimSpace(parts[0])
+	version := strings.TrimSpace(parts[1])
 
-		// Optional profile name
-		if len(parts) == 3 {
-			profileName := strings.TrimSpace(parts[2])
-			if profileName != "" {
-				req.DeploymentProfileName = &profileName
-			}
-		}
+	if packageName == "" || version == "" {
+		return nil, fmt.Errorf("invalid deployment requirement '%s': package name and version cannot be empty", reqStr)
+	}
 
-		requirements = append(requirements, req)
+	req := &catapi.CatalogV3DeploymentRequirement{
+		Name:    packageName,
+		Version: version,
 	}
 
-	return &requirements, nil
+	// Optional profile name
+	if len(parts) == 3 {
+		profileName := strings.TrimSpace(parts[2])
+		if profileName != "" {
+			req.DeploymentProfileName = &profileName
+		}
+	}
+
+	return req, nil
 }
+
 func runDeleteProfileCommand(cmd *cobra.Command, args []string) error {
 	ctx, catalogClient, projectName, err := CatalogFactory(cmd)
 	if err != nil {
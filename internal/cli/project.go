@@ -6,7 +6,9 @@ package cli
 import (
 	"fmt"
 	"io"
+	"net/http"
 
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/tenancy"
@@ -35,6 +37,9 @@ orch-cli create project myproject
 
 # Create a project with a given name and description
 orch-cli create project myproject --description "my description"
+
+# Create a project and wait until it is provisioned, so that commands using it can follow
+orch-cli create project myproject --wait --timeout 5m
 `
 
 const deleteProjectExamples = `#Delete a project using it's name
@@ -107,6 +112,9 @@ func printProjects(cmd *cobra.Command, writer io.Writer, projects *tenancy.Proje
 		NameLimit: -1,
 		Data:      items,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -173,14 +181,16 @@ func getListProjectCommand() *cobra.Command {
 
 func getCreateProjectCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "project [flags]",
+		Use:     "project <name> [flags]",
 		Short:   "Creates a project",
 		Example: createProjectExamples,
 		Args:    cobra.ExactArgs(1),
 		Aliases: projectAliases,
 		RunE:    runCreateProjectCommand,
 	}
-	cmd.PersistentFlags().StringP("description", "d", viper.GetString("description"), "Optional flag used to provide a description to a cloud init config resource")
+	cmd.PersistentFlags().StringP("description", "d", viper.GetString("description"), "Optional flag used to provide a description to the project")
+	cmd.Flags().Bool("wait", false, "Wait until the project is provisioned, bounded by --timeout")
+	cmd.Flags().Duration("interval", defaultWaitInterval, "Time between two polls of the project status with --wait")
 	return cmd
 }
 
@@ -215,6 +225,10 @@ func runGetProjectCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, resp.JSON200); selected {
+		return err
+	}
+
 	if err := printProject(cmd, writer, name, resp.JSON200); err != nil {
 		return err
 	}
@@ -284,6 +298,12 @@ func runCreateProjectCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	wait, _ := cmd.Flags().GetBool("wait")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if wait && interval <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be a positive duration, got %s", interval))
+	}
+
 	resp, err := projectClient.PUTV1ProjectsProjectProjectWithResponse(ctx, name, &tenancy.PUTV1ProjectsProjectProjectParams{},
 		tenancy.PUTV1ProjectsProjectProjectJSONRequestBody{
 			Description: &desc,
@@ -291,7 +311,38 @@ func runCreateProjectCommand(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return processError(err)
 	}
-	return checkResponse(resp.HTTPResponse, resp.Body, "error while creating project")
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while creating project"); err != nil {
+		return err
+	}
+	clearProjectNames(cmd)
+	if !wait {
+		return nil
+	}
+
+	// The project is usable once the tenancy controllers report it idle
+	return pollUntil(cmd, "project "+name, "provisioned", interval, func() (waitState, error) {
+		resp, err := projectClient.GETV1ProjectsProjectProjectWithResponse(ctx, name, auth.AddAuthHeader)
+		if err != nil {
+			return waitState{}, processError(err)
+		}
+		// The project may not be visible right after its creation
+		if resp.HTTPResponse != nil && resp.HTTPResponse.StatusCode == http.StatusNotFound {
+			return waitState{Status: "not found yet"}, nil
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting project"); err != nil {
+			return waitState{}, err
+		}
+		var indicator, message string
+		if resp.JSON200 != nil && resp.JSON200.Status != nil && resp.JSON200.Status.ProjectStatus != nil {
+			indicator = safeString(resp.JSON200.Status.ProjectStatus.StatusIndicator)
+			message = safeString(resp.JSON200.Status.ProjectStatus.Message)
+		}
+		return waitState{
+			Status: waitStatusSummary("status", indicator, "message", message),
+			Met:    indicator == "STATUS_INDICATION_IDLE",
+			Final:  indicator == "STATUS_INDICATION_ERROR",
+		}, nil
+	})
 }
 
 // Deletes Project - checks if a project already exists and then deletes it if it does
@@ -308,5 +359,9 @@ func runDeleteProjectCommand(cmd *cobra.Command, args []string) error {
 		return processError(err)
 	}
 
-	return checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error deleting project %s", name))
+	if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error deleting project %s", name)); err != nil {
+		return err
+	}
+	clearProjectNames(cmd)
+	return nil
 }
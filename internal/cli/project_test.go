@@ -49,6 +49,17 @@ func (s *CLITestSuite) TestProject() {
 	_, err = s.createProject(project, name, CArgs)
 	s.NoError(err)
 
+	//create project and wait until it is provisioned
+	out, err := s.createProject(project, name, commandArgs{"wait": "true"})
+	s.NoError(err)
+	s.Equal("project itep condition provisioned met: status STATUS_INDICATION_IDLE, message Project itep CREATE is complete\n", out)
+
+	_, err = s.createProject(project, "failed-project", commandArgs{"wait": "true"})
+	s.EqualError(err, "project failed-project ended with status STATUS_INDICATION_ERROR, message Project failed-project CREATE failed, condition provisioned can no longer be met")
+
+	_, err = s.createProject(project, name, commandArgs{"wait": "true", "interval": "0s"})
+	s.EqualError(err, "--interval must be a positive duration, got 0s")
+
 	/////////////////////////////
 	// Test Project Listing
 	/////////////////////////////
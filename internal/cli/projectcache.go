@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// How long the listed project names are reused to complete and check --project before being listed again
+const projectCacheTTL = 5 * time.Minute
+
+// projectNames is the list of the projects of the orchestrator, stored in the CLI config directory
+type projectNames struct {
+	Taken time.Time `json:"taken"`
+	Names []string  `json:"names"`
+}
+
+// The project names are kept per orchestrator and user, as users see different projects
+func projectCachePath(cmd *cobra.Command) (string, error) {
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return "", errors.New("no config directory")
+	}
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	sum := sha256.Sum256([]byte(endpoint + "\n" + viper.GetString(auth.UserName)))
+	return filepath.Join(filepath.Dir(configFile), hostCacheDirName, "projects-"+hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// Returns the project names listed less than projectCacheTTL ago, or nil
+func loadProjectNames(cmd *cobra.Command) *projectNames {
+	path, err := projectCachePath(cmd)
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	cached := &projectNames{}
+	if err := json.Unmarshal(data, cached); err != nil {
+		log.Debugf("Unable to read cached projects: %v", err)
+		return nil
+	}
+	if time.Since(cached.Taken) > projectCacheTTL {
+		return nil
+	}
+	return cached
+}
+
+// Stores the project names; failing to do so only costs another listing
+func saveProjectNames(cmd *cobra.Command, names []string) {
+	path, err := projectCachePath(cmd)
+	if err == nil {
+		var data []byte
+		if data, err = json.Marshal(&projectNames{Taken: time.Now(), Names: names}); err == nil {
+			if err = os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+				err = os.WriteFile(path, data, 0600)
+			}
+		}
+	}
+	if err != nil {
+		log.Debugf("Unable to cache projects: %v", err)
+	}
+}
+
+// Forgets the project names once a project is created or deleted
+func clearProjectNames(cmd *cobra.Command) {
+	if path, err := projectCachePath(cmd); err == nil {
+		_ = os.Remove(path)
+	}
+}
+
+// Returns the names of the projects, from the cache if they were listed recently
+func listProjectNames(cmd *cobra.Command) ([]string, error) {
+	if cached := loadProjectNames(cmd); cached != nil {
+		return cached.Names, nil
+	}
+	ctx, projectClient, err := TenancyFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := projectClient.LISTV1ProjectsWithResponse(ctx, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while listing projects"); err != nil {
+		return nil, err
+	}
+	var names []string
+	if resp.JSON200 != nil {
+		for _, p := range *resp.JSON200 {
+			if name := derefString(p.Name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	saveProjectNames(cmd, names)
+	return names, nil
+}
+
+// Completes --project with the names of the projects
+func completeProjectNames(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, err := listProjectNames(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var matches []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			matches = append(matches, name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Reports whether the recently listed projects include the project, sparing the call checking it exists
+func isCachedProject(cmd *cobra.Command, projectName string) bool {
+	cached := loadProjectNames(cmd)
+	return cached != nil && slices.Contains(cached.Names, projectName)
+}
+
+// Returns the project a mistyped project name was likely meant to be, e.g. edge-prod for edge-prd
+func suggestProjectName(projectName string, names []string) string {
+	maxDistance := 2
+	if len(projectName) <= 4 {
+		maxDistance = 1
+	}
+	best, bestDistance := "", maxDistance+1
+	for _, name := range names {
+		if distance := editDistance(strings.ToLower(projectName), strings.ToLower(name)); distance < bestDistance {
+			best, bestDistance = name, distance
+		}
+	}
+	return best
+}
+
+// Error of a project that does not exist, suggesting the project it was likely meant to be
+func projectNotFoundError(cmd *cobra.Command, projectName string) error {
+	message := fmt.Sprintf("project %s does not exist or you do not have access to it", projectName)
+	names, err := listProjectNames(cmd)
+	if err != nil {
+		log.Debugf("Unable to list projects: %v", err)
+	}
+	if suggestion := suggestProjectName(projectName, names); suggestion != "" {
+		message += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return errors.New(message)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestProjectNames() {
+	configFile := viper.ConfigFileUsed()
+	defer viper.SetConfigFile(configFile)
+	viper.SetConfigFile(filepath.Join(s.T().TempDir(), "orch-cli.yaml"))
+	cmd := getRootCmd()
+	s.NoError(cmd.ParseFlags([]string{"--api-endpoint", apiTest}))
+
+	names, directive := completeProjectNames(cmd, nil, "it")
+	s.Equal([]string{"itep"}, names)
+	s.Equal(cobra.ShellCompDirectiveNoFileComp, directive)
+	names, _ = completeProjectNames(cmd, nil, "edge")
+	s.Empty(names)
+	s.NotNil(loadProjectNames(cmd))
+
+	// Recently listed projects are not checked again, and suggest the project a mistyped one was meant to be
+	saveProjectNames(cmd, []string{"edge-prod", "nonexistent-project"})
+	s.NoError(checkProjectExists(cmd, "nonexistent-project"))
+	s.EqualError(checkProjectExists(cmd, "nonexistent-init"), "project nonexistent-init does not exist or you do not have access to it")
+	s.EqualError(projectNotFoundError(cmd, "edge-prd"), `project edge-prd does not exist or you do not have access to it, did you mean "edge-prod"?`)
+
+	// Creating a project lists the projects again
+	_, err := s.runCommand("create project new-project")
+	s.NoError(err)
+	s.Nil(loadProjectNames(cmd))
+}
+
+func TestSuggestProjectName(t *testing.T) {
+	names := []string{"edge-dev", "edge-prod", "itep"}
+	assert.Equal(t, "edge-prod", suggestProjectName("edge-prd", names))
+	assert.Equal(t, "edge-prod", suggestProjectName("Edge-Prod", names))
+	assert.Equal(t, "itep", suggestProjectName("iteo", names))
+	assert.Empty(t, suggestProjectName("ite-p1", names[:2]))
+	assert.Empty(t, suggestProjectName("edge-prod", nil))
+}
@@ -5,14 +5,17 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
@@ -42,6 +45,21 @@ const createProviderExamples = `# Create specific provider
 # Create a provider by providing name, kind, and empty API endpoint
 orch-cli create provider myprovider "PROVIDER_KIND_BAREMETAL" "" --vendor "PROVIDER_VENDOR_UNSPECIFIED" --config ""defaultOs":"","autoProvision":false,"defaultLocalAccount":"","osSecurityFeatureEnable":false" --project some-project`
 
+const setProviderExamples = `# Merge a JSON patch into the config of a provider, e.g. {"autoProvision": true, "defaultOs": "os-1234abcd"}
+orch-cli set provider provider-aaaa1111 --config-file cfg.json --merge --project some-project
+
+# Replace the config of a provider with the content of a file
+orch-cli set provider myprovider --config-file cfg.json --project some-project
+
+# Print the resulting config without changing the provider
+orch-cli set provider myprovider --config-file cfg.json --merge --dry-run --project some-project
+
+# Set the default OS profile, given by name or resource ID, and turn on the automatic provisioning
+orch-cli set provider myprovider --default-os os-1234abcd --auto-provision=true --project some-project
+
+# Set the default local account by username and turn off the OS security feature
+orch-cli set provider myprovider --default-local-account admin --security-feature=false --project some-project`
+
 const deleteProviderExamples = `# Delete a provider by resource ID
 orch-cli delete provider provider-aaaa1111 --project some-project
 # Delete a provider by name
@@ -73,6 +91,9 @@ func printProviders(cmd *cobra.Command, writer io.Writer, providers *[]infra.Pro
 		NameLimit: -1,
 		Data:      *providers,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -131,6 +152,29 @@ func getCreateProviderCommand() *cobra.Command {
 	return cmd
 }
 
+func getSetProviderCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider <name|resourceID> [flags]",
+		Short: "Update the config of a provider",
+		Long: "Updates the config of a provider from a JSON file, either replacing it or merging the file into it as a JSON merge patch " +
+			"(RFC 7386: objects are merged recursively and null removes a key), or sets its fields given by flags, which take precedence " +
+			"over the file. The resulting config is validated before it is applied. " +
+			"As providers cannot be modified in place, the provider is deleted and created again with the new config, which gives it a new resource ID.",
+		Example: setProviderExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: providerAliases,
+		RunE:    runSetProviderCommand,
+	}
+	cmd.PersistentFlags().String("config-file", "", "JSON file holding the config, or the patch of the config with --merge")
+	cmd.PersistentFlags().Bool("merge", false, "Merge the config file into the current config instead of replacing it")
+	cmd.PersistentFlags().BoolP("dry-run", "d", false, "Print the resulting config without changing the provider")
+	cmd.PersistentFlags().String(defaultOSFlag, "", "OS profile, by name or resource ID, installed on the hosts onboarded with the provider, empty to unset it")
+	cmd.PersistentFlags().Bool(autoProvisionFlag, false, "Provision the hosts automatically once onboarded: --auto-provision=true|false")
+	cmd.PersistentFlags().String(defaultLocalAccountFlag, "", "Local account, by username or resource ID, of the provisioned hosts, empty to unset it")
+	cmd.PersistentFlags().Bool(securityFeatureFlag, false, "Enable the OS security feature on the provisioned hosts: --security-feature=true|false")
+	return cmd
+}
+
 func getDeleteProviderCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "provider <name|resourceID> [flags]",
@@ -461,6 +505,10 @@ func runGetProviderCommand(cmd *cobra.Command, args []string) error {
 			"", "error getting provider"); !proceed {
 			return err
 		}
+		if selected, err := printSelectedOutput(cmd, *resp.JSON200); selected {
+			return err
+		}
+
 		providers := []infra.ProviderResource{*resp.JSON200}
 		var emptyFilter string
 		if err := printProviders(cmd, writer, &providers, nil, &emptyFilter, false, false); err != nil {
@@ -500,6 +548,10 @@ func runGetProviderCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, provider); selected {
+		return err
+	}
+
 	providers := []infra.ProviderResource{provider}
 	var emptyFilter string
 	// Get command always shows full details (forList=false)
@@ -570,6 +622,146 @@ func runDeleteProviderCommand(cmd *cobra.Command, args []string) error {
 	return err
 }
 
+// Updates the config of a provider; the API has no update operation for providers, so the provider is replaced
+func runSetProviderCommand(cmd *cobra.Command, args []string) error {
+	configFile, _ := cmd.Flags().GetString("config-file")
+	merge, _ := cmd.Flags().GetBool("merge")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if configFile == "" && !providerConfigFlagsChanged(cmd) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--config-file or one of --%s must be given", strings.Join(providerConfigFlags, ", --")))
+	}
+	// Without a file the fields given by flags are merged into the current config
+	patch := map[string]interface{}{}
+	if configFile != "" {
+		var err error
+		if patch, err = readProviderConfigFile(configFile); err != nil {
+			return err
+		}
+	} else {
+		merge = true
+	}
+
+	ctx, providerClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	provider, err := resolveProvider(ctx, providerClient, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	fields, err := providerConfigFlagFields(ctx, cmd, providerClient, projectName)
+	if err != nil {
+		return err
+	}
+	for key, value := range fields {
+		patch[key] = value
+	}
+
+	var config interface{} = patch
+	current, currentErr := decodeProviderConfig(derefString(provider.Config))
+	if merge {
+		if currentErr != nil {
+			return fmt.Errorf("current config of provider %s cannot be merged, use --config-file without --merge to replace it: %w", args[0], currentErr)
+		}
+		config = mergeJSONPatch(current, patch)
+	}
+	if err := validateProviderConfig(config); err != nil {
+		return err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\n", configJSON)
+		return nil
+	}
+	if currentErr == nil && reflect.DeepEqual(current, config) {
+		fmt.Fprintf(cmd.OutOrStdout(), "Config of provider %s is unchanged\n", provider.Name)
+		return nil
+	}
+
+	fmt.Println("Warning: Providers cannot be modified in place, the provider will be deleted and created again with the new config and get a new resource ID. Resources referring to its current resource ID must be updated.")
+	fmt.Println("Are you sure you want to proceed? (y/n)")
+	var response string
+	if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+		return errors.New("operation cancelled by user")
+	}
+
+	deleteResp, err := providerClient.ProviderServiceDeleteProviderWithResponse(ctx, projectName,
+		derefString(provider.ResourceId), auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	if err := checkResponse(deleteResp.HTTPResponse, deleteResp.Body, "error while deleting provider"); err != nil {
+		return err
+	}
+
+	configString := string(configJSON)
+	createResp, err := providerClient.ProviderServiceCreateProviderWithResponse(ctx, projectName, infra.ProviderServiceCreateProviderJSONRequestBody{
+		Name:           provider.Name,
+		ProviderKind:   provider.ProviderKind,
+		ApiEndpoint:    provider.ApiEndpoint,
+		Config:         &configString,
+		ProviderVendor: provider.ProviderVendor,
+		ApiCredentials: provider.ApiCredentials,
+	}, auth.AddAuthHeader)
+	if err != nil {
+		return fmt.Errorf("provider %s was deleted but could not be created again, create it with --config %q: %w", provider.Name, configString, processError(err))
+	}
+	if err := checkResponse(createResp.HTTPResponse, createResp.Body, "error while creating provider"); err != nil {
+		return fmt.Errorf("provider %s was deleted but could not be created again, create it with --config %q: %w", provider.Name, configString, err)
+	}
+	newID := ""
+	if createResp.JSON200 != nil {
+		newID = derefString(createResp.JSON200.ResourceId)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Config of provider %s updated, new resource ID: %s\n", provider.Name, newID)
+	return nil
+}
+
+// Finds a provider by resource ID or by name
+func resolveProvider(ctx context.Context, providerClient infra.ClientWithResponsesInterface, projectName string, query string) (infra.ProviderResource, error) {
+	if isProviderResourceID(query) {
+		resp, err := providerClient.ProviderServiceGetProviderWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return infra.ProviderResource{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving provider"); err != nil {
+			return infra.ProviderResource{}, err
+		}
+		return *resp.JSON200, nil
+	}
+
+	pageSize := 100
+	offset := 0
+	var allProviders []infra.ProviderResource
+	for {
+		resp, err := providerClient.ProviderServiceListProvidersWithResponse(ctx, projectName,
+			&infra.ProviderServiceListProvidersParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return infra.ProviderResource{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving providers"); err != nil {
+			return infra.ProviderResource{}, err
+		}
+		if resp.JSON200 == nil {
+			break
+		}
+		allProviders = append(allProviders, resp.JSON200.Providers...)
+		if len(allProviders) >= int(resp.JSON200.TotalElements) || len(resp.JSON200.Providers) == 0 {
+			break
+		}
+		offset += pageSize
+	}
+	return findProviderByName(allProviders, query)
+}
+
 func printWarning() error {
 	fmt.Println("Warning: Usage of the default provider is recommended. Deleting a provider in use by other resources may lead to resource misconfiguration. This action may have unintended consequences.")
 	fmt.Println("Are you sure you want to proceed? (y/n)")
@@ -6,6 +6,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -284,3 +285,74 @@ func FuzzProvider(f *testing.F) {
 		}
 	})
 }
+
+func (s *CLITestSuite) setProvider(project string, name string, args commandArgs) (string, error) {
+	commandString := addCommandArgs(args, fmt.Sprintf(`set provider "%s" --project %s`, name, project))
+	return s.runCommand(commandString)
+}
+
+func (s *CLITestSuite) TestSetProvider() {
+	resourceID := "provider-7ceae560"
+	writeConfig := func(config string) string {
+		path := filepath.Join(s.T().TempDir(), "cfg.json")
+		s.NoError(os.WriteFile(path, []byte(config), 0600))
+		return path
+	}
+
+	// merge a patch into the current config
+	patch := writeConfig(`{"autoProvision": true, "defaultOs": "os-1234abcd"}`)
+	out, err := s.setProvider(project, resourceID, commandArgs{"config-file": patch, "merge": "", "dry-run": ""})
+	s.NoError(err)
+	s.Equal(`{"autoProvision":true,"defaultLocalAccount":"","defaultOs":"os-1234abcd","osSecurityFeatureEnable":false}`+"\n", out)
+
+	// without --merge the file replaces the config
+	out, err = s.setProvider(project, resourceID, commandArgs{"config-file": patch, "dry-run": ""})
+	s.NoError(err)
+	s.Equal(`{"autoProvision":true,"defaultOs":"os-1234abcd"}`+"\n", out)
+
+	// the provider is looked up by name too
+	out, err = s.setProvider(project, "provider", commandArgs{"config-file": patch, "merge": "", "dry-run": ""})
+	s.NoError(err)
+	s.Contains(out, `"defaultOs":"os-1234abcd"`)
+
+	// the resulting config is validated
+	_, err = s.setProvider(project, resourceID, commandArgs{"config-file": writeConfig(`{"autoProvision": "yes"}`), "merge": ""})
+	s.ErrorContains(err, "config does not conform to schema:\n- autoProvision: Invalid type. Expected: boolean, given: string")
+
+	_, err = s.setProvider(project, resourceID, commandArgs{"config-file": writeConfig(`"autoProvision": true`), "merge": ""})
+	s.ErrorContains(err, "config is not a JSON object")
+
+	// nothing is replaced when the config does not change
+	out, err = s.setProvider(project, resourceID, commandArgs{"config-file": writeConfig(`{"autoProvision": false}`), "merge": ""})
+	s.NoError(err)
+	s.Equal("Config of provider provider is unchanged\n", out)
+
+	// the provider is replaced once confirmed
+	origStdin := os.Stdin
+	defer func() { os.Stdin = origStdin }()
+	r, w, errPipe := os.Pipe()
+	s.NoError(errPipe)
+	_, _ = w.Write([]byte("y"))
+	w.Close()
+	os.Stdin = r
+	out, err = s.setProvider(project, resourceID, commandArgs{"config-file": patch, "merge": ""})
+	s.NoError(err)
+	s.Equal("Config of provider provider updated, new resource ID: provider-abc12345\n", out)
+
+	_, err = s.setProvider(project, resourceID, commandArgs{"config-file": patch, "merge": ""})
+	s.EqualError(err, "operation cancelled by user")
+
+	_, err = s.setProvider(project, resourceID, commandArgs{})
+	s.EqualError(err, "--config-file or one of --default-os, --auto-provision, --default-local-account, --security-feature must be given")
+
+	// fields given by flags are merged into the current config
+	out, err = s.setProvider(project, resourceID, commandArgs{"default-os": "os-1234abcd", "auto-provision": "true",
+		"default-local-account": "localaccount-1234abcd", "security-feature": "true", "dry-run": ""})
+	s.NoError(err)
+	s.Equal(`{"autoProvision":true,"defaultLocalAccount":"localaccount-1234abcd","defaultOs":"os-1234abcd","osSecurityFeatureEnable":true}`+"\n", out)
+
+	// and take precedence over the config file
+	out, err = s.setProvider(project, resourceID, commandArgs{"config-file": patch, "auto-provision": "false", "default-os": `""`, "dry-run": ""})
+	s.NoError(err)
+	s.Equal(`{"autoProvision":false,"defaultOs":""}`+"\n", out)
+}
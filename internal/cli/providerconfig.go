@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Flags of set provider setting a single field of the config
+const (
+	defaultOSFlag           = "default-os"
+	autoProvisionFlag       = "auto-provision"
+	defaultLocalAccountFlag = "default-local-account"
+	securityFeatureFlag     = "security-feature"
+)
+
+var providerConfigFlags = []string{defaultOSFlag, autoProvisionFlag, defaultLocalAccountFlag, securityFeatureFlag}
+
+// Largest provider config file that will be read
+const maxProviderConfigSize = 1 << 20
+
+// Config of the providers, as read by the onboarding and provisioning services; other keys are kept as they are
+var providerConfigSchema = `
+{
+  "type": "object",
+  "properties": {
+    "defaultOs": { "type": "string" },
+    "autoProvision": { "type": "boolean" },
+    "defaultLocalAccount": { "type": "string" },
+    "osSecurityFeatureEnable": { "type": "boolean" }
+  },
+  "additionalProperties": true
+}
+`
+
+// Reads a JSON object from a config file
+func readProviderConfigFile(path string) (map[string]interface{}, error) {
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxProviderConfigSize {
+		return nil, errors.New("config file too large")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := decodeProviderConfig(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func providerConfigFlagsChanged(cmd *cobra.Command) bool {
+	for _, flag := range providerConfigFlags {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the config fields set by flags; the OS profile and the local account are resolved to their resource IDs
+func providerConfigFlagFields(ctx context.Context, cmd *cobra.Command, client infra.ClientWithResponsesInterface, projectName string) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if cmd.Flags().Changed(defaultOSFlag) {
+		osProfile, _ := cmd.Flags().GetString(defaultOSFlag)
+		if osProfile != "" {
+			profile, err := getOSProfileByNameOrID(ctx, client, projectName, osProfile, "error getting OS Profile")
+			if err != nil {
+				return nil, fmt.Errorf("invalid --%s %s: %w", defaultOSFlag, osProfile, err)
+			}
+			osProfile = derefString(profile.ResourceId)
+		}
+		fields["defaultOs"] = osProfile
+	}
+	if cmd.Flags().Changed(autoProvisionFlag) {
+		autoProvision, _ := cmd.Flags().GetBool(autoProvisionFlag)
+		fields["autoProvision"] = autoProvision
+	}
+	if cmd.Flags().Changed(defaultLocalAccountFlag) {
+		account, _ := cmd.Flags().GetString(defaultLocalAccountFlag)
+		if account != "" {
+			localAccount, err := getSSHKeyByNameOrID(ctx, client, projectName, account)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --%s %s: %w", defaultLocalAccountFlag, account, err)
+			}
+			account = derefString(localAccount.ResourceId)
+		}
+		fields["defaultLocalAccount"] = account
+	}
+	if cmd.Flags().Changed(securityFeatureFlag) {
+		securityFeature, _ := cmd.Flags().GetBool(securityFeatureFlag)
+		fields["osSecurityFeatureEnable"] = securityFeature
+	}
+	return fields, nil
+}
+
+// Decodes a provider config, an empty config being an empty object
+func decodeProviderConfig(config string) (map[string]interface{}, error) {
+	decoded := map[string]interface{}{}
+	if strings.TrimSpace(config) == "" {
+		return decoded, nil
+	}
+	if err := json.Unmarshal([]byte(config), &decoded); err != nil {
+		return nil, fmt.Errorf("config is not a JSON object: %v", err)
+	}
+	return decoded, nil
+}
+
+// Applies a JSON merge patch (RFC 7386) to a document: objects are merged recursively,
+// null values remove keys and any other value replaces the one of the document
+func mergeJSONPatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	merged := make(map[string]interface{}, len(targetObject))
+	for key, value := range targetObject {
+		merged[key] = value
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = mergeJSONPatch(merged[key], value)
+	}
+	return merged
+}
+
+func validateProviderConfig(config interface{}) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(providerConfigSchema), gojsonschema.NewGoLoader(config))
+	if err != nil {
+		return fmt.Errorf("schema validation error: %v", err)
+	}
+	if !result.Valid() {
+		var sb strings.Builder
+		for _, desc := range result.Errors() {
+			fmt.Fprintf(&sb, "- %s\n", desc)
+		}
+		return fmt.Errorf("config does not conform to schema:\n%s", sb.String())
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeJSONPatch(t *testing.T) {
+	for _, tc := range []struct {
+		name, target, patch, expected string
+	}{
+		{"replace value", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add value", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"remove value", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"merge nested objects", `{"a":{"b":"c","d":"e"}}`, `{"a":{"d":null,"f":"g"}}`, `{"a":{"b":"c","f":"g"}}`},
+		{"replace arrays", `{"a":["b"]}`, `{"a":["c","d"]}`, `{"a":["c","d"]}`},
+		{"object replaces scalar", `{"a":"b"}`, `{"a":{"c":null,"d":1}}`, `{"a":{"d":1}}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var target, patch interface{}
+			require.NoError(t, json.Unmarshal([]byte(tc.target), &target))
+			require.NoError(t, json.Unmarshal([]byte(tc.patch), &patch))
+			merged, err := json.Marshal(mergeJSONPatch(target, patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(merged))
+		})
+	}
+}
+
+func TestDecodeProviderConfig(t *testing.T) {
+	config, err := decodeProviderConfig("  ")
+	require.NoError(t, err)
+	assert.Empty(t, config)
+
+	_, err = decodeProviderConfig(`"defaultOs":"os-0921fdc0"`)
+	assert.ErrorContains(t, err, "config is not a JSON object")
+}
@@ -105,6 +105,7 @@ func getListRegionCommand() *cobra.Command {
 	}
 	cmd.PersistentFlags().StringP("region", "r", viper.GetString("region"), "Optional filter provided as part of region list to filter region by parent region")
 	addListOrderingFilteringPaginationFlags(cmd, "region")
+	addListLimitFlag(cmd, "region")
 	addStandardListOutputFlags(cmd)
 	// Override default output-type to "tree" for region list; table/json/yaml are also supported
 	if f := cmd.Flags().Lookup("output-type"); f != nil {
@@ -207,6 +208,10 @@ func runGetRegionCommand(cmd *cobra.Command, args []string) error {
 		region.TotalSites = lresp.JSON200.Regions[0].TotalSites
 	}
 
+	if selected, err := printSelectedOutput(cmd, region); selected {
+		return err
+	}
+
 	printRegion(writer, region)
 	return writer.Flush()
 }
@@ -353,6 +358,10 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 	//Get all regions
 	// For table output we will sort client-side; for JSON/YAML allow API ordering
 	outputType, _ := cmd.Flags().GetString("output-type")
+	// The IDs of --quiet are printed by the flat table, not by the tree
+	if quiet, _ := cmd.Flags().GetBool(quietFlag); quiet {
+		outputType = "table"
+	}
 	apiOrderBy := validatedOrderBy
 	if outputType == "table" {
 		apiOrderBy = nil
@@ -387,20 +396,34 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	resp, err := regionClient.RegionServiceListRegionsWithResponse(ctx, projectName,
-		&infra.RegionServiceListRegionsParams{
-			ShowTotalSites: &enableTotalSite,
-			Filter:         validatedFilter,
-			OrderBy:        apiOrderBy,
-		}, auth.AddAuthHeader)
+	pagination, err := getListPagination(cmd)
 	if err != nil {
-		return processError(err)
+		return err
 	}
-
-	if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, verbose,
-		"", "error getting regions"); !proceed {
+	regions := make([]infra.RegionResource, 0)
+	err = pagination.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := regionClient.RegionServiceListRegionsWithResponse(ctx, projectName,
+			&infra.RegionServiceListRegionsParams{
+				ShowTotalSites: &enableTotalSite,
+				Filter:         validatedFilter,
+				OrderBy:        apiOrderBy,
+				PageSize:       &pageSize,
+				Offset:         &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, verbose,
+			"", "error getting regions"); !proceed {
+			return 0, false, err
+		}
+		regions = append(regions, resp.JSON200.Regions...)
+		return len(resp.JSON200.Regions), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
 		return err
 	}
+	regions = limitListItems(regions, pagination)
 
 	regionMap := region2Site{
 		Sites:  make(map[string][]infra.SiteResource),
@@ -408,7 +431,7 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	//Map sites to region
-	for _, region := range resp.JSON200.Regions {
+	for _, region := range regions {
 		regionMap.Region[*region.ResourceId] = region
 		//Get all sites per region
 		regFilter := fmt.Sprintf("region.resource_id='%s'", *region.ResourceId)
@@ -432,7 +455,7 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 			OrderBy:   "",
 			OutputAs:  toOutputType(outputType),
 			NameLimit: -1,
-			Data:      resp.JSON200.Regions,
+			Data:      regions,
 		}
 		GenerateOutput(writer, &result)
 		return writer.Flush()
@@ -445,10 +468,6 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 			return err
 		}
 
-		// Build flat list of regions from response
-		regions := make([]infra.RegionResource, 0, len(resp.JSON200.Regions))
-		regions = append(regions, resp.JSON200.Regions...)
-
 		orderBy := ""
 		if validatedOrderBy != nil {
 			orderBy = *validatedOrderBy
@@ -463,6 +482,9 @@ func runListRegionCommand(cmd *cobra.Command, _ []string) error {
 			NameLimit: -1,
 			Data:      regions,
 		}
+		if err := withListColumns(cmd, &result); err != nil {
+			return err
+		}
 		GenerateOutput(writer, &result)
 		return writer.Flush()
 	}
@@ -192,6 +192,9 @@ func printRegistries(cmd *cobra.Command, writer io.Writer, registryList *[]catap
 		NameLimit: -1,
 		Data:      *registryList,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -448,6 +451,10 @@ func runGetRegistryCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if selected, err := printSelectedOutput(cmd, resp.JSON200.Registry); selected {
+		return err
+	}
+
 	var emptyFilter string
 	if err := printRegistries(cmd, writer, &[]catapi.CatalogV3Registry{resp.JSON200.Registry}, nil, &emptyFilter, verbose, showSensitive); err != nil {
 		return err
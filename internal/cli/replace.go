@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/internal/validator"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const replaceHostExamples = `# Replace a failed host by a new one with another serial number and UUID
+orch-cli replace host host-1234abcd --serial 2500JF7 --uuid 4c4c4544-2046-5310-8052-cac04f515237 --project some-project
+
+# Show the configuration copied to the new host without changing anything
+orch-cli replace host edge-host-001 --serial 2500JF7 --dry-run --project some-project
+
+# Replace a host without confirmation and record why the old host was deauthorized
+orch-cli replace host host-1234abcd --serial 2500JF7 --note "RMA 4711" --yes --project some-project
+`
+
+func getReplaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "replace",
+		Short:             "Replace Edge Orchestrator resources by new ones with the same configuration",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getReplaceHostCommand(),
+	)
+	return cmd
+}
+
+func getReplaceHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> --serial <serial> --uuid <uuid> [flags]",
+		Short: "Replaces a host by a new host, e.g. returned for repair",
+		Long: "Registers a new host with the given serial number and UUID and the name, site, OS profile, metadata, " +
+			"Cloud Init custom configs, local account, LVM size and cluster template of the host it replaces, then " +
+			"deauthorizes the replaced host. The configuration is resolved before anything is changed and the replaced " +
+			"host is only deauthorized once the new host is registered. The replaced host is not deleted and its cluster " +
+			"is kept, the new host gets a cluster of its own.",
+		Example: replaceHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runReplaceHostCommand,
+	}
+	cmd.Flags().String("serial", "", "Serial number of the new host")
+	cmd.Flags().String("uuid", "", "UUID of the new host")
+	cmd.Flags().String("note", "", "Reason of the deauthorization of the replaced host (defaults to the resource ID of the new host)")
+	cmd.Flags().BoolP("dry-run", "d", false, "Resolve and print the configuration of the new host without changing anything")
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	cmd.MarkFlagsOneRequired("serial", "uuid")
+	return cmd
+}
+
+// Registers a new host with the configuration of an existing host and deauthorizes the existing host
+func runReplaceHostCommand(cmd *cobra.Command, args []string) error {
+	serial, _ := cmd.Flags().GetString("serial")
+	uuid, _ := cmd.Flags().GetString("uuid")
+	note, _ := cmd.Flags().GetString("note")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	ctx2, clusterClient, _, err := ClusterFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	host, err := getHostByNameOrID(ctx, hostClient, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	hostID := derefString(host.ResourceId)
+	if strings.EqualFold(serial, derefString(host.SerialNumber)) && strings.EqualFold(uuid, derefString(host.Uuid)) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("the serial number and UUID of the new host are the ones of host %s", hostID))
+	}
+
+	var clusterDetail *cluster.ClusterDetailInfo
+	if clusterName := hostClusterName(host); clusterName != "" && isFeatureEnabled(ClusterOrchFeature) {
+		resp, err := clusterClient.GetV2ProjectsProjectNameClustersNameWithResponse(ctx2, projectName, clusterName, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error getting cluster %s of host", clusterName)); err != nil {
+			return err
+		}
+		clusterDetail = resp.JSON200
+	}
+
+	record := newReplacementRecord(host, serial, uuid, clusterDetail)
+	validated, err := validator.SanitizeEntries([]types.HostRecord{record}, isFeatureEnabled(ProvisioningFeature))
+	if err != nil {
+		if len(validated) == 1 && validated[0].Error != "" {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid new host: %s", strings.Trim(validated[0].Error, "; ")))
+		}
+		return err
+	}
+	record = validated[0]
+
+	respCache := newResponseCache()
+	erringRecords := []types.HostRecord{}
+	// Resolving the configuration fails before anything is changed
+	resolved, err := sanitizeProvisioningFields(ctx, ctx2, hostClient, projectName, record, respCache,
+		&types.HostRecord{}, &erringRecords, clusterClient)
+	if err != nil {
+		return fmt.Errorf("the configuration of host %s cannot be copied: %w", hostID, err)
+	}
+
+	printHostReplacement(cmd.OutOrStdout(), host, record, resolved)
+	if dryRun {
+		return nil
+	}
+	if !yes {
+		fmt.Fprintf(cmd.OutOrStdout(), "Warning: host %s will be deauthorized once the new host is registered.\n", hostID)
+		fmt.Fprintln(cmd.OutOrStdout(), "Are you sure you want to proceed? (y/n)")
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+			return errors.New("operation cancelled by user")
+		}
+	}
+
+	// doRegister names the host after the create host argument
+	hostname = host.Name
+	defer func() { hostname = "" }()
+	newHostID, err := doRegister(ctx, ctx2, hostClient, projectName, record, respCache, &types.HostRecord{}, &erringRecords, clusterClient, nil)
+	if err != nil {
+		return fmt.Errorf("host replacing host %s could not be registered, host %s is unchanged: %w", hostID, hostID, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Host %s registered\n", newHostID)
+
+	if host.CurrentState != nil && *host.CurrentState == infra.HOSTSTATEONBOARDED {
+		if note == "" {
+			note = fmt.Sprintf("replaced by %s", newHostID)
+		}
+		resp, err := hostClient.HostServiceInvalidateHostWithResponse(ctx, projectName,
+			hostID, &infra.HostServiceInvalidateHostParams{Note: &note}, auth.AddAuthHeader)
+		if err == nil {
+			err = checkResponse(resp.HTTPResponse, resp.Body, "error while invalidating host")
+		} else {
+			err = processError(err)
+		}
+		if err != nil {
+			return fmt.Errorf("host %s replacing host %s was registered but host %s could not be deauthorized, "+
+				"deauthorize it with: orch-cli deauthorize host %s --project %s: %w", newHostID, hostID, hostID, hostID, projectName, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Host %s deauthorized\n", hostID)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Host %s (%s) replaced by host %s\n", hostID, host.Name, newHostID)
+	return nil
+}
+
+// Returns the name of the cluster the host is a node of, if any
+func hostClusterName(host infra.HostResource) string {
+	if host.Instance == nil || host.Instance.WorkloadMembers == nil {
+		return ""
+	}
+	for _, member := range *host.Instance.WorkloadMembers {
+		if member.Kind == infra.WORKLOADMEMBERKINDCLUSTERNODE && member.Workload != nil {
+			return derefString(member.Workload.Name)
+		}
+	}
+	return ""
+}
+
+// Derives the record registering the new host from the host it replaces; resources are given by resource ID
+// as the new host is registered in the same project
+func newReplacementRecord(host infra.HostResource, serial, uuid string, clusterDetail *cluster.ClusterDetailInfo) types.HostRecord {
+	record := types.HostRecord{
+		Serial:   serial,
+		UUID:     uuid,
+		Metadata: formatTransferMetadata(host.Metadata),
+	}
+	if host.UserLvmSize != nil {
+		record.LVMSize = strconv.Itoa(*host.UserLvmSize)
+	}
+	record.Site = derefString(host.SiteId)
+	if record.Site == "" && host.Site != nil {
+		record.Site = derefString(host.Site.ResourceId)
+	}
+
+	if instance := host.Instance; instance != nil {
+		record.OSProfile = derefString(instance.OsID)
+		if record.OSProfile == "" && instance.Os != nil {
+			record.OSProfile = derefString(instance.Os.ResourceId)
+		}
+		if instance.SecurityFeature != nil && *instance.SecurityFeature == infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION {
+			record.Secure = types.SecureTrue
+		} else {
+			record.Secure = types.SecureFalse
+		}
+		record.RemoteUser = derefString(instance.LocalAccountID)
+		if instance.Localaccount != nil && instance.Localaccount.Username != "" {
+			record.RemoteUser = instance.Localaccount.Username
+		}
+		configIDs := []string{}
+		for _, config := range instanceCustomConfigs(*instance) {
+			configIDs = append(configIDs, derefString(config.ResourceId))
+		}
+		record.CloudInitMeta = strings.Join(configIDs, "&")
+	}
+
+	if clusterDetail != nil {
+		record.K8sEnable = "true"
+		record.K8sClusterTemplate = clusterTemplateRecord(derefString(clusterDetail.Template))
+		record.K8sConfig = clusterConfigRecord(*clusterDetail, derefString(host.ResourceId))
+	}
+	return record
+}
+
+// Converts the template of a cluster, named as <name>-<version>, to the <name>:<version> form of host records
+func clusterTemplateRecord(template string) string {
+	i := strings.LastIndex(template, "-v")
+	if i <= 0 {
+		return ""
+	}
+	return template[:i] + ":" + template[i+1:]
+}
+
+// Formats the role of the host in its cluster and the labels of the cluster as the cluster config of host records
+func clusterConfigRecord(detail cluster.ClusterDetailInfo, hostID string) string {
+	role := "all"
+	if detail.Nodes != nil {
+		for _, node := range *detail.Nodes {
+			if derefString(node.Id) == hostID && node.Role != nil {
+				role = strings.ReplaceAll(*node.Role, "-", "")
+			}
+		}
+	}
+	config := "role:" + role
+	if detail.Labels != nil && len(*detail.Labels) > 0 {
+		labels := make([]string, 0, len(*detail.Labels))
+		for key, value := range *detail.Labels {
+			labels = append(labels, fmt.Sprintf("%s=%v", key, value))
+		}
+		sort.Strings(labels)
+		config += ";labels:" + strings.Join(labels, "&")
+	}
+	return config
+}
+
+func printHostReplacement(w io.Writer, host infra.HostResource, record types.HostRecord, resolved *types.HostRecord) {
+	fmt.Fprintf(w, "Replacement of host %s (%s):\n", derefString(host.ResourceId), host.Name)
+	fmt.Fprintf(w, "  Serial:       %s -> %s\n", valueOrDefault(derefString(host.SerialNumber), "-"), valueOrDefault(record.Serial, "-"))
+	fmt.Fprintf(w, "  UUID:         %s -> %s\n", valueOrDefault(derefString(host.Uuid), "-"), valueOrDefault(record.UUID, "-"))
+	if isFeatureEnabled(ProvisioningFeature) {
+		fmt.Fprintf(w, "  Site:         %s\n", valueOrDefault(resolved.Site, "-"))
+		fmt.Fprintf(w, "  OS profile:   %s\n", valueOrDefault(resolved.OSProfile, "-"))
+		fmt.Fprintf(w, "  Metadata:     %s\n", valueOrDefault(resolved.Metadata, "-"))
+		fmt.Fprintf(w, "  Cloud Init:   %s\n", valueOrDefault(resolved.CloudInitMeta, "-"))
+		fmt.Fprintf(w, "  Remote user:  %s\n", valueOrDefault(resolved.RemoteUser, "-"))
+	}
+	if record.LVMSize != "" {
+		fmt.Fprintf(w, "  LVM size:     %s GB\n", record.LVMSize)
+	}
+	if resolved.K8sEnable == "true" {
+		fmt.Fprintf(w, "  Cluster:      %s (%s)\n", resolved.K8sClusterTemplate, resolved.K8sConfig)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestReplaceHost() {
+	out, err := s.runCommand("replace host host-abcd1006 --serial 2500JF7 --uuid 4c4c4544-2046-5310-8052-cac04f515237 --dry-run --project some-project")
+	s.NoError(err)
+	s.Contains(out, "Replacement of host host-abcd1006 (edge-host-006):\n")
+	s.Contains(out, "  Serial:       2500JF6 -> 2500JF7\n")
+	s.Contains(out, "  UUID:         4c4c4544-2046-5310-8052-cac04f515236 -> 4c4c4544-2046-5310-8052-cac04f515237\n")
+	s.Contains(out, "  Site:         site-abcd1234\n")
+	s.Contains(out, "  OS profile:   os-1234abcd\n")
+	s.Contains(out, "  Metadata:     rack=r12\n")
+	s.Contains(out, "  Cloud Init:   config-abc12345\n")
+	s.Contains(out, "  Remote user:  localaccount-abc12345\n")
+	s.Contains(out, "  LVM size:     20 GB\n")
+	s.Contains(out, "  Cluster:      default-template:v1.0.0 (role:all;labels:created-by=test)\n")
+	s.NotContains(out, "replaced by")
+
+	out, err = s.runCommand("replace host host-abcd1006 --serial 2500JF7 --uuid 4c4c4544-2046-5310-8052-cac04f515237 --yes --project some-project")
+	s.NoError(err)
+	s.Contains(out, "Host host-1111abcd registered\n")
+	s.Contains(out, "Host host-abcd1006 deauthorized\n")
+	s.Contains(out, "Host host-abcd1006 (edge-host-006) replaced by host host-1111abcd\n")
+
+	_, err = s.runCommand("replace host host-abcd1006 --serial 2500JF6 --uuid 4c4c4544-2046-5310-8052-cac04f515236 --yes --project some-project")
+	s.EqualError(err, "the serial number and UUID of the new host are the ones of host host-abcd1006")
+
+	_, err = s.runCommand("replace host host-abcd1006 --serial 25-JF7 --yes --project some-project")
+	s.ErrorContains(err, "invalid new host")
+
+	_, err = s.runCommand("replace host host-abcd1006 --project some-project")
+	s.Error(err)
+}
+
+func TestNewReplacementRecord(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	secure := infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION
+	lvmSize := 20
+	host := infra.HostResource{
+		ResourceId:  stringPtr("host-1234abcd"),
+		Site:        &infra.SiteResource{ResourceId: stringPtr("site-1234abcd")},
+		UserLvmSize: &lvmSize,
+		Metadata:    &[]infra.MetadataItem{{Key: "rack", Value: "r12"}, {Key: "environment", Value: "production"}},
+		Instance: &infra.InstanceResource{
+			Os:              &infra.OperatingSystemResource{ResourceId: stringPtr("os-1234abcd")},
+			SecurityFeature: &secure,
+			LocalAccountID:  stringPtr("localaccount-1234abcd"),
+			CustomConfigID:  &[]string{"customconfig-1234abcd", "customconfig-5678abcd"},
+		},
+	}
+	detail := &cluster.ClusterDetailInfo{
+		Template: stringPtr("baseline-edge-v2.0.2"),
+		Nodes:    &[]cluster.NodeInfo{{Id: stringPtr("host-1234abcd"), Role: stringPtr("control-plane")}},
+		Labels:   &map[string]interface{}{"zone": "east", "app": "pos"},
+	}
+
+	assert.Equal(t, types.HostRecord{
+		Serial:             "2500JF7",
+		Site:               "site-1234abcd",
+		OSProfile:          "os-1234abcd",
+		Secure:             types.SecureTrue,
+		RemoteUser:         "localaccount-1234abcd",
+		Metadata:           "environment=production&rack=r12",
+		LVMSize:            "20",
+		CloudInitMeta:      "customconfig-1234abcd&customconfig-5678abcd",
+		K8sEnable:          "true",
+		K8sClusterTemplate: "baseline-edge:v2.0.2",
+		K8sConfig:          "role:controlplane;labels:app=pos&zone=east",
+	}, newReplacementRecord(host, "2500JF7", "", detail))
+
+	// A host which is not provisioned only carries its registration
+	record := newReplacementRecord(infra.HostResource{SiteId: stringPtr("site-1234abcd")}, "", "4c4c4544-2046-5310-8052-cac04f515237", nil)
+	assert.Equal(t, types.HostRecord{UUID: "4c4c4544-2046-5310-8052-cac04f515237", Site: "site-1234abcd"}, record)
+}
+
+func TestClusterTemplateRecord(t *testing.T) {
+	assert.Equal(t, "default-template:v1.0.0", clusterTemplateRecord("default-template-v1.0.0"))
+	assert.Equal(t, "edge:v1.2.3", clusterTemplateRecord("edge-v1.2.3"))
+	assert.Empty(t, clusterTemplateRecord("v1.2.3"))
+	assert.Empty(t, clusterTemplateRecord(""))
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const reportHardwareExamples = `# Print the NICs, storage devices, GPUs and USB devices of every host of a project
+orch-cli report hardware --project some-project
+
+# Write the inventory as CSV for an asset tracking system
+orch-cli report hardware --project some-project -o csv > inventory.csv
+
+# Report the hardware of the hosts of a site only
+orch-cli report hardware --project some-project --filter 'site.resourceId="site-abcd1234"'
+
+# Sample output
+HOST     RESOURCE ID     DEVICE    NAME   ID                   VENDOR    MODEL    SERIAL     CAPACITY   LINK STATE   SRIOV            PCI ID
+edge-1   host-1234abcd   nic       eth0   30:d0:42:d9:02:7c    N/A       N/A      N/A        N/A        UP           true (4/8 VFs)   0000:19:00.0
+edge-1   host-1234abcd   storage   N/A    0x5000c500a0b1c2d3   Seagate   ST1000   ZA1B2C3D   931 GB     N/A          N/A              N/A
+`
+
+// hardwareReportRow is a device of a host in the hardware report; the fields a device does not have are N/A
+type hardwareReportRow struct {
+	Host       string `json:"host" yaml:"host"`
+	ResourceID string `json:"resourceId" yaml:"resourceId"`
+	Device     string `json:"device" yaml:"device"`
+	Name       string `json:"name" yaml:"name"`
+	// MAC address of a NIC, WWID of a storage device, PCI ID of a GPU, vendor:product ID of a USB device
+	ID        string `json:"id" yaml:"id"`
+	Vendor    string `json:"vendor" yaml:"vendor"`
+	Model     string `json:"model" yaml:"model"`
+	Serial    string `json:"serial" yaml:"serial"`
+	Capacity  string `json:"capacity" yaml:"capacity"`
+	LinkState string `json:"linkState" yaml:"linkState"`
+	Sriov     string `json:"sriov" yaml:"sriov"`
+	PciID     string `json:"pciId" yaml:"pciId"`
+}
+
+var hardwareReportHeaders = []string{"HOST", "RESOURCE ID", "DEVICE", "NAME", "ID", "VENDOR", "MODEL", "SERIAL", "CAPACITY", "LINK STATE", "SRIOV", "PCI ID"}
+
+func (r hardwareReportRow) values() []string {
+	return []string{r.Host, r.ResourceID, r.Device, r.Name, r.ID, r.Vendor, r.Model, r.Serial, r.Capacity, r.LinkState, r.Sriov, r.PciID}
+}
+
+func getReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "report",
+		Short:             "Report on Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getReportHardwareCommand(),
+	)
+	return cmd
+}
+
+func getReportHardwareCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hardware [flags]",
+		Short: "Report the NICs, storage devices, GPUs and USB devices of the hosts of a project",
+		Long: "Gets every host of the project and reports one line per NIC (MAC address, SR-IOV, link state), " +
+			"storage device (WWID, capacity), GPU and USB device, for asset tracking.",
+		Example: reportHardwareExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runReportHardwareCommand,
+	}
+	cmd.Flags().StringP("filter", "f", "", "Optional filter selecting the hosts to report\nUsage:\n\tCustom filter: --filter \"<custom filter>\" see https://google.aip.dev/160 and API spec. \n\tPredefined filters: --filter provisioned/onboarded/registered/not connected/deauthorized")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, csv, json, yaml")
+	return cmd
+}
+
+func runReportHardwareCommand(cmd *cobra.Command, _ []string) error {
+	filter, _ := cmd.Flags().GetString("filter")
+	outputType, _ := cmd.Flags().GetString("output-type")
+	switch outputType {
+	case "table", "csv", "json", "yaml":
+	default:
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --output-type %q, must be one of: table, csv, json, yaml", outputType))
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	hostIDs := make([]string, 0)
+	err = listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{
+				Filter:   filterHelper(filter),
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return 0, false, err
+		}
+		for _, host := range resp.JSON200.Hosts {
+			hostIDs = append(hostIDs, safeString(host.ResourceId))
+		}
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The list may leave out the device details, every host is read in full
+	rows := make([]hardwareReportRow, 0)
+	for _, hostID := range hostIDs {
+		resp, err := hostClient.HostServiceGetHostWithResponse(ctx, projectName, hostID, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while retrieving host %s", hostID)); err != nil {
+			return err
+		}
+		rows = append(rows, hostHardwareRows(resp.JSON200)...)
+	}
+
+	switch outputType {
+	case "json", "yaml":
+		result := CommandResult{
+			OutputAs: toOutputType(outputType),
+			Data:     rows,
+		}
+		GenerateOutput(cmd.OutOrStdout(), &result)
+		return nil
+	case "csv":
+		return printHardwareReportCSV(cmd.OutOrStdout(), rows)
+	}
+	writer := newOutputWriter(cmd, cmd.OutOrStdout())
+	fmt.Fprintln(writer, strings.Join(hardwareReportHeaders, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row.values(), "\t"))
+	}
+	return writer.Flush()
+}
+
+// Flattens the devices of a host, formatted as for get host
+func hostHardwareRows(host *infra.HostResource) []hardwareReportRow {
+	item := outfmt.NewHostDetail(host)
+	newRow := func(device string) hardwareReportRow {
+		return hardwareReportRow{
+			Host: host.Name, ResourceID: item.ResourceId, Device: device,
+			Name: "N/A", ID: "N/A", Vendor: "N/A", Model: "N/A", Serial: "N/A",
+			Capacity: "N/A", LinkState: "N/A", Sriov: "N/A", PciID: "N/A",
+		}
+	}
+
+	var rows []hardwareReportRow
+	for _, nic := range item.Nics {
+		row := newRow("nic")
+		row.Name, row.ID, row.LinkState, row.PciID = nic.Name, nic.MacAddress, nic.LinkState, nic.PciId
+		row.Sriov = nic.Sriov
+		if nic.Sriov == "true" && nic.SriovVFNum != "N/A" && nic.SriovVFTotal != "N/A" {
+			row.Sriov = fmt.Sprintf("true (%s/%s VFs)", nic.SriovVFNum, nic.SriovVFTotal)
+		}
+		rows = append(rows, row)
+	}
+	for _, storage := range item.Storage {
+		row := newRow("storage")
+		row.ID, row.Vendor, row.Model, row.Serial, row.Capacity = storage.Wwid, storage.Vendor, storage.Model, storage.Serial, storage.Capacity
+		rows = append(rows, row)
+	}
+	for _, gpu := range item.Gpus {
+		row := newRow("gpu")
+		row.Name, row.ID, row.Vendor, row.PciID = gpu.DeviceName, gpu.PciId, gpu.Vendor, gpu.PciId
+		rows = append(rows, row)
+	}
+	for _, usb := range item.Usbs {
+		row := newRow("usb")
+		row.Name, row.ID, row.Serial = usb.Class, usb.VendorId+":"+usb.ProductId, usb.Serial
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func printHardwareReportCSV(w io.Writer, rows []hardwareReportRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(hardwareReportHeaders); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writer.Write(row.values()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+func (s *CLITestSuite) TestReportHardware() {
+	out, err := s.runCommand("report hardware --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^HOST\s+\|RESOURCE ID\s+\|DEVICE\s+\|NAME\s+\|ID\s+\|VENDOR`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|nic\s+\|eth0\s+\|30:d0:42:d9:02:7c\s+\|.*\|true \(4/8 VFs\)\s+\|0000:19:00.0$`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|storage\s+\|N/A\s+\|abcd\s+\|Vendor1\s+\|Model1\s+\|123456\s+\|0 GB`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|gpu\s+\|TestGPU\s+\|03:00.0\s+\|TestVendor`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|usb\s+\|Hub\s+\|abcd:1234\s+\|`, out)
+
+	out, err = s.runCommand("report hardware -o csv --project " + project)
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	s.Equal("HOST,RESOURCE ID,DEVICE,NAME,ID,VENDOR,MODEL,SERIAL,CAPACITY,LINK STATE,SRIOV,PCI ID", lines[0])
+	s.Contains(lines, "edge-host-001,host-abc12345,storage,N/A,abcd,Vendor1,Model1,123456,0 GB,N/A,N/A,N/A")
+
+	out, err = s.runCommand("report hardware -o json --project " + project)
+	s.NoError(err)
+	var rows []hardwareReportRow
+	s.NoError(json.Unmarshal([]byte(out), &rows))
+	s.Len(rows, 4)
+	s.Equal("nic", rows[0].Device)
+
+	_, err = s.runCommand("report hardware -o xml --project " + project)
+	s.EqualError(err, `invalid --output-type "xml", must be one of: table, csv, json, yaml`)
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/rest/cluster"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	cacheDirFlag        = "cache-dir"
+	cacheTTLFlag        = "cache-ttl"
+	defaultResolveCache = 15 * time.Minute
+)
+
+// resolveCacheEntry is a resolved resource along with the time it was fetched from the orchestrator
+type resolveCacheEntry[T any] struct {
+	Fetched  time.Time `json:"fetched"`
+	Resource T         `json:"resource"`
+}
+
+// resolveCacheFile holds the resources resolved by a host import, keyed by the name or ID they were given by
+type resolveCacheFile struct {
+	OSProfiles       map[string]resolveCacheEntry[infra.OperatingSystemResource] `json:"osProfiles,omitempty"`
+	Sites            map[string]resolveCacheEntry[infra.SiteResource]            `json:"sites,omitempty"`
+	LocalAccounts    map[string]resolveCacheEntry[infra.LocalAccountResource]    `json:"localAccounts,omitempty"`
+	ClusterTemplates map[string]resolveCacheEntry[cluster.TemplateInfo]          `json:"clusterTemplates,omitempty"`
+}
+
+// resolveCache persists the OS profile, site, local account and cluster template lookups of a host import
+// across runs so that repeated imports do not list the same resources again
+type resolveCache struct {
+	path string
+	// The entries loaded from the file which had not expired, saved again with their original fetch time
+	loaded resolveCacheFile
+}
+
+// Adds the flags enabling the on-disk cache of resolved resources to a command
+func addResolveCacheFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(cacheDirFlag, "", "Directory to persist the OS profiles, sites, local accounts and cluster templates resolved during an import to, reused by later imports")
+	cmd.PersistentFlags().Duration(cacheTTLFlag, defaultResolveCache, "How long the resources persisted with --cache-dir are reused before being looked up again")
+}
+
+// Fills respCache with the unexpired entries persisted in the --cache-dir of the command;
+// returns nil when no cache directory is given
+func loadResolveCache(cmd *cobra.Command, projectName string, respCache ResponseCache) (*resolveCache, error) {
+	dir, _ := cmd.Flags().GetString(cacheDirFlag)
+	if dir == "" {
+		return nil, nil
+	}
+	ttl, _ := cmd.Flags().GetDuration(cacheTTLFlag)
+	if ttl <= 0 {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s must be a positive duration, got %s", cacheTTLFlag, ttl))
+	}
+
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	sum := sha256.Sum256([]byte(endpoint + "\n" + projectName))
+	cache := &resolveCache{path: filepath.Join(dir, "resolve-"+hex.EncodeToString(sum[:8])+".json")}
+
+	data, err := os.ReadFile(cache.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the resolve cache: %w", err)
+	}
+	var file resolveCacheFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		// A damaged cache is rebuilt rather than failing the import
+		log.Debugf("Ignoring unreadable resolve cache %s: %v", cache.path, err)
+		return cache, nil
+	}
+
+	expiry := time.Now().Add(-ttl)
+	cache.loaded = resolveCacheFile{
+		OSProfiles:       loadResolveEntries(file.OSProfiles, respCache.OSProfileCache, expiry),
+		Sites:            loadResolveEntries(file.Sites, respCache.SiteCache, expiry),
+		LocalAccounts:    loadResolveEntries(file.LocalAccounts, respCache.LACache, expiry),
+		ClusterTemplates: loadResolveEntries(file.ClusterTemplates, respCache.K8sClusterTemplateCache, expiry),
+	}
+	return cache, nil
+}
+
+// Persists the resources of respCache for the next import; failing to do so does not fail the import
+func (c *resolveCache) save(respCache ResponseCache) {
+	if c == nil {
+		return
+	}
+	now := time.Now()
+	file := resolveCacheFile{
+		OSProfiles:       storeResolveEntries(respCache.OSProfileCache, c.loaded.OSProfiles, now),
+		Sites:            storeResolveEntries(respCache.SiteCache, c.loaded.Sites, now),
+		LocalAccounts:    storeResolveEntries(respCache.LACache, c.loaded.LocalAccounts, now),
+		ClusterTemplates: storeResolveEntries(respCache.K8sClusterTemplateCache, c.loaded.ClusterTemplates, now),
+	}
+	if err := c.write(&file); err != nil {
+		log.Debugf("Unable to persist the resolve cache: %v", err)
+	}
+}
+
+func (c *resolveCache) write(file *resolveCacheFile) error {
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	// Write to a temporary file first so a concurrent import never reads a partial cache
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// Copies the entries fetched after expiry into resources and returns them
+func loadResolveEntries[T any](entries map[string]resolveCacheEntry[T], resources map[string]T, expiry time.Time) map[string]resolveCacheEntry[T] {
+	fresh := make(map[string]resolveCacheEntry[T], len(entries))
+	for key, entry := range entries {
+		if entry.Fetched.After(expiry) {
+			fresh[key] = entry
+			resources[key] = entry.Resource
+		}
+	}
+	return fresh
+}
+
+// Timestamps the resources, keeping the fetch time of the ones which were loaded from the cache
+func storeResolveEntries[T any](resources map[string]T, loaded map[string]resolveCacheEntry[T], now time.Time) map[string]resolveCacheEntry[T] {
+	entries := make(map[string]resolveCacheEntry[T], len(resources))
+	for key, resource := range resources {
+		fetched := now
+		if entry, ok := loaded[key]; ok {
+			fetched = entry.Fetched
+		}
+		entries[key] = resolveCacheEntry[T]{Fetched: fetched, Resource: resource}
+	}
+	return entries
+}
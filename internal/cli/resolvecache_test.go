@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestCreateHostResolveCache() {
+	cacheDir := filepath.Join(s.T().TempDir(), "resolve")
+
+	// The resources resolved by the import are persisted
+	out, err := s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", cacheDirFlag: cacheDir})
+	s.NoError(err)
+	s.Contains(out, "1 of 1 host(s) imported, 0 failed")
+
+	entries, err := os.ReadDir(cacheDir)
+	s.NoError(err)
+	s.Len(entries, 1)
+	cachePath := filepath.Join(cacheDir, entries[0].Name())
+	file := s.readResolveCache(cachePath)
+	s.Contains(file.OSProfiles, "Edge Microvisor Toolkit 3.0.20250504")
+	s.Contains(file.Sites, "site-7ceae560")
+	s.Contains(file.LocalAccounts, "account-abc12345")
+	s.Contains(file.ClusterTemplates, "baseline:v2.0.2")
+	fetched := file.OSProfiles["Edge Microvisor Toolkit 3.0.20250504"].Fetched
+	s.WithinDuration(time.Now(), fetched, time.Minute)
+
+	// A cached OS profile is used without looking it up, and keeps its fetch time
+	file.OSProfiles["cached-profile"] = file.OSProfiles["Edge Microvisor Toolkit 3.0.20250504"]
+	s.writeResolveCache(cachePath, file)
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", "os-profile": "cached-profile", cacheDirFlag: cacheDir})
+	s.NoError(err)
+	file = s.readResolveCache(cachePath)
+	s.True(fetched.Equal(file.OSProfiles["cached-profile"].Fetched))
+
+	// Expired entries are looked up again
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", "os-profile": "cached-profile",
+		cacheDirFlag: cacheDir, cacheTTLFlag: "1ns"})
+	s.EqualError(err, "Failed to provision hosts")
+
+	// Without a cache directory the cached OS profile is unknown
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", "os-profile": "cached-profile"})
+	s.EqualError(err, "Failed to provision hosts")
+
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", cacheDirFlag: cacheDir, cacheTTLFlag: "0s"})
+	s.EqualError(err, "--cache-ttl must be a positive duration, got 0s")
+
+	// A damaged cache is rebuilt
+	s.NoError(os.WriteFile(cachePath, []byte("{"), 0600))
+	_, err = s.createHost(project, commandArgs{"import-from-csv": "./testdata/mock.csv", cacheDirFlag: cacheDir})
+	s.NoError(err)
+	s.Contains(s.readResolveCache(cachePath).OSProfiles, "Edge Microvisor Toolkit 3.0.20250504")
+}
+
+func (s *CLITestSuite) readResolveCache(path string) resolveCacheFile {
+	data, err := os.ReadFile(path)
+	s.NoError(err)
+	var file resolveCacheFile
+	s.NoError(json.Unmarshal(data, &file))
+	return file
+}
+
+func (s *CLITestSuite) writeResolveCache(path string, file resolveCacheFile) {
+	data, err := json.Marshal(file)
+	s.NoError(err)
+	s.NoError(os.WriteFile(path, data, 0600))
+}
+
+func TestStoreResolveEntries(t *testing.T) {
+	fetched := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := fetched.Add(time.Hour)
+	loaded := map[string]resolveCacheEntry[infra.SiteResource]{
+		"site-a": {Fetched: fetched, Resource: infra.SiteResource{Name: stringPtr("a")}},
+	}
+	resources := map[string]infra.SiteResource{}
+
+	fresh := loadResolveEntries(loaded, resources, fetched.Add(-time.Minute))
+	assert.Len(t, fresh, 1)
+	assert.Equal(t, "a", *resources["site-a"].Name)
+	assert.Empty(t, loadResolveEntries(loaded, map[string]infra.SiteResource{}, fetched))
+
+	resources["site-b"] = infra.SiteResource{Name: stringPtr("b")}
+	stored := storeResolveEntries(resources, fresh, now)
+	assert.Equal(t, fetched, stored["site-a"].Fetched)
+	assert.Equal(t, now, stored["site-b"].Fetched)
+}
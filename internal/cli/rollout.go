@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultRolloutBatchSize = 10
+	defaultRolloutInterval  = 30 * time.Second
+)
+
+const rolloutOSUpdateExamples = `# Roll an OS update policy out to the hosts of a region, ten hosts at a time, stopping at the first batch with a failed update
+orch-cli rollout osupdate --policy osupdatepolicy-1234abcd --region region-1234abcd --batch-size 10 --pause-on-failure --project some-project
+
+# Show the batches the hosts of a region would be updated in, without updating them
+orch-cli rollout osupdate --policy osupdatepolicy-1234abcd --region europe --dry-run --project some-project
+
+# Give up on a rollout which has not finished within two hours
+orch-cli rollout osupdate --policy osupdatepolicy-1234abcd --region europe --timeout 2h --project some-project
+
+# Run the rollout in the background and check its progress later with 'orch-cli jobs status <jobID>'
+orch-cli rollout osupdate --policy osupdatepolicy-1234abcd --region europe --async --project some-project`
+
+// rolloutHost is a host of an OS update rollout and the outcome of its update
+type rolloutHost struct {
+	HostID     string
+	Name       string
+	InstanceID string
+	Policy     string
+	Batch      int
+	// Result is empty until the update of the host is over
+	Result string
+	Detail string
+}
+
+const (
+	rolloutResultUpdated = "updated"
+	rolloutResultFailed  = "failed"
+)
+
+func getRolloutCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Roll changes out to the hosts of a region in batches",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	addCommandIfFeatureEnabled(cmd, getRolloutOSUpdateCommand(), Day2Feature)
+	return cmd
+}
+
+func getRolloutOSUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "osupdate --policy <resourceID> --region <name|resourceID> [flags]",
+		Short: "Update the OS of the hosts of a region in batches",
+		Long: "Discovers the provisioned hosts of a region and its sub-regions, sets the OS update policy on them and " +
+			"schedules an immediate OS update for one batch of hosts at a time. The OS update runs of a batch are " +
+			"monitored until all of them are over before the next batch is scheduled. The rollout is bounded by --timeout.",
+		Example: rolloutOSUpdateExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runRolloutOSUpdateCommand,
+	}
+	cmd.Flags().String("policy", "", "Resource ID of the OS update policy to roll out (mandatory)")
+	cmd.Flags().String("region", "", "Name or resource ID of the region whose hosts are updated (mandatory)")
+	cmd.Flags().Int("batch-size", defaultRolloutBatchSize, "Number of hosts updated at the same time")
+	cmd.Flags().Bool("pause-on-failure", false, "Stop the rollout after a batch in which the OS update of a host failed")
+	cmd.Flags().Duration("interval", defaultRolloutInterval, "Time between two polls of the OS update runs of a batch")
+	cmd.Flags().Bool("dry-run", false, "Print the batches of hosts without updating them")
+	addAsyncFlag(cmd)
+	_ = cmd.MarkFlagRequired("policy")
+	_ = cmd.MarkFlagRequired("region")
+	return cmd
+}
+
+func runRolloutOSUpdateCommand(cmd *cobra.Command, args []string) error {
+	if started, err := startAsyncJob(cmd, args); started || err != nil {
+		return err
+	}
+	policyID, _ := cmd.Flags().GetString("policy")
+	regionFlag, _ := cmd.Flags().GetString("region")
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	pauseOnFailure, _ := cmd.Flags().GetBool("pause-on-failure")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if err := validateOSUpdatePolicy(policyID); err != nil {
+		return err
+	}
+	if batchSize <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--batch-size must be positive, got %d", batchSize))
+	}
+	if interval <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be a positive duration, got %s", interval))
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	regionID, err := resolveRegionID(ctx, hostClient, projectName, regionFlag)
+	if err != nil {
+		return err
+	}
+	hosts, skipped, err := listRolloutHosts(ctx, hostClient, projectName, regionID)
+	if err != nil {
+		return err
+	}
+	for _, name := range skipped {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Skipping host %s, it has no instance to update\n", name)
+	}
+	if len(hosts) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No provisioned hosts found in region %s\n", regionID)
+		return nil
+	}
+
+	batches := rolloutBatches(hosts, batchSize)
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Rolling OS update policy %s out to %d host(s) of region %s in %d batch(es)\n", policyID, len(hosts), regionID, len(batches))
+	if dryRun {
+		for i, batch := range batches {
+			names := make([]string, 0, len(batch))
+			for _, host := range batch {
+				names = append(names, host.Name)
+			}
+			fmt.Fprintf(out, "Batch %d: %s\n", i+1, strings.Join(names, ", "))
+		}
+		return nil
+	}
+
+	failed := 0
+	for i, batch := range batches {
+		fmt.Fprintf(out, "Batch %d/%d: updating %d host(s)\n", i+1, len(batches), len(batch))
+		scheduled := time.Now().Unix()
+		if err := scheduleRolloutBatch(ctx, hostClient, projectName, policyID, batch); err != nil {
+			return err
+		}
+		if err := monitorRolloutBatch(ctx, cmd, hostClient, projectName, batch, scheduled, interval); err != nil {
+			return err
+		}
+		batchFailed := 0
+		for _, host := range batch {
+			if host.Detail != "" {
+				fmt.Fprintf(out, "  Host %s %s %s - %s\n", host.Name, host.HostID, host.Result, host.Detail)
+			} else {
+				fmt.Fprintf(out, "  Host %s %s %s\n", host.Name, host.HostID, host.Result)
+			}
+			if host.Result == rolloutResultFailed {
+				batchFailed++
+			}
+		}
+		failed += batchFailed
+		if batchFailed > 0 && pauseOnFailure && i+1 < len(batches) {
+			remaining := 0
+			for _, next := range batches[i+1:] {
+				remaining += len(next)
+			}
+			return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("rollout paused after batch %d of %d: the OS update of %d host(s) failed, %d host(s) were not updated",
+				i+1, len(batches), batchFailed, remaining))
+		}
+	}
+
+	fmt.Fprintf(out, "Rollout done: %d of %d host(s) updated, %d failed\n", len(hosts)-failed, len(hosts), failed)
+	if failed > 0 {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("the OS update of %d host(s) failed", failed))
+	}
+	return nil
+}
+
+// Lists the hosts of the sites of a region and of its sub-regions, along with the names of the hosts without instance
+func listRolloutHosts(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, regionID string) ([]*rolloutHost, []string, error) {
+	regionFilter := fmt.Sprintf("region.resource_id='%s' OR region.parent_region.resource_id='%s' OR region.parent_region.parent_region.resource_id='%s' OR region.parent_region.parent_region.parent_region.resource_id='%s'",
+		regionID, regionID, regionID, regionID)
+	sites := make([]infra.SiteResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := hostClient.SiteServiceListSitesWithResponse(ctx, projectName, regionID,
+			&infra.SiteServiceListSitesParams{
+				Filter:   &regionFilter,
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving sites"); err != nil {
+			return 0, false, err
+		}
+		sites = append(sites, resp.JSON200.Sites...)
+		return len(resp.JSON200.Sites), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sites) == 0 {
+		return nil, nil, e.WithCode(e.CodeNotFound, fmt.Errorf("no site was found in region %s", regionID))
+	}
+
+	siteFilters := make([]string, 0, len(sites))
+	for _, site := range sites {
+		siteFilters = append(siteFilters, fmt.Sprintf("site.resourceId='%s'", derefString(site.ResourceId)))
+	}
+	hostFilter := strings.Join(siteFilters, " OR ")
+	resources, err := listHostsMatching(ctx, hostClient, projectName, &hostFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hosts := make([]*rolloutHost, 0, len(resources))
+	skipped := make([]string, 0)
+	for _, resource := range resources {
+		if resource.Instance == nil || derefString(resource.Instance.InstanceID) == "" {
+			skipped = append(skipped, resource.Name)
+			continue
+		}
+		host := &rolloutHost{
+			HostID:     derefString(resource.ResourceId),
+			Name:       resource.Name,
+			InstanceID: derefString(resource.Instance.InstanceID),
+		}
+		if resource.Instance.UpdatePolicy != nil {
+			host.Policy = derefString(resource.Instance.UpdatePolicy.ResourceId)
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, skipped, nil
+}
+
+// Splits the hosts in batches of at most size hosts, numbered from 1
+func rolloutBatches(hosts []*rolloutHost, size int) [][]*rolloutHost {
+	batches := make([][]*rolloutHost, 0, (len(hosts)+size-1)/size)
+	for start := 0; start < len(hosts); start += size {
+		end := min(start+size, len(hosts))
+		for _, host := range hosts[start:end] {
+			host.Batch = len(batches) + 1
+		}
+		batches = append(batches, hosts[start:end])
+	}
+	return batches
+}
+
+// Sets the policy on the instances of a batch and schedules their OS update; a host which cannot be scheduled
+// is failed rather than failing the rollout
+func scheduleRolloutBatch(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, policyID string, batch []*rolloutHost) error {
+	for _, host := range batch {
+		if host.Policy != policyID {
+			policy := policyID
+			resp, err := hostClient.InstanceServicePatchInstanceWithResponse(ctx, projectName, host.InstanceID, &infra.InstanceServicePatchInstanceParams{},
+				infra.InstanceServicePatchInstanceJSONRequestBody{OsUpdatePolicyID: &policy}, auth.AddAuthHeader)
+			if err != nil {
+				return processError(err)
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error while setting the OS update policy"); err != nil {
+				host.Result, host.Detail = rolloutResultFailed, err.Error()
+				continue
+			}
+			host.Policy = policyID
+		}
+
+		schedule := newImmediateOSUpdateSchedule(host.HostID)
+		resp, err := hostClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName, schedule, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", *schedule.Name)); err != nil {
+			host.Result, host.Detail = rolloutResultFailed, err.Error()
+		}
+	}
+	return nil
+}
+
+// Polls the OS update runs of the instances of a batch until the runs started since the batch was scheduled are
+// all over; the deadline of --timeout is carried by the context of the command
+func monitorRolloutBatch(ctx context.Context, cmd *cobra.Command, hostClient infra.ClientWithResponsesInterface, projectName string,
+	batch []*rolloutHost, scheduled int64, interval time.Duration) error {
+	deadline := commandContext(cmd)
+	for {
+		pending := 0
+		for _, host := range batch {
+			if host.Result != "" {
+				continue
+			}
+			run, err := latestOSUpdateRun(ctx, hostClient, projectName, host.InstanceID, scheduled)
+			if err != nil {
+				return err
+			}
+			if run == nil || run.EndTime == nil || *run.EndTime == 0 {
+				pending++
+				continue
+			}
+			host.Result, host.Detail = rolloutRunResult(*run)
+		}
+		if pending == 0 {
+			return nil
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for the OS update of %d host(s)\n", pending)
+		select {
+		case <-deadline.Done():
+			return fmt.Errorf("the OS update of %d host(s) of batch %d did not finish", pending, batch[0].Batch)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Returns the most recent OS update run of an instance started at or after since, nil when there is none yet
+func latestOSUpdateRun(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, instanceID string, since int64) (*infra.OSUpdateRun, error) {
+	filter := fmt.Sprintf("instance.resourceId='%s'", instanceID)
+	resp, err := hostClient.OSUpdateRunListOSUpdateRunWithResponse(ctx, projectName,
+		&infra.OSUpdateRunListOSUpdateRunParams{Filter: &filter}, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving OS Update runs"); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, errors.New("error while retrieving OS Update runs: empty response")
+	}
+	var latest *infra.OSUpdateRun
+	for i, run := range resp.JSON200.OsUpdateRuns {
+		if run.StartTime == nil || int64(*run.StartTime) < since {
+			continue
+		}
+		if latest == nil || *run.StartTime > *latest.StartTime {
+			latest = &resp.JSON200.OsUpdateRuns[i]
+		}
+	}
+	return latest, nil
+}
+
+// Tells whether a finished OS update run succeeded, from its status indicator or its status
+func rolloutRunResult(run infra.OSUpdateRun) (string, string) {
+	status := safeString(run.Status)
+	if (run.StatusIndicator != nil && *run.StatusIndicator == infra.STATUSINDICATIONERROR) ||
+		statusMatchesCondition(status, "failed") || statusMatchesCondition(status, "error") {
+		return rolloutResultFailed, valueOrDefault(safeString(run.StatusDetails), status)
+	}
+	return rolloutResultUpdated, ""
+}
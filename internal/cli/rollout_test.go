@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestRolloutOSUpdate() {
+	out, err := s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --region region-12345678 --interval 10ms --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Rolling OS update policy osupdatepolicy-1234abcd out to 1 host(s) of region region-12345678 in 1 batch(es)\n")
+	s.Contains(out, "Batch 1/1: updating 1 host(s)\n  Host edge-host-001 host-abc12345 updated\n")
+	s.Contains(out, "Rollout done: 1 of 1 host(s) updated, 0 failed\n")
+
+	out, err = s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --region region-12345678 --dry-run --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Batch 1: edge-host-001\n")
+	s.NotContains(out, "updating")
+
+	out, err = s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --region region-12345678 --interval 10ms --project rollout-failure-project")
+	s.EqualError(err, "the OS update of 1 host(s) failed")
+	s.Contains(out, "  Host edge-host-001 host-abc12345 failed - package download failed\n")
+	s.Contains(out, "Rollout done: 0 of 1 host(s) updated, 1 failed\n")
+
+	_, err = s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --region region-12345678 --batch-size 0 --project " + project)
+	s.EqualError(err, "--batch-size must be positive, got 0")
+
+	_, err = s.runCommand("rollout osupdate --policy policy --region region-12345678 --project " + project)
+	s.Error(err)
+
+	_, err = s.runCommand("rollout osupdate --policy osupdatepolicy-1234abcd --project " + project)
+	s.EqualError(err, `required flag(s) "region" not set`)
+}
+
+func TestRolloutBatches(t *testing.T) {
+	hosts := []*rolloutHost{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	batches := rolloutBatches(hosts, 2)
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[2], 1)
+	assert.Equal(t, 1, hosts[1].Batch)
+	assert.Equal(t, 3, hosts[4].Batch)
+
+	assert.Len(t, rolloutBatches(hosts, 10), 1)
+}
+
+func TestRolloutRunResult(t *testing.T) {
+	result, detail := rolloutRunResult(infra.OSUpdateRun{Status: stringPtr("completed")})
+	assert.Equal(t, rolloutResultUpdated, result)
+	assert.Empty(t, detail)
+
+	errorIndicator := infra.STATUSINDICATIONERROR
+	result, detail = rolloutRunResult(infra.OSUpdateRun{Status: stringPtr("done"), StatusIndicator: &errorIndicator})
+	assert.Equal(t, rolloutResultFailed, result)
+	assert.Equal(t, "done", detail)
+
+	result, detail = rolloutRunResult(infra.OSUpdateRun{Status: stringPtr("UPDATE_STATUS_FAILED"), StatusDetails: stringPtr("no space left")})
+	assert.Equal(t, rolloutResultFailed, result)
+	assert.Equal(t, "no space left", detail)
+}
@@ -4,11 +4,19 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/atomix/dazl"
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/retry"
 	clilib "github.com/open-edge-platform/orch-library/go/pkg/cli"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
@@ -23,8 +31,34 @@ const (
 
 	apiEndpoint  = "api-endpoint"
 	debugHeaders = "debug-headers"
+	wideFlag     = "wide"
+	noColorFlag  = "no-color"
+	noColorEnv   = "NO_COLOR"
 	project      = "project"
 
+	fallbackAPIEndpoint = "fallback-api-endpoint"
+	failoverWritesFlag  = "failover-writes"
+
+	retriesFlag       = "retries"
+	retryMaxDelayFlag = "retry-max-delay"
+
+	timeoutFlag = "timeout"
+
+	traceFileFlag = "trace-file"
+
+	proxyFlag              = auth.ProxyField
+	caCertFlag             = auth.CACertField
+	insecureSkipVerifyFlag = auth.InsecureSkipVerifyField
+	clientCertFlag         = auth.ClientCertField
+	clientKeyFlag          = auth.ClientKeyField
+
+	policyDirConfig   = "policy_dir"
+	explainPolicyFlag = "explain-policy"
+
+	errorFormatFlag = "error-format"
+	errorFormatText = "text"
+	errorFormatJSON = "json"
+
 	// Default for dev deployment
 	apiDefaultEndpoint = "https://api.kind.internal/"
 )
@@ -49,28 +83,116 @@ func Init() {
 // Execute is tha main entry point for the command-line execution.
 func Execute() {
 	rootCmd := getRootCmd()
-	if err := rootCmd.Execute(); err != nil {
-		// Check if this is an unknown command error for a disabled command
-		if errStr := err.Error(); strings.Contains(errStr, "unknown command") {
-			// Extract the command name from the error
-			// Error format: unknown command "wipe" for "orch-cli"
-			if start := strings.Index(errStr, "\""); start != -1 {
-				if end := strings.Index(errStr[start+1:], "\""); end != -1 {
-					cmdName := errStr[start+1 : start+1+end]
-					if isCommandDisabledWithParent(rootCmd, cmdName) {
-						fmt.Fprintf(os.Stderr, "Error: command %q is disabled in the current Edge Orchestrator configuration\n", cmdName)
-						os.Exit(1)
-					}
+	addPluginCommand(rootCmd, os.Args[1:], os.Getenv("PATH"))
+	markJobStarted()
+	err := rootCmd.Execute()
+	code := 0
+	if err != nil {
+		code = reportError(rootCmd, os.Args[1:], err, os.Stderr)
+	}
+	markJobEnded(code, err)
+	if err != nil {
+		os.Exit(code)
+	}
+}
+
+// Writes the error in the format selected by --error-format and returns the exit code matching it
+func reportError(rootCmd *cobra.Command, args []string, err error, w io.Writer) int {
+	// A plugin reports its own errors, only its exit code is passed on
+	var pluginErr *pluginExitError
+	if errors.As(err, &pluginErr) {
+		return pluginErr.code
+	}
+
+	text := err.Error()
+	// Check if this is an unknown command error for a disabled command
+	if errStr := err.Error(); strings.Contains(errStr, "unknown command") {
+		err = e.WithCode(e.CodeInvalidArgument, err)
+		// It's a truly unknown command - print the error with help suggestion
+		text = fmt.Sprintf("Error: %s\nRun '%s --help' for usage.", err, rootCmd.CommandPath())
+		// Extract the command name from the error
+		// Error format: unknown command "wipe" for "orch-cli"
+		if start := strings.Index(errStr, "\""); start != -1 {
+			if end := strings.Index(errStr[start+1:], "\""); end != -1 {
+				cmdName := errStr[start+1 : start+1+end]
+				if isCommandDisabledWithParent(rootCmd, cmdName) {
+					err = e.WithCode(e.CodeInvalidArgument, fmt.Errorf("command %q is disabled in the current Edge Orchestrator configuration", cmdName))
+					text = fmt.Sprintf("Error: %s", err)
 				}
 			}
-			// It's a truly unknown command - print the error with help suggestion
-			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
-			fmt.Fprintf(os.Stderr, "Run '%s --help' for usage.\n", rootCmd.CommandPath())
-		} else {
-			// Other errors - print them
-			fmt.Fprintln(os.Stderr, err)
 		}
-		os.Exit(1)
+	} else if e.CodeOf(err) == e.CodeUnknown && isUsageError(err) {
+		err = e.WithCode(e.CodeInvalidArgument, err)
+	}
+
+	if getErrorFormat(rootCmd, args) == errorFormatJSON {
+		_ = json.NewEncoder(w).Encode(e.NewReport(err))
+	} else {
+		fmt.Fprintln(w, text)
+	}
+	return e.ExitCode(err)
+}
+
+// Flag parsing stops at the first invalid flag, so --error-format may not have been parsed yet
+func getErrorFormat(rootCmd *cobra.Command, args []string) string {
+	if flag := rootCmd.PersistentFlags().Lookup(errorFormatFlag); flag.Changed {
+		return flag.Value.String()
+	}
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, "--"+errorFormatFlag+"="); ok {
+			return value
+		}
+		if arg == "--"+errorFormatFlag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return viper.GetString(errorFormatFlag)
+}
+
+// Cobra reports missing required flags and flag group violations before the command runs, as plain errors
+func isUsageError(err error) bool {
+	msg := err.Error()
+	for _, prefix := range []string{"required flag(s)", "if any flags in the group", "at least one of the flags in the group"} {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Runs every command of the tree with a context bounded by --timeout; the factories hand it to the API calls,
+// so that paginated loops and retries stop once the budget of the command is spent
+func applyTimeout(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(c *cobra.Command, args []string) error {
+			timeout, _ := c.Flags().GetDuration(timeoutFlag)
+			if timeout <= 0 {
+				return run(c, args)
+			}
+			ctx, cancel := context.WithTimeout(commandContext(c), timeout)
+			defer cancel()
+			c.SetContext(ctx)
+			err := run(c, args)
+			if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return e.WithCode(e.CodeDeadlineExceeded, fmt.Errorf("command timed out after %s: %w", timeout, err))
+			}
+			return err
+		}
+	}
+	for _, child := range cmd.Commands() {
+		applyTimeout(child)
+	}
+}
+
+// Marks errors of flag parsing and argument validation as invalid arguments, for the whole command tree
+func markUsageErrors(cmd *cobra.Command) {
+	if validateArgs := cmd.Args; validateArgs != nil {
+		cmd.Args = func(c *cobra.Command, args []string) error {
+			return e.WithCode(e.CodeInvalidArgument, validateArgs(c, args))
+		}
+	}
+	for _, child := range cmd.Commands() {
+		markUsageErrors(child)
 	}
 }
 
@@ -87,11 +209,50 @@ func getRootCmd() *cobra.Command {
 	viper.SetDefault(debugHeaders, false)
 	viper.SetDefault("verbose", false)
 	viper.SetDefault(project, "")
+	viper.SetDefault(retriesFlag, retry.DefaultMaxRetries)
+	viper.SetDefault(retryMaxDelayFlag, retry.DefaultMaxDelay)
+	viper.SetDefault(errorFormatFlag, errorFormatText)
+	viper.SetDefault(policyDirConfig, "")
+	viper.SetDefault(timeoutFlag, time.Duration(0))
+	viper.SetDefault(traceFileFlag, "")
+	viper.SetDefault(fallbackAPIEndpoint, "")
+	viper.SetDefault(proxyFlag, "")
+	viper.SetDefault(caCertFlag, "")
+	viper.SetDefault(insecureSkipVerifyFlag, false)
+	viper.SetDefault(clientCertFlag, "")
+	viper.SetDefault(clientKeyFlag, "")
+	viper.SetDefault(noPagerFlag, false)
+	viper.SetDefault(pagerConfig, "")
 
 	// Setup global persistent flags for endpoint addresses of various services
 	rootCmd.PersistentFlags().String(apiEndpoint, viper.GetString(apiEndpoint), "API Service Endpoint")
 	rootCmd.PersistentFlags().Bool(debugHeaders, viper.GetBool(debugHeaders), "emit debug-style headers separating columns via '|' character")
+	rootCmd.PersistentFlags().Bool(wideFlag, false, fmt.Sprintf("show the full values of table columns instead of cutting the ones longer than %d characters", maxTableCellWidth))
+	rootCmd.PersistentFlags().Bool(noColorFlag, false, "do not color the statuses, CVE severities and maintenance states of the tables written to a terminal; also disabled by the NO_COLOR environment variable")
+	rootCmd.PersistentFlags().Bool(noPagerFlag, viper.GetBool(noPagerFlag), "write the output of the list and get commands directly instead of through the pager used when it does not fit on the terminal; the pager is the pager configuration, else $PAGER, else 'less -R'")
 	rootCmd.PersistentFlags().StringP(project, "p", viper.GetString(project), "Active project name")
+	_ = rootCmd.RegisterFlagCompletionFunc(project, completeProjectNames)
+	rootCmd.PersistentFlags().Int(retriesFlag, viper.GetInt(retriesFlag), "number of times an idempotent API call is retried after a transient failure (429, 502, 503 or network error); 0 disables retries")
+	rootCmd.PersistentFlags().Duration(retryMaxDelayFlag, viper.GetDuration(retryMaxDelayFlag), "maximum delay between two attempts of a retried API call")
+	rootCmd.PersistentFlags().Duration(timeoutFlag, viper.GetDuration(timeoutFlag), "maximum time a command may spend, API calls and retries included, e.g. 30s or 5m; 0 disables the limit")
+	rootCmd.PersistentFlags().String(fallbackAPIEndpoint, viper.GetString(fallbackAPIEndpoint), "API Service Endpoint of a warm standby orchestrator serving the read-only API calls when the API Service Endpoint fails with network or 5xx errors")
+	rootCmd.PersistentFlags().Bool(failoverWritesFlag, false, "let the API calls creating, updating or deleting resources fail over to the --fallback-api-endpoint too")
+	rootCmd.PersistentFlags().Bool(offlineFlag, false, "serve the API reads from the responses last received by the list and get commands over the past week, whose time is written to stderr, without connecting to the orchestrator; commands changing resources fail")
+	rootCmd.PersistentFlags().String(traceFileFlag, viper.GetString(traceFileFlag), "file to append a JSON line to for every API call, with its method, URL, project, status and latency; credentials are redacted")
+	rootCmd.PersistentFlags().String(proxyFlag, viper.GetString(proxyFlag), "URL of the proxy the API and Keycloak requests go through, e.g. http://proxy.example.com:3128; defaults to the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables")
+	rootCmd.PersistentFlags().String(caCertFlag, viper.GetString(caCertFlag), "PEM file of certificate authorities trusted, in addition to the system ones, to verify the API and Keycloak endpoints")
+	rootCmd.PersistentFlags().Bool(insecureSkipVerifyFlag, viper.GetBool(insecureSkipVerifyFlag), "do not verify the certificates of the API and Keycloak endpoints; for test deployments only")
+	rootCmd.PersistentFlags().String(clientCertFlag, viper.GetString(clientCertFlag), "PEM file of the client certificate presented to the API and Keycloak endpoints requiring mutual TLS; needs --client-key")
+	rootCmd.PersistentFlags().String(clientKeyFlag, viper.GetString(clientKeyFlag), "PEM file of the private key of the --client-cert")
+	// The Keycloak client is built without the command, it reads the flags and their environment variables
+	// through the configuration
+	for _, name := range []string{proxyFlag, caCertFlag, insecureSkipVerifyFlag, clientCertFlag, clientKeyFlag} {
+		_ = viper.BindPFlag(name, rootCmd.PersistentFlags().Lookup(name))
+		_ = viper.BindEnv(name, profileEnvVar(name))
+	}
+	rootCmd.PersistentFlags().Bool(explainPolicyFlag, false, "write to stderr how the policies of the policy_dir configuration decided on each create, update or delete call")
+	rootCmd.PersistentFlags().String(configProfileFlag, "", "configuration profile whose flag defaults apply, instead of ORCH_CLI_CONFIG_PROFILE; see 'orch-cli config get-defaults'")
+	rootCmd.PersistentFlags().String(errorFormatFlag, viper.GetString(errorFormatFlag), "format of errors written to stderr: text or json; the exit code is 2 for validation, 3 for not found, 4 for conflict, 5 for authentication, 6 for server errors and 1 otherwise")
 
 	// Setup global persistent flag for verbose output
 	var Verbose bool
@@ -99,8 +260,12 @@ func getRootCmd() *cobra.Command {
 	var NoAuth bool
 	rootCmd.PersistentFlags().BoolVarP(&NoAuth, "noauth", "n", viper.GetBool("noauth"), "use without authentication checks")
 
+	configCmd := clilib.GetConfigCommand()
+	configCmd.AddCommand(getContextCommands()...)
+	configCmd.AddCommand(getGetDefaultsCommand())
+
 	rootCmd.AddCommand(
-		clilib.GetConfigCommand(),
+		configCmd,
 		getCreateCommand(),
 		getListCommand(),
 		getGetCommand(),
@@ -110,20 +275,62 @@ func getRootCmd() *cobra.Command {
 		getLoginCommand(),
 		getLogoutCommand(),
 
+		getBenchCommand(),
+		getDoctorCommand(),
+		getWaitCommand(),
+		getPluginsCommand(),
+		getJobsCommand(),
+		getExplainCommand(),
+
 		versionCommand(),
 	)
 
 	addCommandIfFeatureEnabled(rootCmd, getGenerateCommand(), OxmFeature)
 
 	addCommandIfFeatureEnabled(rootCmd, getDeauthorizeCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getDiscoverCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getFindCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getTransferCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getReplaceCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getPatchCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getSummaryCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getReportCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getGroupCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getEventsCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getLogsCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getDashboardCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(rootCmd, getTopCommand(), EdgeNodeObservabilityFeature)
+	addCommandIfFeatureEnabled(rootCmd, getDiffCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(rootCmd, getCloneCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(rootCmd, getVerifyCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(rootCmd, getStartCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(rootCmd, getStopCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(rootCmd, getCheckCommand(), Day2Feature)
+	addCommandIfFeatureEnabled(rootCmd, getServeCommand(), EIMFeature)
 
 	addCommandIfFeatureEnabled(rootCmd, getUpdateCommand(), Day2Feature)
+	addCommandIfFeatureEnabled(rootCmd, getRolloutCommand(), Day2Feature)
 
 	addCommandIfFeatureEnabled(rootCmd, getWipeProjectCommand(), AppOrchFeature)
-	addCommandIfFeatureEnabled(rootCmd, getImportCommand(), AppOrchFeature)
+	// Import and export hold the packages of application orchestration and the maintenance bundles of day 2
+	importExportFeature := AppOrchFeature
+	if !isFeatureEnabled(AppOrchFeature) {
+		importExportFeature = Day2Feature
+	}
+	addCommandIfFeatureEnabled(rootCmd, getImportCommand(), importExportFeature)
+	addCommandIfFeatureEnabled(rootCmd, getExportCommand(), importExportFeature)
 	addCommandIfFeatureEnabled(rootCmd, getUploadCommand(), AppOrchFeature)
 	addCommandIfFeatureEnabled(rootCmd, getUpgradeCommand(), AppOrchFeature)
-	addCommandIfFeatureEnabled(rootCmd, getExportCommand(), AppOrchFeature)
+
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return e.WithCode(e.CodeInvalidArgument, err)
+	})
+	markUsageErrors(rootCmd)
+	applyProfileDefaults(rootCmd)
+	applyHostGroups(rootCmd)
+	applyTimeout(rootCmd)
+	applyOffline(rootCmd)
+	applyPager(rootCmd)
 
 	return rootCmd
 }
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// Runs the command the way Execute does and returns what is written to stderr and the exit code
+func (s *CLITestSuite) runCommandReportingError(commandArgs string) (string, int) {
+	args := append(parseArgs(commandArgs), "--api-endpoint", apiTest)
+	cmd := getRootCmd()
+	cmd.SetArgs(args)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	err := cmd.Execute()
+	s.Error(err)
+
+	stderr := new(bytes.Buffer)
+	exitCode := reportError(cmd, args, err, stderr)
+	return stderr.String(), exitCode
+}
+
+func (s *CLITestSuite) TestErrorReport() {
+	out, exitCode := s.runCommandReportingError("delete project nonexistent-project --project " + project)
+	s.Equal(e.ExitNotFound, exitCode)
+	s.Equal("error deleting project nonexistent-project: Not Found\n", out)
+
+	out, exitCode = s.runCommandReportingError("delete project nonexistent-project --project " + project + " --error-format json")
+	s.Equal(e.ExitNotFound, exitCode)
+	report := e.Report{}
+	s.NoError(json.Unmarshal([]byte(out), &report))
+	s.Equal(e.Report{
+		Code:       e.CodeNotFound,
+		Message:    "error deleting project nonexistent-project: Not Found",
+		ExitCode:   e.ExitNotFound,
+		HTTPStatus: 404,
+	}, report)
+
+	out, exitCode = s.runCommandReportingError("list hosts --no-such-flag --error-format json")
+	s.Equal(e.ExitValidation, exitCode)
+	s.Contains(out, `"code":"invalid_argument"`)
+
+	out, exitCode = s.runCommandReportingError("get host")
+	s.Equal(e.ExitValidation, exitCode)
+	s.Contains(out, "accepts 1 arg(s), received 0")
+
+	out, exitCode = s.runCommandReportingError("no-such-command")
+	s.Equal(e.ExitValidation, exitCode)
+	s.Equal("Error: unknown command \"no-such-command\" for \"orch-cli\"\nRun 'orch-cli --help' for usage.\n", out)
+}
+
+// Builds a command tree whose leaf waits for its context like a hanging API call would
+func newTimeoutTestCommand() *cobra.Command {
+	root := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().Duration(timeoutFlag, 0, "timeout")
+	root.AddCommand(&cobra.Command{
+		Use: "hang",
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := commandContext(c)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(200 * time.Millisecond):
+				return nil
+			}
+		},
+	})
+	applyTimeout(root)
+	return root
+}
+
+func TestApplyTimeout(t *testing.T) {
+	cmd := newTimeoutTestCommand()
+	cmd.SetArgs([]string{"hang", "--timeout", "20ms"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "command timed out after 20ms: context deadline exceeded")
+	assert.Equal(t, e.CodeDeadlineExceeded, e.CodeOf(err))
+
+	cmd = newTimeoutTestCommand()
+	cmd.SetArgs([]string{"hang"})
+	assert.NoError(t, cmd.Execute(), "no deadline without --timeout")
+}
+
+func TestServiceContextDeadline(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String(apiEndpoint, "http://localhost:12345", "API endpoint")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd.SetContext(ctx)
+
+	tctx, _, err := getTenancyServiceContext(cmd)
+	assert.NoError(t, err)
+	_, ok := tctx.Deadline()
+	assert.True(t, ok, "the factories hand out the context of the command")
+}
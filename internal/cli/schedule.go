@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,6 +25,18 @@ import (
 
 const listScheduleExamples = `# List all schedule resources
 orch-cli list schedule --project some-project
+
+# List all schedule resources with their times shown in a particular timezone
+orch-cli list schedule --project some-project --timezone America/New_York
+
+# List at most 20 schedule resources
+orch-cli list schedule --project some-project --limit 20
+
+# List the schedules that apply to a host, including the ones inherited from its site and regions
+orch-cli list schedule --project some-project --host host-1234abcd
+
+# List the schedules that apply to a site, given by name, including the ones inherited from its regions
+orch-cli list schedule --project some-project --site "Store 42"
 `
 
 const getScheduleExamples = `# Get a schedule by resource ID
@@ -40,8 +54,18 @@ orch-cli create schedules my-schedule --timezone GMT --frequency-type repeated -
 # Create a new repeated schedule, a maintenance, using days of month (target by resource ID)
 orch-cli create schedules my-schedule --timezone GMT  --frequency-type repeated  --maintenance-type maintenance --target site-532d1d07 --frequency monthly --start-time "10:10" --day-of-month "1,6,31" --months "2,4,7-12" --duration 3600
 
+# Create a new repeated schedule using a human readable day and time, converted from --timezone to UTC
+orch-cli create schedules my-schedule --timezone America/New_York --maintenance-type maintenance --target site-532d1d07 --every "saturday 02:00" --duration 2h
+
+# Create a new repeated schedule on days of the month, in some months only
+orch-cli create schedules my-schedule --timezone Europe/Berlin --maintenance-type osupdate --target site-532d1d07 --every "day 1,15 03:00" --months "1-6" --duration 90m
+
 # Create a new single schedule, an osupdate (target region by name)
 orch-cli create schedules my-schedule --timezone GMT --frequency-type single --maintenance-type osupdate --target region:"Europe West" --start-time "2026-12-01 20:20" --end-time "2027-12-01 20:20"
+
+# Create schedules from the events of an iCalendar file - events with an RRULE become repeated schedules, the others single
+# schedules named after the event SUMMARY; times without a time zone are interpreted in --timezone (default UTC)
+orch-cli create schedules --from-ical maintenance.ics --maintenance-type maintenance --target region-1234abcd
 `
 
 const deleteScheduleExamples = `# Delete a schedule resource using it's resource ID
@@ -61,22 +85,30 @@ orch-cli set schedules singlesche-abcd1234 --timezone GMT --maintenance-type osu
 
 // Template-based output constants for schedules
 const (
-	DEFAULT_SCHEDULE_FORMAT              = "table{{.Name}}\t{{.Target}}\t{{str .ResourceId}}"
-	DEFAULT_SCHEDULE_VERBOSE_FORMAT      = "table{{.Name}}\t{{.Target}}\t{{str .ResourceId}}\t{{.Status}}\t{{.Type}}"
-	DEFAULT_SCHEDULE_GET_FORMAT          = "Name:\t{{.Name}}\nResource ID:\t{{str .ResourceId}}\nTarget Host ID:\t{{str .TargetHost}}\nTarget Region ID:\t{{str .TargetRegion}}\nTarget Site ID:\t{{str .TargetSite}}\nSchedule Status:\t{{.ScheduleStatus}}\nStart Time:\t{{formatTime .StartSeconds}}\nEnd Time:\t{{formatTime .EndSeconds}}\nCron Month:\t{{.CronMonth}}\nCron DayMonth:\t{{.CronDayMonth}}\nCron DayWeek:\t{{.CronDayWeek}}\nHour (UTC):\t{{.CronHours}}\nMinute (UTC):\t{{.CronMinutes}}\nDuration:\t{{.DurationSeconds}}\n"
-	DEFAULT_SCHEDULE_GET_SINGLE_FORMAT   = "Name:\t{{.Name}}\nResource ID:\t{{str .ResourceId}}\nTarget Host ID:\t{{str .TargetHost}}\nTarget Region ID:\t{{str .TargetRegion}}\nTarget Site ID:\t{{str .TargetSite}}\nSchedule Status:\t{{.ScheduleStatus}}\nStart Time:\t{{formatTime .StartSeconds}}\nEnd Time:\t{{formatTime .EndSeconds}}\n"
-	DEFAULT_SCHEDULE_GET_REPEATED_FORMAT = DEFAULT_SCHEDULE_GET_FORMAT
+	DEFAULT_SCHEDULE_FORMAT                = "table{{.Name}}\t{{.Target}}\t{{.Schedule}}\t{{str .ResourceId}}"
+	DEFAULT_SCHEDULE_VERBOSE_FORMAT        = "table{{.Name}}\t{{.Target}}\t{{.Schedule}}\t{{str .ResourceId}}\t{{.Status}}\t{{.Type}}"
+	DEFAULT_SCHEDULE_SOURCE_FORMAT         = "table{{.Name}}\t{{.Target}}\t{{.Source}}\t{{.Schedule}}\t{{str .ResourceId}}"
+	DEFAULT_SCHEDULE_SOURCE_VERBOSE_FORMAT = "table{{.Name}}\t{{.Target}}\t{{.Source}}\t{{.Schedule}}\t{{str .ResourceId}}\t{{.Status}}\t{{.Type}}"
+	DEFAULT_SCHEDULE_GET_FORMAT            = "Name:\t{{.Name}}\nResource ID:\t{{str .ResourceId}}\nTarget Host ID:\t{{str .TargetHost}}\nTarget Region ID:\t{{str .TargetRegion}}\nTarget Site ID:\t{{str .TargetSite}}\nSchedule Status:\t{{.ScheduleStatus}}\nSchedule:\t{{.Schedule}}\nStart Time:\t{{formatTime .StartSeconds}}\nEnd Time:\t{{formatTime .EndSeconds}}\nCron Month:\t{{.CronMonth}}\nCron DayMonth:\t{{.CronDayMonth}}\nCron DayWeek:\t{{.CronDayWeek}}\nHour (UTC):\t{{.CronHours}}\nMinute (UTC):\t{{.CronMinutes}}\nDuration:\t{{.DurationSeconds}}\n"
+	DEFAULT_SCHEDULE_GET_SINGLE_FORMAT     = "Name:\t{{.Name}}\nResource ID:\t{{str .ResourceId}}\nTarget Host ID:\t{{str .TargetHost}}\nTarget Region ID:\t{{str .TargetRegion}}\nTarget Site ID:\t{{str .TargetSite}}\nSchedule Status:\t{{.ScheduleStatus}}\nSchedule:\t{{.Schedule}}\nStart Time:\t{{formatTime .StartSeconds}}\nEnd Time:\t{{formatTime .EndSeconds}}\n"
+	DEFAULT_SCHEDULE_GET_REPEATED_FORMAT   = DEFAULT_SCHEDULE_GET_FORMAT
 )
 const SCHEDULE_OUTPUT_TEMPLATE_ENVVAR = "ORCH_CLI_SCHEDULE_OUTPUT_TEMPLATE"
 const SCHEDULE_INSPECT_TEMPLATE_ENVVAR = "ORCH_CLI_SCHEDULE_INSPECT_TEMPLATE"
 
-func getScheduleOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (string, error) {
+func getScheduleOutputFormat(cmd *cobra.Command, verbose bool, forList bool, withSource bool) (string, error) {
 	if verbose && forList {
+		if withSource {
+			return DEFAULT_SCHEDULE_SOURCE_VERBOSE_FORMAT, nil
+		}
 		return DEFAULT_SCHEDULE_VERBOSE_FORMAT, nil
 	}
 	if !forList {
 		return resolveTableOutputTemplate(cmd, DEFAULT_SCHEDULE_GET_FORMAT, SCHEDULE_INSPECT_TEMPLATE_ENVVAR)
 	}
+	if withSource {
+		return resolveTableOutputTemplate(cmd, DEFAULT_SCHEDULE_SOURCE_FORMAT, SCHEDULE_OUTPUT_TEMPLATE_ENVVAR)
+	}
 	return resolveTableOutputTemplate(cmd, DEFAULT_SCHEDULE_FORMAT, SCHEDULE_OUTPUT_TEMPLATE_ENVVAR)
 }
 
@@ -87,13 +119,18 @@ const REPEATED = 1
 type scheduleListItem struct { //nolint:revive
 	Name       string
 	Target     string
+	Schedule   string
 	ResourceId *string
 	Status     string
 	Type       string
+	// Whether the schedule targets the listed host, site or region directly or is inherited from a level above it
+	Source string
 }
 
-func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infra.SingleScheduleResource, repeatedSchedules []infra.RepeatedScheduleResource, orderBy *string, outputFilter *string, verbose bool) error {
+// Prints the schedules; when sources is not nil, the source of their target is shown in a SOURCE column
+func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infra.SingleScheduleResource, repeatedSchedules []infra.RepeatedScheduleResource, orderBy *string, outputFilter *string, verbose bool, loc *time.Location, sources map[string]string) error {
 	items := make([]scheduleListItem, 0)
+	now := time.Now()
 
 	for _, schedule := range singleSchedules {
 		target := "Unspecified"
@@ -108,6 +145,8 @@ func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infr
 		items = append(items, scheduleListItem{
 			Name:       derefString(schedule.Name),
 			Target:     target,
+			Source:     sources[scheduleTarget(schedule.TargetHostId, schedule.TargetSiteId, schedule.TargetRegionId)],
+			Schedule:   describeSingleSchedule(schedule, loc),
 			ResourceId: schedule.ResourceId,
 			Status:     status,
 			Type:       "single",
@@ -127,6 +166,8 @@ func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infr
 		items = append(items, scheduleListItem{
 			Name:       derefString(schedule.Name),
 			Target:     target,
+			Source:     sources[scheduleTarget(schedule.TargetHostId, schedule.TargetSiteId, schedule.TargetRegionId)],
+			Schedule:   describeRepeatedSchedule(schedule, loc, now),
 			ResourceId: schedule.ResourceId,
 			Status:     status,
 			Type:       "repeated",
@@ -134,7 +175,7 @@ func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infr
 	}
 
 	// Build output result
-	outputFormat, err := getScheduleOutputFormat(cmd, verbose, true)
+	outputFormat, err := getScheduleOutputFormat(cmd, verbose, true, sources != nil)
 	if err != nil {
 		return err
 	}
@@ -156,6 +197,9 @@ func printSchedules(cmd *cobra.Command, writer io.Writer, singleSchedules []infr
 		NameLimit: -1,
 		Data:      items,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
@@ -175,6 +219,7 @@ type scheduleGetItem struct { //nolint:revive
 	TargetRegion    *string
 	TargetSite      *string
 	ScheduleStatus  string
+	Schedule        string
 	StartSeconds    interface{}
 	EndSeconds      interface{}
 	CronMonth       string
@@ -185,7 +230,7 @@ type scheduleGetItem struct { //nolint:revive
 	DurationSeconds int32
 }
 
-func printSchedule(cmd *cobra.Command, writer io.Writer, singleSchedule infra.SingleScheduleResource, repeatedSchedule infra.RepeatedScheduleResource, _ *time.Location) error {
+func printSchedule(cmd *cobra.Command, writer io.Writer, singleSchedule infra.SingleScheduleResource, repeatedSchedule infra.RepeatedScheduleResource, loc *time.Location) error {
 	var item scheduleGetItem
 	if singleSchedule.ResourceId != nil {
 		item = scheduleGetItem{
@@ -195,6 +240,7 @@ func printSchedule(cmd *cobra.Command, writer io.Writer, singleSchedule infra.Si
 			TargetRegion:   singleSchedule.TargetRegionId,
 			TargetSite:     singleSchedule.TargetSiteId,
 			ScheduleStatus: string(singleSchedule.ScheduleStatus),
+			Schedule:       describeSingleSchedule(singleSchedule, loc),
 			StartSeconds:   singleSchedule.StartSeconds,
 			EndSeconds:     singleSchedule.EndSeconds,
 		}
@@ -206,6 +252,7 @@ func printSchedule(cmd *cobra.Command, writer io.Writer, singleSchedule infra.Si
 			TargetRegion:    repeatedSchedule.TargetRegionId,
 			TargetSite:      repeatedSchedule.TargetSiteId,
 			ScheduleStatus:  string(repeatedSchedule.ScheduleStatus),
+			Schedule:        describeRepeatedSchedule(repeatedSchedule, loc, time.Now()),
 			CronMonth:       repeatedSchedule.CronMonth,
 			CronDayMonth:    repeatedSchedule.CronDayMonth,
 			CronDayWeek:     repeatedSchedule.CronDayWeek,
@@ -215,8 +262,12 @@ func printSchedule(cmd *cobra.Command, writer io.Writer, singleSchedule infra.Si
 		}
 	}
 
+	if selected, err := printSelectedOutput(cmd, item); selected {
+		return err
+	}
+
 	// Choose GET output template depending on whether this is a single or repeated schedule
-	outputFormat, err := getScheduleOutputFormat(cmd, false, false)
+	outputFormat, err := getScheduleOutputFormat(cmd, false, false, false)
 	if err != nil {
 		return err
 	}
@@ -782,7 +833,7 @@ func getSetScheduleCommand() *cobra.Command {
 	cmd.PersistentFlags().StringP("hour", "H", viper.GetString("hour"), "Hour of the day for repeated schedule (0-23): --hour 2")
 	cmd.PersistentFlags().StringP("minute", "M", viper.GetString("minute"), "Minute of the hour for repeated schedule (0-59): --minute 30")
 	cmd.PersistentFlags().StringP("name", "N", viper.GetString("name"), "Schedule name")
-	cmd.PersistentFlags().IntP("duration", "u", viper.GetInt("duration"), "Duration of the maintenance window in seconds: --duration 3600")
+	cmd.PersistentFlags().StringP("duration", "u", viper.GetString("duration"), "Duration of the maintenance window in seconds or as a duration: --duration 3600|2h|1h30m")
 
 	return cmd
 }
@@ -798,6 +849,13 @@ func getListScheduleCommand() *cobra.Command {
 	// The schedules API does not support a generic server-side filter parameter.
 	// Client-side filtering is available via the standard `--output-filter` flag.
 	cmd.Flags().String("order-by", "", "order results by field (table output only)")
+	cmd.Flags().StringP("timezone", "t", viper.GetString("timezone"), "Display time in particular timezone: --timezone Europe/Berlin")
+	cmd.Flags().String("host", "", "List the schedules applying to a host, given by name or resource ID, including the ones of its site and regions")
+	cmd.Flags().String("site", "", "List the schedules applying to a site, given by name or resource ID, including the ones of its regions")
+	cmd.Flags().String("region", "", "List the schedules applying to a region, given by name or resource ID, including the ones of its parent regions")
+	cmd.MarkFlagsMutuallyExclusive("host", "site", "region")
+	addListPaginationFlags(cmd, "schedule")
+	addListLimitFlag(cmd, "schedule")
 	addStandardListOutputFlags(cmd)
 	return cmd
 }
@@ -807,7 +865,12 @@ func getCreateScheduleCommand() *cobra.Command {
 		Use:     "schedule [flags]",
 		Short:   "Creates a schedule configuration",
 		Example: createScheduleExamples,
-		Args:    cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if fromICal, _ := cmd.Flags().GetString("from-ical"); fromICal != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		Aliases: scheduleAliases,
 		RunE:    runCreateScheduleCommand,
 	}
@@ -823,7 +886,9 @@ func getCreateScheduleCommand() *cobra.Command {
 	cmd.PersistentFlags().StringP("months", "x", viper.GetString("months"), "The months in which the schedule should run --months \"1-2,12\"")
 	cmd.PersistentFlags().StringP("hour", "H", viper.GetString("hour"), "Hour of the day for repeated schedule (0-23): --hour 2")
 	cmd.PersistentFlags().StringP("minute", "M", viper.GetString("minute"), "Minute of the hour for repeated schedule (0-59): --minute 30")
-	cmd.PersistentFlags().IntP("duration", "u", viper.GetInt("duration"), "Duration of the maintenance window in seconds: --duration 3600")
+	cmd.PersistentFlags().StringP("duration", "u", viper.GetString("duration"), "Duration of the maintenance window in seconds or as a duration: --duration 3600|2h|1h30m")
+	cmd.PersistentFlags().String("every", "", "Day and time of a repeated schedule in --timezone: --every \"saturday 02:00\"|\"mon,thu 22:30\"|\"weekdays 01:00\"|\"day 03:00\"|\"day 1,15 03:00\"")
+	cmd.PersistentFlags().String("from-ical", "", "Create a schedule for each event of an iCalendar (.ics) file: --from-ical maintenance.ics")
 
 	return cmd
 }
@@ -895,6 +960,15 @@ func runGetScheduleCommand(cmd *cobra.Command, args []string) error {
 
 // Lists all schedules - retrieves all schedules and displays selected information in tabular format
 func runListScheduleCommand(cmd *cobra.Command, _ []string) error {
+	timezone, _ := cmd.Flags().GetString("timezone")
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
+		}
+	}
 
 	writer, verbose := getOutputContext(cmd)
 
@@ -922,27 +996,133 @@ func runListScheduleCommand(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
-	resp, err := scheduleClient.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
-		&infra.ScheduleServiceListSchedulesParams{}, auth.AddAuthHeader)
+	sources, err := scheduleSources(cmd, ctx, scheduleClient, projectName)
 	if err != nil {
-		return processError(err)
+		return err
 	}
 
-	if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, verbose,
-		"", "error getting schedules"); !proceed {
+	pagination, err := getListPagination(cmd)
+	if err != nil {
 		return err
 	}
+	fetchPagination := pagination
+	if sources != nil {
+		// The schedules applying to the target are only known once all of them are fetched, --limit applies to them
+		fetchPagination.Limit = 0
+	}
+	singleSchedules := make([]infra.SingleScheduleResource, 0)
+	repeatedSchedules := make([]infra.RepeatedScheduleResource, 0)
+	err = fetchPagination.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := scheduleClient.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+			&infra.ScheduleServiceListSchedulesParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, verbose,
+			"", "error getting schedules"); !proceed {
+			return 0, false, err
+		}
+		singleSchedules = append(singleSchedules, resp.JSON200.SingleSchedules...)
+		repeatedSchedules = append(repeatedSchedules, resp.JSON200.RepeatedSchedules...)
+		return len(resp.JSON200.SingleSchedules) + len(resp.JSON200.RepeatedSchedules), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return err
+	}
+	if sources != nil {
+		singleSchedules = slices.DeleteFunc(singleSchedules, func(s infra.SingleScheduleResource) bool {
+			_, ok := sources[scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)]
+			return !ok
+		})
+		repeatedSchedules = slices.DeleteFunc(repeatedSchedules, func(s infra.RepeatedScheduleResource) bool {
+			_, ok := sources[scheduleTarget(s.TargetHostId, s.TargetSiteId, s.TargetRegionId)]
+			return !ok
+		})
+	}
+	// A page holds both kinds of schedules; the limit keeps the single ones first, as listed by the server
+	singleSchedules = limitListItems(singleSchedules, pagination)
+	if pagination.Limit > 0 {
+		repeatedSchedules = repeatedSchedules[:min(len(repeatedSchedules), pagination.Limit-len(singleSchedules))]
+	}
 
 	outputFilter, _ := cmd.Flags().GetString("output-filter")
-	if err := printSchedules(cmd, writer, resp.JSON200.SingleSchedules, resp.JSON200.RepeatedSchedules, validatedOrderBy, &outputFilter, verbose); err != nil {
+	if err := printSchedules(cmd, writer, singleSchedules, repeatedSchedules, validatedOrderBy, &outputFilter, verbose, loc, sources); err != nil {
 		return err
 	}
 
 	return writer.Flush()
 }
 
+// Returns the source of the schedules applying to the --host, --site or --region of a list by the resource ID
+// they target: "direct" for the listed resource, "inherited from site|region" for the levels above it; nil
+// when no target is given
+func scheduleSources(cmd *cobra.Command, ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) (map[string]string, error) {
+	hostArg, _ := cmd.Flags().GetString("host")
+	siteArg, _ := cmd.Flags().GetString("site")
+	regionArg, _ := cmd.Flags().GetString("region")
+
+	var targetID string
+	var levels map[string]string
+	var err error
+	switch {
+	case hostArg != "":
+		var host infra.HostResource
+		if host, err = getHostByNameOrID(ctx, client, projectName, hostArg); err != nil {
+			return nil, err
+		}
+		targetID = derefString(host.ResourceId)
+		levels, err = hostScheduleLevels(ctx, client, projectName, host)
+	case siteArg != "":
+		if targetID, err = resolveScheduleTargetID(ctx, client, projectName, "site", siteArg); err != nil {
+			return nil, err
+		}
+		levels, err = siteScheduleLevels(ctx, client, projectName, targetID, map[string]string{})
+	case regionArg != "":
+		if targetID, err = resolveScheduleTargetID(ctx, client, projectName, "region", regionArg); err != nil {
+			return nil, err
+		}
+		levels, err = regionScheduleLevels(ctx, client, projectName, targetID, map[string]string{})
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]string, len(levels))
+	for id, kind := range levels {
+		if id == targetID {
+			sources[id] = "direct"
+		} else {
+			sources[id] = "inherited from " + kind
+		}
+	}
+	return sources, nil
+}
+
+// Resolves a site or region given by resource ID or by name to its resource ID
+func resolveScheduleTargetID(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, kind string, query string) (string, error) {
+	if (kind == "site" && isSiteResourceID(query)) || (kind == "region" && isRegionResourceID(query)) {
+		return query, nil
+	}
+	_, region, site, err := resolveTargetForSchedule(ctx, client, projectName, kind+":"+query)
+	if err != nil {
+		return "", err
+	}
+	if site != nil {
+		return *site, nil
+	}
+	return *region, nil
+}
+
 // Creates SSH key configuration
 func runCreateScheduleCommand(cmd *cobra.Command, args []string) error {
+	if fromICal, _ := cmd.Flags().GetString("from-ical"); fromICal != "" {
+		return runCreateScheduleFromICal(cmd, fromICal)
+	}
 	name := args[0]
 
 	timezone, _ := cmd.Flags().GetString("timezone")
@@ -959,18 +1139,41 @@ func runCreateScheduleCommand(cmd *cobra.Command, args []string) error {
 	dayOfWeek, _ := cmd.Flags().GetString("day-of-week")
 	dayOfMonth, _ := cmd.Flags().GetString("day-of-month")
 	months, _ := cmd.Flags().GetString("months")
-	duration, _ := cmd.Flags().GetInt("duration")
+	durationIn, _ := cmd.Flags().GetString("duration")
+	every, _ := cmd.Flags().GetString("every")
 
 	// Validate timezone
 	if timezone == "" {
 		return errors.New("timezone must be specified")
 	}
 
-	_, err := time.LoadLocation(timezone)
+	loc, err := time.LoadLocation(timezone)
 	if err != nil {
 		return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
 	}
 
+	duration, err := parseScheduleDuration(durationIn)
+	if err != nil {
+		return err
+	}
+
+	var everySpec cronSpec
+	if every != "" {
+		for _, flag := range []string{"frequency", "day-of-week", "day-of-month", "start-time", "end-time"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("--every cannot be used with --%s", flag)
+			}
+		}
+		if scheduleType == "" {
+			scheduleType = "repeated"
+		} else if scheduleType != "repeated" {
+			return errors.New("--every can only be used with --frequency-type repeated")
+		}
+		if everySpec, err = parseEvery(every, loc, time.Now()); err != nil {
+			return err
+		}
+	}
+
 	if scheduleType != "single" && scheduleType != "repeated" {
 		return errors.New("invalid schedule type, must be 'single' or 'repeated'")
 	}
@@ -995,6 +1198,37 @@ func runCreateScheduleCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if every != "" {
+		cronMonth := "*"
+		if months != "" {
+			if cronMonth, err = convertMonthToCron(months); err != nil {
+				return err
+			}
+		}
+		if duration <= 0 {
+			return errors.New("--duration must be specified for repeated schedules")
+		}
+
+		resp, err := scheduleClient.ScheduleServiceCreateRepeatedScheduleWithResponse(ctx, projectName,
+			infra.ScheduleServiceCreateRepeatedScheduleJSONRequestBody{
+				Name:            &name,
+				ScheduleStatus:  infra.ScheduleStatus(maintenanceType),
+				CronDayWeek:     everySpec.DayWeek,
+				CronDayMonth:    everySpec.DayMonth,
+				CronMonth:       cronMonth,
+				CronHours:       everySpec.Hours,
+				CronMinutes:     everySpec.Minutes,
+				DurationSeconds: int32(duration),
+				TargetHostId:    hostname,
+				TargetRegionId:  region,
+				TargetSiteId:    site,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		return checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", name))
+	}
+
 	// Repeated schedule logic
 	if scheduleType == "repeated" {
 		if startTime == "" || !validateStartTimeFormat(startTime, REPEATED) {
@@ -1048,7 +1282,7 @@ func runCreateScheduleCommand(cmd *cobra.Command, args []string) error {
 		}
 
 		if duration <= 0 {
-			return errors.New("duration must be a positive integer representing seconds")
+			return errors.New("--duration must be specified for repeated schedules")
 		}
 
 		resp, err := scheduleClient.ScheduleServiceCreateRepeatedScheduleWithResponse(ctx, projectName,
@@ -1109,6 +1343,133 @@ func runCreateScheduleCommand(cmd *cobra.Command, args []string) error {
 	return errors.New("cannot create schedule")
 }
 
+// Creates a schedule for each event of an iCalendar file
+func runCreateScheduleFromICal(cmd *cobra.Command, path string) error {
+	timezone, _ := cmd.Flags().GetString("timezone")
+	maintenanceType, _ := cmd.Flags().GetString("maintenance-type")
+	target, _ := cmd.Flags().GetString("target")
+
+	if !strings.HasSuffix(strings.ToLower(path), ".ics") {
+		return errors.New("--from-ical requires that file name ends with .ics")
+	}
+	if err := isSafePath(path); err != nil {
+		return err
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
+	}
+
+	switch maintenanceType {
+	case "", "maintenance":
+		maintenanceType = string(infra.SCHEDULESTATUSMAINTENANCE)
+	case "osupdate":
+		maintenanceType = string(infra.SCHEDULESTATUSOSUPDATE)
+	default:
+		return errors.New("invalid maintenance type, must be 'maintenance' or 'osupdate'")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	events, err := parseICal(file, loc)
+	if err != nil {
+		return fmt.Errorf("invalid iCalendar file %s: %w", path, err)
+	}
+
+	// Convert all events before creating anything so that an unsupported event does not leave a partial import
+	now := time.Now()
+	schedules := make([]*icalSchedule, 0, len(events))
+	for i := range events {
+		schedule, err := events[i].toSchedule(i, now)
+		if err != nil {
+			return err
+		}
+		if schedule == nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Skipping event %q: cancelled, already over or overriding a single occurrence\n", events[i].name(i))
+			continue
+		}
+		for _, warning := range schedule.Warnings {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: event %q: %s\n", schedule.Name, warning)
+		}
+		schedules = append(schedules, schedule)
+	}
+	if len(schedules) == 0 {
+		return fmt.Errorf("no schedules to create from %s", path)
+	}
+
+	ctx, scheduleClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	hostname, region, site, err := resolveTargetForSchedule(ctx, scheduleClient, projectName, target)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, schedule := range schedules {
+		name := schedule.Name
+		if schedule.Repeated {
+			resp, err := scheduleClient.ScheduleServiceCreateRepeatedScheduleWithResponse(ctx, projectName,
+				infra.ScheduleServiceCreateRepeatedScheduleJSONRequestBody{
+					Name:            &name,
+					ScheduleStatus:  infra.ScheduleStatus(maintenanceType),
+					CronDayWeek:     schedule.CronDayWeek,
+					CronDayMonth:    schedule.CronDayMonth,
+					CronMonth:       schedule.CronMonth,
+					CronHours:       schedule.CronHours,
+					CronMinutes:     schedule.CronMinutes,
+					DurationSeconds: schedule.DurationSeconds,
+					TargetHostId:    hostname,
+					TargetRegionId:  region,
+					TargetSiteId:    site,
+				}, auth.AddAuthHeader)
+			if err == nil {
+				err = checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", name))
+			}
+			if err != nil {
+				failed++
+				fmt.Fprintln(cmd.ErrOrStderr(), processError(err))
+				continue
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Repeated schedule %q created\n", name)
+			continue
+		}
+
+		resp, err := scheduleClient.ScheduleServiceCreateSingleScheduleWithResponse(ctx, projectName,
+			infra.ScheduleServiceCreateSingleScheduleJSONRequestBody{
+				Name:           &name,
+				ScheduleStatus: infra.ScheduleStatus(maintenanceType),
+				StartSeconds:   schedule.StartSeconds,
+				EndSeconds:     schedule.EndSeconds,
+				TargetHostId:   hostname,
+				TargetRegionId: region,
+				TargetSiteId:   site,
+			}, auth.AddAuthHeader)
+		if err == nil {
+			err = checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating schedule %s", name))
+		}
+		if err != nil {
+			failed++
+			fmt.Fprintln(cmd.ErrOrStderr(), processError(err))
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Single schedule %q created\n", name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to create %d of %d schedules from %s", failed, len(schedules), path)
+	}
+	return nil
+}
+
 // set schedule
 func runSetScheduleCommand(cmd *cobra.Command, args []string) error {
 	id := args[0]
@@ -1126,7 +1487,7 @@ func runSetScheduleCommand(cmd *cobra.Command, args []string) error {
 	dayOfWeek, _ := cmd.Flags().GetString("day-of-week")
 	dayOfMonth, _ := cmd.Flags().GetString("day-of-month")
 	months, _ := cmd.Flags().GetString("months")
-	duration, _ := cmd.Flags().GetInt("duration")
+	durationIn, _ := cmd.Flags().GetString("duration")
 
 	// Validate timezone
 	if timezone == "" {
@@ -1137,6 +1498,11 @@ func runSetScheduleCommand(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("invalid timezone '%s': %w", timezone, err)
 	}
+
+	duration, err := parseScheduleDuration(durationIn)
+	if err != nil {
+		return err
+	}
 	if maintenanceType != "" {
 		if maintenanceType != "maintenance" && maintenanceType != "osupdate" {
 			return errors.New("invalid maintenance type, must be 'maintenance' or 'osupdate'")
@@ -1241,11 +1607,7 @@ func runSetScheduleCommand(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		if duration != 0 {
-			if duration <= 0 {
-				return errors.New("duration must be a positive integer representing seconds")
-			}
-		} else {
+		if duration == 0 {
 			duration = int(gresp.JSON200.DurationSeconds)
 		}
 
@@ -157,6 +157,45 @@ func (s *CLITestSuite) TestSchedule() {
 	_, err = s.createSchedule(project, name, SArgs)
 	s.NoError(err)
 
+	//create repeated schedule from a human readable day and time
+	SArgs = map[string]string{
+		"timezone":         "America/New_York",
+		"maintenance-type": "maintenance",
+		"target":           siteID,
+		"every":            "\"saturday 02:00\"",
+		"duration":         "2h",
+	}
+	_, err = s.createSchedule(project, name, SArgs)
+	s.NoError(err)
+
+	SArgs["frequency"] = "weekly"
+	_, err = s.createSchedule(project, name, SArgs)
+	s.EqualError(err, "--every cannot be used with --frequency")
+
+	SArgs = map[string]string{
+		"timezone":         "GMT",
+		"maintenance-type": "maintenance",
+		"target":           siteID,
+		"every":            "\"saturday 02:00\"",
+		"duration":         "2 hours",
+	}
+	_, err = s.createSchedule(project, name, SArgs)
+	s.EqualError(err, "invalid duration \"2 hours\", must be a positive number of seconds or a duration such as 2h or 90m")
+
+	//create schedules from an iCalendar file
+	out, err := s.runCommand(fmt.Sprintf("create schedule --from-ical ./testdata/maintenance.ics --target %s --project %s", hostID, project))
+	s.NoError(err)
+	s.Contains(out, `Warning: event "Weekly patch window": UNTIL is ignored, the repeated schedule does not end`)
+	s.Contains(out, `Skipping event "Past outage"`)
+	s.Contains(out, `Repeated schedule "Weekly patch window" created`)
+	s.Contains(out, `Single schedule "Firmware upgrade, rack 4" created`)
+
+	_, err = s.runCommand(fmt.Sprintf("create schedule my-schedule --from-ical ./testdata/maintenance.ics --target %s --project %s", hostID, project))
+	s.EqualError(err, `unknown command "my-schedule" for "orch-cli create schedule"`)
+
+	_, err = s.runCommand(fmt.Sprintf("create schedule --from-ical ./testdata/mock.csv --target %s --project %s", hostID, project))
+	s.EqualError(err, "--from-ical requires that file name ends with .ics")
+
 	//create single schedule target host by site
 	SArgs = map[string]string{
 		"timezone":         "GMT",
@@ -187,6 +226,7 @@ func (s *CLITestSuite) TestSchedule() {
 
 	//List Schedule
 
+	// Schedules longer than the table columns are cut unless --wide is given
 	SArgs = map[string]string{}
 	listOutput, err := s.listSchedule(project, SArgs)
 	s.NoError(err)
@@ -197,11 +237,13 @@ func (s *CLITestSuite) TestSchedule() {
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "once at 1970-01-01 02:46 UTC, open ended",
 			"RESOURCE ID": sresourceID,
 		},
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "every Mon and on day 1 of the month in…",
 			"RESOURCE ID": rresourceID,
 		},
 	}
@@ -211,6 +253,7 @@ func (s *CLITestSuite) TestSchedule() {
 	//List schedule --verbose
 	SArgs = map[string]string{
 		"verbose": "true",
+		"wide":    "",
 	}
 	listOutput, err = s.listSchedule(project, SArgs)
 	s.NoError(err)
@@ -221,6 +264,7 @@ func (s *CLITestSuite) TestSchedule() {
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "once at 1970-01-01 02:46 UTC, open ended",
 			"RESOURCE ID": sresourceID,
 			"STATUS":      "SCHEDULE_STATUS_MAINTENANCE",
 			"TYPE":        "single",
@@ -228,6 +272,7 @@ func (s *CLITestSuite) TestSchedule() {
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "every Mon and on day 1 of the month in Jan at 01:01 UTC for 1s",
 			"RESOURCE ID": rresourceID,
 			"STATUS":      "SCHEDULE_STATUS_MAINTENANCE",
 			"TYPE":        "repeated",
@@ -253,6 +298,7 @@ func (s *CLITestSuite) TestSchedule() {
 		"Name:":            "schedule",
 		"Resource ID:":     "repeatedsche-abcd1234",
 		"Schedule Status:": "SCHEDULE_STATUS_MAINTENANCE",
+		"Schedule:":        "every Mon and on day 1 of the month in Jan at 01:01 GMT for 1s",
 		"Cron Month:":      "1",
 		"Cron DayMonth:":   "1",
 		"Cron DayWeek:":    "1",
@@ -283,6 +329,7 @@ func (s *CLITestSuite) TestSchedule() {
 		"Target Region ID:": "",
 		"Target Site ID:":   "site-abcd1234",
 		"Schedule Status:":  "SCHEDULE_STATUS_MAINTENANCE",
+		"Schedule:":         "once at 1970-01-01 02:46 GMT, open ended",
 		"Start Time:":       "1970-01-01T02:46:40Z",
 		"End Time:":         "",
 	}
@@ -370,6 +417,16 @@ func (s *CLITestSuite) TestSchedule() {
 	_, err = s.setSchedule(project, sresourceID, SArgs)
 	s.EqualError(err, "single schedule --start-time must be specified in format \"YYYY-MM-DD HH:MM\"")
 
+	// List schedules with their times in another timezone
+	SArgs = map[string]string{
+		"timezone": "Asia/Kolkata",
+		"wide":     "",
+	}
+	listOutput, err = s.listSchedule(project, SArgs)
+	s.NoError(err)
+	s.Contains(listOutput, "every Mon and on day 1 of the month in Jan at 06:31 IST for 1s")
+	s.Contains(listOutput, "once at 1970-01-01 08:16 IST, open ended")
+
 	// List schedules with YAML output (order-by is only supported for table output)
 	SArgs = map[string]string{
 		"output-type": "yaml",
@@ -383,6 +440,7 @@ func (s *CLITestSuite) TestSchedule() {
 	SArgs = map[string]string{
 		"output-type": "table",
 		"order-by":    "name",
+		"wide":        "",
 	}
 	tableOutput, err := s.listSchedule(project, SArgs)
 	s.NoError(err)
@@ -392,11 +450,13 @@ func (s *CLITestSuite) TestSchedule() {
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "once at 1970-01-01 02:46 UTC, open ended",
 			"RESOURCE ID": sresourceID,
 		},
 		{
 			"NAME":        name,
 			"TARGET":      siteID,
+			"SCHEDULE":    "every Mon and on day 1 of the month in Jan at 01:01 UTC for 1s",
 			"RESOURCE ID": rresourceID,
 		},
 	}
@@ -513,3 +573,46 @@ func FuzzSchedule(f *testing.F) {
 		}
 	})
 }
+
+func (s *CLITestSuite) TestListScheduleLimit() {
+	// Single schedules are listed before repeated ones
+	out, err := s.runCommand("list schedule --project " + project + " --limit 1")
+	s.NoError(err)
+	s.Contains(out, "singlesche-abcd1234")
+	s.NotContains(out, "repeatedsche-abcd1234")
+
+	_, err = s.runCommand("list schedule --project " + project + " --limit -1")
+	s.EqualError(err, "--limit cannot be negative")
+
+	_, err = s.runCommand("list host --project " + project + " --page-size -5")
+	s.EqualError(err, "--page-size cannot be negative")
+}
+
+func (s *CLITestSuite) TestListScheduleTarget() {
+	// The host is in site-abc123, in region-abcd1234 whose parent is region-abcd1111
+	out, err := s.runCommand("list schedule --host host-abcd1234 --project maintenance-schedules")
+	s.NoError(err)
+	s.Contains(out, "SOURCE")
+	s.Regexp(`weekly-patch\s*\|region-abcd1111\s*\|inherited from region\s*\|`, out)
+	s.Regexp(`site-outage\s*\|site-abc123\s*\|inherited from site\s*\|`, out)
+	s.NotContains(out, "other-site")
+
+	out, err = s.runCommand("list schedule --site site-abcd2222 --project maintenance-schedules -o json")
+	s.NoError(err)
+	s.Contains(out, `"Source":"direct"`)
+	s.Contains(out, `"Source":"inherited from region"`)
+	s.NotContains(out, "site-outage")
+
+	out, err = s.runCommand("list schedule --region region-abcd1234 --project maintenance-schedules")
+	s.NoError(err)
+	s.Contains(out, "weekly-patch")
+	s.NotContains(out, "site-outage")
+
+	out, err = s.runCommand("list schedule --host host-abcd1234 --limit 1 --project maintenance-schedules")
+	s.NoError(err)
+	s.Contains(out, "site-outage")
+	s.NotContains(out, "weekly-patch")
+
+	_, err = s.runCommand("list schedule --host host-abcd1234 --site site-abc123 --project maintenance-schedules")
+	s.Error(err)
+}
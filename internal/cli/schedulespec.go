@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+)
+
+const scheduleDisplayTimeFormat = "2006-01-02 15:04 MST"
+
+var cronWeekdayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// cronSpec holds the day and time fields of a repeated schedule, in UTC
+type cronSpec struct {
+	DayWeek  string
+	DayMonth string
+	Hours    string
+	Minutes  string
+}
+
+// Parses a --duration value given either in seconds or as a duration such as 2h or 1h30m
+func parseScheduleDuration(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0, errors.New("duration must be a positive number of seconds or a duration such as 2h or 90m")
+		}
+		return seconds, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < time.Second || d%time.Second != 0 {
+		return 0, fmt.Errorf("invalid duration %q, must be a positive number of seconds or a duration such as 2h or 90m", value)
+	}
+	return int(d / time.Second), nil
+}
+
+// Parses an --every expression into UTC cron fields. The expression is a day selector followed by a time:
+// "saturday 02:00", "mon,wed,fri 22:30", "weekdays 01:00", "weekends 01:00", "day 03:00" (every day)
+// or "day 1,15 03:00" (days of the month). The time is in the given location, on the date of now.
+func parseEvery(every string, loc *time.Location, now time.Time) (cronSpec, error) {
+	fields := strings.Fields(strings.ToLower(every))
+	if len(fields) < 2 || len(fields) > 3 {
+		return cronSpec{}, fmt.Errorf("invalid --every %q, expected a day and a time such as \"saturday 02:00\"", every)
+	}
+
+	clock, err := time.Parse("15:04", fields[len(fields)-1])
+	if err != nil {
+		return cronSpec{}, fmt.Errorf("invalid --every %q, the time must be in format \"HH:MM\"", every)
+	}
+
+	// Cron fields are in UTC; converting the time may move the occurrence to the previous or next day
+	local := time.Date(now.In(loc).Year(), now.In(loc).Month(), now.In(loc).Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	utc := local.UTC()
+	dayShift := int(time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+
+	spec := cronSpec{DayWeek: "*", DayMonth: "*", Hours: strconv.Itoa(utc.Hour()), Minutes: strconv.Itoa(utc.Minute())}
+	days := fields[0]
+	switch {
+	case len(fields) == 3 && days == "day":
+		if dayShift != 0 {
+			return cronSpec{}, errors.New("the time falls on another day in UTC, which cannot be expressed for days of the month")
+		}
+		if spec.DayMonth, err = convertDayOfMonthToCron(fields[1]); err != nil {
+			return cronSpec{}, err
+		}
+		return spec, nil
+	case len(fields) == 3:
+		return cronSpec{}, fmt.Errorf("invalid --every %q, expected a day and a time such as \"saturday 02:00\"", every)
+	case days == "day" || days == "daily":
+		return spec, nil
+	case days == "weekday" || days == "weekdays":
+		days = "1-5"
+	case days == "weekend" || days == "weekends":
+		days = "0,6"
+	}
+
+	cronDays, err := convertDayOfWeekToCron(days)
+	if err != nil {
+		return cronSpec{}, err
+	}
+	spec.DayWeek = shiftCronWeekdays(cronDays, dayShift)
+	return spec, nil
+}
+
+// Moves a comma separated list of cron days of week by the given number of days
+func shiftCronWeekdays(cronDays string, dayShift int) string {
+	days, _ := expandCronField(cronDays, 0, 6)
+	shifted := make([]int, len(days))
+	for i, day := range days {
+		shifted[i] = (day + dayShift + 7) % 7
+	}
+	return joinCronValues(shifted)
+}
+
+// Expands a cron field made of numbers and ranges; ok is false for "*" or unsupported syntax
+func expandCronField(field string, minValue, maxValue int) ([]int, bool) {
+	set := map[int]bool{}
+	for _, part := range strings.Split(strings.TrimSpace(field), ",") {
+		low, high, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(low)
+		if err != nil {
+			return nil, false
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(high); err != nil || end < start {
+				return nil, false
+			}
+		}
+		if start < minValue || end > maxValue {
+			return nil, false
+		}
+		for v := start; v <= end; v++ {
+			set[v] = true
+		}
+	}
+	values := make([]int, 0, len(set))
+	for v := minValue; v <= maxValue; v++ {
+		if set[v] {
+			values = append(values, v)
+		}
+	}
+	return values, true
+}
+
+// Joins cron values in ascending order, dropping duplicates
+func joinCronValues(values []int) string {
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	cron := make([]string, 0, len(sorted))
+	for i, v := range sorted {
+		if i == 0 || v != sorted[i-1] {
+			cron = append(cron, strconv.Itoa(v))
+		}
+	}
+	return strings.Join(cron, ",")
+}
+
+// Formats seconds as a short duration such as 2h, 1h30m or 45s
+func formatScheduleDuration(seconds int) string {
+	d := time.Duration(seconds) * time.Second
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// Describes a repeated schedule in words, with its time shown in the given location
+func describeRepeatedSchedule(schedule infra.RepeatedScheduleResource, loc *time.Location, now time.Time) string {
+	hour, errHour := strconv.Atoi(schedule.CronHours)
+	minute, errMinute := strconv.Atoi(schedule.CronMinutes)
+	weekdays, weekly := expandCronField(schedule.CronDayWeek, 0, 6)
+	monthDays, monthly := expandCronField(schedule.CronDayMonth, 1, 31)
+	months, inMonths := expandCronField(schedule.CronMonth, 1, 12)
+	if errHour != nil || errMinute != nil ||
+		(!weekly && schedule.CronDayWeek != "*") || (!monthly && schedule.CronDayMonth != "*") || (!inMonths && schedule.CronMonth != "*") {
+		return fmt.Sprintf("cron %s %s %s %s %s", schedule.CronMinutes, schedule.CronHours,
+			schedule.CronDayMonth, schedule.CronMonth, schedule.CronDayWeek)
+	}
+
+	utc := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, time.UTC)
+	local := utc.In(loc)
+	dayShift := int(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+	if monthly && dayShift != 0 {
+		// Days of the month cannot be moved to another time zone, keep the schedule in UTC
+		local, dayShift = utc, 0
+	}
+
+	var parts []string
+	switch {
+	case weekly && len(weekdays) < 7:
+		days, _ := expandCronField(shiftCronWeekdays(schedule.CronDayWeek, dayShift), 0, 6)
+		names := make([]string, len(days))
+		for i, day := range days {
+			names[i] = cronWeekdayNames[day]
+		}
+		parts = append(parts, "every "+strings.Join(names, ","))
+	case !monthly:
+		parts = append(parts, "every day")
+	}
+	if monthly {
+		if len(parts) > 0 {
+			parts = append(parts, "and")
+		}
+		parts = append(parts, "on day "+joinCronValues(monthDays)+" of the month")
+	}
+	if inMonths && len(months) < 12 {
+		names := make([]string, len(months))
+		for i, month := range months {
+			names[i] = time.Month(month).String()[:3]
+		}
+		parts = append(parts, "in "+strings.Join(names, ","))
+	}
+	parts = append(parts, "at "+local.Format("15:04 MST"))
+	if schedule.DurationSeconds > 0 {
+		parts = append(parts, "for "+formatScheduleDuration(int(schedule.DurationSeconds)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Describes a single schedule in words, with its times shown in the given location
+func describeSingleSchedule(schedule infra.SingleScheduleResource, loc *time.Location) string {
+	start := time.Unix(int64(schedule.StartSeconds), 0).In(loc).Format(scheduleDisplayTimeFormat)
+	if schedule.EndSeconds == nil {
+		return fmt.Sprintf("once at %s, open ended", start)
+	}
+	end := time.Unix(int64(*schedule.EndSeconds), 0).In(loc).Format(scheduleDisplayTimeFormat)
+	return fmt.Sprintf("once from %s until %s", start, end)
+}
+
+// cronMatcher evaluates the UTC cron fields of a repeated schedule
+type cronMatcher struct {
+	minutes, hours, daysOfMonth, months, daysOfWeek []bool
+	anyDayOfMonth, anyDayOfWeek                     bool
+}
+
+// Builds a matcher for a repeated schedule. As in standard cron, when both the day of the month and the day of the
+// week are restricted, a day matches if either of them matches.
+func newCronMatcher(schedule infra.RepeatedScheduleResource) (*cronMatcher, error) {
+	m := &cronMatcher{}
+	var err error
+	if m.minutes, _, err = parseCronField(schedule.CronMinutes, 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid cron minutes %q: %w", schedule.CronMinutes, err)
+	}
+	if m.hours, _, err = parseCronField(schedule.CronHours, 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid cron hours %q: %w", schedule.CronHours, err)
+	}
+	if m.daysOfMonth, m.anyDayOfMonth, err = parseCronField(schedule.CronDayMonth, 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid cron day of month %q: %w", schedule.CronDayMonth, err)
+	}
+	if m.months, _, err = parseCronField(schedule.CronMonth, 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid cron month %q: %w", schedule.CronMonth, err)
+	}
+	// Day of week accepts 7 as an alias of Sunday
+	if m.daysOfWeek, m.anyDayOfWeek, err = parseCronField(schedule.CronDayWeek, 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid cron day of week %q: %w", schedule.CronDayWeek, err)
+	}
+	m.daysOfWeek[0] = m.daysOfWeek[0] || m.daysOfWeek[7]
+	return m, nil
+}
+
+// Parses a cron field made of "*", numbers, ranges and steps into the set of matching values, indexed by value.
+// wildcard reports whether the field is an unrestricted "*".
+func parseCronField(field string, minValue, maxValue int) (values []bool, wildcard bool, err error) {
+	values = make([]bool, maxValue+1)
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return nil, false, errors.New("empty field")
+	}
+	wildcard = field == "*"
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return nil, false, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+		start, end := minValue, maxValue
+		if rangePart != "*" {
+			low, high, isRange := strings.Cut(rangePart, "-")
+			if start, err = strconv.Atoi(low); err != nil {
+				return nil, false, fmt.Errorf("invalid value %q", low)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(high); err != nil {
+					return nil, false, fmt.Errorf("invalid value %q", high)
+				}
+			} else if hasStep {
+				end = maxValue
+			}
+		}
+		if start < minValue || end > maxValue || start > end {
+			return nil, false, fmt.Errorf("%q is out of range %d-%d", part, minValue, maxValue)
+		}
+		for v := start; v <= end; v += step {
+			values[v] = true
+		}
+	}
+	return values, wildcard, nil
+}
+
+// Reports whether the cron day fields match the UTC date of t
+func (m *cronMatcher) matchesDay(t time.Time) bool {
+	if !m.months[int(t.Month())] {
+		return false
+	}
+	dayOfMonth := m.daysOfMonth[t.Day()]
+	dayOfWeek := m.daysOfWeek[int(t.Weekday())]
+	if m.anyDayOfMonth || m.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// Returns the start of the occurrence of a repeated schedule that is active at t, if any. An occurrence starts at
+// every UTC minute matched by the cron fields and lasts DurationSeconds.
+func activeRepeatedOccurrence(schedule infra.RepeatedScheduleResource, t time.Time) (time.Time, bool, error) {
+	m, err := newCronMatcher(schedule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if schedule.DurationSeconds <= 0 {
+		return time.Time{}, false, nil
+	}
+	t = t.UTC()
+	earliest := t.Add(-time.Duration(schedule.DurationSeconds) * time.Second)
+
+	// Walk back day by day from t, taking the latest matching minute of each day inside (earliest, t]
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for ; !day.Add(24 * time.Hour).Before(earliest); day = day.AddDate(0, 0, -1) {
+		if !m.matchesDay(day) {
+			continue
+		}
+		for hour := 23; hour >= 0; hour-- {
+			if !m.hours[hour] {
+				continue
+			}
+			for minute := 59; minute >= 0; minute-- {
+				if !m.minutes[minute] {
+					continue
+				}
+				start := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+				if start.After(t) {
+					continue
+				}
+				if start.After(earliest) {
+					return start, true, nil
+				}
+				// Earlier minutes of this day and earlier days all start before the window
+				return time.Time{}, false, nil
+			}
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// Returns the starts of the latest occurrences of a repeated schedule in [from, to), at most limit of them
+func repeatedOccurrences(schedule infra.RepeatedScheduleResource, from, to time.Time, limit int) ([]time.Time, error) {
+	m, err := newCronMatcher(schedule)
+	if err != nil {
+		return nil, err
+	}
+	var starts []time.Time
+	m.walk(from, to, func(start time.Time) bool {
+		if len(starts) == limit {
+			starts = starts[1:]
+		}
+		starts = append(starts, start)
+		return true
+	})
+	return starts, nil
+}
+
+// Returns the start of the first occurrence of a repeated schedule in [from, to), if any
+func nextRepeatedOccurrence(schedule infra.RepeatedScheduleResource, from, to time.Time) (time.Time, bool, error) {
+	m, err := newCronMatcher(schedule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	var next time.Time
+	found := false
+	m.walk(from, to, func(start time.Time) bool {
+		next, found = start, true
+		return false
+	})
+	return next, found, nil
+}
+
+// Calls visit with every UTC minute in [from, to) matched by the cron fields, in order, until it returns false
+func (m *cronMatcher) walk(from, to time.Time, visit func(time.Time) bool) {
+	from, to = from.UTC(), to.UTC()
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !m.matchesDay(day) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if !m.hours[hour] {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if !m.minutes[minute] {
+					continue
+				}
+				start := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+				if start.Before(from) {
+					continue
+				}
+				if !start.Before(to) || !visit(start) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reports whether a single schedule is active at t
+func isSingleScheduleActive(schedule infra.SingleScheduleResource, t time.Time) bool {
+	if t.Unix() < int64(schedule.StartSeconds) {
+		return false
+	}
+	return schedule.EndSeconds == nil || t.Unix() < int64(*schedule.EndSeconds)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleDuration(t *testing.T) {
+	tests := []struct {
+		input     string
+		seconds   int
+		wantError bool
+	}{
+		{input: "", seconds: 0},
+		{input: "3600", seconds: 3600},
+		{input: "2h", seconds: 7200},
+		{input: "1h30m", seconds: 5400},
+		{input: "0", wantError: true},
+		{input: "-5m", wantError: true},
+		{input: "1500ms", wantError: true},
+		{input: "two hours", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			seconds, err := parseScheduleDuration(tt.input)
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.seconds, seconds)
+		})
+	}
+}
+
+func TestParseEvery(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	winter := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		every     string
+		loc       *time.Location
+		now       time.Time
+		want      cronSpec
+		wantError string
+	}{
+		{name: "weekday in utc", every: "saturday 02:00", loc: time.UTC, now: winter,
+			want: cronSpec{DayWeek: "6", DayMonth: "*", Hours: "2", Minutes: "0"}},
+		{name: "winter offset", every: "Saturday 02:00", loc: newYork, now: winter,
+			want: cronSpec{DayWeek: "6", DayMonth: "*", Hours: "7", Minutes: "0"}},
+		{name: "summer offset", every: "saturday 02:00", loc: newYork, now: summer,
+			want: cronSpec{DayWeek: "6", DayMonth: "*", Hours: "6", Minutes: "0"}},
+		{name: "next day in utc", every: "fri,sat 22:30", loc: newYork, now: winter,
+			want: cronSpec{DayWeek: "0,6", DayMonth: "*", Hours: "3", Minutes: "30"}},
+		{name: "previous day in utc", every: "weekdays 01:00", loc: tokyo, now: winter,
+			want: cronSpec{DayWeek: "0,1,2,3,4", DayMonth: "*", Hours: "16", Minutes: "0"}},
+		{name: "weekends", every: "weekends 04:15", loc: time.UTC, now: winter,
+			want: cronSpec{DayWeek: "0,6", DayMonth: "*", Hours: "4", Minutes: "15"}},
+		{name: "every day", every: "day 03:00", loc: tokyo, now: winter,
+			want: cronSpec{DayWeek: "*", DayMonth: "*", Hours: "18", Minutes: "0"}},
+		{name: "days of month", every: "day 1,15 03:00", loc: newYork, now: winter,
+			want: cronSpec{DayWeek: "*", DayMonth: "1,15", Hours: "8", Minutes: "0"}},
+		{name: "days of month on another utc day", every: "day 1,15 22:00", loc: newYork, now: winter,
+			wantError: "the time falls on another day in UTC, which cannot be expressed for days of the month"},
+		{name: "missing time", every: "saturday", loc: time.UTC, now: winter,
+			wantError: `invalid --every "saturday", expected a day and a time such as "saturday 02:00"`},
+		{name: "bad time", every: "saturday 2am", loc: time.UTC, now: winter,
+			wantError: `invalid --every "saturday 2am", the time must be in format "HH:MM"`},
+		{name: "bad day", every: "someday 02:00", loc: time.UTC, now: winter,
+			wantError: "invalid day 'someday', must be one of: sun,mon,tue,wed,thu,fri,sat or 0-6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := parseEvery(tt.every, tt.loc, tt.now)
+			if tt.wantError != "" {
+				assert.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, spec)
+		})
+	}
+}
+
+func TestDescribeSchedules(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	winter := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	weekly := infra.RepeatedScheduleResource{CronDayWeek: "0,6", CronDayMonth: "*", CronMonth: "*", CronHours: "3", CronMinutes: "30", DurationSeconds: 7200}
+	assert.Equal(t, "every Sun,Sat at 03:30 UTC for 2h", describeRepeatedSchedule(weekly, time.UTC, winter))
+	assert.Equal(t, "every Fri,Sat at 22:30 EST for 2h", describeRepeatedSchedule(weekly, newYork, winter))
+
+	monthly := infra.RepeatedScheduleResource{CronDayWeek: "*", CronDayMonth: "1-3", CronMonth: "1,2,3", CronHours: "2", CronMinutes: "0", DurationSeconds: 5400}
+	assert.Equal(t, "on day 1,2,3 of the month in Jan,Feb,Mar at 02:00 UTC for 1h30m", describeRepeatedSchedule(monthly, newYork, winter))
+
+	daily := infra.RepeatedScheduleResource{CronDayWeek: "*", CronDayMonth: "*", CronMonth: "*", CronHours: "12", CronMinutes: "5", DurationSeconds: 45}
+	assert.Equal(t, "every day at 07:05 EST for 45s", describeRepeatedSchedule(daily, newYork, winter))
+
+	unsupported := infra.RepeatedScheduleResource{CronDayWeek: "*/2", CronDayMonth: "*", CronMonth: "*", CronHours: "1", CronMinutes: "0"}
+	assert.Equal(t, "cron 0 1 * * */2", describeRepeatedSchedule(unsupported, time.UTC, winter))
+
+	end := 1798768800
+	single := infra.SingleScheduleResource{StartSeconds: 1798761600}
+	assert.Equal(t, "once at 2026-12-31 19:00 EST, open ended", describeSingleSchedule(single, newYork))
+	single.EndSeconds = &end
+	assert.Equal(t, "once from 2027-01-01 00:00 UTC until 2027-01-01 02:00 UTC", describeSingleSchedule(single, time.UTC))
+}
+
+func TestParseCronField(t *testing.T) {
+	values, wildcard, err := parseCronField("*", 0, 6)
+	require.NoError(t, err)
+	assert.True(t, wildcard)
+	assert.Equal(t, []bool{true, true, true, true, true, true, true}, values)
+
+	values, wildcard, err = parseCronField("1-3,5,*/3", 0, 6)
+	require.NoError(t, err)
+	assert.False(t, wildcard)
+	assert.Equal(t, []bool{true, true, true, true, false, true, true}, values)
+
+	values, _, err = parseCronField("10/20", 0, 59)
+	require.NoError(t, err)
+	assert.True(t, values[10] && values[30] && values[50])
+	assert.False(t, values[0] || values[20])
+
+	for _, field := range []string{"", "7", "3-1", "*/0", "mon"} {
+		_, _, err := parseCronField(field, 0, 6)
+		assert.Error(t, err, field)
+	}
+}
+
+func TestActiveRepeatedOccurrence(t *testing.T) {
+	// Saturdays at 23:00 UTC for 3 hours, crossing midnight
+	weekly := infra.RepeatedScheduleResource{CronDayWeek: "6", CronDayMonth: "*", CronMonth: "*", CronHours: "23", CronMinutes: "0", DurationSeconds: 10800}
+	saturday := time.Date(2026, 4, 4, 23, 0, 0, 0, time.UTC)
+
+	start, active, err := activeRepeatedOccurrence(weekly, saturday.Add(90*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.Equal(t, saturday, start)
+
+	_, active, _ = activeRepeatedOccurrence(weekly, saturday)
+	assert.True(t, active, "the window starts at the cron time")
+	_, active, _ = activeRepeatedOccurrence(weekly, saturday.Add(3*time.Hour))
+	assert.False(t, active, "the window ends after the duration")
+	_, active, _ = activeRepeatedOccurrence(weekly, saturday.Add(-time.Minute))
+	assert.False(t, active)
+
+	// Day of month and day of week restricted together match either of them
+	either := infra.RepeatedScheduleResource{CronDayWeek: "1", CronDayMonth: "15", CronMonth: "*", CronHours: "*", CronMinutes: "*/30", DurationSeconds: 600}
+	_, active, _ = activeRepeatedOccurrence(either, time.Date(2026, 4, 15, 10, 35, 0, 0, time.UTC)) // a Wednesday
+	assert.True(t, active)
+	_, active, _ = activeRepeatedOccurrence(either, time.Date(2026, 4, 13, 10, 35, 0, 0, time.UTC)) // a Monday
+	assert.True(t, active)
+	_, active, _ = activeRepeatedOccurrence(either, time.Date(2026, 4, 14, 10, 35, 0, 0, time.UTC))
+	assert.False(t, active)
+	_, active, _ = activeRepeatedOccurrence(either, time.Date(2026, 4, 15, 10, 45, 0, 0, time.UTC))
+	assert.False(t, active)
+
+	invalid := infra.RepeatedScheduleResource{CronDayWeek: "*", CronDayMonth: "*", CronMonth: "13", CronHours: "1", CronMinutes: "0", DurationSeconds: 60}
+	_, _, err = activeRepeatedOccurrence(invalid, saturday)
+	assert.EqualError(t, err, `invalid cron month "13": "13" is out of range 1-12`)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/open-edge-platform/cli/pkg/selector"
+	"github.com/spf13/cobra"
+)
+
+const selectFlag = "select"
+
+// Adds the --select flag to a list command; the command applies it with selectItems
+func addSelectFlag(cmd *cobra.Command) {
+	cmd.Flags().String(selectFlag, "", "Optional client-side expression the listed items must satisfy, evaluated on their JSON fields "+
+		"including nested ones, for all output types, e.g. 'cpuCores >= 16 && hostStatus == \"Running\"'. "+
+		"Supports ==, !=, <, <=, >, >=, =~ (regular expression), in, &&, ||, !, has(), size() and lower()")
+}
+
+// Keeps the items satisfying the --select expression of the command, all of them when it is not set
+func selectItems[T any](cmd *cobra.Command, items []T) ([]T, error) {
+	return selectItemViews(cmd, items, func(item T) (interface{}, error) {
+		return toSelectView(item)
+	})
+}
+
+// Like selectItems, with the JSON view of the items the expression is evaluated on given by view
+func selectItemViews[T any](cmd *cobra.Command, items []T, view func(T) (interface{}, error)) ([]T, error) {
+	expression, _ := cmd.Flags().GetString(selectFlag)
+	if expression == "" {
+		return items, nil
+	}
+	sel, err := selector.Parse(expression)
+	if err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s expression: %w", selectFlag, err))
+	}
+
+	selected := make([]T, 0, len(items))
+	for _, item := range items {
+		v, err := view(item)
+		if err != nil {
+			return nil, err
+		}
+		match, err := sel.MatchView(v)
+		if err != nil {
+			return nil, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s expression: %w", selectFlag, err))
+		}
+		if match {
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+func toSelectView(item interface{}) (interface{}, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var view interface{}
+	if err := json.Unmarshal(data, &view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// Hosts are selected with the CVEs of their instance decoded, e.g. size(instance.existingCves) > 0
+func hostSelectView(host infra.HostResource) (interface{}, error) {
+	view, err := toSelectView(host)
+	if err != nil {
+		return nil, err
+	}
+	if host.Instance == nil || host.Instance.ExistingCves == nil || *host.Instance.ExistingCves == "" {
+		return view, nil
+	}
+	var cves []interface{}
+	if err := json.Unmarshal([]byte(*host.Instance.ExistingCves), &cves); err != nil {
+		return view, nil
+	}
+	if instance, ok := view.(map[string]interface{})["instance"].(map[string]interface{}); ok {
+		instance["existingCves"] = cves
+	}
+	return view, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/open-edge-platform/cli/pkg/selector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestListHostSelect() {
+	out, err := s.runCommand("list host --project some-project --select \"cpuCores == 8 && instance.os.name =~ '^Edge Microvisor'\"")
+	s.NoError(err)
+	s.Contains(out, "host-abc12345")
+
+	out, err = s.runCommand("list host --project some-project --select \"cpuCores >= 16\" --output-type json")
+	s.NoError(err)
+	s.Equal("[]", out)
+
+	_, err = s.runCommand("list host --project some-project --select \"cpuCores >=\"")
+	s.EqualError(err, "invalid --select expression: unexpected end of expression at position 12")
+	s.Equal(e.CodeInvalidArgument, e.CodeOf(err))
+
+	out, err = s.runCommand("list site --project some-project --select \"name != 'site'\"")
+	s.NoError(err)
+	s.NotContains(out, "site-abcd")
+}
+
+func TestHostSelectView(t *testing.T) {
+	cves := `[{"cve_id":"CVE-2024-0001","priority":"critical","affected_packages":["openssl"]}]`
+	host := infra.HostResource{Name: "edge-host-001", Instance: &infra.InstanceResource{ExistingCves: &cves}}
+	view, err := hostSelectView(host)
+	require.NoError(t, err)
+
+	sel, err := selector.Parse(`size(instance.existingCves) == 1 && "critical" in instance.existingCves.priority`)
+	require.NoError(t, err)
+	match, err := sel.MatchView(view)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	// Hosts without an instance have no CVEs
+	view, err = hostSelectView(infra.HostResource{Name: "edge-host-002"})
+	require.NoError(t, err)
+	match, err = sel.MatchView(view)
+	require.NoError(t, err)
+	assert.False(t, match)
+}
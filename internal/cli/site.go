@@ -8,15 +8,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
 
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/open-edge-platform/cli/pkg/rest/network"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -37,13 +42,20 @@ const createSiteExamples = `# Create specific site
 # Create a site in a region by resource ID (default longitude and latitude set to 0)
 orch-cli create site name --project some-project --region region-bbbb1111
 
-# Create a site in a region by name
-orch-cli create site name --project some-project --region "My Region" --longitude 5 --latitude 5
+# Create a site in a region by name, located in decimal degrees
+orch-cli create site name --project some-project --region "My Region" --lat 40.7128 --lng -74.006
+
+# Create a site located by looking its street address up with the geocoding service
+orch-cli create site name --project some-project --region "My Region" --address "1 Main St, New York"
 `
-const deleteSiteExamples = `# Delete a site by resource ID
+const deleteSiteExamples = `# Delete a site by resource ID; it fails with the list of the hosts assigned to the site, if any
 orch-cli delete site site-aaaa1111 --project some-project
 # Delete a site by name
-orch-cli delete site "my-site" --project some-project`
+orch-cli delete site "my-site" --project some-project
+# Unassign the hosts of the site, after confirmation, then delete it
+orch-cli delete site site-aaaa1111 --project some-project --cascade
+# Delete the hosts of the site and their instances without confirmation, then delete it
+orch-cli delete site site-aaaa1111 --project some-project --cascade --delete-hosts --yes`
 
 var queryRegion = "region"
 
@@ -89,7 +101,9 @@ func getListSiteCommand() *cobra.Command {
 	}
 	cmd.PersistentFlags().StringP("region", "r", viper.GetString("region"), "Optional filter provided as part of site list to filter sites by parent region")
 	addListOrderingFilteringPaginationFlags(cmd, "site")
+	addListLimitFlag(cmd, "site")
 	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
 	return cmd
 }
 
@@ -116,20 +130,45 @@ func getCreateSiteCommand() *cobra.Command {
 		RunE:    runCreateSiteCommand,
 	}
 	cmd.PersistentFlags().StringP("region", "r", viper.GetString("region"), "Region to which the site will be deployed: --region region-aaaa1111 or --region \"My Region\"")
-	cmd.PersistentFlags().StringP("latitude", "l", viper.GetString("latitude"), "Optional flag to provide latitude: --latitude 5")
-	cmd.PersistentFlags().StringP("longitude", "g", viper.GetString("longitude"), "Optional flag to provide longitude: longitude 5 ")
+	cmd.PersistentFlags().StringP("latitude", "l", viper.GetString("latitude"), "Optional latitude of the site in decimal degrees, also accepted as --lat: --latitude 40.7128")
+	cmd.PersistentFlags().StringP("longitude", "g", viper.GetString("longitude"), "Optional longitude of the site in decimal degrees, also accepted as --lng: --longitude -74.006")
+	cmd.PersistentFlags().String("address", "", "Optional street address the latitude and longitude of the site are looked up from with the --geocoder-url service")
+	geocoderURL := viper.GetString(geocoderURLFlag)
+	if geocoderURL == "" {
+		geocoderURL = defaultGeocoderURL
+	}
+	cmd.PersistentFlags().String(geocoderURLFlag, geocoderURL, "Search URL of the Nominatim compatible geocoding service resolving --address; the address is sent to it")
+	cmd.MarkFlagsMutuallyExclusive("address", "latitude")
+	cmd.MarkFlagsMutuallyExclusive("address", "longitude")
+	cmd.SetGlobalNormalizationFunc(siteCoordinateFlagAliases)
 	return cmd
 }
 
+// Accepts --lat for --latitude and --lng or --lon for --longitude
+func siteCoordinateFlagAliases(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+	switch name {
+	case "lat":
+		name = "latitude"
+	case "lng", "lon":
+		name = "longitude"
+	}
+	return pflag.NormalizedName(name)
+}
+
 func getDeleteSiteCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "site <name|resourceID> [flags]",
 		Short:   "Delete a site",
 		Example: deleteSiteExamples,
+		Long: "Deletes a site that has no hosts assigned. With --cascade the hosts assigned to the site are listed and, " +
+			"after confirmation, unassigned from it, or deleted with --delete-hosts, before the site is deleted.",
 		Args:    cobra.ExactArgs(1),
 		Aliases: siteAliases,
 		RunE:    runDeleteSiteCommand,
 	}
+	cmd.Flags().Bool("cascade", false, "Unassign the hosts of the site before deleting it")
+	cmd.Flags().Bool("delete-hosts", false, "With --cascade, delete the hosts of the site and their instances instead of unassigning them")
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
 	return cmd
 }
 
@@ -148,20 +187,10 @@ func runListSiteCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Paging
-	pageSize32, offset32, err := getPageSizeOffset(cmd)
+	pagination, err := getListPagination(cmd)
 	if err != nil {
 		return err
 	}
-	var pageSize *int
-	var offset *int
-	if pageSize32 > 0 {
-		v := int(pageSize32)
-		pageSize = &v
-	}
-	if offset32 > 0 {
-		v := int(offset32)
-		offset = &v
-	}
 
 	// Filtering
 	filterSpec := getNonEmptyFlag(cmd, "filter")
@@ -222,33 +251,27 @@ func runListSiteCommand(cmd *cobra.Command, _ []string) error {
 		// For table output, do not send order-by to API (client-side sort)
 		apiOrderBy = nil
 	}
-	for {
+	err = pagination.fetch(func(pageSize int, offset int) (int, bool, error) {
 		resp, err := siteClient.SiteServiceListSitesWithResponse(ctx, projectName, queryRegion,
 			&infra.SiteServiceListSitesParams{
 				Filter:   validatedFilter,
 				OrderBy:  apiOrderBy,
-				PageSize: pageSize,
-				Offset:   offset,
+				PageSize: &pageSize,
+				Offset:   &offset,
 			}, auth.AddAuthHeader)
 		if err != nil {
-			return processError(err)
+			return 0, false, processError(err)
 		}
 		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving sites"); err != nil {
-			return err
+			return 0, false, err
 		}
 		sites = append(sites, resp.JSON200.Sites...)
-		if !resp.JSON200.HasNext {
-			break
-		}
-		// Advance offset for next page
-		if offset == nil {
-			v := len(sites)
-			offset = &v
-		} else {
-			v := *offset + len(resp.JSON200.Sites)
-			offset = &v
-		}
+		return len(resp.JSON200.Sites), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return err
 	}
+	sites = limitListItems(sites, pagination)
 
 	outputFilter, _ := cmd.Flags().GetString("output-filter")
 	if err := printSites(cmd, writer, &sites, validatedOrderBy, &outputFilter, verbose); err != nil {
@@ -274,24 +297,9 @@ func runCreateSiteCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Resolve --region: accept resource ID or region name.
-	var regionID string
-	if isRegionResourceID(regFlag) {
-		regionID = regFlag
-	} else {
-		lresp, err := siteClient.RegionServiceListRegionsWithResponse(ctx, projectName,
-			&infra.RegionServiceListRegionsParams{}, auth.AddAuthHeader)
-		if err != nil {
-			return processError(err)
-		}
-		if err := checkResponse(lresp.HTTPResponse, lresp.Body, "error while retrieving regions"); err != nil {
-			return err
-		}
-		r, err := findRegionByName(lresp.JSON200.Regions, regFlag)
-		if err != nil {
-			return err
-		}
-		regionID = derefString(r.ResourceId)
+	regionID, err := resolveRegionID(ctx, siteClient, projectName, regFlag)
+	if err != nil {
+		return err
 	}
 
 	err = checkName(name, SITE)
@@ -307,6 +315,11 @@ func runCreateSiteCommand(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if address, _ := cmd.Flags().GetString("address"); address != "" {
+		if siteLat, siteLng, err = geocodeSite(cmd, address); err != nil {
+			return err
+		}
+	}
 
 	rresp, err := siteClient.RegionServiceGetRegionWithResponse(ctx, projectName,
 		regionID, auth.AddAuthHeader)
@@ -332,6 +345,51 @@ func runCreateSiteCommand(cmd *cobra.Command, args []string) error {
 	return checkResponse(resp.HTTPResponse, resp.Body, "error while creating site")
 }
 
+// Resolves a region given by resource ID or by name to its resource ID
+func resolveRegionID(ctx context.Context, siteClient infra.ClientWithResponsesInterface, projectName string, region string) (string, error) {
+	if isRegionResourceID(region) {
+		return region, nil
+	}
+	resp, err := siteClient.RegionServiceListRegionsWithResponse(ctx, projectName,
+		&infra.RegionServiceListRegionsParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return "", processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving regions"); err != nil {
+		return "", err
+	}
+	r, err := findRegionByName(resp.JSON200.Regions, region)
+	if err != nil {
+		return "", err
+	}
+	return derefString(r.ResourceId), nil
+}
+
+// Retrieves a site given by resource ID or by name
+func getSiteByNameOrID(ctx context.Context, siteClient infra.ClientWithResponsesInterface, projectName string, query string) (infra.SiteResource, error) {
+	if isSiteResourceID(query) {
+		resp, err := siteClient.SiteServiceGetSiteWithResponse(ctx, projectName,
+			"empty", query, auth.AddAuthHeader)
+		if err != nil {
+			return infra.SiteResource{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting site"); err != nil {
+			return infra.SiteResource{}, err
+		}
+		return *resp.JSON200, nil
+	}
+
+	resp, err := siteClient.SiteServiceListSitesWithResponse(ctx, projectName, queryRegion,
+		&infra.SiteServiceListSitesParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return infra.SiteResource{}, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving sites"); err != nil {
+		return infra.SiteResource{}, err
+	}
+	return findSiteByName(resp.JSON200.Sites, query)
+}
+
 func runGetSiteCommand(cmd *cobra.Command, args []string) error {
 	writer, _ := getOutputContext(cmd)
 	ctx, siteClient, projectName, err := InfraFactory(cmd)
@@ -402,6 +460,10 @@ func runDeleteSiteCommand(cmd *cobra.Command, args []string) error {
 		id = derefString(site.ResourceId)
 	}
 
+	if err := releaseSiteHosts(ctx, cmd, siteClient, projectName, id); err != nil {
+		return err
+	}
+
 	resp, err := siteClient.SiteServiceDeleteSiteWithResponse(ctx, projectName,
 		"empty", id, auth.AddAuthHeader)
 	if err != nil {
@@ -417,7 +479,93 @@ func runDeleteSiteCommand(cmd *cobra.Command, args []string) error {
 	return err
 }
 
+// Checks that no host is assigned to the site before it is deleted; with --cascade the hosts are listed and,
+// after confirmation, unassigned from the site or deleted
+func releaseSiteHosts(ctx context.Context, cmd *cobra.Command, hostClient infra.ClientWithResponsesInterface, projectName, siteID string) error {
+	cascade, _ := cmd.Flags().GetBool("cascade")
+	deleteHosts, _ := cmd.Flags().GetBool("delete-hosts")
+	yes, _ := cmd.Flags().GetBool("yes")
+	if deleteHosts && !cascade {
+		return e.WithCode(e.CodeInvalidArgument, errors.New("--delete-hosts requires --cascade"))
+	}
+
+	filter := fmt.Sprintf("site.resourceId='%s'", siteID)
+	hosts, err := listHostsMatching(ctx, hostClient, projectName, &filter)
+	if err != nil {
+		return err
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+	if !cascade {
+		out = cmd.ErrOrStderr()
+	}
+	fmt.Fprintf(out, "Hosts assigned to site %s:\n", siteID)
+	writer := newOutputWriter(cmd, out)
+	fmt.Fprintf(writer, "RESOURCE ID\tNAME\tHOST STATUS\tSERIAL NUMBER\n")
+	for _, h := range hosts {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", derefString(h.ResourceId), h.Name, outfmt.HostStatus(h), valueOrNone(h.SerialNumber))
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if !cascade {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("site %s cannot be deleted, %d hosts are assigned to it; "+
+			"unassign them or use --cascade", siteID, len(hosts)))
+	}
+
+	action, done := "unassigned from the site", "unassigned from site "+siteID
+	if deleteHosts {
+		action, done = "deleted together with their instances", "deleted"
+	}
+	if !yes {
+		fmt.Fprintf(out, "Warning: %d hosts will be %s.\n", len(hosts), action)
+		fmt.Fprintln(out, "Are you sure you want to proceed? (y/n)")
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+			return errors.New("operation cancelled by user")
+		}
+	}
+
+	for _, h := range hosts {
+		hostID := derefString(h.ResourceId)
+		if deleteHosts {
+			err = deleteHostAndInstance(ctx, hostClient, projectName, h)
+		} else {
+			err = unassignHostSite(ctx, hostClient, projectName, h)
+		}
+		if err != nil {
+			return fmt.Errorf("site %s not deleted, host %s: %w", siteID, hostID, err)
+		}
+		fmt.Fprintf(out, "Host %s %s\n", hostID, done)
+	}
+	return nil
+}
+
+// Removes the site of a host
+func unassignHostSite(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, host infra.HostResource) error {
+	fieldMask := "siteId"
+	noSite := ""
+	resp, err := hostClient.HostServicePatchHostWithResponse(ctx, projectName, derefString(host.ResourceId),
+		&infra.HostServicePatchHostParams{FieldMask: &fieldMask},
+		infra.HostServicePatchHostJSONRequestBody{
+			Name:   host.Name,
+			SiteId: &noSite,
+		}, auth.AddAuthHeader)
+	if err != nil {
+		return processError(err)
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body, "error while unassigning host from site")
+}
+
 func printSites(cmd *cobra.Command, writer io.Writer, sites *[]infra.SiteResource, orderBy *string, outputFilter *string, verbose bool) error {
+	selected, err := selectItems(cmd, *sites)
+	if err != nil {
+		return err
+	}
+	sites = &selected
 	outputType, _ := cmd.Flags().GetString("output-type")
 	outputFormat, err := getSiteOutputFormat(cmd, verbose, true)
 	if err != nil {
@@ -441,14 +589,17 @@ func printSites(cmd *cobra.Command, writer io.Writer, sites *[]infra.SiteResourc
 		NameLimit: -1,
 		Data:      *sites,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 	GenerateOutput(writer, &result)
 	return nil
 }
 
 func getSiteOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (string, error) {
 	const DEFAULT_SITE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.RegionId}}\t{{.Region.Name}}"
-	const DEFAULT_SITE_VERBOSE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.RegionId}}\t{{.Region.Name}}\t{{.SiteLng}}\t{{.SiteLat}}"
-	const DEFAULT_SITE_INSPECT_FORMAT = "Name:\t{{.Name}}\nResource ID:\t{{.ResourceId}}\nRegion Name:\t{{.Region.Name}}\nRegion ID:\t{{.RegionId}}\nLongitude:\t{{.SiteLng}}\nLatitude:\t{{.SiteLat}}\n"
+	const DEFAULT_SITE_VERBOSE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.RegionId}}\t{{.Region.Name}}\t{{degrees .SiteLng}}\t{{degrees .SiteLat}}"
+	const DEFAULT_SITE_INSPECT_FORMAT = "Name:\t{{.Name}}\nResource ID:\t{{.ResourceId}}\nRegion Name:\t{{.Region.Name}}\nRegion ID:\t{{.RegionId}}\nLongitude:\t{{degrees .SiteLng}}\nLatitude:\t{{degrees .SiteLat}}\n"
 
 	if verbose && forList {
 		return DEFAULT_SITE_VERBOSE_FORMAT, nil
@@ -461,6 +612,10 @@ func getSiteOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (string
 
 // Prints output details of site using template-based output
 func printSite(cmd *cobra.Command, writer io.Writer, site *infra.SiteResource) error {
+	if selected, err := printSelectedOutput(cmd, site); selected {
+		return err
+	}
+
 	outputType, _ := cmd.Flags().GetString("output-type")
 	outputFormat, err := getSiteOutputFormat(cmd, true, false)
 	if err != nil {
@@ -479,39 +634,61 @@ func printSite(cmd *cobra.Command, writer io.Writer, site *infra.SiteResource) e
 }
 
 func resolveLatitude(value string) (*int32, error) {
-	defaultVal := int32(0)
+	return resolveCoordinate(value, "latitude", 90)
+}
+
+func resolveLongitude(value string) (*int32, error) {
+	return resolveCoordinate(value, "longitude", 180)
+}
+
+// Converts a coordinate given in decimal degrees to the E7 format of the API, 0 when it is not given
+func resolveCoordinate(value string, name string, limit float64) (*int32, error) {
+	e7 := int32(0)
 	if value == "" {
-		return &defaultVal, nil
+		return &e7, nil
 	}
-
-	parsedValue, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return nil, errors.New("invalid latitude value")
+	degrees, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(degrees) {
+		return nil, fmt.Errorf("invalid %s value", name)
+	}
+	if degrees < -limit || degrees > limit {
+		return nil, fmt.Errorf("invalid %s value %s, it must be between -%g and %g degrees", name, value, limit, limit)
 	}
+	e7 = degreesToE7(degrees)
+	return &e7, nil
+}
 
-	scaling := 10000000
-	int32Value := int32(parsedValue * float64(scaling))
-	return &int32Value, nil
+// Sites store their coordinates as degrees multiplied by 10^7
+func degreesToE7(degrees float64) int32 {
+	return int32(math.Round(degrees * 1e7))
 }
 
-func resolveLongitude(value string) (*int32, error) {
-	defaultVal := int32(0)
-	if value == "" {
-		return &defaultVal, nil
+// Looks the coordinates of a site up from its address, through the proxy and with the CA of the command
+func geocodeSite(cmd *cobra.Command, address string) (*int32, *int32, error) {
+	endpoint, _ := cmd.Flags().GetString(geocoderURLFlag)
+	transport, err := network.NewTransport(networkConfig(cmd))
+	if err != nil {
+		return nil, nil, e.WithCode(e.CodeInvalidArgument, err)
 	}
-
-	parsedValue, err := strconv.ParseFloat(value, 64)
+	client := &http.Client{Transport: transport, Timeout: geocoderTimeout}
+	location, err := geocodeAddress(commandContext(cmd), client, endpoint, address)
 	if err != nil {
-		return nil, errors.New("invalid longitude value")
+		return nil, nil, err
 	}
-
-	scaling := 10000000
-	int32Value := int32(parsedValue * float64(scaling))
-	return &int32Value, nil
+	lat, err := resolveCoordinate(strconv.FormatFloat(location.lat, 'f', -1, 64), "latitude", 90)
+	if err != nil {
+		return nil, nil, err
+	}
+	lng, err := resolveCoordinate(strconv.FormatFloat(location.lng, 'f', -1, 64), "longitude", 180)
+	if err != nil {
+		return nil, nil, err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Address %q located at latitude %g, longitude %g (%s)\n", address, location.lat, location.lng, location.displayName)
+	return lat, lng, nil
 }
 
 // Returns a validated order-by string for the site resource, with hints for valid fields
-func getValidatedSiteOrderBy(_ interface{}, cmd *cobra.Command, siteClient infra.ClientWithResponsesInterface, projectName string) (*string, error) {
+func getValidatedSiteOrderBy(ctx context.Context, cmd *cobra.Command, siteClient infra.ClientWithResponsesInterface, projectName string) (*string, error) {
 	raw, err := cmd.Flags().GetString("order-by")
 	if err != nil {
 		return nil, err
@@ -537,7 +714,7 @@ func getValidatedSiteOrderBy(_ interface{}, cmd *cobra.Command, siteClient infra
 	}
 	pageSize := 1
 	offset := 0
-	resp, err := siteClient.SiteServiceListSitesWithResponse(context.Background(), projectName, queryRegion,
+	resp, err := siteClient.SiteServiceListSitesWithResponse(ctx, projectName, queryRegion,
 		&infra.SiteServiceListSitesParams{
 			OrderBy:  &normalized,
 			PageSize: &pageSize,
@@ -4,7 +4,10 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -84,6 +87,60 @@ func (s *CLITestSuite) TestSite() {
 	_, err = s.createSite(project, name, SArgs)
 	s.EqualError(err, "invalid latitude value")
 
+	//create with latitude out of range
+	SArgs = map[string]string{
+		"region":    "region-abcd1111",
+		"longitude": "5",
+		"latitude":  "91",
+	}
+	_, err = s.createSite(project, name, SArgs)
+	s.EqualError(err, "invalid latitude value 91, it must be between -90 and 90 degrees")
+
+	//create with the short coordinate flags in decimal degrees
+	SArgs = map[string]string{
+		"region": "region-abcd1111",
+		"lat":    "40.7128",
+		"lng":    "-74.006",
+	}
+	_, err = s.createSite(project, name, SArgs)
+	s.NoError(err)
+
+	//create located by address
+	geocoder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "1 Main St, New York" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[{"lat":"40.7128","lon":"-74.006","display_name":"Main Street, New York"}]`)
+	}))
+	defer geocoder.Close()
+	SArgs = map[string]string{
+		"region":       "region-abcd1111",
+		"address":      "1 Main St, New York",
+		"geocoder-url": geocoder.URL,
+	}
+	out, err := s.createSite(project, name, SArgs)
+	s.NoError(err)
+	s.Contains(out, `Address "1 Main St, New York" located at latitude 40.7128, longitude -74.006 (Main Street, New York)`)
+
+	//create with an address the geocoder cannot find
+	SArgs = map[string]string{
+		"region":       "region-abcd1111",
+		"address":      "nowhere",
+		"geocoder-url": geocoder.URL,
+	}
+	_, err = s.createSite(project, name, SArgs)
+	s.EqualError(err, "no location found for address \"nowhere\", give --latitude and --longitude instead")
+
+	//create with both an address and coordinates
+	SArgs = map[string]string{
+		"region":   "region-abcd1111",
+		"address":  "1 Main St, New York",
+		"latitude": "5",
+	}
+	_, err = s.createSite(project, name, SArgs)
+	s.Error(err)
+
 	/////////////////////////////
 	// Test Site Listing
 	/////////////////////////////
@@ -122,8 +179,8 @@ func (s *CLITestSuite) TestSite() {
 			"NAME":        name,
 			"REGION ID":   regionID,
 			"REGION NAME": "region",
-			"SITE LAT":    "50000000",
-			"SITE LNG":    "50000000",
+			"SITE LAT":    "5",
+			"SITE LNG":    "5",
 		},
 	}
 
@@ -145,8 +202,8 @@ func (s *CLITestSuite) TestSite() {
 			"NAME":        name,
 			"REGION ID":   regionID,
 			"REGION NAME": "region",
-			"SITE LAT":    "50000000",
-			"SITE LNG":    "50000000",
+			"SITE LAT":    "5",
+			"SITE LNG":    "5",
 		},
 	}
 
@@ -217,8 +274,8 @@ func (s *CLITestSuite) TestSite() {
 		"Resource ID:": resourceID,
 		"Region ID:":   regionID,
 		"Region Name:": "region",
-		"Latitude:":    "50000000",
-		"Longitude:":   "50000000",
+		"Latitude:":    "5",
+		"Longitude:":   "5",
 	}
 
 	s.compareGetOutput(expectedOutput, parsedOutput)
@@ -233,8 +290,8 @@ func (s *CLITestSuite) TestSite() {
 		"Resource ID:": resourceID,
 		"Region ID:":   regionID,
 		"Region Name:": "region",
-		"Latitude:":    "50000000",
-		"Longitude:":   "50000000",
+		"Latitude:":    "5",
+		"Longitude:":   "5",
 	}
 
 	s.compareGetOutput(expectedOutput, parsedOutput)
@@ -247,9 +304,24 @@ func (s *CLITestSuite) TestSite() {
 	// Test Site Delete
 	/////////////////////////////
 
-	//delete custom config
-	_, err = s.deleteSite(project, resourceID, make(map[string]string))
+	//delete site with hosts assigned
+	out, err = s.deleteSite(project, resourceID, make(map[string]string))
+	s.EqualError(err, "site site-7ceae560 cannot be deleted, 1 hosts are assigned to it; unassign them or use --cascade")
+	s.Contains(out, "Hosts assigned to site site-7ceae560:")
+	s.Regexp(`(?m)^host-abc12345\s+\|edge-host-001\s+\|`, out)
+
+	_, err = s.deleteSite(project, resourceID, map[string]string{"delete-hosts": ""})
+	s.EqualError(err, "--delete-hosts requires --cascade")
+
+	//delete site unassigning its hosts
+	out, err = s.deleteSite(project, resourceID, map[string]string{"cascade": "", "yes": ""})
+	s.NoError(err)
+	s.Contains(out, "Host host-abc12345 unassigned from site site-7ceae560")
+
+	//delete site with its hosts
+	out, err = s.deleteSite(project, resourceID, map[string]string{"cascade": "", "delete-hosts": "", "yes": ""})
 	s.NoError(err)
+	s.Contains(out, "Host host-abc12345 deleted")
 
 	//delete invalid custom config
 	_, err = s.deleteSite(project, "nonexistent-site", make(map[string]string))
@@ -309,3 +381,62 @@ func FuzzSite(f *testing.F) {
 		}
 	})
 }
+
+func TestResolveCoordinate(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  int32
+	}{
+		{"", 0},
+		{"5", 50000000},
+		{"40.7128", 407128000},
+		{"-74.006", -740060000},
+		{"180", 1800000000},
+		{"0.00000005", 1},
+	} {
+		got, err := resolveCoordinate(tc.value, "longitude", 180)
+		if err != nil || *got != tc.want {
+			t.Errorf("resolveCoordinate(%q) = %v, %v, want %d", tc.value, got, err, tc.want)
+		}
+	}
+	for _, value := range []string{"nope", "NaN", "180.1", "-200"} {
+		if _, err := resolveCoordinate(value, "longitude", 180); err == nil {
+			t.Errorf("resolveCoordinate(%q) succeeded, want an error", value)
+		}
+	}
+}
+
+func TestGeocodeAddress(t *testing.T) {
+	var userAgent string
+	geocoder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		switch r.URL.Query().Get("q") {
+		case "fail":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "garbage":
+			fmt.Fprint(w, `[{"lat":"north","lon":"-74.006"}]`)
+		default:
+			fmt.Fprint(w, `[{"lat":"40.7128","lon":"-74.006","display_name":"New York"}]`)
+		}
+	}))
+	defer geocoder.Close()
+
+	location, err := geocodeAddress(context.Background(), geocoder.Client(), geocoder.URL, "New York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.lat != 40.7128 || location.lng != -74.006 || location.displayName != "New York" {
+		t.Errorf("unexpected location %+v", location)
+	}
+	if userAgent != CLIName+"/"+Version {
+		t.Errorf("unexpected User-Agent %q", userAgent)
+	}
+	for _, address := range []string{"fail", "garbage"} {
+		if _, err := geocodeAddress(context.Background(), geocoder.Client(), geocoder.URL, address); err == nil {
+			t.Errorf("geocoding %q succeeded, want an error", address)
+		}
+	}
+	if _, err := geocodeAddress(context.Background(), geocoder.Client(), "not a url", "New York"); err == nil {
+		t.Error("geocoding with an invalid URL succeeded, want an error")
+	}
+}
@@ -10,28 +10,37 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/cli/pkg/format"
 	"github.com/open-edge-platform/cli/pkg/rest/infra"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v2"
 )
 
 const (
 	DEFAULT_SSHKEY_FORMAT              = "table{{.Username}}\t{{str .ResourceId}}"
 	DEFAULT_SSHKEY_LIST_VERBOSE_FORMAT = "table{{.Username}}\t{{str .ResourceId}}\t{{.InUse}}"
-	DEFAULT_SSHKEY_GET_FORMAT          = "Remote User Name: \t{{.Username}}\nResource ID: \t{{str .ResourceId}}\nKey: \t{{.SshKey}}\nIn use by: \t{{.UseHosts}}\n"
+	DEFAULT_SSHKEY_GET_FORMAT          = "Remote User Name: \t{{.Username}}\nResource ID: \t{{str .ResourceId}}\nKey: \t{{.SshKey}}\nFingerprint: \t{{.Fingerprint}}\nComment: \t{{.Comment}}\nIn use by: \t{{.UseHosts}}\n"
 	SSHKEY_OUTPUT_TEMPLATE_ENVVAR      = "ORCH_CLI_SSHKEY_OUTPUT_TEMPLATE"
 	SSHKEY_INSPECT_TEMPLATE_ENVVAR     = "ORCH_CLI_SSHKEY_INSPECT_TEMPLATE"
 )
 
-// SSHKeyWithUsage wraps LocalAccountResource with usage information
+// SSH key types accepted by Edge Orchestrator
+var supportedSSHKeyTypes = []string{ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA521}
+
+// SSHKeyWithUsage wraps LocalAccountResource with usage and key information
 type SSHKeyWithUsage struct {
 	infra.LocalAccountResource
-	InUse    string
-	UseHosts string
+	InUse       string
+	UseHosts    string
+	Fingerprint string
+	Comment     string
 }
 
 const listSSHKeyExamples = `# List all SSH key resources
@@ -39,13 +48,40 @@ orch-cli list sshkey --project some-project
 `
 
 const getSSHKeyExamples = `# Get detailed information about specific SSH key resource using it's name
-orch-cli get sshkey mysshkey --project some-project`
+orch-cli get sshkey mysshkey --project some-project
+
+# Get a SSH key resource using it's resource ID
+orch-cli get sshkey localaccount-1234abcd --project some-project`
 
 const createSSHKeyExamples = `# Create a new SSH key resource with a given name using a public key file as input
-orch-cli create sshkey mysshkey /path/to/publickey.pub --project some-project`
+orch-cli create sshkey mysshkey /path/to/publickey.pub --project some-project
+
+# Create the local accounts listed in a file, key paths are relative to the file
+orch-cli create localaccount --from-file users.yaml --project some-project
+
+# Sample users.yaml
+users:
+  - username: alice
+    sshKeyPath: keys/alice.pub
+  - username: bob
+    sshKeyPath: /home/bob/.ssh/id_ed25519.pub`
+
+// SSHKeyImportFile lists the local accounts created by create sshkey --from-file
+type SSHKeyImportFile struct {
+	Users []SSHKeyImportUser `yaml:"users"`
+}
+
+// SSHKeyImportUser is a local account of an SSHKeyImportFile
+type SSHKeyImportUser struct {
+	Username   string `yaml:"username"`
+	SSHKeyPath string `yaml:"sshKeyPath"`
+}
 
 const deleteSSHKeyExamples = `# Delete a SSH key resource using it's name
-orch-cli delete sshkey mysshkey --project some-project`
+orch-cli delete sshkey mysshkey --project some-project
+
+# Delete a SSH key resource using it's resource ID
+orch-cli delete sshkey localaccount-1234abcd --project some-project`
 
 func printSSHKeys(cmd *cobra.Command, writer io.Writer, sshKeys *[]infra.LocalAccountResource, instances *[]infra.InstanceResource, orderBy *string, outputFilter *string, verbose bool, forList bool) error {
 	outputType, _ := cmd.Flags().GetString("output-type")
@@ -65,9 +101,9 @@ func printSSHKeys(cmd *cobra.Command, writer io.Writer, sshKeys *[]infra.LocalAc
 		filterSpec = *outputFilter
 	}
 
-	// Create wrapper with usage information if instances are provided
+	// Create wrapper with usage information if instances are provided, get always shows the key information
 	var data interface{}
-	if instances != nil && len(*instances) > 0 {
+	if instances != nil && (len(*instances) > 0 || !forList) {
 		keysWithUsage := make([]SSHKeyWithUsage, 0, len(*sshKeys))
 		for _, sshKey := range *sshKeys {
 			inUse := "No"
@@ -83,10 +119,13 @@ func printSSHKeys(cmd *cobra.Command, writer io.Writer, sshKeys *[]infra.LocalAc
 					}
 				}
 			}
+			fingerprint, comment := sshKeyFingerprint(sshKey.SshKey)
 			keysWithUsage = append(keysWithUsage, SSHKeyWithUsage{
 				LocalAccountResource: sshKey,
 				InUse:                inUse,
 				UseHosts:             useHosts,
+				Fingerprint:          fingerprint,
+				Comment:              comment,
 			})
 		}
 		data = keysWithUsage
@@ -102,6 +141,9 @@ func printSSHKeys(cmd *cobra.Command, writer io.Writer, sshKeys *[]infra.LocalAc
 		NameLimit: -1,
 		Data:      data,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -120,6 +162,38 @@ func getSSHKeyOutputFormat(cmd *cobra.Command, verbose bool, forList bool) (stri
 }
 
 // Filters list of SSH keys to find one with specific name
+// localAccountResourceIDPattern matches local account resource IDs: "localaccount-" followed by 8 hex chars.
+var localAccountResourceIDPattern = regexp.MustCompile(`^localaccount-[0-9a-f]{8}$`)
+
+func isLocalAccountResourceID(s string) bool {
+	return localAccountResourceIDPattern.MatchString(s)
+}
+
+// Retrieves an SSH key (local account) given by resource ID or by username
+func getSSHKeyByNameOrID(ctx context.Context, sshKeyClient infra.ClientWithResponsesInterface, projectName string, query string) (*infra.LocalAccountResource, error) {
+	if isLocalAccountResourceID(query) {
+		resp, err := sshKeyClient.LocalAccountServiceGetLocalAccountWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting SSH key configuration"); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	}
+
+	nameFilter := fmt.Sprintf("username=%q", query)
+	resp, err := sshKeyClient.LocalAccountServiceListLocalAccountsWithResponse(ctx, projectName,
+		&infra.LocalAccountServiceListLocalAccountsParams{Filter: &nameFilter}, auth.AddAuthHeader)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting SSH key configuration"); err != nil {
+		return nil, err
+	}
+	return filterSSHKeysByName(resp.JSON200.LocalAccounts, query)
+}
+
 func filterSSHKeysByName(SSHKeys []infra.LocalAccountResource, name string) (*infra.LocalAccountResource, error) {
 	for _, key := range SSHKeys {
 		if key.Username == name {
@@ -163,22 +237,58 @@ func readSSHKeyFromFile(certPath string) (string, error) {
 		return "", fmt.Errorf("ssh key exceeds maximum length of 800 characters")
 	}
 
-	// Validate key format
+	if _, _, err := parseSSHPublicKey(sshKeyString); err != nil {
+		return "", err
+	}
+	return sshKeyString, nil
+}
+
+// Parses a public key in authorized_keys format, checking that it is of a type accepted by
+// Edge Orchestrator and that the key data is well formed and of the declared type
+func parseSSHPublicKey(key string) (ssh.PublicKey, string, error) {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return nil, "", fmt.Errorf("invalid ssh key format: must be ssh-ed25519 or ecdsa-sha2-nistp521")
+	}
+	if !slices.Contains(supportedSSHKeyTypes, fields[0]) {
+		return nil, "", fmt.Errorf("unsupported ssh key type %q: must be ssh-ed25519 or ecdsa-sha2-nistp521, e.g. generate one with 'ssh-keygen -t ed25519'", fields[0])
+	}
+	publicKey, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid ssh key: the key data of the %s key is malformed or truncated", fields[0])
+	}
+	if publicKey.Type() != fields[0] {
+		return nil, "", fmt.Errorf("invalid ssh key: declared as %s but the key data is %s", fields[0], publicKey.Type())
+	}
+
+	// Same format as checked by the API
 	pattern := `^(ssh-ed25519|ecdsa-sha2-nistp521) ([A-Za-z0-9+/=]+) ?(.*)?$`
-	matched, err := regexp.MatchString(pattern, sshKeyString)
+	matched, err := regexp.MatchString(pattern, key)
 	if err != nil {
-		return "", fmt.Errorf("failed to validate ssh key format: %w", err)
+		return nil, "", fmt.Errorf("failed to validate ssh key format: %w", err)
 	}
 	if !matched {
-		return "", fmt.Errorf("invalid ssh key format: must be ssh-ed25519 or ecdsa-sha2-nistp521")
+		return nil, "", fmt.Errorf("invalid ssh key format: must be ssh-ed25519 or ecdsa-sha2-nistp521")
 	}
+	return publicKey, comment, nil
+}
 
-	return sshKeyString, nil
+// Returns the SHA256 fingerprint and the comment of a stored key, keys that cannot be parsed have no fingerprint
+func sshKeyFingerprint(key string) (string, string) {
+	publicKey, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		comment = ""
+		if fields := strings.Fields(key); len(fields) > 2 {
+			comment = strings.Join(fields[2:], " ")
+		}
+		return "<invalid key>", valueOrNone(&comment)
+	}
+	return ssh.FingerprintSHA256(publicKey), valueOrNone(&comment)
 }
 
 func getGetSSHKeyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "sshkey <name> [flags]",
+		Use:     "sshkey <name|resourceID> [flags]",
 		Short:   "Get a SSH Key remote user configuration",
 		Example: getSSHKeyExamples,
 		Args:    cobra.ExactArgs(1),
@@ -204,19 +314,25 @@ func getListSSHKeyCommand() *cobra.Command {
 
 func getCreateSSHKeyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "sshkey [flags]",
+		Use:     "sshkey [<name> <path>] [flags]",
 		Short:   "Creates SSH Key remote user configuration",
 		Example: createSSHKeyExamples,
-		Args:    cobra.ExactArgs(2),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
 		Aliases: sshKeyAliases,
 		RunE:    runCreateSSHKeyCommand,
 	}
+	cmd.Flags().String("from-file", "", "Create the local accounts listed in a yaml file instead of a single one")
 	return cmd
 }
 
 func getDeleteSSHKeyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "sshkey <name> [flags]",
+		Use:     "sshkey <name|resourceID> [flags]",
 		Short:   "Delete a SSH Key remote user configuration",
 		Example: deleteSSHKeyExamples,
 		Args:    cobra.ExactArgs(1),
@@ -234,19 +350,7 @@ func runGetSSHKeyCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	resp, err := sshKeyClient.LocalAccountServiceListLocalAccountsWithResponse(ctx, projectName,
-		&infra.LocalAccountServiceListLocalAccountsParams{}, auth.AddAuthHeader)
-	if err != nil {
-		return processError(err)
-	}
-
-	if proceed, err := processResponse(resp.HTTPResponse, resp.Body, writer, true,
-		"", "error getting SSH key configuration"); !proceed {
-		return err
-	}
-
-	name := args[0]
-	sshKey, err := filterSSHKeysByName(resp.JSON200.LocalAccounts, name)
+	sshKey, err := getSSHKeyByNameOrID(ctx, sshKeyClient, projectName, args[0])
 	if err != nil {
 		return err
 	}
@@ -273,6 +377,10 @@ func runGetSSHKeyCommand(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if selected, err := printSelectedOutput(cmd, *sshKey); selected {
+		return err
+	}
+
 	sshKeys := []infra.LocalAccountResource{*sshKey}
 	var emptyFilter string
 	// Get command always shows full details (forList=false)
@@ -497,6 +605,9 @@ func runListSSHKeyCommand(cmd *cobra.Command, _ []string) error {
 
 // Creates SSH key configuration
 func runCreateSSHKeyCommand(cmd *cobra.Command, args []string) error {
+	if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+		return runCreateSSHKeysFromFile(cmd, fromFile)
+	}
 	name := args[0]
 	path := args[1]
 
@@ -526,6 +637,100 @@ func runCreateSSHKeyCommand(cmd *cobra.Command, args []string) error {
 	return checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("error while creating SSH key from %s", path))
 }
 
+// Creates the local accounts of a file; all keys are read and validated before any account is created,
+// and a failure to create one account does not stop the others
+func runCreateSSHKeysFromFile(cmd *cobra.Command, path string) error {
+	users, err := readSSHKeyImportFile(path)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, len(users))
+	var invalid []string
+	seen := map[string]bool{}
+	for i, user := range users {
+		if err := verifySSHUserName(user.Username); err != nil {
+			invalid = append(invalid, fmt.Sprintf("user %d %q: %v", i+1, user.Username, err))
+			continue
+		}
+		if seen[user.Username] {
+			invalid = append(invalid, fmt.Sprintf("user %d %q: duplicate username", i+1, user.Username))
+			continue
+		}
+		seen[user.Username] = true
+		if user.SSHKeyPath == "" {
+			invalid = append(invalid, fmt.Sprintf("user %d %q: sshKeyPath is required", i+1, user.Username))
+			continue
+		}
+		keyPath := user.SSHKeyPath
+		if !filepath.IsAbs(keyPath) {
+			if err := isSafePath(keyPath); err != nil {
+				invalid = append(invalid, fmt.Sprintf("user %d %q: %v", i+1, user.Username, err))
+				continue
+			}
+			keyPath = filepath.Join(filepath.Dir(path), keyPath)
+		}
+		if keys[i], err = readSSHKeyFromFile(keyPath); err != nil {
+			invalid = append(invalid, fmt.Sprintf("user %d %q: %v", i+1, user.Username, err))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid local accounts in %s, none created:\n  %s", path, strings.Join(invalid, "\n  "))
+	}
+
+	ctx, sshKeyClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for i, user := range users {
+		resp, err := sshKeyClient.LocalAccountServiceCreateLocalAccountWithResponse(ctx, projectName,
+			infra.LocalAccountServiceCreateLocalAccountJSONRequestBody{
+				Username: user.Username,
+				SshKey:   keys[i],
+			}, auth.AddAuthHeader)
+		if err != nil {
+			err = processError(err)
+		} else {
+			err = checkResponse(resp.HTTPResponse, resp.Body, "error while creating local account")
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Local account %s: %v\n", user.Username, err)
+			failed = append(failed, user.Username)
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Local account %s created\n", user.Username)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to create %d of %d local accounts: %s", len(failed), len(users), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// Reads the users of a create sshkey --from-file input
+func readSSHKeyImportFile(path string) ([]SSHKeyImportUser, error) {
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local accounts file: %w", err)
+	}
+	if len(data) > 1<<20 { // 1MB limit
+		return nil, fmt.Errorf("local accounts file too large")
+	}
+
+	var input SSHKeyImportFile
+	if err := yaml.UnmarshalStrict(data, &input); err != nil {
+		return nil, fmt.Errorf("error unmarshalling local accounts file: %v", err)
+	}
+	if len(input.Users) == 0 {
+		return nil, fmt.Errorf("no users found in %s", path)
+	}
+	return input.Users, nil
+}
+
 func getValidatedSSHKeyFilter(
 	ctx context.Context,
 	cmd *cobra.Command,
@@ -567,17 +772,7 @@ func runDeleteSSHKeyCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	gresp, err := sshKeyClient.LocalAccountServiceListLocalAccountsWithResponse(ctx, projectName,
-		&infra.LocalAccountServiceListLocalAccountsParams{}, auth.AddAuthHeader)
-	if err != nil {
-		return processError(err)
-	}
-
-	if err = checkResponse(gresp.HTTPResponse, gresp.Body, "Error getting SSH keys"); err != nil {
-		return err
-	}
-
-	sshKey, err := filterSSHKeysByName(gresp.JSON200.LocalAccounts, name)
+	sshKey, err := getSSHKeyByNameOrID(ctx, sshKeyClient, projectName, name)
 	if err != nil {
 		return err
 	}
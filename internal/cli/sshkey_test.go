@@ -4,8 +4,20 @@
 package cli
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 )
 
 func (s *CLITestSuite) createSSHKey(project string, name string, path string, args commandArgs) (string, error) {
@@ -152,11 +164,20 @@ func (s *CLITestSuite) TestSSHKey() {
 		"Remote User Name:": name,
 		"Resource ID:":      resourceID,
 		"Key:":              "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7... admin@example.com",
+		"Fingerprint:":      "<invalid key>",
+		"Comment:":          "admin@example.com",
 		"In use by:":        "",
 	}
 
 	s.compareGetOutput(expectedOutput, parsedOutput)
 
+	//get SSH key by resource ID
+	getOutput, err = s.getSSHKey(project, "localaccount-abc12345", make(map[string]string))
+	s.NoError(err)
+	parsedOutput = mapGetOutput(getOutput)
+	s.Equal("admin", parsedOutput["Remote User Name:"])
+	s.Equal("localaccount-abc12345", parsedOutput["Resource ID:"])
+
 	/////////////////////////////
 	// Test SSH Key Delete
 	/////////////////////////////
@@ -165,6 +186,10 @@ func (s *CLITestSuite) TestSSHKey() {
 	_, err = s.deleteSSHKey(project, name, make(map[string]string))
 	s.NoError(err)
 
+	//delete SSH key by resource ID
+	_, err = s.deleteSSHKey(project, "localaccount-abc12345", make(map[string]string))
+	s.NoError(err)
+
 	//delete invalid custom config
 	_, err = s.deleteSSHKey(project, "nonexistent-key", make(map[string]string))
 	s.EqualError(err, "no SSH key matches the given name")
@@ -222,3 +247,83 @@ func FuzzSSHKey(f *testing.F) {
 		}
 	})
 }
+
+func (s *CLITestSuite) TestCreateSSHKeysFromFile() {
+	out, err := s.runCommand("create localaccount --from-file ./testdata/localaccounts.yaml --project " + project)
+	s.NoError(err)
+	s.Equal("Local account alice created\nLocal account bob created\n", out)
+
+	// Invalid entries are all reported and nothing is created
+	out, err = s.runCommand("create localaccount --from-file ./testdata/invalidlocalaccounts.yaml --project " + project)
+	s.Empty(out)
+	s.EqualError(err, `invalid local accounts in ./testdata/invalidlocalaccounts.yaml, none created:
+  user 1 "alice": invalid ssh key format: must be ssh-ed25519 or ecdsa-sha2-nistp521
+  user 2 "Bob": input is not a valid SSH username
+  user 3 "carol": sshKeyPath is required`)
+
+	// A failure to create one account does not stop the others
+	key, err := filepath.Abs("./testdata/testpublickey.pub")
+	s.NoError(err)
+	usersFile := filepath.Join(s.T().TempDir(), "users.yaml")
+	s.NoError(os.WriteFile(usersFile, []byte(fmt.Sprintf(`users:
+  - username: existing-user
+    sshKeyPath: %[1]s
+  - username: dave
+    sshKeyPath: %[1]s
+`, key)), 0600))
+	out, err = s.runCommand("create sshkey --from-file " + usersFile + " --project " + project)
+	s.Contains(out, "Local account existing-user: error while creating local account: Conflict")
+	s.Contains(out, "Local account dave created\n")
+	s.EqualError(err, "failed to create 1 of 2 local accounts: existing-user")
+
+	_, err = s.runCommand("create sshkey alice --from-file ./testdata/localaccounts.yaml --project " + project)
+	s.EqualError(err, `unknown command "alice" for "orch-cli create sshkey"`)
+
+	_, err = s.runCommand("create sshkey --from-file ./testdata/values.yaml --project " + project)
+	s.ErrorContains(err, "error unmarshalling local accounts file")
+}
+
+func TestParseSSHPublicKey(t *testing.T) {
+	authorizedKey := func(key interface{}, comment string) string {
+		publicKey, err := ssh.NewPublicKey(key)
+		require.NoError(t, err)
+		return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))) + comment
+	}
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+	ec256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key := authorizedKey(edKey, " alice@laptop")
+	publicKey, comment, err := parseSSHPublicKey(key)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@laptop", comment)
+	fingerprint, comment := sshKeyFingerprint(key)
+	assert.Equal(t, ssh.FingerprintSHA256(publicKey), fingerprint)
+	assert.Equal(t, "alice@laptop", comment)
+
+	_, _, err = parseSSHPublicKey(authorizedKey(&ecKey.PublicKey, ""))
+	assert.NoError(t, err)
+	_, comment = sshKeyFingerprint(authorizedKey(&ecKey.PublicKey, ""))
+	assert.Equal(t, "<none>", comment)
+
+	_, _, err = parseSSHPublicKey(authorizedKey(&rsaKey.PublicKey, ""))
+	assert.EqualError(t, err, `unsupported ssh key type "ssh-rsa": must be ssh-ed25519 or ecdsa-sha2-nistp521, e.g. generate one with 'ssh-keygen -t ed25519'`)
+	_, _, err = parseSSHPublicKey(authorizedKey(&ec256Key.PublicKey, ""))
+	assert.ErrorContains(t, err, `unsupported ssh key type "ecdsa-sha2-nistp256"`)
+
+	// Truncated key data and key data of another type than declared
+	_, _, err = parseSSHPublicKey(key[:40])
+	assert.EqualError(t, err, "invalid ssh key: the key data of the ssh-ed25519 key is malformed or truncated")
+	ecFields := strings.Fields(authorizedKey(&ecKey.PublicKey, ""))
+	_, _, err = parseSSHPublicKey("ssh-ed25519 " + ecFields[1])
+	assert.Error(t, err)
+
+	fingerprint, comment = sshKeyFingerprint("ssh-rsa AAAAB3... admin@example.com")
+	assert.Equal(t, "<invalid key>", fingerprint)
+	assert.Equal(t, "admin@example.com", comment)
+}
@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+var hostSummaryGroupings = []string{"site", "region", "osprofile", "status"}
+
+const summaryHostExamples = `# Show how many hosts of a project are running, provisioning, failed or deauthorized
+orch-cli summary host --project some-project
+
+# Break the counts down per site
+orch-cli summary host --project some-project --by site
+
+# Emit the counts as JSON for a monitoring system
+orch-cli summary host --project some-project --by region -o json
+
+# Sample output
+Hosts:          12
+  Running:      8
+  Provisioning: 1
+  Error:        1
+  Deauthorized: 1
+  Other:        1
+
+Operating systems:
+  Ubuntu 22.04      7
+  Not Provisioned   5
+`
+
+// hostCounts are the numbers of hosts in each state of a summary
+type hostCounts struct {
+	Total        int `json:"total" yaml:"total"`
+	Running      int `json:"running" yaml:"running"`
+	Provisioning int `json:"provisioning" yaml:"provisioning"`
+	Error        int `json:"error" yaml:"error"`
+	Deauthorized int `json:"deauthorized" yaml:"deauthorized"`
+	Other        int `json:"other" yaml:"other"`
+}
+
+// hostSummaryGroup are the counts of the hosts sharing a site, region, OS profile or status
+type hostSummaryGroup struct {
+	Name       string `json:"name" yaml:"name"`
+	hostCounts `yaml:",inline"`
+}
+
+// hostSummary aggregates the hosts of a project, optionally grouped
+type hostSummary struct {
+	hostCounts       `yaml:",inline"`
+	OperatingSystems map[string]int     `json:"operatingSystems" yaml:"operatingSystems"`
+	By               string             `json:"by,omitempty" yaml:"by,omitempty"`
+	Groups           []hostSummaryGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+func getSummaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "summary",
+		Short:             "Summarize Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getSummaryHostCommand(),
+	)
+	return cmd
+}
+
+func getSummaryHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host [flags]",
+		Short: "Counts the hosts of a project per state",
+		Long: "Counts the hosts of the project that are running, provisioning, in error or deauthorized, " +
+			"and the operating systems they run. Hosts in none of these states, e.g. registered hosts waiting " +
+			"for onboarding, are counted as other. --by breaks the counts down per site, region, OS profile or host status.",
+		Example: summaryHostExamples,
+		Args:    cobra.NoArgs,
+		Aliases: hostAliases,
+		RunE:    runSummaryHostCommand,
+	}
+	cmd.Flags().String("by", "", fmt.Sprintf("Group the counts by one of: %s", strings.Join(hostSummaryGroupings, ", ")))
+	cmd.Flags().StringP("filter", "f", "", "Optional filter selecting the hosts to count\nUsage:\n\tCustom filter: --filter \"<custom filter>\" see https://google.aip.dev/160 and API spec. \n\tPredefined filters: --filter provisioned/onboarded/registered/not connected/deauthorized")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	return cmd
+}
+
+func runSummaryHostCommand(cmd *cobra.Command, _ []string) error {
+	by, _ := cmd.Flags().GetString("by")
+	filter, _ := cmd.Flags().GetString("filter")
+	outputType, _ := cmd.Flags().GetString("output-type")
+
+	by = strings.ToLower(by)
+	if by != "" && !slices.Contains(hostSummaryGroupings, by) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --by %q, must be one of: %s", by, strings.Join(hostSummaryGroupings, ", ")))
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	hosts := make([]infra.HostResource, 0)
+	err = listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := hostClient.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{
+				Filter:   filterHelper(filter),
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return 0, false, err
+		}
+		hosts = append(hosts, resp.JSON200.Hosts...)
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	summary := summarizeHosts(hosts, by)
+	if outputType == "json" || outputType == "yaml" {
+		result := CommandResult{
+			OutputAs: toOutputType(outputType),
+			Data:     summary,
+		}
+		GenerateOutput(cmd.OutOrStdout(), &result)
+		return nil
+	}
+	return printHostSummary(cmd, cmd.OutOrStdout(), summary)
+}
+
+// Aggregates hosts into counts per state and operating system, and per group if by is set
+func summarizeHosts(hosts []infra.HostResource, by string) hostSummary {
+	summary := hostSummary{OperatingSystems: map[string]int{}, By: by}
+	groups := map[string]*hostSummaryGroup{}
+	for _, h := range hosts {
+		state := hostSummaryState(h)
+		summary.add(state)
+		summary.OperatingSystems[hostOperatingSystem(h)]++
+		if by == "" {
+			continue
+		}
+		name := hostSummaryGroupName(h, by)
+		group, ok := groups[name]
+		if !ok {
+			group = &hostSummaryGroup{Name: name}
+			groups[name] = group
+		}
+		group.add(state)
+	}
+	for _, group := range groups {
+		summary.Groups = append(summary.Groups, *group)
+	}
+	sort.Slice(summary.Groups, func(i, j int) bool {
+		if summary.Groups[i].Total != summary.Groups[j].Total {
+			return summary.Groups[i].Total > summary.Groups[j].Total
+		}
+		return summary.Groups[i].Name < summary.Groups[j].Name
+	})
+	return summary
+}
+
+func (c *hostCounts) add(state string) {
+	c.Total++
+	switch state {
+	case "running":
+		c.Running++
+	case "provisioning":
+		c.Provisioning++
+	case "error":
+		c.Error++
+	case "deauthorized":
+		c.Deauthorized++
+	default:
+		c.Other++
+	}
+}
+
+// Classifies a host; a deauthorized host counts as such whatever its status, and an error of the host
+// or of its instance takes precedence over the running and provisioning states
+func hostSummaryState(h infra.HostResource) string {
+	if h.CurrentState != nil && *h.CurrentState == infra.HOSTSTATEUNTRUSTED {
+		return "deauthorized"
+	}
+	if h.HostStatus != nil && strings.EqualFold(*h.HostStatus, "invalidated") {
+		return "deauthorized"
+	}
+	if h.HostStatusIndicator != nil && *h.HostStatusIndicator == infra.STATUSINDICATIONERROR {
+		return "error"
+	}
+	if h.Instance != nil {
+		for _, indicator := range []*infra.StatusIndication{h.Instance.InstanceStatusIndicator, h.Instance.ProvisioningStatusIndicator} {
+			if indicator != nil && *indicator == infra.STATUSINDICATIONERROR {
+				return "error"
+			}
+		}
+		if h.Instance.ProvisioningStatusIndicator != nil && *h.Instance.ProvisioningStatusIndicator == infra.STATUSINDICATIONINPROGRESS {
+			return "provisioning"
+		}
+	}
+	if h.HostStatus != nil && strings.EqualFold(*h.HostStatus, "running") {
+		return "running"
+	}
+	return "other"
+}
+
+func hostOperatingSystem(h infra.HostResource) string {
+	if h.Instance != nil && h.Instance.Os != nil && derefString(h.Instance.Os.Name) != "" {
+		return *h.Instance.Os.Name
+	}
+	return "Not Provisioned"
+}
+
+func hostSummaryGroupName(h infra.HostResource, by string) string {
+	switch by {
+	case "site":
+		if h.Site != nil && derefString(h.Site.Name) != "" {
+			return *h.Site.Name
+		}
+		return valueOrNone(h.SiteId)
+	case "region":
+		if h.Site != nil && h.Site.Region != nil && derefString(h.Site.Region.Name) != "" {
+			return *h.Site.Region.Name
+		}
+		if h.Site != nil {
+			return valueOrNone(h.Site.RegionId)
+		}
+		return "<none>"
+	case "osprofile":
+		return hostOperatingSystem(h)
+	default:
+		return outfmt.HostStatus(h)
+	}
+}
+
+func printHostSummary(cmd *cobra.Command, w io.Writer, summary hostSummary) error {
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "Hosts:\t%d\n", summary.Total)
+	fmt.Fprintf(writer, "  Running:\t%d\n", summary.Running)
+	fmt.Fprintf(writer, "  Provisioning:\t%d\n", summary.Provisioning)
+	fmt.Fprintf(writer, "  Error:\t%d\n", summary.Error)
+	fmt.Fprintf(writer, "  Deauthorized:\t%d\n", summary.Deauthorized)
+	fmt.Fprintf(writer, "  Other:\t%d\n", summary.Other)
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if len(summary.OperatingSystems) > 0 {
+		names := make([]string, 0, len(summary.OperatingSystems))
+		for name := range summary.OperatingSystems {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			ci, cj := summary.OperatingSystems[names[i]], summary.OperatingSystems[names[j]]
+			if ci != cj {
+				return ci > cj
+			}
+			return names[i] < names[j]
+		})
+		fmt.Fprintf(w, "\nOperating systems:\n")
+		writer = newOutputWriter(cmd, w)
+		for _, name := range names {
+			fmt.Fprintf(writer, "  %s\t%d\n", name, summary.OperatingSystems[name])
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if summary.By == "" {
+		return nil
+	}
+	fmt.Fprintln(w)
+	writer = newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "%s\tTOTAL\tRUNNING\tPROVISIONING\tERROR\tDEAUTHORIZED\tOTHER\n", strings.ToUpper(summary.By))
+	for _, g := range summary.Groups {
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%d\t%d\t%d\t%d\n", g.Name, g.Total, g.Running, g.Provisioning, g.Error, g.Deauthorized, g.Other)
+	}
+	return writer.Flush()
+}
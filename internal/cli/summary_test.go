@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestSummaryHost() {
+	out, err := s.runCommand("summary host --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^Hosts:\s+\|1$`, out)
+	s.Regexp(`(?m)^  Running:\s+\|1$`, out)
+	s.Regexp(`(?m)^  Edge Microvisor Toolkit 3.0.20250504\s+\|1$`, out)
+	s.NotContains(out, "TOTAL")
+
+	out, err = s.runCommand("summary host --project " + project + " --by status")
+	s.NoError(err)
+	s.Regexp(`(?m)^STATUS\s+\|TOTAL\s+\|RUNNING`, out)
+	s.Regexp(`(?m)^Running\s+\|1\s+\|1\s+\|0`, out)
+
+	out, err = s.runCommand("summary host --project " + project + " --by site -o json")
+	s.NoError(err)
+	var summary hostSummary
+	s.NoError(json.Unmarshal([]byte(out), &summary))
+	s.Equal(1, summary.Total)
+	s.Equal(1, summary.Running)
+	s.Equal(map[string]int{"Edge Microvisor Toolkit 3.0.20250504": 1}, summary.OperatingSystems)
+	s.Len(summary.Groups, 1)
+
+	_, err = s.runCommand("summary host --project " + project + " --by cluster")
+	s.EqualError(err, `invalid --by "cluster", must be one of: site, region, osprofile, status`)
+
+	_, err = s.runCommand("summary host --project nonexistent-project")
+	s.Error(err)
+}
+
+func TestSummarizeHosts(t *testing.T) {
+	running := "Running"
+	untrusted := infra.HOSTSTATEUNTRUSTED
+	hostError := infra.STATUSINDICATIONERROR
+	inProgress := infra.STATUSINDICATIONINPROGRESS
+	ubuntu := &infra.OperatingSystemResource{Name: stringPtr("Ubuntu")}
+	east := &infra.SiteResource{Name: stringPtr("east"), Region: &infra.RegionResource{Name: stringPtr("us")}}
+	west := &infra.SiteResource{Name: stringPtr("west"), Region: &infra.RegionResource{Name: stringPtr("us")}}
+
+	hosts := []infra.HostResource{
+		{HostStatus: &running, Site: east, Instance: &infra.InstanceResource{Os: ubuntu}},
+		{HostStatus: &running, Site: east, Instance: &infra.InstanceResource{Os: ubuntu, InstanceStatusIndicator: &hostError}},
+		{HostStatus: &running, CurrentState: &untrusted, Site: west},
+		{Site: west, Instance: &infra.InstanceResource{Os: ubuntu, ProvisioningStatusIndicator: &inProgress}},
+		{},
+	}
+
+	summary := summarizeHosts(hosts, "region")
+	assert.Equal(t, hostCounts{Total: 5, Running: 1, Provisioning: 1, Error: 1, Deauthorized: 1, Other: 1}, summary.hostCounts)
+	assert.Equal(t, map[string]int{"Ubuntu": 3, "Not Provisioned": 2}, summary.OperatingSystems)
+	assert.Equal(t, []hostSummaryGroup{
+		{Name: "us", hostCounts: hostCounts{Total: 4, Running: 1, Provisioning: 1, Error: 1, Deauthorized: 1}},
+		{Name: "<none>", hostCounts: hostCounts{Total: 1, Other: 1}},
+	}, summary.Groups)
+
+	summary = summarizeHosts(hosts, "site")
+	assert.Equal(t, []string{"east", "west", "<none>"}, []string{summary.Groups[0].Name, summary.Groups[1].Name, summary.Groups[2].Name})
+	assert.Empty(t, summarizeHosts(hosts, "").Groups)
+}
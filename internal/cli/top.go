@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	promrest "github.com/open-edge-platform/cli/pkg/rest/prometheus"
+	promapi "github.com/prometheus/client_golang/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	cpuQueryFlag    = "cpu-query"
+	memoryQueryFlag = "memory-query"
+
+	// Utilization in percent of the CPUs and the memory of the hosts, as reported by the node agents
+	defaultTopCPUQuery    = `100 - cpu_usage_idle{cpu="cpu-total"}`
+	defaultTopMemoryQuery = `mem_used_percent`
+
+	defaultTopInterval = 10 * time.Second
+
+	// Switches to the alternate screen and hides the cursor, and back
+	topEnterScreen = "\033[?1049h\033[?25l"
+	topLeaveScreen = "\033[?25h\033[?1049l"
+	topClearScreen = "\033[H\033[2J"
+)
+
+var topSortKeys = []string{"cpu", "memory", "name"}
+
+const topHostExamples = `# Show the CPU and memory utilization of the hosts of a project, the busiest CPU first
+orch-cli top host --project some-project
+
+# Sort by memory utilization and refresh every 5 seconds until Ctrl+C is pressed
+orch-cli top host --project some-project --sort-by memory --watch --interval 5s
+
+# Use other metrics of the telemetry service, e.g. the load of the hosts
+orch-cli top host --project some-project --cpu-query 'system_load1 * 100 / system_n_cpus'
+`
+
+// topHostRow is the utilization of a host; the values are nil when the telemetry service has no sample of the host
+type topHostRow struct {
+	Name       string   `json:"name" yaml:"name"`
+	ResourceID string   `json:"resourceId" yaml:"resourceId"`
+	Status     string   `json:"status" yaml:"status"`
+	CPU        *float64 `json:"cpuPercent,omitempty" yaml:"cpuPercent,omitempty"`
+	Memory     *float64 `json:"memoryPercent,omitempty" yaml:"memoryPercent,omitempty"`
+}
+
+func getTopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "top",
+		Short:             "Show the resource utilization of Edge Orchestrator resources",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getTopHostCommand(),
+	)
+	return cmd
+}
+
+func getTopHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host [flags]",
+		Short: "Shows the CPU and memory utilization of the hosts of a project",
+		Long: "Shows the CPU and memory utilization of the hosts of the project, in percent, as last reported to the " +
+			"telemetry service (Mimir) by their node agents, sorted by the highest utilization first. Hosts without " +
+			"samples are shown last. --watch refreshes the view until Ctrl+C is pressed when the output is a terminal.",
+		Example: topHostExamples,
+		Args:    cobra.NoArgs,
+		Aliases: hostAliases,
+		RunE:    runTopHostCommand,
+	}
+	cmd.Flags().String("sort-by", "cpu", fmt.Sprintf("Sort the hosts by one of: %s", strings.Join(topSortKeys, ", ")))
+	cmd.Flags().Bool("watch", false, "Refresh the view periodically until Ctrl+C is pressed")
+	cmd.Flags().Duration("interval", defaultTopInterval, "Time between two refreshes with --watch")
+	cmd.Flags().String(cpuQueryFlag, defaultTopCPUQuery, "PromQL query returning the CPU utilization in percent per host GUID")
+	cmd.Flags().String(memoryQueryFlag, defaultTopMemoryQuery, "PromQL query returning the memory utilization in percent per host GUID")
+	cmd.Flags().String(metricsEndpointFlag, configuredMetricsEndpoint(), "Mimir (Prometheus-compatible) base URL")
+	cmd.Flags().String(orgIDFlag, viper.GetString(orgIDFlag), "Mimir tenant ID sent as X-Scope-OrgID")
+	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
+	return cmd
+}
+
+func runTopHostCommand(cmd *cobra.Command, _ []string) error {
+	sortBy, _ := cmd.Flags().GetString("sort-by")
+	watch, _ := cmd.Flags().GetBool("watch")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	cpuQuery, _ := cmd.Flags().GetString(cpuQueryFlag)
+	memoryQuery, _ := cmd.Flags().GetString(memoryQueryFlag)
+	outputType, _ := cmd.Flags().GetString("output-type")
+
+	sortBy = strings.ToLower(sortBy)
+	if !slices.Contains(topSortKeys, sortBy) {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --sort-by %q, must be one of: %s", sortBy, strings.Join(topSortKeys, ", ")))
+	}
+	if watch && outputType != "table" {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--watch only applies to the table output"))
+	}
+	if interval < time.Second {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be at least 1s"))
+	}
+
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	promClient, err := PrometheusClientFactory(cmd)
+	if err != nil {
+		return err
+	}
+	orgID, err := resolveOrgID(cmd)
+	if err != nil {
+		return err
+	}
+	collect := func(ctx context.Context) ([]topHostRow, error) {
+		return collectTopHosts(ctx, hostClient, projectName, promClient, orgID, cpuQuery, memoryQuery, sortBy)
+	}
+
+	out := cmd.OutOrStdout()
+	if !watch || !isTerminal(out) {
+		rows, err := collect(ctx)
+		if err != nil {
+			return err
+		}
+		if outputType == "json" || outputType == "yaml" {
+			GenerateOutput(out, &CommandResult{OutputAs: toOutputType(outputType), Data: rows})
+			return nil
+		}
+		return printTopHosts(cmd, out, rows)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	fmt.Fprint(out, topEnterScreen)
+	defer fmt.Fprint(out, topLeaveScreen)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var rows []topHostRow
+	for {
+		// A failed refresh keeps showing the previous utilization along with the error
+		latest, refreshErr := collect(ctx)
+		if refreshErr == nil {
+			rows = latest
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		var frame bytes.Buffer
+		fmt.Fprintf(&frame, "Project %s - %s\n", projectName, time.Now().UTC().Format(scheduleDisplayTimeFormat))
+		if refreshErr != nil {
+			fmt.Fprintf(&frame, "Refresh failed: %v\n", refreshErr)
+		}
+		fmt.Fprintln(&frame)
+		if err := printTopHosts(cmd, &frame, rows); err != nil {
+			return err
+		}
+		fmt.Fprintf(&frame, "\nRefreshing every %s, press Ctrl+C to quit\n", interval)
+		fmt.Fprint(out, topClearScreen)
+		if _, err := out.Write(frame.Bytes()); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Fetches the hosts of the project and their last CPU and memory utilization, sorted by sortBy
+func collectTopHosts(ctx context.Context, hostClient infra.ClientWithResponsesInterface, projectName string, promClient promapi.Client,
+	orgID string, cpuQuery string, memoryQuery string, sortBy string) ([]topHostRow, error) {
+	hosts, err := listHostsMatching(ctx, hostClient, projectName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, defaultMetricsTimeout)
+	defer cancel()
+	cpu, err := queryHostUtilization(queryCtx, promClient, cpuQuery, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the CPU utilization: %w", err)
+	}
+	memory, err := queryHostUtilization(queryCtx, promClient, memoryQuery, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the memory utilization: %w", err)
+	}
+
+	rows := make([]topHostRow, 0, len(hosts))
+	for _, h := range hosts {
+		row := topHostRow{
+			Name:       h.Name,
+			ResourceID: derefString(h.ResourceId),
+			Status:     outfmt.HostStatus(h),
+		}
+		if guid := strings.ToLower(derefString(h.Uuid)); guid != "" {
+			if value, ok := cpu[guid]; ok {
+				row.CPU = &value
+			}
+			if value, ok := memory[guid]; ok {
+				row.Memory = &value
+			}
+		}
+		rows = append(rows, row)
+	}
+	sortTopHosts(rows, sortBy)
+	return rows, nil
+}
+
+// Returns the values of the query per host GUID; a host reporting several series keeps the highest value
+func queryHostUtilization(ctx context.Context, client promapi.Client, query string, orgID string) (map[string]float64, error) {
+	samples, err := promrest.QueryVector(ctx, client, query, orgID, defaultMetricsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]float64, len(samples))
+	for _, sample := range samples {
+		guid := strings.ToLower(sample.Labels["hostGuid"])
+		if guid == "" {
+			continue
+		}
+		if value, ok := values[guid]; !ok || sample.Value > value {
+			values[guid] = sample.Value
+		}
+	}
+	return values, nil
+}
+
+// Sorts by the highest utilization first, hosts without samples last, or by name
+func sortTopHosts(rows []topHostRow, sortBy string) {
+	key := func(row topHostRow) *float64 {
+		if sortBy == "memory" {
+			return row.Memory
+		}
+		return row.CPU
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if sortBy == "name" {
+			return rows[i].Name < rows[j].Name
+		}
+		a, b := key(rows[i]), key(rows[j])
+		switch {
+		case a == nil || b == nil:
+			return a != nil
+		case *a != *b:
+			return *a > *b
+		default:
+			return rows[i].Name < rows[j].Name
+		}
+	})
+}
+
+func printTopHosts(cmd *cobra.Command, w io.Writer, rows []topHostRow) error {
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No hosts found")
+		return err
+	}
+	writer := newOutputWriter(cmd, w)
+	fmt.Fprintf(writer, "NAME\tRESOURCE ID\tHOST STATUS\tCPU %%\tMEMORY %%\n")
+	for _, row := range rows {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", row.Name, row.ResourceID, row.Status, formatPercent(row.CPU), formatPercent(row.Memory))
+	}
+	return writer.Flush()
+}
+
+func formatPercent(value *float64) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*value, 'f', 1, 64)
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	promapi "github.com/prometheus/client_golang/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// Answers the instant queries of the telemetry service with the vector of the query
+type fakeTelemetryClient struct {
+	vectors map[string]string
+}
+
+func (f *fakeTelemetryClient) URL(ep string, _ map[string]string) *url.URL {
+	return &url.URL{Scheme: "https", Host: "metrics-node-cli.kind.internal", Path: ep}
+}
+
+func (f *fakeTelemetryClient) Do(_ context.Context, req *http.Request) (*http.Response, []byte, error) {
+	body, _ := io.ReadAll(req.Body)
+	values, _ := url.ParseQuery(string(body))
+	result := f.vectors[values.Get("query")]
+	return &http.Response{StatusCode: http.StatusOK}, []byte(`{"status":"success","data":{"resultType":"vector","result":[` + result + `]}}`), nil
+}
+
+func (s *CLITestSuite) TestTopHost() {
+	originalFactory := PrometheusClientFactory
+	s.T().Cleanup(func() {
+		PrometheusClientFactory = originalFactory
+	})
+	PrometheusClientFactory = func(_ *cobra.Command) (promapi.Client, error) {
+		return &fakeTelemetryClient{vectors: map[string]string{
+			defaultTopCPUQuery:    `{"metric":{"hostGuid":"550E8400-E29B-41D4-A716-446655440000"},"value":[1714478400,"37.25"]}`,
+			defaultTopMemoryQuery: `{"metric":{"hostGuid":"550e8400-e29b-41d4-a716-446655440000"},"value":[1714478400,"61"]}`,
+		}}, nil
+	}
+
+	out, err := s.runCommand("top host --project " + project + " --org-id 698fde6a-b721-447a-a7c2-7187d64393c1")
+	s.NoError(err)
+	s.Regexp(`(?m)^NAME\s+\|RESOURCE ID\s+\|HOST STATUS\s+\|CPU %\s+\|MEMORY %$`, out)
+	s.Regexp(`(?m)^edge-host-001\s+\|host-abc12345\s+\|Running\s+\|37.2\s+\|61.0$`, out)
+
+	out, err = s.runCommand("top host --project " + project + " --org-id 698fde6a-b721-447a-a7c2-7187d64393c1 -o json")
+	s.NoError(err)
+	var rows []topHostRow
+	s.NoError(json.Unmarshal([]byte(out), &rows))
+	s.NotEmpty(rows)
+
+	_, err = s.runCommand("top host --project " + project + " --sort-by disk")
+	s.EqualError(err, `invalid --sort-by "disk", must be one of: cpu, memory, name`)
+
+	_, err = s.runCommand("top host --project " + project + " --watch -o json")
+	s.EqualError(err, "--watch only applies to the table output")
+}
+
+func TestSortTopHosts(t *testing.T) {
+	low, high := 10.0, 90.0
+	rows := []topHostRow{
+		{Name: "c"},
+		{Name: "b", CPU: &low, Memory: &high},
+		{Name: "a", CPU: &high},
+	}
+
+	sortTopHosts(rows, "cpu")
+	assert.Equal(t, "a b c", topHostNames(rows))
+
+	sortTopHosts(rows, "memory")
+	assert.Equal(t, "b a c", topHostNames(rows))
+
+	sortTopHosts(rows, "name")
+	assert.Equal(t, "a b c", topHostNames(rows))
+
+	assert.Equal(t, "-", formatPercent(nil))
+	assert.Equal(t, "90.0", formatPercent(&high))
+}
+
+func topHostNames(rows []topHostRow) string {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, row.Name)
+	}
+	return strings.Join(names, " ")
+}
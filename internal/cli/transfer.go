@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const transferHostExamples = `# Move a host to another project, keeping its site and OS profile when the destination has ones of the same name
+orch-cli transfer host host-1234abcd --to-project other-project --project some-project
+
+# Map the sites and OS profiles of the source project to the ones of the destination project
+orch-cli transfer host edge-host-001 --to-project other-project --site-map sites.csv --os-profile-map os-profiles.csv --project some-project
+
+# Show what would be done without changing anything
+orch-cli transfer host host-1234abcd --to-project other-project --dry-run --project some-project
+
+--site-map and --os-profile-map - CSV files of "source,destination" lines, where source is the name or resource ID
+of a site (OS profile) of the source project and destination the name or resource ID of the one replacing it in the
+destination project, e.g.:
+# source,destination
+site-c69a3c81,store-042
+Edge Microvisor Toolkit 3.0.20250617,os-6a4c1f2d
+`
+
+// Largest mapping file that will be read
+const maxTransferMapSize = 1 << 20
+
+// hostTransfer is the registration of a host in the destination project derived from its source project
+type hostTransfer struct {
+	Host          infra.HostResource
+	SourceProject string
+	TargetProject string
+	Record        types.HostRecord
+}
+
+func getTransferCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "transfer",
+		Short:             "Move Edge Orchestrator resources between projects",
+		PersistentPreRunE: checkAuth,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getTransferHostCommand(),
+	)
+	return cmd
+}
+
+func getTransferHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> --to-project <project> [flags]",
+		Short: "Moves a host to another project",
+		Long: "Deauthorizes and deletes the host in the active project and registers it again, with the same serial number, " +
+			"UUID, name, metadata and LVM size, in the destination project. The site and OS profile of the host are " +
+			"looked up in the destination project by name unless mapped by --site-map and --os-profile-map. " +
+			"The destination is checked before the host is removed from the source project. " +
+			"The host gets a new resource ID and must be onboarded again.",
+		Example: transferHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runTransferHostCommand,
+	}
+	cmd.Flags().String("to-project", "", "Project to move the host to (mandatory)")
+	cmd.Flags().String("site-map", "", "CSV file mapping the sites of the source project to the ones of the destination project")
+	cmd.Flags().String("os-profile-map", "", "CSV file mapping the OS profiles of the source project to the ones of the destination project")
+	cmd.Flags().BoolP("dry-run", "d", false, "Check the destination project and print the transfer without changing anything")
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	_ = cmd.MarkFlagRequired("to-project")
+	return cmd
+}
+
+// Moves a host from the active project to the destination project
+func runTransferHostCommand(cmd *cobra.Command, args []string) error {
+	targetProject, _ := cmd.Flags().GetString("to-project")
+	siteMapPath, _ := cmd.Flags().GetString("site-map")
+	osProfileMapPath, _ := cmd.Flags().GetString("os-profile-map")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	siteMap, err := readTransferMap(siteMapPath)
+	if err != nil {
+		return err
+	}
+	osProfileMap, err := readTransferMap(osProfileMapPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, hostClient, sourceProject, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	if sourceProject == targetProject {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("host is already in project %s", targetProject))
+	}
+	host, err := getHostByNameOrID(ctx, hostClient, sourceProject, args[0])
+	if err != nil {
+		return err
+	}
+	if host.Instance != nil && host.Instance.WorkloadMembers != nil && len(*host.Instance.WorkloadMembers) > 0 {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("host %s is a member of a cluster, remove it from the cluster before transferring it", derefString(host.ResourceId)))
+	}
+
+	transfer := newHostTransfer(host, sourceProject, targetProject, siteMap, osProfileMap)
+
+	// The destination is selected through the project flag so that InfraFactory checks the access to it
+	if err := cmd.Flags().Set(project, targetProject); err != nil {
+		return err
+	}
+	targetCtx, targetClient, _, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	respCache := newResponseCache()
+	erringRecords := []types.HostRecord{}
+	// Resolving the site and OS profile in the destination fails before anything is changed
+	resolved, err := sanitizeProvisioningFields(targetCtx, targetCtx, targetClient, targetProject, transfer.Record,
+		respCache, &types.HostRecord{}, &erringRecords, nil)
+	if err != nil {
+		return fmt.Errorf("host cannot be registered in project %s: %w", targetProject, err)
+	}
+
+	printHostTransfer(cmd.OutOrStdout(), transfer, resolved)
+	if dryRun {
+		return nil
+	}
+	if !yes {
+		fmt.Fprintf(cmd.OutOrStdout(), "Warning: the host will be deleted from project %s and get a new resource ID in project %s.\n", sourceProject, targetProject)
+		fmt.Fprintln(cmd.OutOrStdout(), "Are you sure you want to proceed? (y/n)")
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+			return errors.New("operation cancelled by user")
+		}
+	}
+
+	hostID := derefString(host.ResourceId)
+	if host.CurrentState != nil && *host.CurrentState == infra.HOSTSTATEONBOARDED {
+		resp, err := hostClient.HostServiceInvalidateHostWithResponse(ctx, sourceProject,
+			hostID, &infra.HostServiceInvalidateHostParams{}, auth.AddAuthHeader)
+		if err != nil {
+			return processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while invalidating host"); err != nil {
+			return err
+		}
+	}
+	if err := deleteHostAndInstance(ctx, hostClient, sourceProject, host); err != nil {
+		return err
+	}
+
+	// doRegister names the host after the create host argument
+	hostname = host.Name
+	defer func() { hostname = "" }()
+	newHostID, err := doRegister(targetCtx, targetCtx, targetClient, targetProject, transfer.Record, respCache,
+		&types.HostRecord{}, &erringRecords, nil, nil)
+	if err != nil {
+		return fmt.Errorf("host %s was removed from project %s but could not be registered in project %s, "+
+			"register it again with: orch-cli create host %s --project %s %s: %w",
+			hostID, sourceProject, targetProject, host.Name, targetProject, transferRecordFlags(transfer.Record), err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Host %s of project %s transferred to project %s as %s\n", hostID, sourceProject, targetProject, newHostID)
+	return nil
+}
+
+// Derives the record registering the host in the destination project, mapping its site and OS profile
+func newHostTransfer(host infra.HostResource, sourceProject, targetProject string, siteMap, osProfileMap map[string]string) hostTransfer {
+	record := types.HostRecord{
+		Serial:   derefString(host.SerialNumber),
+		UUID:     derefString(host.Uuid),
+		Metadata: formatTransferMetadata(host.Metadata),
+	}
+	if host.UserLvmSize != nil {
+		record.LVMSize = strconv.Itoa(*host.UserLvmSize)
+	}
+
+	siteID := derefString(host.SiteId)
+	var siteName string
+	if host.Site != nil {
+		siteID = valueOrDefault(siteID, derefString(host.Site.ResourceId))
+		siteName = derefString(host.Site.Name)
+	}
+	record.Site = mapTransferResource(siteMap, siteID, siteName)
+
+	if host.Instance != nil {
+		osID := derefString(host.Instance.OsID)
+		var osName string
+		if host.Instance.Os != nil {
+			osID = valueOrDefault(osID, derefString(host.Instance.Os.ResourceId))
+			osName = derefString(host.Instance.Os.Name)
+		}
+		record.OSProfile = mapTransferResource(osProfileMap, osID, osName)
+		if host.Instance.SecurityFeature != nil && *host.Instance.SecurityFeature == infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION {
+			record.Secure = types.SecureTrue
+		} else {
+			record.Secure = types.SecureFalse
+		}
+	}
+
+	return hostTransfer{Host: host, SourceProject: sourceProject, TargetProject: targetProject, Record: record}
+}
+
+// Resources are mapped by resource ID first, then by name; unmapped ones are looked up by name in the destination
+func mapTransferResource(mapping map[string]string, resourceID, name string) string {
+	if target, ok := mapping[resourceID]; ok && resourceID != "" {
+		return target
+	}
+	if target, ok := mapping[name]; ok && name != "" {
+		return target
+	}
+	return name
+}
+
+// Formats the metadata of the host as a metadata string, sorted by key
+func formatTransferMetadata(metadata *[]infra.MetadataItem) string {
+	if metadata == nil {
+		return ""
+	}
+	pairs := make([]string, 0, len(*metadata))
+	for _, item := range *metadata {
+		pairs = append(pairs, item.Key+"="+item.Value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// Reads a CSV file of "source,destination" lines; lines starting with '#' are comments
+func readTransferMap(path string) (map[string]string, error) {
+	mapping := map[string]string{}
+	if path == "" {
+		return mapping, nil
+	}
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxTransferMapSize {
+		return nil, fmt.Errorf("mapping file %s too large", path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid mapping file %s: %w", path, err)
+		}
+		source, target := strings.TrimSpace(line[0]), strings.TrimSpace(line[1])
+		if source == "" || target == "" {
+			return nil, fmt.Errorf("invalid mapping file %s: empty source or destination", path)
+		}
+		if _, ok := mapping[source]; ok {
+			return nil, fmt.Errorf("invalid mapping file %s: %s mapped more than once", path, source)
+		}
+		mapping[source] = target
+	}
+	return mapping, nil
+}
+
+func printHostTransfer(w io.Writer, transfer hostTransfer, resolved *types.HostRecord) {
+	host := transfer.Host
+	fmt.Fprintf(w, "Transfer of host %s (%s) from project %s to project %s:\n", derefString(host.ResourceId), host.Name,
+		transfer.SourceProject, transfer.TargetProject)
+	fmt.Fprintf(w, "  Serial:     %s\n", transfer.Record.Serial)
+	fmt.Fprintf(w, "  UUID:       %s\n", transfer.Record.UUID)
+	if isFeatureEnabled(ProvisioningFeature) {
+		fmt.Fprintf(w, "  Site:       %s -> %s\n", hostSiteDisplay(host), resolved.Site)
+		fmt.Fprintf(w, "  OS profile: %s -> %s\n", transferOSProfileDisplay(host), resolved.OSProfile)
+		fmt.Fprintf(w, "  Metadata:   %s\n", valueOrDefault(resolved.Metadata, "-"))
+	}
+	if transfer.Record.LVMSize != "" {
+		fmt.Fprintf(w, "  LVM size:   %s GB\n", transfer.Record.LVMSize)
+	}
+}
+
+func transferOSProfileDisplay(host infra.HostResource) string {
+	if host.Instance == nil {
+		return "-"
+	}
+	if host.Instance.Os == nil {
+		return valueOrDefault(derefString(host.Instance.OsID), "-")
+	}
+	return fmt.Sprintf("%s (%s)", derefString(host.Instance.Os.Name), derefString(host.Instance.Os.ResourceId))
+}
+
+// Flags of create host registering the record, for the recovery of a failed transfer
+func transferRecordFlags(record types.HostRecord) string {
+	flags := []string{}
+	add := func(name, value string) {
+		if value != "" {
+			flags = append(flags, fmt.Sprintf("--%s %q", name, value))
+		}
+	}
+	add("serial", record.Serial)
+	add("uuid", record.UUID)
+	if isFeatureEnabled(ProvisioningFeature) {
+		add("site", record.Site)
+		add("os-profile", record.OSProfile)
+		add("secure", string(record.Secure))
+		add("metadata", record.Metadata)
+	}
+	add("lvm-size", record.LVMSize)
+	return strings.Join(flags, " ")
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestTransferHost() {
+	dir := s.T().TempDir()
+	siteMap := filepath.Join(dir, "sites.csv")
+	s.NoError(os.WriteFile(siteMap, []byte("# source,destination\nsite-abcd1234,site-abcd5678\n"), 0600))
+	osProfileMap := filepath.Join(dir, "os-profiles.csv")
+	s.NoError(os.WriteFile(osProfileMap, []byte("Edge Microvisor Toolkit 3.0.20250101,os-1234abcd\n"), 0600))
+
+	out, err := s.runCommand("transfer host host-abcd1003 --to-project other-project --site-map " + siteMap +
+		" --os-profile-map " + osProfileMap + " --dry-run --project some-project")
+	s.NoError(err)
+	s.Contains(out, "Transfer of host host-abcd1003 (edge-host-003) from project some-project to project other-project:\n")
+	s.Contains(out, "  Site:       store-042 (site-abcd1234) -> site-abcd5678\n")
+	s.Contains(out, "  OS profile: Edge Microvisor Toolkit 3.0.20250101 (os-abcd1003) -> os-1234abcd\n")
+	s.Contains(out, "  Metadata:   environment=production&rack=r12\n")
+	s.NotContains(out, "transferred")
+
+	out, err = s.runCommand("transfer host host-abcd1003 --to-project other-project --site-map " + siteMap +
+		" --os-profile-map " + osProfileMap + " --yes --project some-project")
+	s.NoError(err)
+	s.Contains(out, "Host host-abcd1003 of project some-project transferred to project other-project as host-1111abcd\n")
+
+	// Without mapping the OS profile is looked up by name in the destination and not found, so nothing is changed
+	_, err = s.runCommand("transfer host host-abcd1003 --to-project other-project --site-map " + siteMap + " --yes --project some-project")
+	s.EqualError(err, "host cannot be registered in project other-project: OS Profile not found")
+
+	_, err = s.runCommand("transfer host host-abcd1003 --to-project some-project --project some-project")
+	s.EqualError(err, "host is already in project some-project")
+
+	_, err = s.runCommand("transfer host host-abcd1003 --project some-project")
+	s.Error(err)
+}
+
+func TestReadTransferMap(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "map.csv")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	mapping, err := readTransferMap("")
+	require.NoError(t, err)
+	assert.Empty(t, mapping)
+
+	mapping, err = readTransferMap(write("# sites\nsite-abcd1234, store-042\nold-site,new-site\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"site-abcd1234": "store-042", "old-site": "new-site"}, mapping)
+
+	_, err = readTransferMap(write("a,b\na,c\n"))
+	assert.ErrorContains(t, err, "a mapped more than once")
+
+	_, err = readTransferMap(write("a,b,c\n"))
+	assert.ErrorContains(t, err, "wrong number of fields")
+
+	_, err = readTransferMap(write("a,\n"))
+	assert.ErrorContains(t, err, "empty source or destination")
+}
+
+func TestNewHostTransfer(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	secure := infra.SECURITYFEATURESECUREBOOTANDFULLDISKENCRYPTION
+	lvmSize := 20
+	host := infra.HostResource{
+		ResourceId:   stringPtr("host-1234abcd"),
+		SerialNumber: stringPtr("2500JF3"),
+		Uuid:         stringPtr("4c4c4544-2046-5310-8052-cac04f515233"),
+		SiteId:       stringPtr("site-1234abcd"),
+		Site:         &infra.SiteResource{Name: stringPtr("store-042")},
+		UserLvmSize:  &lvmSize,
+		Instance: &infra.InstanceResource{
+			OsID:            stringPtr("os-1234abcd"),
+			Os:              &infra.OperatingSystemResource{Name: stringPtr("Ubuntu 22.04")},
+			SecurityFeature: &secure,
+		},
+		Metadata: &[]infra.MetadataItem{{Key: "rack", Value: "r12"}, {Key: "env", Value: "prod"}},
+	}
+
+	transfer := newHostTransfer(host, "src", "dst", map[string]string{"store-042": "store-7"}, map[string]string{"os-1234abcd": "Ubuntu 24.04"})
+	assert.Equal(t, "2500JF3", transfer.Record.Serial)
+	assert.Equal(t, "4c4c4544-2046-5310-8052-cac04f515233", transfer.Record.UUID)
+	assert.Equal(t, "store-7", transfer.Record.Site)
+	assert.Equal(t, "Ubuntu 24.04", transfer.Record.OSProfile)
+	assert.Equal(t, "env=prod&rack=r12", transfer.Record.Metadata)
+	assert.Equal(t, "20", transfer.Record.LVMSize)
+	assert.Equal(t, "true", string(transfer.Record.Secure))
+
+	// Unmapped resources keep their names
+	transfer = newHostTransfer(host, "src", "dst", map[string]string{}, map[string]string{})
+	assert.Equal(t, "store-042", transfer.Record.Site)
+	assert.Equal(t, "Ubuntu 22.04", transfer.Record.OSProfile)
+}
@@ -4,8 +4,6 @@
 package cli
 
 import (
-	"context"
-
 	"github.com/open-edge-platform/cli/pkg/auth"
 	"github.com/open-edge-platform/orch-library/go/pkg/loader"
 	"github.com/spf13/cobra"
@@ -17,7 +15,7 @@ func getUploadCommand() *cobra.Command {
 		Aliases:           []string{"load"},
 		Args:              cobra.ExactArgs(1),
 		Short:             "Create catalog resources by uploading YAML files",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		Example:           "orch-cli upload /path/to/resource.yaml --project some-project",
 		RunE:              uploadResources,
 	}
@@ -35,7 +33,7 @@ func uploadResources(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := commandContext(cmd)
 
 	// Get the access token by using the auth mechanism
 	// If no token is available, proceed with empty token (for scenarios without auth)
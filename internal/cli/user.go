@@ -181,6 +181,9 @@ func printUsers(cmd *cobra.Command, writer io.Writer, users []keycloak.UserRepre
 		NameLimit: -1,
 		Data:      users,
 	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
 
 	GenerateOutput(writer, &result)
 	return nil
@@ -190,6 +193,10 @@ func printUser(cmd *cobra.Command, writer io.Writer, user *keycloak.UserRepresen
 	outputType, _ := cmd.Flags().GetString("output-type")
 
 	item := flattenUser(user, groups, roles)
+	if selected, err := printSelectedOutput(cmd, item); selected {
+		return err
+	}
+
 	outputFormat, err := resolveTableOutputTemplate(cmd, DEFAULT_USER_INSPECT_FORMAT, USER_INSPECT_TEMPLATE_ENVVAR)
 	if err != nil {
 		return err
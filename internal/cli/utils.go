@@ -5,7 +5,6 @@ package cli
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,21 +12,32 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/open-edge-platform/cli/internal/cli/interfaces"
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/format"
+	alertapi "github.com/open-edge-platform/cli/pkg/rest/alerting"
 	catapi "github.com/open-edge-platform/cli/pkg/rest/catalog"
 	catutilapi "github.com/open-edge-platform/cli/pkg/rest/catalogutilities"
 	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
 	depapi "github.com/open-edge-platform/cli/pkg/rest/deployment"
+	"github.com/open-edge-platform/cli/pkg/rest/failover"
 	infraapi "github.com/open-edge-platform/cli/pkg/rest/infra"
 	kcapi "github.com/open-edge-platform/cli/pkg/rest/keycloak"
 	mpsapi "github.com/open-edge-platform/cli/pkg/rest/mps"
+	"github.com/open-edge-platform/cli/pkg/rest/network"
+	"github.com/open-edge-platform/cli/pkg/rest/offline"
 	orchapi "github.com/open-edge-platform/cli/pkg/rest/orchutilities"
+	"github.com/open-edge-platform/cli/pkg/rest/policy"
+	"github.com/open-edge-platform/cli/pkg/rest/retry"
 	rpsapi "github.com/open-edge-platform/cli/pkg/rest/rps"
 	tenantapi "github.com/open-edge-platform/cli/pkg/rest/tenancy"
+	"github.com/open-edge-platform/cli/pkg/rest/trace"
 	promapi "github.com/prometheus/client_golang/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -35,6 +45,15 @@ import (
 
 const maxValuesYAMLSize = 1 << 20 // 1 MiB
 
+// Flags of the get commands selecting fields of the resource, see addOutputSelectionFlags
+const (
+	templateFlag = "template"
+	jsonPathFlag = "jsonpath"
+)
+
+// Number of items requested per page by the list commands paginated with listPagination, the maximum of the infra API
+const defaultListPageSize = 100
+
 const (
 	EIMFeature                       = "orchestrator.features.edge-infrastructure-manager.installed"
 	OobFeature                       = "orchestrator.features.edge-infrastructure-manager.oob.installed"
@@ -99,6 +118,10 @@ var KeycloakAdminFactory interfaces.KeycloakAdminFactoryFunc = func(cmd *cobra.C
 	return getKeycloakAdminServiceContext(cmd)
 }
 
+var AlertingFactory interfaces.AlertingFactoryFunc = func(cmd *cobra.Command) (context.Context, alertapi.ClientWithResponsesInterface, string, error) {
+	return getAlertingServiceContext(cmd)
+}
+
 var PrometheusClientFactory interfaces.PrometheusFactoryFunc = func(cmd *cobra.Command) (promapi.Client, error) {
 	return newPrometheusClient(cmd)
 }
@@ -112,7 +135,11 @@ func newPrometheusClient(cmd *cobra.Command) (promapi.Client, error) {
 		return nil, fmt.Errorf("metrics endpoint not configured. Set --%s or run 'orch-cli config set %s <url>'", metricsEndpointFlag, metricsEndpointFlag)
 	}
 
-	roundTripper := metricsAuthRoundTripper{base: promapi.DefaultRoundTripper}
+	base, err := network.NewTransport(networkConfig(cmd))
+	if err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, err)
+	}
+	roundTripper := metricsAuthRoundTripper{base: base}
 	client, err := promapi.NewClient(promapi.Config{
 		Address: endpoint,
 		Client: &http.Client{
@@ -139,19 +166,55 @@ func (rt metricsAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response,
 	return rt.base.RoundTrip(clone)
 }
 
-func getOutputContext(cmd *cobra.Command) (*tabwriter.Writer, bool) {
+// Returns the context of a command, which carries the deadline set by --timeout
+func commandContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+func getOutputContext(cmd *cobra.Command) (*format.TableWriter, bool) {
 	verbose, _ := cmd.Flags().GetBool("verbose")
+	return newOutputWriter(cmd, cmd.OutOrStdout()), verbose
+}
+
+// Largest number of characters of a table cell followed by other cells, unless --wide is given
+const maxTableCellWidth = 40
+
+// Creates a table writer on top of w, honouring the --debug-headers, --wide and --no-color flags
+func newOutputWriter(cmd *cobra.Command, w io.Writer) *format.TableWriter {
 	debugHeadersValue, _ := cmd.Flags().GetBool(debugHeaders)
-	writer := new(tabwriter.Writer)
-	tabindent := tabwriter.TabIndent
+	wide, _ := cmd.Flags().GetBool(wideFlag)
+	tabindent := uint(tabwriter.TabIndent)
 	if debugHeadersValue {
 		tabindent = tabwriter.Debug
 	}
-	writer.Init(cmd.OutOrStdout(), 0, 0, 3, ' ', tabindent)
-	return writer, verbose
+	maxCellWidth := maxTableCellWidth
+	if wide {
+		maxCellWidth = 0
+	}
+	if colorOutput(cmd, w) {
+		return format.NewColorTableWriter(w, 3, tabindent, maxCellWidth)
+	}
+	return format.NewTableWriter(w, 3, tabindent, maxCellWidth)
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Statuses are colored on terminals only, unless --no-color is given or the NO_COLOR environment variable is set
+func colorOutput(cmd *cobra.Command, w io.Writer) bool {
+	if noColor, _ := cmd.Flags().GetBool(noColorFlag); noColor {
+		return false
+	}
+	if os.Getenv(noColorEnv) != "" {
+		return false
+	}
+	if _, paged := w.(*pagerBuffer); paged {
+		return true
+	}
+	return isTerminal(w)
+}
+
+// Get the command context, REST client, and project name given the specified command.
 func getCatalogServiceContext(cmd *cobra.Command) (context.Context, *catapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -161,14 +224,14 @@ func getCatalogServiceContext(cmd *cobra.Command) (context.Context, *catapi.Clie
 	if err != nil {
 		return nil, nil, "", err
 	}
-	catalogClient, err := catapi.NewClientWithResponses(serverAddress, TLS13CatalogClientOption())
+	catalogClient, err := catapi.NewClientWithResponses(serverAddress, TLS13CatalogClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), catalogClient, projectName, nil
+	return commandContext(cmd), catalogClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getCatalogUtilitiesServiceContext(cmd *cobra.Command) (context.Context, *catutilapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -182,10 +245,10 @@ func getCatalogUtilitiesServiceContext(cmd *cobra.Command) (context.Context, *ca
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), catalogUtilitiesClient, projectName, nil
+	return commandContext(cmd), catalogUtilitiesClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getDeploymentServiceContext(cmd *cobra.Command) (context.Context, *depapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -195,14 +258,14 @@ func getDeploymentServiceContext(cmd *cobra.Command) (context.Context, *depapi.C
 	if err != nil {
 		return nil, nil, "", err
 	}
-	deploymentClient, err := depapi.NewClientWithResponses(serverAddress, TLS13DeploymentClientOption())
+	deploymentClient, err := depapi.NewClientWithResponses(serverAddress, TLS13DeploymentClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), deploymentClient, projectName, nil
+	return commandContext(cmd), deploymentClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getClusterServiceContext(cmd *cobra.Command) (context.Context, *coapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -212,14 +275,14 @@ func getClusterServiceContext(cmd *cobra.Command) (context.Context, *coapi.Clien
 	if err != nil {
 		return nil, nil, "", err
 	}
-	coClient, err := coapi.NewClientWithResponses(serverAddress, TLS13ClusterClientOption())
+	coClient, err := coapi.NewClientWithResponses(serverAddress, TLS13ClusterClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), coClient, projectName, nil
+	return commandContext(cmd), coClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getInfraServiceContext(cmd *cobra.Command) (context.Context, *infraapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -229,14 +292,14 @@ func getInfraServiceContext(cmd *cobra.Command) (context.Context, *infraapi.Clie
 	if err != nil {
 		return nil, nil, "", err
 	}
-	infraClient, err := infraapi.NewClientWithResponses(serverAddress, TLS13InfraClientOption())
+	infraClient, err := infraapi.NewClientWithResponses(serverAddress, TLS13InfraClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), infraClient, projectName, nil
+	return commandContext(cmd), infraClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getRpsServiceContext(cmd *cobra.Command) (context.Context, *rpsapi.ClientWithResponses, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -246,14 +309,14 @@ func getRpsServiceContext(cmd *cobra.Command) (context.Context, *rpsapi.ClientWi
 	if err != nil {
 		return nil, nil, "", err
 	}
-	rpsClient, err := rpsapi.NewClientWithResponses(serverAddress, TLS13RPSClientOption())
+	rpsClient, err := rpsapi.NewClientWithResponses(serverAddress, TLS13RPSClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), rpsClient, projectName, nil
+	return commandContext(cmd), rpsClient, projectName, nil
 }
 
-// Get the new background context, MPS REST client, and project name given the specified command.
+// Get the command context, MPS REST client, and project name given the specified command.
 func getMpsServiceContext(cmd *cobra.Command) (context.Context, mpsapi.ClientWithResponsesInterface, string, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
@@ -267,27 +330,44 @@ func getMpsServiceContext(cmd *cobra.Command) (context.Context, mpsapi.ClientWit
 	// Traefik routes mps-wss.<domain>/* → MPS:3000, whereas api.<domain>/api/v1/amt/*
 	// has no matching route and returns 404.
 	mpsAddress := strings.Replace(serverAddress, "api.", "mps-wss.", 1)
-	mpsClient, err := mpsapi.NewClientWithResponses(mpsAddress, TLS13MPSClientOption())
+	mpsClient, err := mpsapi.NewClientWithResponses(mpsAddress, TLS13MPSClientOption(cmd))
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return commandContext(cmd), mpsClient, projectName, nil
+}
+
+// Get the command context, alerting monitor REST client, and project name given the specified command.
+func getAlertingServiceContext(cmd *cobra.Command) (context.Context, *alertapi.ClientWithResponses, string, error) {
+	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	projectName, err := getProjectName(cmd)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	alertClient, err := alertapi.NewClientWithResponses(serverAddress, TLS13AlertingClientOption(cmd))
 	if err != nil {
 		return nil, nil, "", err
 	}
-	return context.Background(), mpsClient, projectName, nil
+	return commandContext(cmd), alertClient, projectName, nil
 }
 
-// Get the new background context, REST client, and project name given the specified command.
+// Get the command context, REST client, and project name given the specified command.
 func getTenancyServiceContext(cmd *cobra.Command) (context.Context, *tenantapi.ClientWithResponses, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
 		return nil, nil, err
 	}
-	tenancyClient, err := tenantapi.NewClientWithResponses(serverAddress, TLS13TenancyClientOption())
+	tenancyClient, err := tenantapi.NewClientWithResponses(serverAddress, TLS13TenancyClientOption(cmd))
 	if err != nil {
 		return nil, nil, err
 	}
-	return context.Background(), tenancyClient, nil
+	return commandContext(cmd), tenancyClient, nil
 }
 
-// Get the new background context, Keycloak Admin client, and realm given the specified command.
+// Get the command context, Keycloak Admin client, and realm given the specified command.
 func getKeycloakAdminServiceContext(cmd *cobra.Command) (context.Context, *kcapi.Client, string, error) {
 	keycloakEp := viper.GetString(auth.KeycloakEndpointField)
 	if keycloakEp == "" {
@@ -323,20 +403,20 @@ func getKeycloakAdminServiceContext(cmd *cobra.Command) (context.Context, *kcapi
 	}
 
 	client := kcapi.NewClient(baseURL, auth.AddAuthHeader)
-	return context.Background(), client, realm, nil
+	return commandContext(cmd), client, realm, nil
 }
 
-// Get the new background context and REST client for orchestrator service.
+// Get the command context and REST client for orchestrator service.
 func getOrchestratorServiceContext(cmd *cobra.Command) (context.Context, *orchapi.Client, error) {
 	serverAddress, err := cmd.Flags().GetString(apiEndpoint)
 	if err != nil {
 		return nil, nil, err
 	}
-	orchClient, err := orchapi.NewClient(serverAddress, TLS13OrchestratorClientOption())
+	orchClient, err := orchapi.NewClient(serverAddress, TLS13OrchestratorClientOption(cmd))
 	if err != nil {
 		return nil, nil, err
 	}
-	return context.Background(), orchClient, nil
+	return commandContext(cmd), orchClient, nil
 }
 
 // Adds the mandatory project UUID, and the standard display-name, and description
@@ -349,10 +429,20 @@ func addEntityFlags(cmd *cobra.Command, entity string) {
 func addListOrderingFilteringPaginationFlags(cmd *cobra.Command, entity string) {
 	cmd.Flags().String("order-by", "", fmt.Sprintf("%s list order by", entity))
 	cmd.Flags().String("filter", "", fmt.Sprintf("%s list filter", entity))
+	addListPaginationFlags(cmd, entity)
+}
+
+// Adds the standard page-size and offset flags for List operations
+func addListPaginationFlags(cmd *cobra.Command, entity string) {
 	cmd.Flags().Int32("page-size", 0, fmt.Sprintf("%s list maximum number of items", entity))
 	cmd.Flags().Int32("offset", 0, fmt.Sprintf("%s list starting offset", entity))
 }
 
+// Adds the limit flag for List operations paginated with listPagination
+func addListLimitFlag(cmd *cobra.Command, entity string) {
+	cmd.Flags().Int("limit", 0, fmt.Sprintf("%s list maximum number of items in total, fetched in pages of --page-size from --offset (0 for all)", entity))
+}
+
 // Adds standard table output template override flags for commands with table rendering.
 func addTableOutputTemplateFlags(cmd *cobra.Command) {
 	cmd.Flags().String("output-template", "", "Optional custom output template (Go text/template) for table output")
@@ -360,12 +450,13 @@ func addTableOutputTemplateFlags(cmd *cobra.Command) {
 }
 
 // Adds standard output flags for list commands supporting table/json/yaml output,
-// optional client-side table filtering, and table template overrides.
+// optional client-side table filtering, table template overrides and column selection.
 func addStandardListOutputFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
 	filterHelp := "Optional client-side filter for table output (see https://google.aip.dev/160); does not apply to JSON/YAML"
 	cmd.Flags().String("output-filter", "", filterHelp)
 	addTableOutputTemplateFlags(cmd)
+	addColumnsFlags(cmd)
 }
 
 // Adds standard output flags for get commands supporting table/json/yaml output
@@ -373,6 +464,69 @@ func addStandardListOutputFlags(cmd *cobra.Command) {
 func addStandardGetOutputFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("output-type", "o", "table", "output type: table, json, yaml")
 	addTableOutputTemplateFlags(cmd)
+	addOutputSelectionFlags(cmd)
+}
+
+// Adds the flags extracting fields of the resource printed by a get command, so that scripts need no JSON tooling
+func addOutputSelectionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(templateFlag, "", "Go template applied to the resource instead of the table, e.g. '{{.Instance.ProvisioningStatus}}'")
+	cmd.Flags().String(jsonPathFlag, "", "JSONPath expression applied to the JSON output of the resource, e.g. '{.resourceId}'")
+	cmd.MarkFlagsMutuallyExclusive(templateFlag, jsonPathFlag)
+}
+
+// Reports whether --template or --jsonpath selects fields of the resource, in which case nothing else may be printed
+func outputSelected(cmd *cobra.Command) bool {
+	tmpl, _ := cmd.Flags().GetString(templateFlag)
+	jsonPath, _ := cmd.Flags().GetString(jsonPathFlag)
+	return tmpl != "" || jsonPath != ""
+}
+
+// Prints the fields of data selected by --template or --jsonpath; returns false when neither is given, for the
+// caller to print data as usual. --template refers to the Go fields of data and --jsonpath to the fields of its
+// JSON output. The selection is printed as is, bypassing the table writer of the command.
+func printSelectedOutput(cmd *cobra.Command, data interface{}) (bool, error) {
+	writer := cmd.OutOrStdout()
+	if !outputSelected(cmd) {
+		return false, nil
+	}
+	tmpl, _ := cmd.Flags().GetString(templateFlag)
+	jsonPath, _ := cmd.Flags().GetString(jsonPathFlag)
+	if outputType, _ := cmd.Flags().GetString("output-type"); outputType != "table" {
+		return true, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s and --%s cannot be combined with --output-type %s", templateFlag, jsonPathFlag, outputType))
+	}
+
+	if tmpl != "" {
+		tmpl = normalizeEscapedOutputTemplate(tmpl)
+		if format.Format(tmpl).IsTable() {
+			return true, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--%s selects fields of a single resource, use --output-template for a table", templateFlag))
+		}
+		if err := format.Format(tmpl).Execute(writer, false, -1, data); err != nil {
+			return true, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s: %w", templateFlag, err))
+		}
+		return true, nil
+	}
+
+	path, err := format.ParseJSONPath(jsonPath)
+	if err != nil {
+		return true, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s: %w", jsonPathFlag, err))
+	}
+	// Like the template, the path applies to each item of a list, e.g. the versions of an application
+	items := []interface{}{data}
+	if list := reflect.ValueOf(data); list.Kind() == reflect.Slice {
+		items = make([]interface{}, list.Len())
+		for i := range items {
+			items[i] = list.Index(i).Interface()
+		}
+	}
+	for _, item := range items {
+		if err := path.Execute(writer, item); err != nil {
+			return true, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --%s: %w", jsonPathFlag, err))
+		}
+		if _, err := fmt.Fprintln(writer); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
 }
 
 func normalizeEscapedOutputTemplate(in string) string {
@@ -436,6 +590,10 @@ func getEntityFlags(cmd *cobra.Command) (string, string, error) {
 }
 
 func checkProjectExists(cmd *cobra.Command, projectName string) error {
+	if isCachedProject(cmd, projectName) {
+		return nil
+	}
+
 	ctx, projectClient, err := TenancyFactory(cmd)
 	if err != nil {
 		return err
@@ -448,7 +606,7 @@ func checkProjectExists(cmd *cobra.Command, projectName string) error {
 	// be returned.
 
 	if err == nil && (resp == nil || resp.JSON200 == nil || statusUnauthorized(resp.HTTPResponse)) {
-		return fmt.Errorf("project %s does not exist or you do not have access to it", projectName)
+		return projectNotFoundError(cmd, projectName)
 	}
 
 	if err != nil {
@@ -529,6 +687,74 @@ func getPageSizeOffset(cmd *cobra.Command) (int32, int32, error) {
 	return pageSize, offset, nil
 }
 
+// listPagination is the part of a list selected with the --page-size, --offset and --limit flags
+type listPagination struct {
+	PageSize int
+	Offset   int
+	// Limit is the maximum number of items to list in total, 0 for all of them
+	Limit int
+	// SinglePage is set when only the page at Offset is requested, with --page-size or --offset but no --limit
+	SinglePage bool
+}
+
+// Gets the pagination of a list command; without flags all the items are fetched in pages of defaultListPageSize
+func getListPagination(cmd *cobra.Command) (listPagination, error) {
+	pageSize, offset, err := getPageSizeOffset(cmd)
+	if err != nil {
+		return listPagination{}, err
+	}
+	limit := 0
+	if cmd.Flags().Lookup("limit") != nil {
+		if limit, err = cmd.Flags().GetInt("limit"); err != nil {
+			return listPagination{}, err
+		}
+	}
+	switch {
+	case pageSize < 0:
+		return listPagination{}, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--page-size cannot be negative"))
+	case offset < 0:
+		return listPagination{}, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--offset cannot be negative"))
+	case limit < 0:
+		return listPagination{}, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--limit cannot be negative"))
+	}
+
+	p := listPagination{PageSize: int(pageSize), Offset: int(offset), Limit: limit}
+	p.SinglePage = limit == 0 && (cmd.Flags().Changed("page-size") || cmd.Flags().Changed("offset"))
+	if p.PageSize == 0 {
+		p.PageSize = defaultListPageSize
+	}
+	return p, nil
+}
+
+// Calls list with successive offsets until the pages selected by the pagination are fetched; list returns
+// the number of items of the page it fetched and whether the server has more of them
+func (p listPagination) fetch(list func(pageSize int, offset int) (int, bool, error)) error {
+	offset, fetched := p.Offset, 0
+	for {
+		pageSize := p.PageSize
+		if p.Limit > 0 && p.Limit-fetched < pageSize {
+			pageSize = p.Limit - fetched
+		}
+		n, hasNext, err := list(pageSize, offset)
+		if err != nil {
+			return err
+		}
+		fetched += n
+		if p.SinglePage || !hasNext || n == 0 || (p.Limit > 0 && fetched >= p.Limit) {
+			return nil
+		}
+		offset += n
+	}
+}
+
+// Truncates items to the --limit of a pagination, for servers returning more items than the requested page size
+func limitListItems[T any](items []T, p listPagination) []T {
+	if p.Limit > 0 && len(items) > p.Limit {
+		return items[:p.Limit]
+	}
+	return items
+}
+
 // Reads input from the specified file path; from stdin if the path is "-"
 func readInput(path string) ([]byte, error) {
 	if err := isSafePath(path); err != nil {
@@ -578,6 +804,10 @@ func checkResponse(response *http.Response, body []byte, message string) error {
 // Checks the specified REST status and if it signals an anomaly, return an error formatted using the specified message
 // and status details.
 func checkResponseCode(responseCode int, message string, responseMessage string, body []byte) error {
+	return e.FromHTTPStatus(responseCode, responseCodeError(responseCode, message, responseMessage, body))
+}
+
+func responseCodeError(responseCode int, message string, responseMessage string, body []byte) error {
 	if responseCode == 401 {
 		return fmt.Errorf("%s. Unauthorized. Please login with a user that has the required permissions. %s", message, responseMessage)
 	} else if responseCode != 200 && responseCode != 201 && responseCode != 204 {
@@ -637,7 +867,11 @@ func checkResponseGRPC(response *http.Response, message string) error {
 			// if the grpc Status included a message then use it and return.
 			// Otherwise, fall back to the standard response message.
 			if status.Message != "" {
-				return checkResponseCode(response.StatusCode, message, status.Message, []byte{})
+				err := responseCodeError(response.StatusCode, message, status.Message, []byte{})
+				if status.Code > 0 {
+					return &e.CodedError{Code: e.CodeFromGRPC(status.Code), HTTPStatus: response.StatusCode, Err: err}
+				}
+				return e.FromHTTPStatus(response.StatusCode, err)
 			}
 		}
 	}
@@ -650,9 +884,9 @@ func checkStatus(statusCode int, message string, statusMessage string) (proceed
 	case http.StatusOK:
 		return true, nil
 	case 403:
-		return false, fmt.Errorf("%s: %s. Unauthenticated. Please login with a user that has the required permissions", message, statusMessage)
+		return false, e.FromHTTPStatus(statusCode, fmt.Errorf("%s: %s. Unauthenticated. Please login with a user that has the required permissions", message, statusMessage))
 	default:
-		return false, fmt.Errorf("no response from backend - check api-endpoint and deployment-endpoint")
+		return false, e.WithCode(e.CodeUnavailable, fmt.Errorf("no response from backend - check api-endpoint and deployment-endpoint"))
 	}
 }
 
@@ -675,9 +909,9 @@ func statusIsAbnormalWithBody(response *http.Response, body []byte, message stri
 		}
 
 		if bodyMessage != "" {
-			return fmt.Errorf("%s: %s\n%s", message, response.Status, bodyMessage)
+			return e.FromHTTPStatus(response.StatusCode, fmt.Errorf("%s: %s\n%s", message, response.Status, bodyMessage))
 		}
-		return fmt.Errorf("%s: %s", message, response.Status)
+		return e.FromHTTPStatus(response.StatusCode, fmt.Errorf("%s: %s", message, response.Status))
 	}
 	return nil
 }
@@ -697,17 +931,17 @@ func statusForbidden(response *http.Response) bool {
 	return response.StatusCode == 403
 }
 
-func processResponse(resp *http.Response, body []byte, writer *tabwriter.Writer, verbose bool, header string, message string) (proceed bool, err error) {
+func processResponse(resp *http.Response, body []byte, writer *format.TableWriter, verbose bool, header string, message string) (proceed bool, err error) {
 	abnormalErr := statusIsAbnormalWithBody(resp, body, message)
 	switch {
 	case abnormalErr != nil:
 		return false, abnormalErr
 	case statusIsNotFound(resp):
-		return false, getError(body, message)
+		return false, e.FromHTTPStatus(resp.StatusCode, getError(body, message))
 	case statusUnauthorized(resp):
-		return false, getError(body, "Unauthorized. Please login with a user that has the required permissions")
+		return false, e.FromHTTPStatus(resp.StatusCode, getError(body, "Unauthorized. Please login with a user that has the required permissions"))
 	case statusForbidden(resp):
-		return false, getError(body, "Unauthorized (forbidden). Please login with a user that has the required permissions")
+		return false, e.FromHTTPStatus(resp.StatusCode, getError(body, "Unauthorized (forbidden). Please login with a user that has the required permissions"))
 	}
 
 	if !verbose && header != "" {
@@ -727,9 +961,36 @@ func getError(body []byte, prefixMessage string) error {
 	return fmt.Errorf("%s", prefixMessage)
 }
 
+// Verifies that the user is logged in; failures are reported as authentication errors
+func checkAuth(cmd *cobra.Command, args []string) error {
+	return e.WithCode(e.CodeUnauthenticated, auth.CheckAuth(cmd, args))
+}
+
 func processError(err error) error {
 	if strings.Contains(err.Error(), "504 DNS look up failed") {
-		return fmt.Errorf("unauthorized. Please login: token expired")
+		return e.WithCode(e.CodeUnauthenticated, fmt.Errorf("unauthorized. Please login: token expired"))
+	}
+	var denied *policy.DeniedError
+	if errors.As(err, &denied) {
+		return e.WithCode(e.CodePermissionDenied, denied)
+	}
+	var blocked *offline.WriteBlockedError
+	if errors.As(err, &blocked) {
+		return e.WithCode(e.CodeFailedPrecondition, blocked)
+	}
+	var notCached *offline.NotCachedError
+	if errors.As(err, &notCached) {
+		return e.WithCode(e.CodeUnavailable, notCached)
+	}
+	var skipped *offline.SkippedError
+	if errors.As(err, &skipped) {
+		return e.WithCode(e.CodeUnavailable, skipped)
+	}
+	if network.ClientCertificateRejected(err) {
+		if viper.GetString(clientCertFlag) == "" {
+			return e.WithCode(e.CodeUnauthenticated, fmt.Errorf("the server requires a client certificate, set --%s and --%s: %w", clientCertFlag, clientKeyFlag, err))
+		}
+		return e.WithCode(e.CodeUnauthenticated, fmt.Errorf("the server rejected the client certificate %s: %w", viper.GetString(clientCertFlag), err))
 	}
 	return err
 }
@@ -753,112 +1014,180 @@ func isSafePath(path string) error {
 	return nil
 }
 
-func TLS13CatalogClientOption() func(*catapi.Client) error {
-	return func(c *catapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
+// Builds the HTTP client used by the REST clients: TLS 1.3 only, through the --proxy, trusting
+// the --ca-cert and presenting the --client-cert if set, with transient failures of idempotent calls retried as configured by the
+// --retries and --retry-max-delay flags, failing over to the --fallback-api-endpoint if one is set,
+// mutations checked against the policies of the policy_dir configuration if it is set, and the reads of the
+// list and get commands cached for --offline, which serves the reads from that cache and blocks the mutations
+func newAPIHTTPClient(cmd *cobra.Command) (*http.Client, error) {
+	retries, err := cmd.Flags().GetInt(retriesFlag)
+	if err != nil {
+		retries = retry.DefaultMaxRetries
+	}
+	maxDelay, err := cmd.Flags().GetDuration(retryMaxDelayFlag)
+	if err != nil {
+		maxDelay = retry.DefaultMaxDelay
+	}
+	base, err := network.NewTransport(networkConfig(cmd))
+	if err != nil {
+		return nil, e.WithCode(e.CodeInvalidArgument, err)
+	}
+	var transport http.RoundTripper = base
+	// Every attempt of a retried call is traced
+	if path, _ := cmd.Flags().GetString(traceFileFlag); path != "" {
+		out, err := openTraceFile(path)
+		if err != nil {
+			return nil, err
+		}
+		transport = trace.NewTransport(transport, out)
+	}
+	transport = retry.NewTransport(transport, retry.Config{
+		MaxRetries: retries,
+		MaxDelay:   maxDelay,
+	})
+
+	// Calls fail over once the retries against the primary endpoint are exhausted
+	if fallback, _ := cmd.Flags().GetString(fallbackAPIEndpoint); fallback != "" {
+		primary, _ := cmd.Flags().GetString(apiEndpoint)
+		writes, _ := cmd.Flags().GetBool(failoverWritesFlag)
+		report := cmd.ErrOrStderr()
+		transport = failover.NewTransport(transport, failover.Config{
+			Primary:  primary,
+			Fallback: fallback,
+			Writes:   writes,
+			Report: func(endpoint, reason string) {
+				fmt.Fprintf(report, "Warning: API endpoint %s failed (%s), requests are served by the fallback endpoint %s\n", primary, reason, endpoint)
 			},
+		})
+	}
+
+	// Policies are evaluated once per mutation, before any retry
+	if dir := viper.GetString(policyDirConfig); dir != "" {
+		engine, err := policy.Load(dir)
+		if err != nil {
+			return nil, err
 		}
-		return nil
+		var explain io.Writer
+		if explainPolicy, _ := cmd.Flags().GetBool(explainPolicyFlag); explainPolicy {
+			explain = cmd.ErrOrStderr()
+		}
+		transport = policy.NewTransport(transport, engine, explain)
+	}
+
+	// Offline reads are served before any other decorator sees them, and the responses cached are the ones the
+	// command received
+	if cfg, ok := offlineConfig(cmd); ok {
+		transport = offline.NewTransport(transport, cfg)
 	}
+	return &http.Client{Transport: transport}, nil
 }
-func TLS13DeploymentClientOption() func(*depapi.Client) error {
+
+// Returns the connection settings of the --proxy, --ca-cert, --insecure-skip-verify, --client-cert and
+// --client-key flags
+func networkConfig(cmd *cobra.Command) network.Config {
+	proxy, _ := cmd.Flags().GetString(proxyFlag)
+	caCert, _ := cmd.Flags().GetString(caCertFlag)
+	insecure, _ := cmd.Flags().GetBool(insecureSkipVerifyFlag)
+	clientCert, _ := cmd.Flags().GetString(clientCertFlag)
+	clientKey, _ := cmd.Flags().GetString(clientKeyFlag)
+	return network.Config{
+		Proxy:              proxy,
+		CACert:             caCert,
+		InsecureSkipVerify: insecure,
+		ClientCert:         clientCert,
+		ClientKey:          clientKey,
+	}
+}
+
+var (
+	traceFilesMu sync.Mutex
+	// Trace files stay open until the process exits, all the API clients of a command share them
+	traceFiles = map[string]io.Writer{}
+)
+
+func openTraceFile(path string) (io.Writer, error) {
+	traceFilesMu.Lock()
+	defer traceFilesMu.Unlock()
+	if out, ok := traceFiles[path]; ok {
+		return out, nil
+	}
+	if err := isSafePath(path); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open trace file: %w", err)
+	}
+	traceFiles[path] = file
+	return file, nil
+}
+
+func TLS13CatalogClientOption(cmd *cobra.Command) func(*catapi.Client) error {
+	return func(c *catapi.Client) error {
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
+	}
+}
+func TLS13DeploymentClientOption(cmd *cobra.Command) func(*depapi.Client) error {
 	return func(c *depapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
-func TLS13InfraClientOption() func(*infraapi.Client) error {
+func TLS13InfraClientOption(cmd *cobra.Command) func(*infraapi.Client) error {
 	return func(c *infraapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
-func TLS13ClusterClientOption() func(*coapi.Client) error {
+func TLS13ClusterClientOption(cmd *cobra.Command) func(*coapi.Client) error {
 	return func(c *coapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
 
-func TLS13RPSClientOption() func(*rpsapi.Client) error {
+func TLS13RPSClientOption(cmd *cobra.Command) func(*rpsapi.Client) error {
 	return func(c *rpsapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
 
-func TLS13MPSClientOption() func(*mpsapi.Client) error {
+func TLS13MPSClientOption(cmd *cobra.Command) func(*mpsapi.Client) error {
 	return func(c *mpsapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
 
-func TLS13TenancyClientOption() func(*tenantapi.Client) error {
+func TLS13TenancyClientOption(cmd *cobra.Command) func(*tenantapi.Client) error {
 	return func(c *tenantapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
 
-func TLS13OrchestratorClientOption() func(*orchapi.Client) error {
+func TLS13OrchestratorClientOption(cmd *cobra.Command) func(*orchapi.Client) error {
 	return func(c *orchapi.Client) error {
-		c.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
-		return nil
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
+	}
+}
+
+func TLS13AlertingClientOption(cmd *cobra.Command) func(*alertapi.Client) error {
+	return func(c *alertapi.Client) error {
+		client, err := newAPIHTTPClient(cmd)
+		c.Client = client
+		return err
 	}
 }
 
@@ -5,13 +5,29 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"text/tabwriter"
 
 	tenancymock "github.com/open-edge-platform/cli/internal/cli/mocks/tenancy"
+	e "github.com/open-edge-platform/cli/internal/errors"
 	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/format"
+	"github.com/open-edge-platform/cli/pkg/rest/failover"
+	"github.com/open-edge-platform/cli/pkg/rest/offline"
+	"github.com/open-edge-platform/cli/pkg/rest/policy"
+	"github.com/open-edge-platform/cli/pkg/rest/retry"
+	"github.com/open-edge-platform/cli/pkg/rest/trace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -121,7 +137,7 @@ func TestProcessResponse(t *testing.T) {
 
 	var b bytes.Buffer
 
-	testWriter := tabwriter.NewWriter(&b, 0, 0, 3, 8, 32)
+	testWriter := format.NewTableWriter(&b, 3, tabwriter.Debug, 0)
 
 	for _, test := range tests {
 		testResp := &http.Response{
@@ -136,6 +152,25 @@ func TestProcessResponse(t *testing.T) {
 	}
 }
 
+func TestColorOutput(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool(noColorFlag, false, "")
+	assert.False(t, colorOutput(cmd, &bytes.Buffer{}))
+
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		t.Skip("no terminal")
+	}
+	defer tty.Close()
+	t.Setenv(noColorEnv, "")
+	assert.True(t, colorOutput(cmd, tty))
+	t.Setenv(noColorEnv, "1")
+	assert.False(t, colorOutput(cmd, tty))
+	t.Setenv(noColorEnv, "")
+	assert.NoError(t, cmd.Flags().Set(noColorFlag, "true"))
+	assert.False(t, colorOutput(cmd, tty))
+}
+
 func TestGetServiceContexts(t *testing.T) {
 	// So getProject() can call TenancyFactory to check project existence
 	mctrl := gomock.NewController(t)
@@ -274,3 +309,286 @@ func TestCheckResponseGRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAPIHTTPClientPolicies(t *testing.T) {
+	defer viper.Set(policyDirConfig, "")
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool(explainPolicyFlag, false, "explain")
+
+	viper.Set(policyDirConfig, "")
+	client, err := newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	assert.IsType(t, &retry.Transport{}, client.Transport)
+
+	dir := t.TempDir()
+	viper.Set(policyDirConfig, dir)
+	_, err = newAPIHTTPClient(cmd)
+	assert.EqualError(t, err, "no .rego or .cue policies found in "+dir)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "hosts.rego"), []byte("package orchcli\n"), 0o600))
+	client, err = newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	assert.IsType(t, &policy.Transport{}, client.Transport)
+
+	denied := processError(fmt.Errorf("Post \"https://api/v1\": %w",
+		&policy.DeniedError{Method: "POST", Resource: "compute/hosts", Reasons: []string{"no"}}))
+	assert.EqualError(t, denied, "POST compute/hosts denied by policy: no")
+	assert.Equal(t, e.CodePermissionDenied, e.CodeOf(denied))
+}
+
+func TestNewAPIHTTPClientTrace(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool(explainPolicyFlag, false, "explain")
+	cmd.Flags().String(traceFileFlag, path, "trace")
+
+	// The clients of a command share the trace file
+	for range 2 {
+		client, err := newAPIHTTPClient(cmd)
+		assert.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/projects/itep/regions", nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer secret-token")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2)
+	assert.NotContains(t, string(data), "secret-token")
+	var record trace.Record
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "itep", record.Project)
+	assert.Equal(t, "Bearer REDACTED", record.Auth)
+	assert.Equal(t, http.StatusNoContent, record.Status)
+}
+
+func TestNewAPIHTTPClientFailover(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer fallback.Close()
+
+	var stderr bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetErr(&stderr)
+	cmd.Flags().Bool(explainPolicyFlag, false, "explain")
+	cmd.Flags().String(apiEndpoint, primary.URL+"/", "api")
+	cmd.Flags().String(fallbackAPIEndpoint, fallback.URL+"/", "fallback")
+	cmd.Flags().Bool(failoverWritesFlag, false, "writes")
+	cmd.Flags().Int(retriesFlag, 0, "retries")
+
+	client, err := newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	assert.IsType(t, &failover.Transport{}, client.Transport)
+
+	resp, err := client.Get(primary.URL + "/v1/projects/itep/regions")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, fmt.Sprintf("Warning: API endpoint %s/ failed (500 Internal Server Error), requests are served by the fallback endpoint %s/\n",
+		primary.URL, fallback.URL), stderr.String())
+
+	// Mutations stay on the primary endpoint
+	resp, err = client.Post(primary.URL+"/v1/projects/itep/regions", "application/json", strings.NewReader("{}"))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+func TestNewAPIHTTPClientOffline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"regions":[]}`))
+	}))
+
+	configFile := viper.ConfigFileUsed()
+	defer viper.SetConfigFile(configFile)
+	configDir := t.TempDir()
+	viper.SetConfigFile(filepath.Join(configDir, "orch-cli.yaml"))
+
+	var stderr bytes.Buffer
+	root := &cobra.Command{Use: "orch-cli"}
+	list := &cobra.Command{Use: "list"}
+	cmd := &cobra.Command{Use: "region"}
+	root.AddCommand(list)
+	list.AddCommand(cmd)
+	cmd.SetErr(&stderr)
+	cmd.Flags().Bool(explainPolicyFlag, false, "explain")
+	cmd.Flags().Int(retriesFlag, 0, "retries")
+	cmd.Flags().Bool(offlineFlag, false, "offline")
+
+	// The list commands cache the responses of their reads
+	client, err := newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	assert.IsType(t, &offline.Transport{}, client.Transport)
+	resp, err := client.Get(srv.URL + "/v1/projects/itep/regions")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	entries, err := os.ReadDir(filepath.Join(configDir, hostCacheDirName, offlineCacheDirName))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Kubeconfigs carry credentials and are never cached
+	resp, err = client.Get(srv.URL + "/v2/projects/itep/clusters/cluster-1/kubeconfigs")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	entries, err = os.ReadDir(filepath.Join(configDir, hostCacheDirName, offlineCacheDirName))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	srv.Close()
+
+	// Offline, the reads are served from the cache and the mutations are blocked
+	assert.NoError(t, cmd.Flags().Set(offlineFlag, "true"))
+	client, err = newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	resp, err = client.Get(srv.URL + "/v1/projects/itep/regions")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, `{"regions":[]}`, string(body))
+	assert.Regexp(t, `^Offline: /v1/projects/itep/regions as cached at \S+ \(\d+s ago\)\n$`, stderr.String())
+
+	_, err = client.Post(srv.URL+"/v1/projects/itep/regions", "application/json", strings.NewReader("{}"))
+	err = processError(err)
+	assert.EqualError(t, err, "offline: POST /v1/projects/itep/regions blocked, changes need a connection to the orchestrator")
+	assert.Equal(t, e.CodeFailedPrecondition, e.CodeOf(err))
+
+	_, err = client.Get(srv.URL + "/v1/projects/itep/sites")
+	err = processError(err)
+	assert.EqualError(t, err, "offline: no cached response for GET "+srv.URL+"/v1/projects/itep/sites, run the command once while connected")
+	assert.Equal(t, e.CodeUnavailable, e.CodeOf(err))
+
+	_, err = client.Get(srv.URL + "/v2/projects/itep/clusters/cluster-1/kubeconfigs")
+	err = processError(err)
+	assert.EqualError(t, err, "offline: responses of GET "+srv.URL+"/v2/projects/itep/clusters/cluster-1/kubeconfigs are never cached, it needs a connection to the orchestrator")
+	assert.Equal(t, e.CodeUnavailable, e.CodeOf(err))
+
+	// Other commands neither keep nor read the cache online
+	client, err = newAPIHTTPClient(&cobra.Command{})
+	assert.NoError(t, err)
+	assert.IsType(t, &retry.Transport{}, client.Transport)
+}
+
+func TestNewAPIHTTPClientNetwork(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	caCert := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0o600))
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool(explainPolicyFlag, false, "explain")
+	cmd.Flags().Int(retriesFlag, 0, "retries")
+	cmd.Flags().String(proxyFlag, "", "proxy")
+	cmd.Flags().String(caCertFlag, caCert, "ca-cert")
+	cmd.Flags().Bool(insecureSkipVerifyFlag, false, "insecure")
+
+	client, err := newAPIHTTPClient(cmd)
+	assert.NoError(t, err)
+	resp, err := client.Get(srv.URL + "/v1/projects/itep/regions")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	assert.NoError(t, cmd.Flags().Set(proxyFlag, "proxy.example.com:3128"))
+	_, err = newAPIHTTPClient(cmd)
+	assert.EqualError(t, err, `invalid proxy URL "proxy.example.com:3128": scheme must be http, https or socks5`)
+	assert.Equal(t, e.CodeInvalidArgument, e.CodeOf(err))
+
+	cmd.Flags().String(clientCertFlag, "", "client-cert")
+	cmd.Flags().String(clientKeyFlag, "", "client-key")
+	assert.NoError(t, cmd.Flags().Set(proxyFlag, ""))
+	assert.NoError(t, cmd.Flags().Set(clientCertFlag, caCert))
+	_, err = newAPIHTTPClient(cmd)
+	assert.EqualError(t, err, "a client certificate and its private key must be given together")
+	assert.Equal(t, e.CodeInvalidArgument, e.CodeOf(err))
+}
+
+func TestProcessErrorClientCertificate(t *testing.T) {
+	rejected := fmt.Errorf("Get \"https://api/v1\": %w", &net.OpError{Op: "remote error", Err: errors.New("tls: certificate required")})
+
+	viper.Set(clientCertFlag, "")
+	err := processError(rejected)
+	assert.EqualError(t, err, "the server requires a client certificate, set --client-cert and --client-key: "+rejected.Error())
+	assert.Equal(t, e.CodeUnauthenticated, e.CodeOf(err))
+
+	viper.Set(clientCertFlag, "client.pem")
+	defer viper.Set(clientCertFlag, "")
+	err = processError(rejected)
+	assert.EqualError(t, err, "the server rejected the client certificate client.pem: "+rejected.Error())
+}
+
+func TestListPaginationFetch(t *testing.T) {
+	// A server holding 250 items, answering with at most 100 of them per page
+	type call struct{ pageSize, offset int }
+	run := func(p listPagination) ([]call, int) {
+		var calls []call
+		fetched := 0
+		err := p.fetch(func(pageSize int, offset int) (int, bool, error) {
+			calls = append(calls, call{pageSize, offset})
+			n := min(pageSize, 100, max(250-offset, 0))
+			fetched += n
+			return n, offset+n < 250, nil
+		})
+		assert.NoError(t, err)
+		return calls, fetched
+	}
+
+	calls, fetched := run(listPagination{PageSize: defaultListPageSize})
+	assert.Equal(t, []call{{100, 0}, {100, 100}, {100, 200}}, calls)
+	assert.Equal(t, 250, fetched)
+
+	calls, fetched = run(listPagination{PageSize: 20, Offset: 40, SinglePage: true})
+	assert.Equal(t, []call{{20, 40}}, calls)
+	assert.Equal(t, 20, fetched)
+
+	calls, fetched = run(listPagination{PageSize: 30, Offset: 10, Limit: 70})
+	assert.Equal(t, []call{{30, 10}, {30, 40}, {10, 70}}, calls)
+	assert.Equal(t, 70, fetched)
+
+	calls, fetched = run(listPagination{PageSize: 100, Offset: 200, Limit: 80})
+	assert.Equal(t, []call{{80, 200}}, calls)
+	assert.Equal(t, 50, fetched)
+
+	assert.Equal(t, []int{1, 2}, limitListItems([]int{1, 2, 3}, listPagination{Limit: 2}))
+	assert.Equal(t, []int{1, 2, 3}, limitListItems([]int{1, 2, 3}, listPagination{}))
+}
+
+func TestGetListPagination(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		addListPaginationFlags(cmd, "host")
+		addListLimitFlag(cmd, "host")
+		assert.NoError(t, cmd.ParseFlags(args))
+		return cmd
+	}
+
+	p, err := getListPagination(newCmd())
+	assert.NoError(t, err)
+	assert.Equal(t, listPagination{PageSize: defaultListPageSize}, p)
+
+	p, err = getListPagination(newCmd("--offset", "20"))
+	assert.NoError(t, err)
+	assert.Equal(t, listPagination{PageSize: defaultListPageSize, Offset: 20, SinglePage: true}, p)
+
+	p, err = getListPagination(newCmd("--page-size", "10", "--limit", "35"))
+	assert.NoError(t, err)
+	assert.Equal(t, listPagination{PageSize: 10, Limit: 35}, p)
+
+	_, err = getListPagination(newCmd("--limit", "-1"))
+	assert.EqualError(t, err, "--limit cannot be negative")
+	assert.Equal(t, e.ExitValidation, e.ExitCode(err))
+}
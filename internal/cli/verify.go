@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	verifySSH         = "ssh"
+	verifyAgents      = "agents"
+	verifyClusterJoin = "cluster-join"
+
+	defaultVerifyInterval = 30 * time.Second
+	sshProbeTimeout       = 10 * time.Second
+)
+
+var verifyCheckNames = []string{verifySSH, verifyAgents, verifyClusterJoin}
+
+const verifyHostExamples = `# Verify that the node agents of a freshly provisioned host are running and that it joined a cluster
+orch-cli verify host host-1234abcd --project some-project
+
+# Wait up to 30 minutes for all checks to pass, including an SSH probe with the local account of the host
+orch-cli verify host edge-host-001 --checks ssh,agents,cluster-join --timeout 30m --project some-project
+
+# Sample output
+CHECK          RESULT   DETAIL
+ssh            pass     SSH server answering on 192.168.1.102:22 for user admin
+agents         pass     10 of 10 components running
+cluster-join   fail     instance is not a member of any cluster
+`
+
+// Opens the connection of the SSH probe; replaced in tests
+var sshProbeDialer = func(ctx context.Context, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: sshProbeTimeout}
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+// The instance status detail reported by the node agents, e.g. "9 of 10 components running"
+var componentsRunningRegex = regexp.MustCompile(`(\d+) of (\d+) components running`)
+
+// verifyResult is the outcome of one check of a host or a site
+type verifyResult struct {
+	Check  string
+	Passed bool
+	Detail string
+}
+
+func getVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify that Edge Orchestrator resources reached their expected state",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	cmd.AddCommand(
+		getVerifyHostCommand(),
+		getVerifySiteCommand(),
+	)
+	return cmd
+}
+
+func getVerifyHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> [flags]",
+		Short: "Verifies that a provisioned host is ready for workloads",
+		Long: "Runs post-provisioning checks against a host and reports a pass or fail result per check. " +
+			"agents parses the instance status reported by the node agents, cluster-join checks that the instance " +
+			"is a member of a cluster and ssh probes the SSH server of the host for the local account of its instance. " +
+			"With --timeout the checks are repeated every --interval until all of them pass or the timeout expires; " +
+			"the command fails if a check did not pass, so that it can be the last step of a provisioning pipeline.",
+		Example: verifyHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runVerifyHostCommand,
+	}
+	cmd.Flags().StringSlice("checks", []string{verifyAgents, verifyClusterJoin},
+		fmt.Sprintf("Checks to run, any of: %s", strings.Join(verifyCheckNames, ", ")))
+	cmd.Flags().Duration("interval", defaultVerifyInterval, "Time between two rounds of checks while waiting for --timeout")
+	cmd.Flags().Int("ssh-port", 22, "Port of the SSH server probed by the ssh check")
+	return cmd
+}
+
+func runVerifyHostCommand(cmd *cobra.Command, args []string) error {
+	checks, _ := cmd.Flags().GetStringSlice("checks")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	sshPort, _ := cmd.Flags().GetInt("ssh-port")
+	timeout, _ := cmd.Flags().GetDuration(timeoutFlag)
+
+	if len(checks) == 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--checks must name at least one of: %s", strings.Join(verifyCheckNames, ", ")))
+	}
+	for i, check := range checks {
+		checks[i] = strings.ToLower(strings.TrimSpace(check))
+		if !slices.Contains(verifyCheckNames, checks[i]) {
+			return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid check %q, must be one of: %s", check, strings.Join(verifyCheckNames, ", ")))
+		}
+	}
+	if interval <= 0 {
+		return e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be a positive duration, got %s", interval))
+	}
+
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	// The deadline of --timeout is carried by the context of the command
+	deadline := commandContext(cmd)
+	var results []verifyResult
+rounds:
+	for {
+		round, err := runVerifyChecks(ctx, client, projectName, args[0], checks, sshPort)
+		if err != nil {
+			// A round cut short by the timeout leaves the results of the previous one to report
+			if results == nil || deadline.Err() == nil {
+				return err
+			}
+			break
+		}
+		results = round
+		if timeout <= 0 || countFailedVerifyResults(results) == 0 {
+			break
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "%d of %d checks not passed yet, checking again in %s\n",
+			countFailedVerifyResults(results), len(results), interval)
+		select {
+		case <-deadline.Done():
+			break rounds
+		case <-time.After(interval):
+		}
+	}
+
+	if err := printVerifyResults(cmd, results); err != nil {
+		return err
+	}
+
+	if failed := countFailedVerifyResults(results); failed > 0 {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("host %s failed %d of %d checks", args[0], failed, len(results)))
+	}
+	return nil
+}
+
+// Retrieves the host and its instance and runs one round of the requested checks
+func runVerifyChecks(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, hostArg string, checks []string, sshPort int) ([]verifyResult, error) {
+	host, err := getHostByNameOrID(ctx, client, projectName, hostArg)
+	if err != nil {
+		return nil, err
+	}
+
+	var instance *infra.InstanceResource
+	if host.Instance != nil && host.Instance.ResourceId != nil {
+		resp, err := client.InstanceServiceGetInstanceWithResponse(ctx, projectName, *host.Instance.ResourceId, auth.AddAuthHeader)
+		if err != nil {
+			return nil, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting instance"); err != nil {
+			return nil, err
+		}
+		instance = resp.JSON200
+	}
+
+	results := make([]verifyResult, 0, len(checks))
+	for _, check := range checks {
+		var result verifyResult
+		switch check {
+		case verifySSH:
+			result = verifyHostSSH(ctx, host, instance, sshPort)
+		case verifyAgents:
+			result = verifyHostAgents(host, instance)
+		case verifyClusterJoin:
+			result = verifyHostClusterJoin(instance)
+		}
+		result.Check = check
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// The node agents report how many of their components run in the instance status detail
+func verifyHostAgents(host infra.HostResource, instance *infra.InstanceResource) verifyResult {
+	if instance == nil {
+		return verifyResult{Detail: "host has no instance, it is not provisioned"}
+	}
+	detail := derefString(instance.InstanceStatusDetail)
+	if m := componentsRunningRegex.FindStringSubmatch(detail); m != nil {
+		running, _ := strconv.Atoi(m[1])
+		total, _ := strconv.Atoi(m[2])
+		return verifyResult{Passed: running == total, Detail: m[0]}
+	}
+	if instance.InstanceStatusIndicator != nil && *instance.InstanceStatusIndicator == infra.STATUSINDICATIONERROR {
+		return verifyResult{Detail: fmt.Sprintf("instance status %s %s", derefString(instance.InstanceStatus), detail)}
+	}
+	if host.HostStatusIndicator != nil && *host.HostStatusIndicator == infra.STATUSINDICATIONERROR {
+		return verifyResult{Detail: fmt.Sprintf("host status %s", derefString(host.HostStatus))}
+	}
+	if instance.CurrentState != nil && *instance.CurrentState == infra.INSTANCESTATERUNNING {
+		return verifyResult{Passed: true, Detail: "instance running"}
+	}
+	return verifyResult{Detail: fmt.Sprintf("host status %s, instance state %s", valueOrNone(host.HostStatus), valueOrNone((*string)(instance.CurrentState)))}
+}
+
+func verifyHostClusterJoin(instance *infra.InstanceResource) verifyResult {
+	if instance == nil {
+		return verifyResult{Detail: "host has no instance, it is not provisioned"}
+	}
+	if instance.WorkloadMembers != nil {
+		for _, member := range *instance.WorkloadMembers {
+			if member.Kind == infra.WORKLOADMEMBERKINDCLUSTERNODE && member.Workload != nil {
+				return verifyResult{Passed: true, Detail: fmt.Sprintf("member of cluster %s", derefString(member.Workload.Name))}
+			}
+		}
+	}
+	return verifyResult{Detail: "instance is not a member of any cluster"}
+}
+
+// Probes the SSH server of the host; the private key of the local account is not known to the CLI, so the probe
+// stops at the identification string the server sends before authentication
+func verifyHostSSH(ctx context.Context, host infra.HostResource, instance *infra.InstanceResource, port int) verifyResult {
+	if instance == nil {
+		return verifyResult{Detail: "host has no instance, it is not provisioned"}
+	}
+	if instance.Localaccount == nil && derefString(instance.LocalAccountID) == "" {
+		return verifyResult{Detail: "instance has no local account, SSH access is not enabled"}
+	}
+	user := derefString(instance.LocalAccountID)
+	if instance.Localaccount != nil && instance.Localaccount.Username != "" {
+		user = instance.Localaccount.Username
+	}
+	ip := hostIPAddress(host)
+	if ip == "" {
+		return verifyResult{Detail: "host reports no IP address"}
+	}
+
+	address := net.JoinHostPort(ip, strconv.Itoa(port))
+	conn, err := sshProbeDialer(ctx, address)
+	if err != nil {
+		return verifyResult{Detail: fmt.Sprintf("cannot connect to %s: %v", address, err)}
+	}
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(sshProbeTimeout))
+	banner, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil && banner == "" {
+		return verifyResult{Detail: fmt.Sprintf("no answer from %s: %v", address, err)}
+	}
+	if !strings.HasPrefix(banner, "SSH-") {
+		return verifyResult{Detail: fmt.Sprintf("%s is not an SSH server", address)}
+	}
+	return verifyResult{Passed: true, Detail: fmt.Sprintf("SSH server answering on %s for user %s", address, user)}
+}
+
+// Returns the first IP address reported on the network interfaces of a host, without its prefix length
+func hostIPAddress(host infra.HostResource) string {
+	if host.HostNics == nil {
+		return ""
+	}
+	for _, nic := range *host.HostNics {
+		if nic.Ipaddresses == nil {
+			continue
+		}
+		for _, ip := range *nic.Ipaddresses {
+			if address := derefString(ip.Address); address != "" {
+				address, _, _ = strings.Cut(address, "/")
+				return address
+			}
+		}
+	}
+	return ""
+}
+
+// Prints the checklist of the results
+func printVerifyResults(cmd *cobra.Command, results []verifyResult) error {
+	writer := newOutputWriter(cmd, cmd.OutOrStdout())
+	fmt.Fprintf(writer, "CHECK\tRESULT\tDETAIL\n")
+	for _, r := range results {
+		result := "pass"
+		if !r.Passed {
+			result = "fail"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", r.Check, result, r.Detail)
+	}
+	return writer.Flush()
+}
+
+func countFailedVerifyResults(results []verifyResult) int {
+	failed := 0
+	for _, r := range results {
+		if !r.Passed {
+			failed++
+		}
+	}
+	return failed
+}
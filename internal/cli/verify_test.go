@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestVerifyHost() {
+	defaultDialer := sshProbeDialer
+	defer func() { sshProbeDialer = defaultDialer }()
+	var dialed string
+	sshProbeDialer = func(_ context.Context, address string) (net.Conn, error) {
+		dialed = address
+		server, client := net.Pipe()
+		go func() {
+			_, _ = server.Write([]byte("SSH-2.0-OpenSSH_9.6\r\n"))
+			_ = server.Close()
+		}()
+		return client, nil
+	}
+
+	out, err := s.runCommand("verify host host-abcd1004 --checks ssh,agents,cluster-join --project " + project)
+	s.NoError(err)
+	s.Equal("192.168.1.104:22", dialed)
+	s.Regexp(`(?m)^ssh\s+\|pass\s+\|SSH server answering on 192.168.1.104:22 for user admin`, out)
+	s.Regexp(`(?m)^agents\s+\|pass\s+\|10 of 10 components running`, out)
+	s.Regexp(`(?m)^cluster-join\s+\|pass\s+\|member of cluster cluster-edge`, out)
+
+	// The default host runs but did not join a cluster
+	out, err = s.runCommand("verify host host-abc12345 --project " + project)
+	s.EqualError(err, "host host-abc12345 failed 1 of 2 checks")
+	s.Regexp(`(?m)^agents\s+\|pass\s+\|instance running`, out)
+	s.Regexp(`(?m)^cluster-join\s+\|fail\s+\|instance is not a member of any cluster`, out)
+
+	// Waiting stops at the timeout with the last results
+	out, err = s.runCommand("verify host host-abc12345 --checks cluster-join --interval 10ms --timeout 50ms --project " + project)
+	s.ErrorContains(err, "command timed out after 50ms: host host-abc12345 failed 1 of 1 checks")
+	s.Contains(out, "1 of 1 checks not passed yet, checking again in 10ms")
+
+	out, err = s.runCommand("verify host host-abcd1002 --checks agents,ssh --project " + project)
+	s.EqualError(err, "host host-abcd1002 failed 2 of 2 checks")
+	s.Regexp(`(?m)^agents\s+\|fail\s+\|host has no instance, it is not provisioned`, out)
+
+	_, err = s.runCommand("verify host host-abcd1004 --checks agents,kubelet --project " + project)
+	s.EqualError(err, `invalid check "kubelet", must be one of: ssh, agents, cluster-join`)
+
+	_, err = s.runCommand("verify host host-abcd1004 --interval 0s --project " + project)
+	s.EqualError(err, "--interval must be a positive duration, got 0s")
+}
+
+func TestVerifyHostAgents(t *testing.T) {
+	running := infra.INSTANCESTATERUNNING
+	errorIndicator := infra.STATUSINDICATIONERROR
+
+	result := verifyHostAgents(infra.HostResource{}, &infra.InstanceResource{InstanceStatusDetail: stringPtr("9 of 10 components running")})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "9 of 10 components running", result.Detail)
+
+	result = verifyHostAgents(infra.HostResource{}, &infra.InstanceResource{
+		CurrentState:            &running,
+		InstanceStatus:          stringPtr("Error"),
+		InstanceStatusDetail:    stringPtr("provisioning failed"),
+		InstanceStatusIndicator: &errorIndicator,
+	})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "instance status Error provisioning failed", result.Detail)
+
+	result = verifyHostAgents(infra.HostResource{HostStatus: stringPtr("Booting")}, &infra.InstanceResource{})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "host status Booting, instance state <none>", result.Detail)
+}
+
+func TestVerifyHostSSH(t *testing.T) {
+	defaultDialer := sshProbeDialer
+	defer func() { sshProbeDialer = defaultDialer }()
+	sshProbeDialer = func(_ context.Context, _ string) (net.Conn, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	host := infra.HostResource{HostNics: &[]infra.HostnicResource{
+		{Ipaddresses: &[]infra.IPAddressResource{{Address: stringPtr("10.0.0.5/16")}}},
+	}}
+	result := verifyHostSSH(context.Background(), host, &infra.InstanceResource{}, 22)
+	assert.Equal(t, "instance has no local account, SSH access is not enabled", result.Detail)
+
+	result = verifyHostSSH(context.Background(), host, &infra.InstanceResource{LocalAccountID: stringPtr("localaccount-1")}, 2222)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "cannot connect to 10.0.0.5:2222: connection refused", result.Detail)
+
+	result = verifyHostSSH(context.Background(), infra.HostResource{}, &infra.InstanceResource{LocalAccountID: stringPtr("localaccount-1")}, 22)
+	assert.Equal(t, "host reports no IP address", result.Detail)
+}
+
+func (s *CLITestSuite) TestVerifySite() {
+	// The regions of the mock loop back to themselves and an open ended maintenance window targets the site
+	out, err := s.runCommand("verify site site-abcd1234 --project " + project)
+	s.EqualError(err, "site site-abcd1234 failed 2 of 6 checks")
+	s.Regexp(`(?m)^site\s+\|pass\s+\|site \(site-abcd1234\)`, out)
+	s.Regexp(`(?m)^region-chain\s+\|fail\s+\|region chain loops back to region-abcd1111 after region \(region-abcd1234\) > region \(region-abcd1111\)`, out)
+	s.Regexp(`(?m)^os-profiles\s+\|pass\s+\|1 OS profiles`, out)
+	s.Regexp(`(?m)^providers\s+\|pass\s+\|provider: default OS none, auto provisioning off`, out)
+	s.Regexp(`(?m)^schedules\s+\|fail\s+\|maintenance window schedule \(singlesche-abcd1234\) on site-abcd1234 active with no end`, out)
+	s.Regexp(`(?m)^api\s+\|pass\s+\|infra-service, catalog-service and cluster-service reachable`, out)
+
+	out, err = s.runCommand("verify site site-abcd1234 --project maintenance-schedules")
+	s.EqualError(err, "site site-abcd1234 failed 1 of 6 checks")
+	s.Regexp(`(?m)^schedules\s+\|pass\s+\|no maintenance window active, 1 schedules target the site or its regions`, out)
+
+	// The checks depending on the site are not run without it
+	out, err = s.runCommand("verify site nosuchsite --project " + project)
+	s.EqualError(err, "site nosuchsite failed 3 of 6 checks")
+	s.Regexp(`(?m)^site\s+\|fail\s+\|no site found with name "nosuchsite"`, out)
+	s.Regexp(`(?m)^schedules\s+\|fail\s+\|not checked, the site was not found`, out)
+}
+
+func TestVerifySiteProviders(t *testing.T) {
+	osProfiles := []infra.OperatingSystemResource{{ResourceId: stringPtr("os-1234abcd")}}
+	provider := func(config string) infra.ProviderResource {
+		return infra.ProviderResource{Name: "infra_onboarding", Config: &config}
+	}
+
+	result := verifySiteProviders([]infra.ProviderResource{provider(`{"defaultOs": "os-1234abcd", "autoProvision": true}`)}, nil, osProfiles)
+	assert.True(t, result.Passed)
+	assert.Equal(t, "infra_onboarding: default OS os-1234abcd, auto provisioning on", result.Detail)
+
+	result = verifySiteProviders([]infra.ProviderResource{provider(`{"defaultOs": "os-9999abcd"}`)}, nil, osProfiles)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "provider infra_onboarding: default OS os-9999abcd does not exist", result.Detail)
+
+	result = verifySiteProviders([]infra.ProviderResource{provider(`{"autoProvision": true}`)}, nil, osProfiles)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "provider infra_onboarding: auto provisioning is on without a default OS", result.Detail)
+
+	result = verifySiteProviders([]infra.ProviderResource{provider(`{"autoProvision": "yes"}`)}, nil, osProfiles)
+	assert.False(t, result.Passed)
+	assert.Contains(t, result.Detail, "autoProvision: Invalid type")
+
+	result = verifySiteProviders(nil, nil, osProfiles)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "no provider, hosts cannot be onboarded", result.Detail)
+
+	result = verifySiteProviders(nil, errors.New("error while retrieving providers"), osProfiles)
+	assert.False(t, result.Passed)
+}
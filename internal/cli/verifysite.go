@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const verifySiteExamples = `# Check that a new site is ready for its hosts to be onboarded
+orch-cli verify site site-1234abcd --project some-project
+
+# Sample output
+CHECK          RESULT   DETAIL
+site           pass     store-042 (site-1234abcd)
+region-chain   pass     west (region-1234abcd) > us (region-abcd1234)
+os-profiles    pass     3 OS profiles
+providers      pass     infra_onboarding: default OS os-1234abcd, auto provisioning on
+schedules      fail     maintenance window weekly-patch (repeatedsche-abcd1111) on region-abcd1234 active until 2026-04-04 04:00:00 UTC
+api            pass     infra-service, catalog-service and cluster-service reachable
+`
+
+func getVerifySiteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "site <name|resourceID> [flags]",
+		Short: "Verifies that a site is ready for its hosts to be onboarded",
+		Long: "Runs read-only checks of the resources a new site depends on and reports a pass or fail result per check: " +
+			"the site exists, its region and the regions above it resolve, OS profiles are available, the providers are " +
+			"configured with an existing default OS, no maintenance window blocks the site now and the services of the API " +
+			"answer. The command fails if a check did not pass, so that field installers get a single go or no-go.",
+		Example: verifySiteExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: siteAliases,
+		RunE:    runVerifySiteCommand,
+	}
+	return cmd
+}
+
+func runVerifySiteCommand(cmd *cobra.Command, args []string) error {
+	ctx, client, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Schedules targeting the site or one of its regions block it
+	targets := map[string]bool{}
+	var results []verifyResult
+	site, siteErr := getSiteByNameOrID(ctx, client, projectName, args[0])
+	if siteErr != nil {
+		results = append(results,
+			verifyResult{Check: "site", Detail: siteErr.Error()},
+			verifyResult{Check: "region-chain", Detail: "not checked, the site was not found"})
+	} else {
+		targets[derefString(site.ResourceId)] = true
+		results = append(results,
+			verifyResult{Check: "site", Passed: true, Detail: fmt.Sprintf("%s (%s)", derefString(site.Name), derefString(site.ResourceId))},
+			verifySiteRegionChain(ctx, client, projectName, site, targets))
+	}
+
+	osProfiles, osErr := listAllOSProfiles(ctx, client, projectName)
+	providers, providersErr := listAllProviders(ctx, client, projectName)
+	results = append(results,
+		verifySiteOSProfiles(osProfiles, osErr),
+		verifySiteProviders(providers, providersErr, osProfiles))
+
+	if siteErr != nil {
+		results = append(results, verifyResult{Check: "schedules", Detail: "not checked, the site was not found"})
+	} else {
+		results = append(results, verifySiteSchedules(ctx, client, projectName, targets, time.Now()))
+	}
+	results = append(results, verifySiteAPI(cmd))
+
+	if err := printVerifyResults(cmd, results); err != nil {
+		return err
+	}
+	if failed := countFailedVerifyResults(results); failed > 0 {
+		return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("site %s failed %d of %d checks", args[0], failed, len(results)))
+	}
+	return nil
+}
+
+// Walks up from the region of the site to the root region, adding the regions to the schedule targets
+func verifySiteRegionChain(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, site infra.SiteResource, targets map[string]bool) verifyResult {
+	result := verifyResult{Check: "region-chain"}
+	regionID := siteRegionID(site)
+	if regionID == "" {
+		result.Detail = "site is not in a region"
+		return result
+	}
+	var chain []string
+	for regionID != "" {
+		if targets[regionID] {
+			result.Detail = fmt.Sprintf("region chain loops back to %s after %s", regionID, strings.Join(chain, " > "))
+			return result
+		}
+		targets[regionID] = true
+		resp, err := client.RegionServiceGetRegionWithResponse(ctx, projectName, regionID, auth.AddAuthHeader)
+		if err != nil {
+			result.Detail = processError(err).Error()
+			return result
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, fmt.Sprintf("region %s does not resolve", regionID)); err != nil {
+			result.Detail = err.Error()
+			return result
+		}
+		chain = append(chain, fmt.Sprintf("%s (%s)", derefString(resp.JSON200.Name), regionID))
+		regionID = derefString(resp.JSON200.ParentId)
+	}
+	result.Passed, result.Detail = true, strings.Join(chain, " > ")
+	return result
+}
+
+func verifySiteOSProfiles(osProfiles []infra.OperatingSystemResource, err error) verifyResult {
+	result := verifyResult{Check: "os-profiles"}
+	switch {
+	case err != nil:
+		result.Detail = err.Error()
+	case len(osProfiles) == 0:
+		result.Detail = "no OS profile, hosts cannot be provisioned"
+	default:
+		result.Passed, result.Detail = true, fmt.Sprintf("%d OS profiles", len(osProfiles))
+	}
+	return result
+}
+
+// The providers must have a valid config whose default OS, if any, is one of the OS profiles
+func verifySiteProviders(providers []infra.ProviderResource, err error, osProfiles []infra.OperatingSystemResource) verifyResult {
+	result := verifyResult{Check: "providers"}
+	if err != nil {
+		result.Detail = err.Error()
+		return result
+	}
+	if len(providers) == 0 {
+		result.Detail = "no provider, hosts cannot be onboarded"
+		return result
+	}
+	osIDs := map[string]bool{}
+	for _, profile := range osProfiles {
+		osIDs[derefString(profile.ResourceId)] = true
+	}
+
+	details := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		config, err := decodeProviderConfig(derefString(provider.Config))
+		if err == nil {
+			err = validateProviderConfig(config)
+		}
+		if err != nil {
+			result.Detail = fmt.Sprintf("provider %s: %v", provider.Name, err)
+			return result
+		}
+		defaultOS, _ := config["defaultOs"].(string)
+		autoProvision, _ := config["autoProvision"].(bool)
+		switch {
+		case defaultOS != "" && !osIDs[defaultOS]:
+			result.Detail = fmt.Sprintf("provider %s: default OS %s does not exist", provider.Name, defaultOS)
+			return result
+		case defaultOS == "" && autoProvision:
+			result.Detail = fmt.Sprintf("provider %s: auto provisioning is on without a default OS", provider.Name)
+			return result
+		}
+		if defaultOS == "" {
+			defaultOS = "none"
+		}
+		autoProvisioning := "off"
+		if autoProvision {
+			autoProvisioning = "on"
+		}
+		details = append(details, fmt.Sprintf("%s: default OS %s, auto provisioning %s", provider.Name, defaultOS, autoProvisioning))
+	}
+	result.Passed, result.Detail = true, strings.Join(details, "; ")
+	return result
+}
+
+// A maintenance window active on the site or on one of its regions blocks the onboarding and the updates of its hosts
+func verifySiteSchedules(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, targets map[string]bool, now time.Time) verifyResult {
+	result := verifyResult{Check: "schedules"}
+	resp, err := client.ScheduleServiceListSchedulesWithResponse(ctx, projectName,
+		&infra.ScheduleServiceListSchedulesParams{}, auth.AddAuthHeader)
+	if err != nil {
+		result.Detail = processError(err).Error()
+		return result
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving schedules"); err != nil {
+		result.Detail = err.Error()
+		return result
+	}
+	windows, evaluated, err := findMaintenanceWindows(resp.JSON200.SingleSchedules, resp.JSON200.RepeatedSchedules, targets, now)
+	if err != nil {
+		result.Detail = err.Error()
+		return result
+	}
+	if len(windows) == 0 {
+		result.Passed = true
+		result.Detail = fmt.Sprintf("no maintenance window active, %d schedules target the site or its regions", evaluated)
+		return result
+	}
+	w := windows[0]
+	until := "active with no end"
+	if w.End != nil {
+		until = "active until " + w.End.UTC().Format(scheduleDisplayTimeFormat)
+	}
+	result.Detail = fmt.Sprintf("maintenance window %s (%s) on %s %s", w.Name, w.ResourceID, w.Target, until)
+	return result
+}
+
+// The services of the API are reachable when none of the checks of doctor fails
+func verifySiteAPI(cmd *cobra.Command) verifyResult {
+	result := verifyResult{Check: "api"}
+	var names, failures []string
+	for _, service := range doctorServices {
+		resp, err := service.call(cmd)
+		check := doctorServiceCheck(service.name, service.description, resp, err)
+		names = append(names, service.name)
+		if check.Status == doctorFail {
+			failures = append(failures, fmt.Sprintf("%s %s", service.name, check.Detail))
+		}
+	}
+	if len(failures) > 0 {
+		result.Detail = strings.Join(failures, "; ")
+		return result
+	}
+	result.Passed = true
+	result.Detail = fmt.Sprintf("%s and %s reachable", strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+	return result
+}
+
+func listAllOSProfiles(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.OperatingSystemResource, error) {
+	profiles := make([]infra.OperatingSystemResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.OperatingSystemServiceListOperatingSystemsWithResponse(ctx, projectName,
+			&infra.OperatingSystemServiceListOperatingSystemsParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving OS profiles"); err != nil {
+			return 0, false, err
+		}
+		profiles = append(profiles, resp.JSON200.OperatingSystemResources...)
+		return len(resp.JSON200.OperatingSystemResources), resp.JSON200.HasNext, nil
+	})
+	return profiles, err
+}
+
+func listAllProviders(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.ProviderResource, error) {
+	providers := make([]infra.ProviderResource, 0)
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.ProviderServiceListProvidersWithResponse(ctx, projectName,
+			&infra.ProviderServiceListProvidersParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving providers"); err != nil {
+			return 0, false, err
+		}
+		providers = append(providers, resp.JSON200.Providers...)
+		return len(resp.JSON200.Providers), resp.JSON200.HasNext, nil
+	})
+	return providers, err
+}
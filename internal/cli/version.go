@@ -5,27 +5,183 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"runtime"
+	"runtime/debug"
+	"sort"
 
+	"github.com/open-edge-platform/cli/pkg/auth"
+	orchapi "github.com/open-edge-platform/cli/pkg/rest/orchutilities"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var Version = "dev"
 
+const versionExamples = `# Print the versions of the CLI and of the Edge Orchestrator it targets
+orch-cli version
+
+# Sample output
+Orchestrator CLI version v2026.0.0 amd64
+Commit:     3f2a9c1d7e4b
+Built:      2026-03-02T10:15:00Z
+Go version: go1.24.4 linux/amd64
+
+Target Edge Orchestrator version v2026.0.0 (https://api.example.com)
+Components:
+  application-orchestration                 installed
+  cluster-orchestration                     installed
+  edge-infrastructure-manager               installed
+  edge-infrastructure-manager.day2          not installed
+
+# Print the version of the CLI only, without contacting the orchestrator
+orch-cli version --client
+`
+
+// cliBuildInfo describes how the running binary was built
+type cliBuildInfo struct {
+	commit    string
+	modified  bool
+	time      string
+	goVersion string
+}
+
 func versionCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Get Orchestrator CLI version",
-		RunE: func(_ *cobra.Command, _ []string) error {
-			fmt.Printf("Orchestrator CLI version %s %s\n", Version, runtime.GOARCH)
+		Long: "Prints the version and build details of the CLI, then the version and installed components of the " +
+			"Edge Orchestrator at the API endpoint, and warns when the CLI release does not match the orchestrator release. " +
+			"The version stored at login is printed when the orchestrator cannot be reached.",
+		Example: versionExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runVersionCommand,
+	}
+	cmd.Flags().Bool("client", false, "Print the version of the CLI only, without contacting the orchestrator")
+	return cmd
+}
+
+func runVersionCommand(cmd *cobra.Command, _ []string) error {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Orchestrator CLI version %s %s\n", Version, runtime.GOARCH)
+	printCLIBuildInfo(out, readCLIBuildInfo())
+
+	if client, _ := cmd.Flags().GetBool("client"); client {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	info, err := getOrchestratorInfo(cmd)
+	if err != nil || info.Orchestrator == nil || info.Orchestrator.Version == nil {
+		if cached := viper.GetString(OrchVersion); cached != "" {
+			fmt.Fprintf(out, "Target Edge Orchestrator version %s (stored at login)\n", cached)
+		} else {
+			fmt.Fprintf(out, "Target Edge Orchestrator version not retrieved\n")
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not get the orchestrator version: %v\n", err)
+		}
+		return nil
+	}
+
+	endpoint, _ := cmd.Flags().GetString(apiEndpoint)
+	fmt.Fprintf(out, "Target Edge Orchestrator version %s (%s)\n", *info.Orchestrator.Version, endpoint)
+	if err := printOrchestratorComponents(cmd, out, info.Orchestrator.Features); err != nil {
+		return err
+	}
+
+	if check := compareDoctorVersions(Version, *info.Orchestrator.Version); check.Status != doctorOK {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s: %s\n", check.Detail, check.Fix)
+	}
+	return nil
+}
 
-			if viper.GetString(OrchVersion) != "" {
-				fmt.Printf("Target Edge Orchestrator version %s\n", viper.GetString(OrchVersion))
-			} else {
-				fmt.Printf("Target Edge Orchestrator version not retrieved\n")
+func readCLIBuildInfo() cliBuildInfo {
+	build := cliBuildInfo{goVersion: runtime.Version()}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return build
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.commit = setting.Value
+		case "vcs.time":
+			build.time = setting.Value
+		case "vcs.modified":
+			build.modified = setting.Value == "true"
+		}
+	}
+	return build
+}
+
+func printCLIBuildInfo(w io.Writer, build cliBuildInfo) {
+	commit := "unknown"
+	if build.commit != "" {
+		commit = build.commit[:min(len(build.commit), 12)]
+		if build.modified {
+			commit += " (modified)"
+		}
+	}
+	fmt.Fprintf(w, "Commit:     %s\n", commit)
+	if build.time != "" {
+		fmt.Fprintf(w, "Built:      %s\n", build.time)
+	}
+	fmt.Fprintf(w, "Go version: %s %s/%s\n", build.goVersion, runtime.GOOS, runtime.GOARCH)
+}
+
+// Queries the orchestrator info service; the access token is sent when logged in, the service may answer without it
+func getOrchestratorInfo(cmd *cobra.Command) (*orchapi.Info, error) {
+	ctx, orchClient, err := OrchestratorFactory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	var editors []orchapi.RequestEditorFn
+	if noAuth, _ := cmd.Flags().GetBool("noauth"); !noAuth && auth.CheckAuth(cmd, nil) == nil {
+		editors = append(editors, auth.AddAuthHeader)
+	}
+	resp, err := orchClient.GetOrchestratorInfoWithResponse(ctx, editors...)
+	if err != nil {
+		return nil, processError(err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("orchestrator info not available (%s)", resp.Status())
+	}
+	return resp.JSON200, nil
+}
+
+// Prints the installed state of the components, nested components with their dotted path
+func printOrchestratorComponents(cmd *cobra.Command, w io.Writer, features map[string]orchapi.FeatureInfo) error {
+	states := map[string]string{}
+	var collect func(prefix string, features map[string]orchapi.FeatureInfo)
+	collect = func(prefix string, features map[string]orchapi.FeatureInfo) {
+		for name, feature := range features {
+			path := prefix + name
+			switch {
+			case feature.Installed == nil:
+				states[path] = "unknown"
+			case *feature.Installed:
+				states[path] = "installed"
+			default:
+				states[path] = "not installed"
 			}
-			return nil
-		},
+			collect(path+".", feature.Features)
+		}
+	}
+	collect("", features)
+	if len(states) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(states))
+	for path := range states {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	fmt.Fprintln(w, "Components:")
+	writer := newOutputWriter(cmd, w)
+	for _, path := range paths {
+		fmt.Fprintf(writer, "  %s\t%s\n", path, states[path])
 	}
+	return writer.Flush()
 }
@@ -4,7 +4,13 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 )
 
 func (s *CLITestSuite) version(project string, args commandArgs) (string, error) {
@@ -12,8 +18,40 @@ func (s *CLITestSuite) version(project string, args commandArgs) (string, error)
 	return s.runCommand(commandString)
 }
 
+func TestPrintCLIBuildInfo(t *testing.T) {
+	var out bytes.Buffer
+	printCLIBuildInfo(&out, cliBuildInfo{commit: "3f2a9c1d7e4b5a6f", modified: true, time: "2026-03-02T10:15:00Z", goVersion: "go1.24.4"})
+	assert.Equal(t, "Commit:     3f2a9c1d7e4b (modified)\nBuilt:      2026-03-02T10:15:00Z\n"+
+		"Go version: go1.24.4 "+runtime.GOOS+"/"+runtime.GOARCH+"\n", out.String())
+
+	out.Reset()
+	printCLIBuildInfo(&out, cliBuildInfo{goVersion: "go1.24.4"})
+	assert.Equal(t, "Commit:     unknown\nGo version: go1.24.4 "+runtime.GOOS+"/"+runtime.GOARCH+"\n", out.String())
+}
+
 func (s *CLITestSuite) TestVersion() {
 
-	_, err := s.version(project, map[string]string{})
+	out, err := s.version(project, map[string]string{})
+	s.NoError(err)
+	s.Contains(out, "Orchestrator CLI version dev "+runtime.GOARCH)
+	s.Contains(out, "Go version: "+runtime.Version())
+	s.Contains(out, "Target Edge Orchestrator version v2026.0.0-test (http://unit-test-api")
+	s.Regexp(`(?m)^  application-orchestration\s+\|installed$`, out)
+	s.Regexp(`(?m)^  edge-infrastructure-manager\.onboarding\s+\|installed$`, out)
+	s.Contains(out, "Warning: CLI dev, orchestrator v2026.0.0-test: this is a development build")
+
+	out, err = s.version(project, map[string]string{"client": ""})
+	s.NoError(err)
+	s.Contains(out, "Orchestrator CLI version dev")
+	s.NotContains(out, "Target Edge Orchestrator")
+
+	// The version stored at login is shown when the orchestrator does not answer
+	viper.Set("test_orchestrator_404", true)
+	defer viper.Set("test_orchestrator_404", false)
+	defer viper.Set(OrchVersion, viper.GetString(OrchVersion))
+	viper.Set(OrchVersion, "v2025.2.0")
+	out, err = s.version(project, map[string]string{})
 	s.NoError(err)
+	s.Contains(out, "Target Edge Orchestrator version v2025.2.0 (stored at login)")
+	s.Contains(out, "Warning: could not get the orchestrator version: orchestrator info not available (Not Found)")
 }
@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	outfmt "github.com/open-edge-platform/cli/internal/format"
+	"github.com/open-edge-platform/cli/pkg/auth"
+	coapi "github.com/open-edge-platform/cli/pkg/rest/cluster"
+	infra "github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultWaitInterval = 10 * time.Second
+	waitConditionPrefix = "condition="
+)
+
+const waitHostExamples = `# Wait up to 30 minutes for a host to be provisioned, e.g. in a pipeline that provisions then deploys
+orch-cli wait host host-1234abcd --for condition=provisioned --timeout 30m --project some-project
+
+# Wait for a host given by name to be running, checking every 30 seconds
+orch-cli wait host edge-host-001 --for condition=running --interval 30s --project some-project
+`
+
+const waitInstanceExamples = `# Wait for the OS update of an instance to complete
+orch-cli wait instance inst-1234abcd --for condition=completed --timeout 1h --project some-project
+`
+
+const waitOSUpdateRunExamples = `# Wait for an OS update run to complete; the command fails if the run finishes with another status
+orch-cli wait osupdaterun osupdaterun-1234abcd --for condition=completed --timeout 2h --project some-project
+`
+
+const waitClusterExamples = `# Wait for a cluster to be active, i.e. all its statuses are ready
+orch-cli wait cluster cluster-edge --for condition=active --timeout 45m --project some-project
+`
+
+// waitState is the state of a waited resource at one poll
+type waitState struct {
+	// Status shown while waiting
+	Status string
+	// The condition is met
+	Met bool
+	// The resource reached a final state without meeting the condition, e.g. a failed OS update run
+	Final bool
+}
+
+// waitProbe reads the state of a waited resource
+type waitProbe func() (waitState, error)
+
+func getWaitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for Edge Orchestrator resources to reach a condition",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if isCommandDisabledWithParent(c, args[0]) {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: command %q is disabled in the current Edge Orchestrator configuration\n\n", args[0])
+				} else {
+					fmt.Fprintf(c.ErrOrStderr(), "Error: unknown command %q for %q\n\n", args[0], c.CommandPath())
+				}
+			}
+			return c.Usage()
+		},
+	}
+	addCommandIfFeatureEnabled(cmd, getWaitHostCommand(), OnboardingFeature)
+	addCommandIfFeatureEnabled(cmd, getWaitInstanceCommand(), ProvisioningFeature)
+	addCommandIfFeatureEnabled(cmd, getWaitOSUpdateRunCommand(), Day2Feature)
+	addCommandIfFeatureEnabled(cmd, getWaitClusterCommand(), ClusterOrchFeature)
+	return cmd
+}
+
+func getWaitHostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host <name|resourceID> --for condition=<status> [flags]",
+		Short: "Waits for a host to reach a status",
+		Long: "Polls a host until its status, or the provisioning or instance status of its instance, matches the " +
+			"condition, e.g. onboarded, provisioned or running. " + waitLongSuffix,
+		Example: waitHostExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: hostAliases,
+		RunE:    runWaitHostCommand,
+	}
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func getWaitInstanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance <resourceID> --for condition=<status> [flags]",
+		Short: "Waits for an instance to reach a status",
+		Long: "Polls an instance until its current state, instance, provisioning or update status matches the " +
+			"condition, e.g. running or completed. " + waitLongSuffix,
+		Example: waitInstanceExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: instanceAliases,
+		RunE:    runWaitInstanceCommand,
+	}
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func getWaitOSUpdateRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "osupdaterun <name|resourceID> --for condition=<status> [flags]",
+		Short: "Waits for an OS update run to reach a status",
+		Long: "Polls an OS update run until its status matches the condition, e.g. completed; the command fails " +
+			"as soon as the run ends with another status. " + waitLongSuffix,
+		Example: waitOSUpdateRunExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: osUpdateRunAliases,
+		RunE:    runWaitOSUpdateRunCommand,
+	}
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func getWaitClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster <name> --for condition=<status> [flags]",
+		Short: "Waits for a cluster to reach a status",
+		Long: "Polls a cluster until it is active, i.e. its lifecycle phase, provider, control plane, infrastructure " +
+			"and node health statuses are all ready, or until its lifecycle phase matches another condition. " + waitLongSuffix,
+		Example: waitClusterExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: clusterAliases,
+		RunE:    runWaitClusterCommand,
+	}
+	addWaitFlags(cmd)
+	return cmd
+}
+
+const waitLongSuffix = "Statuses are matched ignoring case, spaces and underscores, and without their prefix, " +
+	"e.g. completed matches PROVISIONING_STATUS_COMPLETED. The wait is bounded by --timeout."
+
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().String("for", "", "Condition to wait for, condition=<status> (mandatory)")
+	_ = cmd.MarkFlagRequired("for")
+	cmd.Flags().Duration("interval", defaultWaitInterval, "Time between two polls of the resource")
+}
+
+// Reads the condition of --for and the poll interval
+func getWaitFlags(cmd *cobra.Command) (string, time.Duration, error) {
+	forFlag, _ := cmd.Flags().GetString("for")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	condition, found := strings.CutPrefix(strings.TrimSpace(forFlag), waitConditionPrefix)
+	if !found || strings.TrimSpace(condition) == "" {
+		return "", 0, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("invalid --for %q, expected condition=<status>", forFlag))
+	}
+	if interval <= 0 {
+		return "", 0, e.WithCode(e.CodeInvalidArgument, fmt.Errorf("--interval must be a positive duration, got %s", interval))
+	}
+	return strings.TrimSpace(condition), interval, nil
+}
+
+// Polls a resource until its condition is met; status changes are reported on stderr. The deadline of --timeout
+// is carried by the context of the command
+func pollUntil(cmd *cobra.Command, resource string, condition string, interval time.Duration, probe waitProbe) error {
+	return pollUntilContext(commandContext(cmd), cmd, resource, condition, interval, probe)
+}
+
+// Polls a resource like pollUntil, until the deadline of ctx
+func pollUntilContext(deadline context.Context, cmd *cobra.Command, resource string, condition string, interval time.Duration, probe waitProbe) error {
+	last := ""
+	for {
+		state, err := probe()
+		if err != nil {
+			return err
+		}
+		if state.Met {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s condition %s met: %s\n", resource, condition, state.Status)
+			return nil
+		}
+		if state.Final {
+			return e.WithCode(e.CodeFailedPrecondition, fmt.Errorf("%s ended with %s, condition %s can no longer be met", resource, state.Status, condition))
+		}
+		if state.Status != last {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s: %s, waiting for condition %s\n", resource, state.Status, condition)
+			last = state.Status
+		}
+		select {
+		case <-deadline.Done():
+			return fmt.Errorf("%s did not meet condition %s, last status: %s", resource, condition, last)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Matches a status against a condition ignoring case, spaces and underscores; an enum status such as
+// PROVISIONING_STATUS_COMPLETED or INSTANCE_STATE_RUNNING also matches without its prefix
+func statusMatchesCondition(status string, condition string) bool {
+	if status == "" {
+		return false
+	}
+	want := normalizeColumnName(condition)
+	if normalizeColumnName(status) == want {
+		return true
+	}
+	for _, marker := range []string{"STATUS_", "STATE_"} {
+		if i := strings.LastIndex(status, marker); i >= 0 && normalizeColumnName(status[i+len(marker):]) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// Joins the non-empty statuses of a resource as "<name> <status>" pairs
+func waitStatusSummary(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			parts = append(parts, pairs[i]+" "+pairs[i+1])
+		}
+	}
+	if len(parts) == 0 {
+		return "no status"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func runWaitHostCommand(cmd *cobra.Command, args []string) error {
+	condition, interval, err := getWaitFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx, hostClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	return pollUntil(cmd, "host "+args[0], condition, interval, func() (waitState, error) {
+		host, err := getHostByNameOrID(ctx, hostClient, projectName, args[0])
+		if err != nil {
+			return waitState{}, err
+		}
+		statuses := []string{outfmt.HostStatus(host)}
+		var provisioning, instance string
+		if host.Instance != nil {
+			provisioning, instance = safeString(host.Instance.ProvisioningStatus), safeString(host.Instance.InstanceStatus)
+			statuses = append(statuses, provisioning, instance)
+		}
+		state := waitState{Status: waitStatusSummary("host", outfmt.HostStatus(host), "provisioning", provisioning, "instance", instance)}
+		for _, status := range statuses {
+			state.Met = state.Met || statusMatchesCondition(status, condition)
+		}
+		return state, nil
+	})
+}
+
+func runWaitInstanceCommand(cmd *cobra.Command, args []string) error {
+	condition, interval, err := getWaitFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx, instanceClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	return pollUntil(cmd, "instance "+args[0], condition, interval, func() (waitState, error) {
+		resp, err := instanceClient.InstanceServiceGetInstanceWithResponse(ctx, projectName, args[0], auth.AddAuthHeader)
+		if err != nil {
+			return waitState{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting instance"); err != nil {
+			return waitState{}, err
+		}
+		instance := resp.JSON200
+		current := safeString((*string)(instance.CurrentState))
+		statuses := []string{current, safeString(instance.InstanceStatus), safeString(instance.ProvisioningStatus), safeString(instance.UpdateStatus)}
+		state := waitState{Status: waitStatusSummary("state", statuses[0], "instance", statuses[1], "provisioning", statuses[2], "update", statuses[3])}
+		for _, status := range statuses {
+			state.Met = state.Met || statusMatchesCondition(status, condition)
+		}
+		return state, nil
+	})
+}
+
+func runWaitOSUpdateRunCommand(cmd *cobra.Command, args []string) error {
+	condition, interval, err := getWaitFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx, runClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	return pollUntil(cmd, "OS update run "+args[0], condition, interval, func() (waitState, error) {
+		run, err := getOSUpdateRunByNameOrID(ctx, runClient, projectName, args[0])
+		if err != nil {
+			return waitState{}, err
+		}
+		status := safeString(run.Status)
+		return waitState{
+			Status: waitStatusSummary("status", status),
+			Met:    statusMatchesCondition(status, condition),
+			// A run with an end time is over, its status no longer changes
+			Final: run.EndTime != nil && *run.EndTime > 0,
+		}, nil
+	})
+}
+
+func runWaitClusterCommand(cmd *cobra.Command, args []string) error {
+	condition, interval, err := getWaitFlags(cmd)
+	if err != nil {
+		return err
+	}
+	ctx, clusterClient, projectName, err := ClusterFactory(cmd)
+	if err != nil {
+		return err
+	}
+
+	return pollUntil(cmd, "cluster "+args[0], condition, interval, func() (waitState, error) {
+		cluster, err := getClusterDetails(ctx, clusterClient, projectName, args[0])
+		if err != nil {
+			return waitState{}, err
+		}
+		phase := ""
+		if cluster.LifecyclePhase != nil {
+			phase = safeString(cluster.LifecyclePhase.Message)
+		}
+		state := waitState{Status: waitStatusSummary("lifecycle phase", phase)}
+		switch normalizeColumnName(condition) {
+		case "active", "ready":
+			state.Met = clusterReady(coapi.ClusterInfo{
+				LifecyclePhase:      cluster.LifecyclePhase,
+				ProviderStatus:      cluster.ProviderStatus,
+				ControlPlaneReady:   cluster.ControlPlaneReady,
+				InfrastructureReady: cluster.InfrastructureReady,
+				NodeHealth:          cluster.NodeHealth,
+			})
+		default:
+			state.Met = statusMatchesCondition(phase, condition)
+		}
+		return state, nil
+	})
+}
+
+// Retrieves an OS update run given by resource ID or by name
+func getOSUpdateRunByNameOrID(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string, query string) (infra.OSUpdateRun, error) {
+	if isOSUpdateRunResourceID(query) {
+		resp, err := client.OSUpdateRunGetOSUpdateRunWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return infra.OSUpdateRun{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting OS Update run"); err != nil {
+			return infra.OSUpdateRun{}, err
+		}
+		if resp.JSON200 == nil {
+			return infra.OSUpdateRun{}, errors.New("error getting OS Update run: empty response")
+		}
+		return *resp.JSON200, nil
+	}
+	resp, err := client.OSUpdateRunListOSUpdateRunWithResponse(ctx, projectName,
+		&infra.OSUpdateRunListOSUpdateRunParams{}, auth.AddAuthHeader)
+	if err != nil {
+		return infra.OSUpdateRun{}, processError(err)
+	}
+	if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving OS Update runs"); err != nil {
+		return infra.OSUpdateRun{}, err
+	}
+	return findOSUpdateRunByName(resp.JSON200.OsUpdateRuns, query)
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestWait() {
+	out, err := s.runCommand("wait host edge-host-001 --for condition=running --project " + project)
+	s.NoError(err)
+	s.Equal("host edge-host-001 condition running met: host Running\n", out)
+
+	out, err = s.runCommand("wait instance instance-abcd1234 --for condition=completed --project " + project)
+	s.NoError(err)
+	s.Contains(out, "instance instance-abcd1234 condition completed met: state INSTANCE_STATE_RUNNING, provisioning PROVISIONING_STATUS_COMPLETED")
+
+	out, err = s.runCommand("wait osupdaterun osupdaterun-abcd1234 --for condition=completed --project " + project)
+	s.NoError(err)
+	s.Equal("OS update run osupdaterun-abcd1234 condition completed met: status completed\n", out)
+
+	out, err = s.runCommand("wait cluster cluster-edge --for condition=active --project " + project)
+	s.NoError(err)
+	s.Equal("cluster cluster-edge condition active met: lifecycle phase Provisioned\n", out)
+
+	// Waiting stops at the timeout with the last status
+	out, err = s.runCommand("wait host host-abc12345 --for condition=provisioned --interval 10ms --timeout 50ms --project " + project)
+	s.EqualError(err, "command timed out after 50ms: host host-abc12345 did not meet condition provisioned, last status: host Running")
+	s.Contains(out, "host host-abc12345: host Running, waiting for condition provisioned\n")
+
+	// A finished run that did not meet the condition fails without waiting
+	_, err = s.runCommand("wait osupdaterun osupdaterun-abcd1234 --for condition=failed --project " + project)
+	s.EqualError(err, "OS update run osupdaterun-abcd1234 ended with status completed, condition failed can no longer be met")
+
+	_, err = s.runCommand("wait host host-abc12345 --for provisioned --project " + project)
+	s.EqualError(err, `invalid --for "provisioned", expected condition=<status>`)
+
+	_, err = s.runCommand("wait host host-abc12345 --for condition=running --interval 0s --project " + project)
+	s.EqualError(err, "--interval must be a positive duration, got 0s")
+}
+
+func TestStatusMatchesCondition(t *testing.T) {
+	assert.True(t, statusMatchesCondition("Running", "running"))
+	assert.True(t, statusMatchesCondition("Not Connected", "not-connected"))
+	assert.True(t, statusMatchesCondition("PROVISIONING_STATUS_COMPLETED", "completed"))
+	assert.True(t, statusMatchesCondition("INSTANCE_STATE_RUNNING", "Running"))
+	assert.True(t, statusMatchesCondition("UPDATE_STATUS_IN_PROGRESS", "in progress"))
+	assert.False(t, statusMatchesCondition("Not Connected", "connected"))
+	assert.False(t, statusMatchesCondition("", "running"))
+	assert.False(t, statusMatchesCondition("Provisioning", "provisioned"))
+}
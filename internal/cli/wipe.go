@@ -18,7 +18,7 @@ func getWipeProjectCommand() *cobra.Command {
 		Use:               "wipe [flags]",
 		Args:              cobra.NoArgs,
 		Short:             "Wipe all data associated with the specified project",
-		PersistentPreRunE: auth.CheckAuth,
+		PersistentPreRunE: checkAuth,
 		Example:           "orch-cli wipe --project some-project --yes",
 		RunE:              runWipeProjectCommand,
 	}
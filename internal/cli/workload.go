@@ -0,0 +1,475 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/open-edge-platform/cli/pkg/auth"
+	"github.com/open-edge-platform/cli/pkg/format"
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/spf13/cobra"
+)
+
+const (
+	DEFAULT_WORKLOAD_FORMAT         = "table{{.ResourceId}}\t{{.Name}}\t{{.Kind}}\t{{.Status}}\t{{.MemberCount}}\t{{.Hosts}}"
+	DEFAULT_WORKLOAD_VERBOSE_FORMAT = "table{{.ResourceId}}\t{{.Name}}\t{{.Kind}}\t{{.Status}}\t{{.MemberCount}}\t{{.Hosts}}\t{{.ExternalId}}"
+	DEFAULT_WORKLOAD_INSPECT_FORMAT = `Name: {{.Name}}
+Resource ID: {{.ResourceId}}
+Kind: {{.Kind}}
+Status: {{.Status}}
+External ID: {{.ExternalId}}{{if .Members}}
+Members:{{range .Members}}
+  - Instance: {{.InstanceId}} ({{.InstanceName}}), Host: {{.HostId}} ({{.HostName}}), Kind: {{.Kind}}{{end}}{{else}}
+Members: <none>{{end}}
+`
+	WORKLOAD_OUTPUT_TEMPLATE_ENVVAR  = "ORCH_CLI_WORKLOAD_OUTPUT_TEMPLATE"
+	WORKLOAD_INSPECT_TEMPLATE_ENVVAR = "ORCH_CLI_WORKLOAD_INSPECT_TEMPLATE"
+)
+
+const listWorkloadExamples = `# List all workloads with the hosts backing their members
+orch-cli list workload --project some-project
+
+# List workloads with the instance and host of each member as JSON
+orch-cli list workload --project some-project -o json`
+
+const getWorkloadExamples = `# Get a workload and its members by resource ID
+orch-cli get workload workload-abcd1234 --project some-project
+
+# Get a workload by name
+orch-cli get workload "Edge Kubernetes Cluster" --project some-project`
+
+// workloadResourceIDPattern matches workload resource IDs: "workload-" followed by 8 hex chars.
+var workloadResourceIDPattern = regexp.MustCompile(`^workload-[0-9a-f]{8}$`)
+
+func isWorkloadResourceID(s string) bool {
+	return workloadResourceIDPattern.MatchString(s)
+}
+
+// WorkloadMemberRow is an instance that is a member of a workload, with the host it runs on.
+type WorkloadMemberRow struct { //nolint:revive
+	Kind         string `json:"kind"`
+	InstanceId   string `json:"instanceId"`
+	InstanceName string `json:"instanceName,omitempty"`
+	HostId       string `json:"hostId,omitempty"`
+	HostName     string `json:"hostName,omitempty"`
+}
+
+// WorkloadRow is a workload joined with the instances that are its members and their hosts.
+// Hosts pre-computes the host names for the table column; JSON and YAML carry them per member.
+type WorkloadRow struct { //nolint:revive
+	ResourceId  string              `json:"resourceId"`
+	Name        string              `json:"name"`
+	Kind        string              `json:"kind"`
+	Status      string              `json:"status"`
+	ExternalId  string              `json:"externalId,omitempty"`
+	MemberCount int                 `json:"memberCount"`
+	Hosts       string              `json:"-"`
+	Members     []WorkloadMemberRow `json:"members"`
+}
+
+func getListWorkloadCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "workload [flags]",
+		Short:   "List all workloads with their member instances and hosts",
+		Example: listWorkloadExamples,
+		Aliases: workloadAliases,
+		RunE:    runListWorkloadCommand,
+	}
+	addListOrderingFilteringPaginationFlags(cmd, "workload")
+	addListLimitFlag(cmd, "workload")
+	addStandardListOutputFlags(cmd)
+	addSelectFlag(cmd)
+	return cmd
+}
+
+func getGetWorkloadCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "workload <name|resourceID> [flags]",
+		Short:   "Get a workload with its member instances and hosts",
+		Example: getWorkloadExamples,
+		Args:    cobra.ExactArgs(1),
+		Aliases: workloadAliases,
+		RunE:    runGetWorkloadCommand,
+	}
+	addStandardGetOutputFlags(cmd)
+	return cmd
+}
+
+// Lists all workloads - retrieves workloads, instances and hosts and joins them into one row per workload
+func runListWorkloadCommand(cmd *cobra.Command, _ []string) error {
+	writer, verbose := getOutputContext(cmd)
+	ctx, workloadClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	workloadClient = newMemoInfraClient(workloadClient)
+
+	raw, err := cmd.Flags().GetString("order-by")
+	if err != nil {
+		return err
+	}
+	outputType, _ := cmd.Flags().GetString("output-type")
+
+	var validatedOrderBy *string
+	if outputType == "table" {
+		validatedOrderBy, err = normalizeOrderByForClientSorting(raw, WorkloadRow{})
+	} else {
+		validatedOrderBy, err = normalizeOrderByWithAPIProbe(raw, "workload", infra.WorkloadResource{}, func(orderBy string) (bool, error) {
+			pageSize := 1
+			resp, err := workloadClient.WorkloadServiceListWorkloadsWithResponse(ctx, projectName,
+				&infra.WorkloadServiceListWorkloadsParams{
+					OrderBy:  &orderBy,
+					PageSize: &pageSize,
+				}, auth.AddAuthHeader)
+			if err != nil {
+				return false, processError(err)
+			}
+			if resp.HTTPResponse != nil && resp.HTTPResponse.StatusCode == http.StatusBadRequest {
+				return false, &api400Error{string(resp.Body)}
+			}
+			if err := checkResponse(resp.HTTPResponse, resp.Body, "error validating workload order-by"); err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	apiOrderBy := validatedOrderBy
+	if outputType == "table" {
+		// Table output sorts locally via GenerateOutput(CommandResult.OrderBy).
+		apiOrderBy = nil
+	}
+
+	pagination, err := getListPagination(cmd)
+	if err != nil {
+		return err
+	}
+
+	workloads := make([]infra.WorkloadResource, 0)
+	err = pagination.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := workloadClient.WorkloadServiceListWorkloadsWithResponse(ctx, projectName,
+			&infra.WorkloadServiceListWorkloadsParams{
+				Filter:   getNonEmptyFlag(cmd, "filter"),
+				OrderBy:  apiOrderBy,
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving workloads"); err != nil {
+			return 0, false, err
+		}
+		workloads = append(workloads, resp.JSON200.Workloads...)
+		return len(resp.JSON200.Workloads), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return err
+	}
+	workloads = limitListItems(workloads, pagination)
+
+	instances, hosts, err := listWorkloadMembership(ctx, workloadClient, projectName)
+	if err != nil {
+		return err
+	}
+	rows, err := selectItems(cmd, toWorkloadRows(workloads, instances, hosts))
+	if err != nil {
+		return err
+	}
+
+	outputFormat := DEFAULT_WORKLOAD_VERBOSE_FORMAT
+	if !verbose {
+		outputFormat, err = resolveTableOutputTemplate(cmd, DEFAULT_WORKLOAD_FORMAT, WORKLOAD_OUTPUT_TEMPLATE_ENVVAR)
+		if err != nil {
+			return err
+		}
+	}
+	sortSpec := ""
+	filterSpec := ""
+	outputFilter, _ := cmd.Flags().GetString("output-filter")
+	if outputType == "table" {
+		if validatedOrderBy != nil {
+			sortSpec = *validatedOrderBy
+		}
+		filterSpec = outputFilter
+	}
+
+	result := CommandResult{
+		Format:    format.Format(outputFormat),
+		Filter:    filterSpec,
+		OrderBy:   sortSpec,
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      rows,
+	}
+	if err := withListColumns(cmd, &result); err != nil {
+		return err
+	}
+	GenerateOutput(writer, &result)
+	return writer.Flush()
+}
+
+// Gets a workload given by resource ID or by name and displays its members with their hosts
+func runGetWorkloadCommand(cmd *cobra.Command, args []string) error {
+	writer, _ := getOutputContext(cmd)
+	ctx, workloadClient, projectName, err := InfraFactory(cmd)
+	if err != nil {
+		return err
+	}
+	workloadClient = newMemoInfraClient(workloadClient)
+
+	workload, err := getWorkloadByNameOrID(ctx, workloadClient, projectName, args[0])
+	if err != nil {
+		return err
+	}
+	instances, hosts, err := listWorkloadMembership(ctx, workloadClient, projectName)
+	if err != nil {
+		return err
+	}
+	row := toWorkloadRows([]infra.WorkloadResource{workload}, instances, hosts)[0]
+
+	if selected, err := printSelectedOutput(cmd, row); selected {
+		return err
+	}
+
+	outputType, _ := cmd.Flags().GetString("output-type")
+	outputFormat, err := resolveTableOutputTemplate(cmd, DEFAULT_WORKLOAD_INSPECT_FORMAT, WORKLOAD_INSPECT_TEMPLATE_ENVVAR)
+	if err != nil {
+		return err
+	}
+	result := CommandResult{
+		Format:    format.Format(outputFormat),
+		OutputAs:  toOutputType(outputType),
+		NameLimit: -1,
+		Data:      row,
+	}
+	GenerateOutput(writer, &result)
+	return writer.Flush()
+}
+
+// Retrieves a workload given by resource ID or by name
+func getWorkloadByNameOrID(ctx context.Context, workloadClient infra.ClientWithResponsesInterface, projectName string, query string) (infra.WorkloadResource, error) {
+	if isWorkloadResourceID(query) {
+		resp, err := workloadClient.WorkloadServiceGetWorkloadWithResponse(ctx, projectName, query, auth.AddAuthHeader)
+		if err != nil {
+			return infra.WorkloadResource{}, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error getting workload"); err != nil {
+			return infra.WorkloadResource{}, err
+		}
+		return *resp.JSON200, nil
+	}
+
+	var matches []infra.WorkloadResource
+	err := listPagination{PageSize: defaultListPageSize}.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := workloadClient.WorkloadServiceListWorkloadsWithResponse(ctx, projectName,
+			&infra.WorkloadServiceListWorkloadsParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving workloads"); err != nil {
+			return 0, false, err
+		}
+		for _, w := range resp.JSON200.Workloads {
+			if derefString(w.Name) == query {
+				matches = append(matches, w)
+			}
+		}
+		return len(resp.JSON200.Workloads), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return infra.WorkloadResource{}, err
+	}
+	switch len(matches) {
+	case 0:
+		return infra.WorkloadResource{}, fmt.Errorf("no workload found with name %q", query)
+	case 1:
+		return matches[0], nil
+	default:
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "multiple workloads found with name %q; use a resource ID instead:\n", query)
+		for _, m := range matches {
+			fmt.Fprintf(&sb, "  name: %s  resource-id: %s\n", derefString(m.Name), derefString(m.ResourceId))
+		}
+		return infra.WorkloadResource{}, errors.New(strings.TrimRight(sb.String(), "\n"))
+	}
+}
+
+// Retrieves all instances and hosts of the project; workload membership is recorded on the instances
+func listWorkloadMembership(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.InstanceResource, []infra.HostResource, error) {
+	instances, err := listAllInstances(ctx, client, projectName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	all := listPagination{PageSize: defaultListPageSize}
+	hosts := make([]infra.HostResource, 0)
+	err = all.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.HostServiceListHostsWithResponse(ctx, projectName,
+			&infra.HostServiceListHostsParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving hosts"); err != nil {
+			return 0, false, err
+		}
+		hosts = append(hosts, resp.JSON200.Hosts...)
+		return len(resp.JSON200.Hosts), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return instances, hosts, nil
+}
+
+// Retrieves all instances of the project, in pages of the size shared by the subroutines listing them so that
+// a memoized client serves the pages already fetched
+func listAllInstances(ctx context.Context, client infra.ClientWithResponsesInterface, projectName string) ([]infra.InstanceResource, error) {
+	all := listPagination{PageSize: defaultListPageSize}
+	instances := make([]infra.InstanceResource, 0)
+	err := all.fetch(func(pageSize int, offset int) (int, bool, error) {
+		resp, err := client.InstanceServiceListInstancesWithResponse(ctx, projectName,
+			&infra.InstanceServiceListInstancesParams{
+				PageSize: &pageSize,
+				Offset:   &offset,
+			}, auth.AddAuthHeader)
+		if err != nil {
+			return 0, false, processError(err)
+		}
+		if err := checkResponse(resp.HTTPResponse, resp.Body, "error while retrieving instances"); err != nil {
+			return 0, false, err
+		}
+		instances = append(instances, resp.JSON200.Instances...)
+		return len(resp.JSON200.Instances), resp.JSON200.HasNext, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// Joins workloads with the instances that are members of them and the hosts these instances run on.
+// Members are taken from the workload membership of the instances and from the workload itself,
+// so that a member is listed even if one of the two sides omits it.
+func toWorkloadRows(workloads []infra.WorkloadResource, instances []infra.InstanceResource, hosts []infra.HostResource) []WorkloadRow {
+	hostByInstance := make(map[string]infra.HostResource)
+	for _, h := range hosts {
+		if h.Instance == nil {
+			continue
+		}
+		for _, id := range []*string{h.Instance.ResourceId, h.Instance.InstanceID} {
+			if derefString(id) != "" {
+				hostByInstance[*id] = h
+			}
+		}
+	}
+
+	instanceByID := make(map[string]infra.InstanceResource)
+	membersByWorkload := make(map[string][]WorkloadMemberRow)
+	for _, inst := range instances {
+		id := derefString(inst.ResourceId)
+		if id == "" {
+			id = derefString(inst.InstanceID)
+		}
+		instanceByID[id] = inst
+		if inst.WorkloadMembers == nil {
+			continue
+		}
+		for _, m := range *inst.WorkloadMembers {
+			workloadID := derefString(m.WorkloadId)
+			if m.Workload != nil && derefString(m.Workload.ResourceId) != "" {
+				workloadID = *m.Workload.ResourceId
+			}
+			membersByWorkload[workloadID] = append(membersByWorkload[workloadID], newWorkloadMemberRow(m.Kind, id, inst, hostByInstance))
+		}
+	}
+
+	rows := make([]WorkloadRow, 0, len(workloads))
+	for _, w := range workloads {
+		id := derefString(w.ResourceId)
+		members := membersByWorkload[id]
+		if w.Members != nil {
+			for _, m := range *w.Members {
+				instanceID := derefString(m.InstanceId)
+				for _, inst := range []*infra.InstanceResource{m.Instance, m.Member} {
+					if instanceID == "" && inst != nil {
+						instanceID = derefString(inst.ResourceId)
+					}
+				}
+				if instanceID == "" || containsWorkloadMember(members, instanceID) {
+					continue
+				}
+				members = append(members, newWorkloadMemberRow(m.Kind, instanceID, instanceByID[instanceID], hostByInstance))
+			}
+		}
+
+		hostNames := make([]string, 0, len(members))
+		for _, m := range members {
+			if m.HostName != "" {
+				hostNames = append(hostNames, m.HostName)
+			} else if m.HostId != "" {
+				hostNames = append(hostNames, m.HostId)
+			}
+		}
+		hostsColumn := strings.Join(hostNames, ",")
+		if hostsColumn == "" {
+			hostsColumn = "<none>"
+		}
+
+		if members == nil {
+			members = []WorkloadMemberRow{}
+		}
+		rows = append(rows, WorkloadRow{
+			ResourceId:  id,
+			Name:        derefString(w.Name),
+			Kind:        strings.TrimPrefix(string(w.Kind), "WORKLOAD_KIND_"),
+			Status:      derefString(w.Status),
+			ExternalId:  derefString(w.ExternalId),
+			MemberCount: len(members),
+			Hosts:       hostsColumn,
+			Members:     members,
+		})
+	}
+	return rows
+}
+
+func newWorkloadMemberRow(kind infra.WorkloadMemberKind, instanceID string, inst infra.InstanceResource, hostByInstance map[string]infra.HostResource) WorkloadMemberRow {
+	row := WorkloadMemberRow{
+		Kind:         strings.TrimPrefix(string(kind), "WORKLOAD_MEMBER_KIND_"),
+		InstanceId:   instanceID,
+		InstanceName: derefString(inst.Name),
+	}
+	if h, ok := hostByInstance[instanceID]; ok {
+		row.HostId, row.HostName = derefString(h.ResourceId), h.Name
+	} else if inst.Host != nil {
+		row.HostId, row.HostName = derefString(inst.Host.ResourceId), inst.Host.Name
+	} else {
+		row.HostId = derefString(inst.HostID)
+	}
+	return row
+}
+
+func containsWorkloadMember(members []WorkloadMemberRow, instanceID string) bool {
+	for _, m := range members {
+		if m.InstanceId == instanceID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CLITestSuite) TestListWorkload() {
+	out, err := s.runCommand("list workload --project " + project)
+	s.NoError(err)
+	s.Regexp(`(?m)^workload-abcd1234\s+\|Edge Kubernetes Cluster\s+\|CLUSTER\s+\|Running\s+\|1\s+\|edge-host-001`, out)
+	s.Regexp(`(?m)^workload-abcd5678\s+\|empty-workload\s+\|CLUSTER\s+\|Pending\s+\|0\s+\|<none>`, out)
+
+	out, err = s.runCommand("list workload --project " + project + " -o json")
+	s.NoError(err)
+	var rows []WorkloadRow
+	s.NoError(json.Unmarshal([]byte(out), &rows))
+	s.Len(rows, 2)
+	s.Equal([]WorkloadMemberRow{{
+		Kind:         "CLUSTER_NODE",
+		InstanceId:   "instance-abcd1234",
+		InstanceName: "edge-instance-001",
+		HostId:       "host-abc12345",
+		HostName:     "edge-host-001",
+	}}, rows[0].Members)
+
+	_, err = s.runCommand("list workload --project nonexistent-project")
+	s.Error(err)
+}
+
+func (s *CLITestSuite) TestGetWorkload() {
+	out, err := s.runCommand("get workload workload-abcd1234 --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Name: Edge Kubernetes Cluster")
+	s.Contains(out, "External ID: k8s-cluster-east-001")
+	s.Contains(out, "  - Instance: instance-abcd1234 (edge-instance-001), Host: host-abc12345 (edge-host-001), Kind: CLUSTER_NODE")
+
+	out, err = s.runCommand("get workload empty-workload --project " + project)
+	s.NoError(err)
+	s.Contains(out, "Resource ID: workload-abcd5678")
+	s.Contains(out, "Members: <none>")
+
+	_, err = s.runCommand("get workload missing-workload --project " + project)
+	s.EqualError(err, `no workload found with name "missing-workload"`)
+
+	_, err = s.runCommand("get workload workload-00000000 --project " + project)
+	s.ErrorContains(err, "error getting workload")
+}
+
+func TestToWorkloadRows(t *testing.T) {
+	// The member is only recorded on the workload; its host is found through the instance of the host
+	workloads := []infra.WorkloadResource{{
+		ResourceId: stringPtr("workload-00000001"),
+		Name:       stringPtr("wl"),
+		Kind:       infra.WORKLOADKINDCLUSTER,
+		Members: &[]infra.WorkloadMember{
+			{Kind: infra.WORKLOADMEMBERKINDCLUSTERNODE, Member: &infra.InstanceResource{ResourceId: stringPtr("inst-1")}},
+			{Kind: infra.WORKLOADMEMBERKINDCLUSTERNODE, InstanceId: stringPtr("inst-2")},
+		},
+	}}
+	instances := []infra.InstanceResource{
+		{ResourceId: stringPtr("inst-1"), Name: stringPtr("instance one")},
+		{ResourceId: stringPtr("inst-2"), HostID: stringPtr("host-2")},
+	}
+	hosts := []infra.HostResource{
+		{ResourceId: stringPtr("host-1"), Name: "node-1", Instance: &infra.InstanceResource{ResourceId: stringPtr("inst-1")}},
+	}
+
+	rows := toWorkloadRows(workloads, instances, hosts)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, 2, rows[0].MemberCount)
+	assert.Equal(t, "node-1,host-2", rows[0].Hosts)
+	assert.Equal(t, WorkloadMemberRow{Kind: "CLUSTER_NODE", InstanceId: "inst-1", InstanceName: "instance one", HostId: "host-1", HostName: "node-1"}, rows[0].Members[0])
+	assert.Equal(t, "host-2", rows[0].Members[1].HostId)
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package errors // nolint:revive
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ConnectErrorCode is the canonical error code of a failed API call, as named by the Connect protocol.
+// The gRPC gateway behind the orchestrator APIs uses the same set of codes.
+type ConnectErrorCode string
+
+const (
+	CodeCanceled           ConnectErrorCode = "canceled"
+	CodeUnknown            ConnectErrorCode = "unknown"
+	CodeInvalidArgument    ConnectErrorCode = "invalid_argument"
+	CodeDeadlineExceeded   ConnectErrorCode = "deadline_exceeded"
+	CodeNotFound           ConnectErrorCode = "not_found"
+	CodeAlreadyExists      ConnectErrorCode = "already_exists"
+	CodePermissionDenied   ConnectErrorCode = "permission_denied"
+	CodeResourceExhausted  ConnectErrorCode = "resource_exhausted"
+	CodeFailedPrecondition ConnectErrorCode = "failed_precondition"
+	CodeAborted            ConnectErrorCode = "aborted"
+	CodeOutOfRange         ConnectErrorCode = "out_of_range"
+	CodeUnimplemented      ConnectErrorCode = "unimplemented"
+	CodeInternal           ConnectErrorCode = "internal"
+	CodeUnavailable        ConnectErrorCode = "unavailable"
+	CodeDataLoss           ConnectErrorCode = "data_loss"
+	CodeUnauthenticated    ConnectErrorCode = "unauthenticated"
+)
+
+// Process exit codes; every command exits with one of these when it fails
+const (
+	ExitGeneral    = 1
+	ExitValidation = 2
+	ExitNotFound   = 3
+	ExitConflict   = 4
+	ExitAuth       = 5
+	ExitServer     = 6
+)
+
+// gRPC status codes in numeric order, as found in the "code" field of a gRPC gateway error body
+var grpcCodes = []ConnectErrorCode{
+	"", CodeCanceled, CodeUnknown, CodeInvalidArgument, CodeDeadlineExceeded, CodeNotFound, CodeAlreadyExists,
+	CodePermissionDenied, CodeResourceExhausted, CodeFailedPrecondition, CodeAborted, CodeOutOfRange,
+	CodeUnimplemented, CodeInternal, CodeUnavailable, CodeDataLoss, CodeUnauthenticated,
+}
+
+var exitCodes = map[ConnectErrorCode]int{
+	CodeInvalidArgument:    ExitValidation,
+	CodeOutOfRange:         ExitValidation,
+	CodeNotFound:           ExitNotFound,
+	CodeAlreadyExists:      ExitConflict,
+	CodeAborted:            ExitConflict,
+	CodeFailedPrecondition: ExitConflict,
+	CodeUnauthenticated:    ExitAuth,
+	CodePermissionDenied:   ExitAuth,
+	CodeDeadlineExceeded:   ExitServer,
+	CodeResourceExhausted:  ExitServer,
+	CodeUnimplemented:      ExitServer,
+	CodeInternal:           ExitServer,
+	CodeUnavailable:        ExitServer,
+	CodeDataLoss:           ExitServer,
+}
+
+// Codes of the CLI's own errors; the ones not listed are reported as unknown
+var customErrorCodes = map[ErrorCode]ConnectErrorCode{
+	ErrNoComment:              CodeInvalidArgument,
+	ErrOneFieldRequired:       CodeInvalidArgument,
+	ErrInvalidSN:              CodeInvalidArgument,
+	ErrInvalidUUID:            CodeInvalidArgument,
+	ErrInvalidSite:            CodeInvalidArgument,
+	ErrInvalidOSProfile:       CodeInvalidArgument,
+	ErrInvalidLocalAccount:    CodeInvalidArgument,
+	ErrInvalidMetadata:        CodeInvalidArgument,
+	ErrDuplicateSN:            CodeInvalidArgument,
+	ErrDuplicateUUID:          CodeInvalidArgument,
+	ErrCheckFailed:            CodeInvalidArgument,
+	ErrURL:                    CodeInvalidArgument,
+	ErrOSSecurityMismatch:     CodeInvalidArgument,
+	ErrOSProfileRequired:      CodeInvalidArgument,
+	ErrSiteRequired:           CodeInvalidArgument,
+	ErrInvalidClusterTemplate: CodeInvalidArgument,
+	ErrInvalidLVMSize:         CodeInvalidArgument,
+	ErrInvalidOSUpdatePolicy:  CodeInvalidArgument,
+	ErrAuthNFailed:            CodeUnauthenticated,
+	ErrAlreadyRegistered:      CodeAlreadyExists,
+	ErrHostDetailMismatch:     CodeAlreadyExists,
+	ErrInternal:               CodeInternal,
+	ErrHTTPReq:                CodeUnavailable,
+}
+
+// CodedError attaches a ConnectErrorCode to an error without changing its message
+type CodedError struct {
+	Code       ConnectErrorCode
+	HTTPStatus int
+	Err        error
+}
+
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// WithCode returns err annotated with the given code
+func WithCode(code ConnectErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{Code: code, Err: err}
+}
+
+// FromHTTPStatus returns err annotated with the code matching the HTTP status of a failed API call
+func FromHTTPStatus(status int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{Code: CodeFromHTTPStatus(status), HTTPStatus: status, Err: err}
+}
+
+// CodeFromHTTPStatus maps an HTTP status to a code following the Connect protocol's HTTP to code mapping
+func CodeFromHTTPStatus(status int) ConnectErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodePermissionDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeAlreadyExists
+	case http.StatusPreconditionFailed:
+		return CodeFailedPrecondition
+	case http.StatusTooManyRequests:
+		return CodeResourceExhausted
+	case http.StatusNotImplemented:
+		return CodeUnimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return CodeUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeDeadlineExceeded
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeUnknown
+}
+
+// CodeFromGRPC maps a numeric gRPC status code to its ConnectErrorCode
+func CodeFromGRPC(code int) ConnectErrorCode {
+	if code <= 0 || code >= len(grpcCodes) {
+		return CodeUnknown
+	}
+	return grpcCodes[code]
+}
+
+// CodeOf returns the code attached to err or to the CLI error it wraps
+func CodeOf(err error) ConnectErrorCode {
+	codedErr := new(CodedError)
+	if errors.As(err, &codedErr) {
+		return codedErr.Code
+	}
+	customErr := new(CustomError)
+	if errors.As(err, &customErr) {
+		if code, ok := customErrorCodes[customErr.Code]; ok {
+			return code
+		}
+	}
+	return CodeUnknown
+}
+
+// ExitCode returns the process exit code for err
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if code, ok := exitCodes[CodeOf(err)]; ok {
+		return code
+	}
+	return ExitGeneral
+}
+
+// Report is the structured form of an error, emitted with --error-format json
+type Report struct {
+	Code       ConnectErrorCode `json:"code"`
+	Message    string           `json:"message"`
+	ExitCode   int              `json:"exitCode"`
+	HTTPStatus int              `json:"httpStatus,omitempty"`
+}
+
+// NewReport builds the structured form of err
+func NewReport(err error) Report {
+	report := Report{Code: CodeOf(err), Message: err.Error(), ExitCode: ExitCode(err)}
+	codedErr := new(CodedError)
+	if errors.As(err, &codedErr) {
+		report.HTTPStatus = codedErr.HTTPStatus
+	}
+	return report
+}
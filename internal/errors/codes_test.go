@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package errors // nolint:revive
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     ConnectErrorCode
+		exitCode int
+	}{
+		{name: "no error", err: nil, code: CodeUnknown, exitCode: 0},
+		{name: "plain error", err: fmt.Errorf("boom"), code: CodeUnknown, exitCode: ExitGeneral},
+		{name: "bad request", err: FromHTTPStatus(http.StatusBadRequest, fmt.Errorf("bad")), code: CodeInvalidArgument, exitCode: ExitValidation},
+		{name: "not found", err: FromHTTPStatus(http.StatusNotFound, fmt.Errorf("missing")), code: CodeNotFound, exitCode: ExitNotFound},
+		{name: "conflict", err: FromHTTPStatus(http.StatusConflict, fmt.Errorf("exists")), code: CodeAlreadyExists, exitCode: ExitConflict},
+		{name: "unauthorized", err: FromHTTPStatus(http.StatusUnauthorized, fmt.Errorf("login")), code: CodeUnauthenticated, exitCode: ExitAuth},
+		{name: "forbidden", err: FromHTTPStatus(http.StatusForbidden, fmt.Errorf("denied")), code: CodePermissionDenied, exitCode: ExitAuth},
+		{name: "server error", err: FromHTTPStatus(http.StatusInternalServerError, fmt.Errorf("oops")), code: CodeInternal, exitCode: ExitServer},
+		{name: "unavailable", err: FromHTTPStatus(http.StatusServiceUnavailable, fmt.Errorf("down")), code: CodeUnavailable, exitCode: ExitServer},
+		{name: "grpc failed precondition", err: WithCode(CodeFromGRPC(9), fmt.Errorf("in use")), code: CodeFailedPrecondition, exitCode: ExitConflict},
+		{name: "custom validation error", err: NewCustomError(ErrInvalidSN), code: CodeInvalidArgument, exitCode: ExitValidation},
+		{name: "custom duplicate", err: NewCustomError(ErrAlreadyRegistered), code: CodeAlreadyExists, exitCode: ExitConflict},
+		{name: "custom uncategorized", err: NewCustomError(ErrFileRW), code: CodeUnknown, exitCode: ExitGeneral},
+		{name: "wrapped", err: fmt.Errorf("context: %w", FromHTTPStatus(http.StatusNotFound, fmt.Errorf("missing"))), code: CodeNotFound, exitCode: ExitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, CodeOf(tt.err))
+			assert.Equal(t, tt.exitCode, ExitCode(tt.err))
+		})
+	}
+}
+
+func TestCodeFromGRPC(t *testing.T) {
+	assert.Equal(t, CodeNotFound, CodeFromGRPC(5))
+	assert.Equal(t, CodeUnauthenticated, CodeFromGRPC(16))
+	assert.Equal(t, CodeUnknown, CodeFromGRPC(0))
+	assert.Equal(t, CodeUnknown, CodeFromGRPC(99))
+}
+
+func TestNewReport(t *testing.T) {
+	err := fmt.Errorf("getting host: %w", FromHTTPStatus(http.StatusNotFound, fmt.Errorf("host not found")))
+	assert.Equal(t, Report{Code: CodeNotFound, Message: "getting host: host not found", ExitCode: ExitNotFound, HTTPStatus: 404}, NewReport(err))
+	assert.Equal(t, Report{Code: CodeUnknown, Message: "boom", ExitCode: ExitGeneral}, NewReport(fmt.Errorf("boom")))
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+)
+
+// HostColumns are the columns of the host import file that can be filled, in the order of HEADER
+var HostColumns = strings.Split(HEADER, ",")[:12]
+
+// ColumnMap maps columns of the host import file to the headers of a sheet naming them differently, e.g. the
+// Serial column to the SN header of the sheet exported by an asset management system
+type ColumnMap map[string]string
+
+// ParseColumnMap parses a column map given as Column=Header pairs separated by commas, e.g.
+// "Serial=SN,UUID=DeviceGUID,Site=Location"; the columns are the ones of HEADER, matched ignoring case
+func ParseColumnMap(spec string) (ColumnMap, error) {
+	columns := ColumnMap{}
+	sources := map[string]string{}
+	for _, pair := range strings.Split(spec, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, source, ok := strings.Cut(pair, "=")
+		name, source = strings.TrimSpace(name), strings.TrimSpace(source)
+		if !ok || name == "" || source == "" {
+			return nil, fmt.Errorf("invalid column mapping %q, expected Column=Header", strings.TrimSpace(pair))
+		}
+		idx := slices.IndexFunc(HostColumns, func(column string) bool { return strings.EqualFold(column, name) })
+		if idx < 0 {
+			return nil, fmt.Errorf("unknown column %q in column mapping, expected one of: %s", name, strings.Join(HostColumns, ", "))
+		}
+		column := HostColumns[idx]
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("column %s is mapped more than once", column)
+		}
+		if other, ok := sources[strings.ToLower(source)]; ok {
+			return nil, fmt.Errorf("header %q is mapped to both %s and %s", source, other, column)
+		}
+		columns[column] = source
+		sources[strings.ToLower(source)] = column
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("empty column mapping, expected Column=Header pairs separated by commas")
+	}
+	return columns, nil
+}
+
+// ReadHostRecordsMapped reads the host records of a CSV file, or of a sheet of an Excel workbook, whose columns
+// are found by their header rather than by their position: the columns of the map are read from the headers they
+// are mapped to, the other ones from the headers of their own name. It returns the columns found in the file.
+func ReadHostRecordsMapped(filePath string, sheet string, columns ColumnMap) ([]types.HostRecord, []string, error) {
+	var rows [][]string
+	var err error
+	if strings.ToLower(filepath.Ext(filePath)) == ".xlsx" {
+		rows, err = ReadXLSXRows(filePath, sheet)
+	} else {
+		rows, err = readCSVRows(filePath)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil, e.NewCustomError(e.ErrFileRW)
+	}
+
+	positions := map[string]int{}
+	for i, header := range rows[0] {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if _, ok := positions[header]; !ok {
+			positions[header] = i
+		}
+	}
+	indices := make([]int, len(HostColumns))
+	var found []string
+	for i, column := range HostColumns {
+		source, mapped := columns[column]
+		if !mapped {
+			source = column
+		}
+		pos, ok := positions[strings.ToLower(source)]
+		if !ok && mapped {
+			return nil, nil, fmt.Errorf("header %q mapped to column %s is not in %s", source, column, filePath)
+		}
+		indices[i] = -1
+		if ok {
+			indices[i] = pos
+			found = append(found, column)
+		}
+	}
+
+	var records []types.HostRecord
+	for _, row := range rows[1:] {
+		// Blank rows are skipped, as they are in a CSV file
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		record := make([]string, len(HostColumns))
+		for i, pos := range indices {
+			if pos >= 0 && pos < len(row) {
+				record[i] = row[pos]
+			}
+		}
+		records = append(records, toHostRecord(record))
+	}
+	return records, found, nil
+}
+
+// Reads all the rows of a CSV file, the header included; rows may have fewer fields than the header
+func readCSVRows(filePath string) ([][]string, error) {
+	if err := isSafePath(filePath); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, e.NewCustomError(e.ErrFileRW)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	var rows [][]string
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, e.NewCustomError(e.ErrFileRW)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
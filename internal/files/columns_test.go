@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/open-edge-platform/cli/internal/types"
+)
+
+func TestParseColumnMap(t *testing.T) {
+	columns, err := files.ParseColumnMap("serial=SN, UUID = DeviceGUID,Site=Location,")
+	require.NoError(t, err)
+	assert.Equal(t, files.ColumnMap{"Serial": "SN", "UUID": "DeviceGUID", "Site": "Location"}, columns)
+
+	tests := []struct {
+		spec   string
+		errStr string
+	}{
+		{"", "empty column mapping, expected Column=Header pairs separated by commas"},
+		{"Serial", `invalid column mapping "Serial", expected Column=Header`},
+		{"Serial=", `invalid column mapping "Serial=", expected Column=Header`},
+		{"Owner=Team", `unknown column "Owner" in column mapping`},
+		{"Error - do not fill=Notes", `unknown column "Error - do not fill" in column mapping`},
+		{"Serial=SN,serial=Tag", "column Serial is mapped more than once"},
+		{"Serial=ID,UUID=id", `header "id" is mapped to both Serial and UUID`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := files.ParseColumnMap(tt.spec)
+			assert.ErrorContains(t, err, tt.errStr)
+		})
+	}
+}
+
+func TestReadHostRecordsMapped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "assets.csv")
+	content := "Location,Owner,SN,DeviceGUID,Secure\n" +
+		"site-c69a3c81,lab,ABCD123,4c4c4c4c-0000-1111-2222-333333333333,true\n" +
+		"\n" +
+		"site-c69a3c81,lab,QWERTY123\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	columns := files.ColumnMap{"Serial": "SN", "UUID": "DeviceGUID", "Site": "location"}
+	records, found, err := files.ReadHostRecordsMapped(path, "", columns)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Serial", "UUID", "Site", "Secure"}, found)
+	require.Len(t, records, 2)
+	assert.Equal(t, "ABCD123", records[0].Serial)
+	assert.Equal(t, "4c4c4c4c-0000-1111-2222-333333333333", records[0].UUID)
+	assert.Equal(t, "site-c69a3c81", records[0].Site)
+	assert.Equal(t, types.SecureTrue, records[0].Secure)
+	assert.Equal(t, "ABCD123,4c4c4c4c-0000-1111-2222-333333333333,,site-c69a3c81,true,,,,,,,,", records[0].RawRecord)
+	assert.Equal(t, "QWERTY123", records[1].Serial)
+	assert.Empty(t, records[1].UUID)
+
+	_, _, err = files.ReadHostRecordsMapped(path, "", files.ColumnMap{"Serial": "Asset Tag"})
+	assert.EqualError(t, err, `header "Asset Tag" mapped to column Serial is not in `+path)
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+)
+
+// Largest discovery manifest read, a few thousand hosts
+const maxDiscoveryManifestSize = 8 << 20
+
+// PrebootNic is a network interface reported by the pre-boot discovery image
+type PrebootNic struct {
+	Name string `json:"name"`
+	MAC  string `json:"mac"`
+}
+
+// PrebootDisk is a disk reported by the pre-boot discovery image
+type PrebootDisk struct {
+	Name      string `json:"name"`
+	Model     string `json:"model,omitempty"`
+	SizeBytes uint64 `json:"sizeBytes"`
+}
+
+// PrebootHost is a machine booted on the pre-boot discovery image of EMT, from an ISO or a USB drive
+type PrebootHost struct {
+	SerialNumber string        `json:"serialNumber"`
+	UUID         string        `json:"uuid"`
+	Nics         []PrebootNic  `json:"nics,omitempty"`
+	Disks        []PrebootDisk `json:"disks,omitempty"`
+}
+
+// ReadDiscoveryManifest reads the hosts of the JSON manifest written by the pre-boot discovery image. The image
+// writes a manifest per machine; manifests gathered in a JSON array or under the hosts key of an object are read
+// as well.
+func ReadDiscoveryManifest(filePath string) ([]PrebootHost, error) {
+	if err := isSafePath(filePath); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, e.NewCustomError(e.ErrFileRW)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxDiscoveryManifestSize+1))
+	if err != nil {
+		return nil, e.NewCustomError(e.ErrFileRW)
+	}
+	if len(data) > maxDiscoveryManifestSize {
+		return nil, fmt.Errorf("discovery manifest %s is larger than %d MiB", filePath, maxDiscoveryManifestSize>>20)
+	}
+
+	var hosts []PrebootHost
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		err = json.Unmarshal(data, &hosts)
+	} else {
+		var manifest struct {
+			Hosts []PrebootHost `json:"hosts"`
+			PrebootHost
+		}
+		if err = json.Unmarshal(data, &manifest); err == nil {
+			hosts = manifest.Hosts
+			if hosts == nil && (manifest.SerialNumber != "" || manifest.UUID != "") {
+				hosts = []PrebootHost{manifest.PrebootHost}
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid discovery manifest %s: %w", filePath, err)
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("discovery manifest %s lists no host", filePath)
+	}
+	return hosts, nil
+}
+
+// ReadHostRecordsDiscovery reads the hosts of a discovery manifest as host records, identified by their serial
+// number and UUID
+func ReadHostRecordsDiscovery(filePath string) ([]types.HostRecord, error) {
+	hosts, err := ReadDiscoveryManifest(filePath)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]types.HostRecord, 0, len(hosts))
+	for _, host := range hosts {
+		records = append(records, types.HostRecord{Serial: host.SerialNumber, UUID: host.UUID})
+	}
+	return records, nil
+}
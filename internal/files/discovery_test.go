@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/open-edge-platform/cli/internal/types"
+)
+
+func TestReadDiscoveryManifest(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+	host := files.PrebootHost{
+		SerialNumber: "2500JF3",
+		UUID:         "4c4c4544-2046-5310-8052-cac04f515233",
+		Nics:         []files.PrebootNic{{Name: "eno1", MAC: "00:1a:2b:3c:4d:5e"}},
+		Disks:        []files.PrebootDisk{{Name: "nvme0n1", Model: "Samsung 980", SizeBytes: 512110190592}},
+	}
+	single := `{"serialNumber": "2500JF3", "uuid": "4c4c4544-2046-5310-8052-cac04f515233",
+	  "nics": [{"name": "eno1", "mac": "00:1a:2b:3c:4d:5e"}],
+	  "disks": [{"name": "nvme0n1", "model": "Samsung 980", "sizeBytes": 512110190592}]}`
+
+	// A manifest of a single machine, as written by the image, or manifests gathered in an array or under hosts
+	for name, content := range map[string]string{
+		"single.json": single,
+		"array.json":  "[" + single + "]",
+		"hosts.json":  `{"hosts": [` + single + `]}`,
+	} {
+		hosts, err := files.ReadDiscoveryManifest(write(name, content))
+		require.NoError(t, err, name)
+		assert.Equal(t, []files.PrebootHost{host}, hosts, name)
+	}
+
+	records, err := files.ReadHostRecordsDiscovery(write("records.json", `[{"serialNumber": "2500JF3"}, {"uuid": "1c4c4544-2046-5310-8052-cac04f515233"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []types.HostRecord{{Serial: "2500JF3"}, {UUID: "1c4c4544-2046-5310-8052-cac04f515233"}}, records)
+
+	path := write("empty.json", `{"hosts": []}`)
+	_, err = files.ReadDiscoveryManifest(path)
+	assert.EqualError(t, err, "discovery manifest "+path+" lists no host")
+
+	_, err = files.ReadDiscoveryManifest(write("invalid.json", `{"serialNumber": 2500}`))
+	assert.ErrorContains(t, err, "invalid discovery manifest")
+}
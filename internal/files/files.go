@@ -18,6 +18,9 @@ import (
 
 const HEADER = "Serial,UUID,OSProfile,Site,Secure,RemoteUser,Metadata,LVMSize,CloudInitMeta,K8sEnable,K8sClusterTemplate,K8sConfig,Error - do not fill"
 
+// Header of the file written with the serial to host ID mapping of a host import
+const REGISTRATION_HEADER = "Serial,UUID,HostID,Status,ErrorCode,Error"
+
 func CreateFile(filePath string) error {
 	// Check if the file already exists
 	if _, err := os.Stat(filePath); err == nil {
@@ -61,7 +64,6 @@ func writeHeaders(file *os.File) error {
 	return nil
 }
 
-//nolint:mnd // indices of fields are fixed in csv
 func ReadHostRecords(filePath string) ([]types.HostRecord, error) {
 
 	// Check path is safe
@@ -100,36 +102,61 @@ func ReadHostRecords(filePath string) ([]types.HostRecord, error) {
 			}
 		}
 
-		// Ensure the record has at least 11 fields
-		for len(record) < 13 {
-			record = append(record, "")
-		}
+		records = append(records, toHostRecord(record))
+	}
 
-		// Create a HostRecord from the CSV record
-		hostRecord := types.HostRecord{
-			Serial:             getField(record, 0),
-			UUID:               getField(record, 1),
-			OSProfile:          getField(record, 2),
-			Site:               getField(record, 3),
-			Secure:             types.StringToRecordSecure(getField(record, 4)),
-			RemoteUser:         getField(record, 5),
-			Metadata:           getField(record, 6),
-			LVMSize:            getField(record, 7),
-			CloudInitMeta:      getField(record, 8),
-			K8sEnable:          getField(record, 9),
-			K8sClusterTemplate: getField(record, 10),
-			K8sConfig:          getField(record, 11),
-			Error:              getField(record, 12),
-			RawRecord:          strings.Join(record, ","),
-		}
+	return records, nil
+}
 
-		// Append the HostRecord to the slice
-		records = append(records, hostRecord)
+// ReadHostRecordsXLSX reads the host records of a worksheet of an Excel workbook, whose columns are the ones of
+// the CSV file; the sheet is selected by name or 1-based position, the first one is read when sheet is empty
+func ReadHostRecordsXLSX(filePath string, sheet string) ([]types.HostRecord, error) {
+	rows, err := ReadXLSXRows(filePath, sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, e.NewCustomError(e.ErrFileRW)
 	}
 
+	var records []types.HostRecord
+	// The first row holds the headers
+	for _, row := range rows[1:] {
+		// Rows of formatted but empty cells are skipped, as blank lines are in a CSV file
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		records = append(records, toHostRecord(row))
+	}
 	return records, nil
 }
 
+//nolint:mnd // indices of fields are fixed in csv
+func toHostRecord(record []string) types.HostRecord {
+	// Ensure the record has at least 11 fields
+	for len(record) < 13 {
+		record = append(record, "")
+	}
+
+	// Create a HostRecord from the CSV record
+	return types.HostRecord{
+		Serial:             getField(record, 0),
+		UUID:               getField(record, 1),
+		OSProfile:          getField(record, 2),
+		Site:               getField(record, 3),
+		Secure:             types.StringToRecordSecure(getField(record, 4)),
+		RemoteUser:         getField(record, 5),
+		Metadata:           getField(record, 6),
+		LVMSize:            getField(record, 7),
+		CloudInitMeta:      getField(record, 8),
+		K8sEnable:          getField(record, 9),
+		K8sClusterTemplate: getField(record, 10),
+		K8sConfig:          getField(record, 11),
+		Error:              getField(record, 12),
+		RawRecord:          strings.Join(record, ","),
+	}
+}
+
 // getField safely retrieves a field from the record.
 func getField(record []string, index int) string {
 	if index < len(record) {
@@ -138,6 +165,39 @@ func getField(record []string, index int) string {
 	return ""
 }
 
+// WriteHostRegistrations writes the serial to host ID mapping of a host import, failed rows included
+func WriteHostRegistrations(filePath string, registrations []types.HostRegistration) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return e.NewCustomError(e.ErrFileCreate)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(strings.Split(REGISTRATION_HEADER, string(writer.Comma))); err != nil {
+		return e.NewCustomError(e.ErrFileRW)
+	}
+	for _, registration := range registrations {
+		fields := []string{
+			sanitizeCSVField(registration.Serial),
+			sanitizeCSVField(registration.UUID),
+			sanitizeCSVField(registration.HostID),
+			sanitizeCSVField(string(registration.Status)),
+			sanitizeCSVField(registration.ErrorCode),
+			sanitizeCSVField(registration.Error),
+		}
+		if err := writer.Write(fields); err != nil {
+			return e.NewCustomError(e.ErrFileRW)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return e.NewCustomError(e.ErrFileRW)
+	}
+	return nil
+}
+
 func WriteHostRecords(filePath string, records []types.HostRecord) error {
 	// Create the file
 	file, err := os.Create(filePath)
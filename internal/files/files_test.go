@@ -318,3 +318,23 @@ func resetUser(t *testing.T, originalUID int) {
 		assert.Nil(t, err, fmt.Sprintf("Could not reset user configuration %v", err))
 	}
 }
+
+func TestWriteHostRegistrations(t *testing.T) {
+	testFilePath := filepath.Join(t.TempDir(), "ids.csv")
+	registrations := []types.HostRegistration{
+		{Serial: "1234", UUID: "uuid-1234", HostID: "host-1234abcd", Status: types.RegistrationRegistered},
+		{Serial: "5678", UUID: "uuid-5678", Status: types.RegistrationFailed, ErrorCode: "already_exists", Error: "Host already registered"},
+	}
+
+	err := files.WriteHostRegistrations(testFilePath, registrations)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(testFilePath)
+	assert.NoError(t, err)
+	assert.Equal(t, files.REGISTRATION_HEADER+"\n"+
+		"1234,uuid-1234,host-1234abcd,registered,,\n"+
+		"5678,uuid-5678,,failed,already_exists,Host already registered\n", string(content))
+
+	err = files.WriteHostRegistrations(filepath.Join(t.TempDir(), "missing", "ids.csv"), registrations)
+	assert.Error(t, err)
+}
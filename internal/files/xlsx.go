@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+)
+
+// Largest uncompressed size of a part of a workbook, which guards against zip bombs
+const maxXLSXPartSize = 64 << 20
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		// Relationship ID of the sheet, the r:id attribute
+		RelID string `xml:"id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// Text of a shared or inline string, either whole or split in runs of different formatting
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSXRows reads the rows of a worksheet of an Excel workbook as text, the header row included.
+// The sheet is selected by name or by its 1-based position, the first sheet is read when sheet is empty.
+func ReadXLSXRows(filePath string, sheet string) ([][]string, error) {
+	if err := isSafePath(filePath); err != nil {
+		return nil, err
+	}
+	archive, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not an Excel workbook: %w", filePath, err)
+	}
+	defer archive.Close()
+
+	var workbook xlsxWorkbook
+	if err := readXLSXPart(&archive.Reader, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+	var rels xlsxRelationships
+	if err := readXLSXPart(&archive.Reader, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+	// A workbook without text cells has no shared strings
+	var shared xlsxSharedStrings
+	if hasXLSXPart(&archive.Reader, "xl/sharedStrings.xml") {
+		if err := readXLSXPart(&archive.Reader, "xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	relID, err := selectXLSXSheet(workbook, sheet)
+	if err != nil {
+		return nil, err
+	}
+	sheetPath := ""
+	for _, rel := range rels.Relationships {
+		if rel.ID == relID {
+			// Targets are relative to the xl directory, unless absolute
+			sheetPath = path.Join("xl", rel.Target)
+			if strings.HasPrefix(rel.Target, "/") {
+				sheetPath = strings.TrimPrefix(rel.Target, "/")
+			}
+		}
+	}
+	if sheetPath == "" {
+		return nil, fmt.Errorf("sheet %q of %s has no content", sheet, filePath)
+	}
+	var worksheet xlsxWorksheet
+	if err := readXLSXPart(&archive.Reader, sheetPath, &worksheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(worksheet.Rows))
+	for _, row := range worksheet.Rows {
+		var cells []string
+		for i, cell := range row.Cells {
+			// Empty cells are omitted, the reference of a cell gives its column
+			column := i
+			if cell.Ref != "" {
+				if column, err = xlsxColumn(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(cells) < column {
+				cells = append(cells, "")
+			}
+			value := cell.Value
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(shared.Items) {
+					return nil, fmt.Errorf("cell %s refers to an unknown shared string %q", cell.Ref, cell.Value)
+				}
+				value = shared.Items[index].String()
+			case "inlineStr":
+				value = cell.Inline.String()
+			case "b":
+				value = map[string]string{"0": "false", "1": "true"}[cell.Value]
+			}
+			cells = append(cells, value)
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+// Returns the relationship ID of the sheet selected by name or position
+func selectXLSXSheet(workbook xlsxWorkbook, sheet string) (string, error) {
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("workbook has no sheets")
+	}
+	if sheet == "" {
+		return workbook.Sheets[0].RelID, nil
+	}
+	names := make([]string, 0, len(workbook.Sheets))
+	for _, s := range workbook.Sheets {
+		if strings.EqualFold(s.Name, sheet) {
+			return s.RelID, nil
+		}
+		names = append(names, s.Name)
+	}
+	if position, err := strconv.Atoi(sheet); err == nil && position >= 1 && position <= len(workbook.Sheets) {
+		return workbook.Sheets[position-1].RelID, nil
+	}
+	return "", fmt.Errorf("sheet %q not found, the workbook has: %s", sheet, strings.Join(names, ", "))
+}
+
+// Returns the 0-based column of a cell reference, e.g. 27 for AB3
+func xlsxColumn(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, c := range strings.ToUpper(ref) {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		column = column*26 + int(c-'A'+1)
+		letters++
+	}
+	if letters == 0 || letters > 3 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return column - 1, nil
+}
+
+func hasXLSXPart(archive *zip.Reader, name string) bool {
+	for _, f := range archive.File {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func readXLSXPart(archive *zip.Reader, name string, v interface{}) error {
+	for _, f := range archive.File {
+		if f.Name != name {
+			continue
+		}
+		if f.UncompressedSize64 > maxXLSXPartSize {
+			return fmt.Errorf("%s of the workbook is larger than %d bytes", name, maxXLSXPartSize)
+		}
+		r, err := f.Open()
+		if err != nil {
+			return e.NewCustomError(e.ErrFileRW)
+		}
+		defer r.Close()
+		if err := xml.NewDecoder(io.LimitReader(r, maxXLSXPartSize)).Decode(v); err != nil {
+			return fmt.Errorf("invalid %s in the workbook: %w", name, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("workbook has no %s", name)
+}
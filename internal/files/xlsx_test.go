@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package files_test
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cli/internal/files"
+	"github.com/open-edge-platform/cli/internal/types"
+)
+
+// Writes a workbook with the given parts to a temporary file
+func writeWorkbook(t *testing.T, parts map[string]string) string {
+	path := filepath.Join(t.TempDir(), "hosts.xlsx")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	for name, content := range parts {
+		part, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+	return path
+}
+
+var workbookParts = map[string]string{
+	"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` +
+		`<sheet name="Notes" sheetId="1" r:id="rId1"/><sheet name="Store 42" sheetId="2" r:id="rId2"/></sheets></workbook>`,
+	"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+	"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<si><t>Serial</t></si><si><t>UUID</t></si><si><r><t>SN12</t></r><r><t>3456</t></r></si></sst>`,
+	"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1" t="inlineStr"><is><t>Fill in the store sheets</t></is></c></row></sheetData></worksheet>`,
+	"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>` +
+		`<row r="2"><c r="A2" t="s"><v>2</v></c><c r="E2" t="b"><v>1</v></c><c r="H2"><v>50</v></c></row>` +
+		`<row r="3"><c r="A3" t="inlineStr"><is><t> </t></is></c></row></sheetData></worksheet>`,
+}
+
+func TestReadXLSXRows(t *testing.T) {
+	path := writeWorkbook(t, workbookParts)
+
+	rows, err := files.ReadXLSXRows(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Fill in the store sheets"}}, rows)
+
+	rows, err = files.ReadXLSXRows(path, "store 42")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Serial", "UUID"},
+		{"SN123456", "", "", "", "true", "", "", "50"},
+		{" "},
+	}, rows)
+
+	_, err = files.ReadXLSXRows(path, "3")
+	assert.EqualError(t, err, `sheet "3" not found, the workbook has: Notes, Store 42`)
+
+	_, err = files.ReadXLSXRows(filepath.Join(t.TempDir(), "missing.xlsx"), "")
+	assert.Error(t, err)
+}
+
+func TestReadHostRecordsXLSX(t *testing.T) {
+	path := writeWorkbook(t, workbookParts)
+
+	records, err := files.ReadHostRecordsXLSX(path, "2")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "SN123456", records[0].Serial)
+	assert.Equal(t, types.SecureTrue, records[0].Secure)
+	assert.Equal(t, "50", records[0].LVMSize)
+}
@@ -0,0 +1,493 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+)
+
+const (
+	notProvisioned = "Not Provisioned"
+	notAvailable   = "N/A"
+)
+
+// HostRow is a flat display struct for table output of the host list.
+// It pre-computes values that require conditional logic (feature-gating, deep nil
+// chains, "Waiting on node agents" special case) so templates use simple field
+// references that produce clean column headers.
+type HostRow struct { //nolint:revive
+	ResourceId         string `json:"resourceId"`
+	Name               string `json:"name"`
+	HostStatus         string `json:"hostStatus"`
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	SerialNumber       string `json:"serialNumber"`
+	OperatingSystem    string `json:"operatingSystem,omitempty"`
+	SiteId             string `json:"siteId,omitempty"`
+	SiteName           string `json:"siteName,omitempty"`
+	Workload           string `json:"workload,omitempty"`
+	Uuid               string `json:"uuid,omitempty"`
+	CpuModel           string `json:"cpuModel,omitempty"`
+	OsUpdateAvailable  string `json:"osUpdateAvailable,omitempty"`
+	TrustedCompute     string `json:"trustedCompute,omitempty"`
+	Note               string `json:"note,omitempty"`
+
+	// The host the row shows, whose fields --columns may also name
+	host infra.HostResource
+}
+
+// Source is the host the row was built from
+func (r HostRow) Source() interface{} {
+	return r.host
+}
+
+// HostRows converts a slice of HostResource into flat HostRow display rows.
+func HostRows(hosts []infra.HostResource) []HostRow {
+	rows := make([]HostRow, 0, len(hosts))
+	for _, h := range hosts {
+		row := HostRow{
+			ResourceId:         valueOr(h.ResourceId, ""),
+			Name:               h.Name,
+			HostStatus:         HostStatus(h),
+			ProvisioningStatus: notProvisioned,
+			SerialNumber:       valueOr(h.SerialNumber, ""),
+			OperatingSystem:    notProvisioned,
+			SiteId:             valueOr(h.SiteId, ""),
+			Workload:           "Not Assigned",
+			Uuid:               valueOr(h.Uuid, ""),
+			CpuModel:           valueOr(h.CpuModel, ""),
+			OsUpdateAvailable:  "No update",
+			TrustedCompute:     "Not compatible",
+			Note:               valueOr(h.Note, ""),
+			host:               h,
+		}
+		if h.Site != nil {
+			row.SiteName = valueOr(h.Site.Name, "")
+		}
+		if instance := h.Instance; instance != nil {
+			row.ProvisioningStatus = valueOr(instance.ProvisioningStatus, notProvisioned)
+			if instance.Os != nil {
+				row.OperatingSystem = valueOr(instance.Os.Name, notProvisioned)
+			}
+			if nonEmptyOr(instance.OsUpdateAvailable, "") != "" {
+				row.OsUpdateAvailable = "Available"
+			}
+			row.TrustedCompute = nonEmptyOr(instance.TrustedAttestationStatus, row.TrustedCompute)
+			if instance.WorkloadMembers != nil && len(*instance.WorkloadMembers) > 0 {
+				row.Workload = valueOr((*instance.WorkloadMembers)[0].Workload.Name, "")
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// HostStatus returns the human-readable host status, handling the
+// "Waiting on node agents" special case for error-state hosts.
+func HostStatus(h infra.HostResource) string {
+	if h.HostStatus != nil && *h.HostStatus != "" {
+		if strings.EqualFold(*h.HostStatus, "error") &&
+			h.Instance != nil &&
+			h.Instance.InstanceStatusDetail != nil &&
+			strings.Contains(*h.Instance.InstanceStatusDetail, "of 10 components running") {
+			return "Waiting on node agents"
+		}
+		return *h.HostStatus
+	}
+	return "Not Connected"
+}
+
+// CVEEntry is a CVE of the JSON list of CVEs of an instance or an OS profile
+type CVEEntry struct {
+	CVEID            string   `json:"cve_id"`
+	Priority         string   `json:"priority"`
+	AffectedPackages []string `json:"affected_packages"`
+}
+
+// Sub-row types used in HostDetail for range loops in the inspect template.
+
+type HostStorageRow struct {
+	Wwid     string `json:"wwid"`
+	Capacity string `json:"capacity"`
+	Model    string `json:"model"`
+	Serial   string `json:"serial"`
+	Vendor   string `json:"vendor"`
+}
+
+type HostNicRow struct { //nolint:revive
+	Name         string `json:"name"`
+	LinkState    string `json:"linkState"`
+	Mtu          string `json:"mtu"`
+	MacAddress   string `json:"macAddress"`
+	PciId        string `json:"pciId"`
+	Sriov        string `json:"sriov"`
+	SriovVFTotal string `json:"sriovVFTotal"`
+	SriovVFNum   string `json:"sriovVFNum"`
+	BmcInterface string `json:"bmcInterface"`
+}
+
+type HostGpuRow struct { //nolint:revive
+	DeviceName   string `json:"deviceName"`
+	Vendor       string `json:"vendor"`
+	Capabilities string `json:"capabilities"`
+	PciId        string `json:"pciId"`
+}
+
+type HostUsbRow struct { //nolint:revive
+	Class     string `json:"class"`
+	Serial    string `json:"serial"`
+	VendorId  string `json:"vendorId"`
+	ProductId string `json:"productId"`
+	Bus       string `json:"bus"`
+	Address   string `json:"address"`
+}
+
+type HostCveRow struct { //nolint:revive
+	CveId            string `json:"cveId"`
+	Priority         string `json:"priority"`
+	AffectedPackages string `json:"affectedPackages"`
+}
+
+type HostMetadataRow struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// HostDetail is the flat struct fed to the inspect template.
+// All complex conditional logic (feature-gating, nil chains, unit conversions,
+// "Waiting on node agents" special case) is resolved in NewHostDetail so
+// the template only needs simple field references and range loops.
+type HostDetail struct { //nolint:revive
+	// Identity
+	ResourceId string `json:"resourceId"`
+	Name       string `json:"name"`
+
+	// Status
+	HostStatus         string `json:"hostStatus"`
+	HostStatusDetails  string `json:"hostStatusDetails"`
+	ProvisioningStatus string `json:"provisioningStatus"`
+	UpdateStatus       string `json:"updateStatus"`
+	OsUpdatePolicy     string `json:"osUpdatePolicy"`
+
+	// Specification
+	SerialNumber    string `json:"serialNumber"`
+	Uuid            string `json:"uuid"`
+	OperatingSystem string `json:"operatingSystem"`
+	BiosVendor      string `json:"biosVendor"`
+	BiosVersion     string `json:"biosVersion"`
+	ProductName     string `json:"productName"`
+
+	// Provisioning-specific identity
+	OsProfile string `json:"osProfile"`
+	NicIps    string `json:"nicIps"`
+	LvmSize   string `json:"lvmSize"`
+
+	// Customizations
+	CustomConfigs string            `json:"customConfigs"`
+	Metadata      []HostMetadataRow `json:"metadata"`
+
+	// CPU
+	CpuModel        string `json:"cpuModel"`
+	CpuCores        string `json:"cpuCores"`
+	CpuArchitecture string `json:"cpuArchitecture"`
+	CpuThreads      string `json:"cpuThreads"`
+	CpuSockets      string `json:"cpuSockets"`
+
+	// Memory
+	MemoryGB string `json:"memoryGB"`
+
+	// Hardware sub-tables
+	Storage []HostStorageRow `json:"storage"`
+	Gpus    []HostGpuRow     `json:"gpus"`
+	Usbs    []HostUsbRow     `json:"usbs"`
+	Nics    []HostNicRow     `json:"nics"`
+	Cves    []HostCveRow     `json:"cves"`
+
+	// AMT
+	AmtEnabled      bool   `json:"amtEnabled"`
+	AmtProvisioned  bool   `json:"amtProvisioned"`
+	AmtSku          string `json:"amtSku"`
+	CurrentAmtState string `json:"currentAmtState"`
+	DesiredAmtState string `json:"desiredAmtState"`
+	AmtControlMode  string `json:"amtControlMode"`
+	AmtDnsSuffix    string `json:"amtDnsSuffix"`
+	CurrentPower    string `json:"currentPower"`
+	DesiredPower    string `json:"desiredPower"`
+	PowerStatus     string `json:"powerStatus"`
+	PowerOnTime     string `json:"powerOnTime"`
+
+	// KVM
+	DesiredKvmState  string `json:"desiredKvmState"`
+	CurrentKvmState  string `json:"currentKvmState"`
+	KvmStatus        string `json:"kvmStatus"`
+	KvmSessionStatus string `json:"kvmSessionStatus"`
+
+	// SOL
+	DesiredSolState  string `json:"desiredSolState"`
+	CurrentSolState  string `json:"currentSolState"`
+	SolSessionStatus string `json:"solSessionStatus"`
+}
+
+// NewHostDetail converts a HostResource into a fully pre-computed HostDetail.
+func NewHostDetail(host *infra.HostResource) HostDetail {
+	item := HostDetail{
+		ResourceId:      valueOr(host.ResourceId, ""),
+		Name:            host.Name,
+		HostStatus:      HostStatus(*host),
+		SerialNumber:    valueOr(host.SerialNumber, ""),
+		Uuid:            valueOr(host.Uuid, ""),
+		BiosVendor:      valueOr(host.BiosVendor, ""),
+		BiosVersion:     valueOr(host.BiosVersion, ""),
+		ProductName:     valueOr(host.ProductName, ""),
+		CpuModel:        valueOr(host.CpuModel, ""),
+		CpuCores:        valueOr(host.CpuCores, "0"),
+		CpuArchitecture: valueOr(host.CpuArchitecture, ""),
+		CpuThreads:      valueOr(host.CpuThreads, "0"),
+		CpuSockets:      valueOr(host.CpuSockets, "0"),
+		Metadata:        hostMetadataRows(host.Metadata),
+		Storage:         hostStorageRows(host.HostStorages),
+		Gpus:            hostGpuRows(host.HostGpus),
+		Usbs:            hostUsbRows(host.HostUsbs),
+		Nics:            hostNicRows(host.HostNics),
+		NicIps:          hostNicIps(host.HostNics),
+	}
+
+	if instance := host.Instance; instance != nil {
+		item.ProvisioningStatus = valueOr(instance.ProvisioningStatus, "")
+		item.HostStatusDetails = valueOr(instance.InstanceStatusDetail, "")
+		if instance.UpdateStatus != nil {
+			item.UpdateStatus = toJSON(instance.UpdateStatus)
+		}
+		if instance.UpdatePolicy != nil {
+			item.OsUpdatePolicy = valueOr(instance.UpdatePolicy.ResourceId, "")
+		}
+		if instance.Os != nil {
+			item.OperatingSystem = valueOr(instance.Os.Name, "")
+			item.OsProfile = item.OperatingSystem
+		}
+		if instance.CustomConfig != nil {
+			names := make([]string, 0, len(*instance.CustomConfig))
+			for _, config := range *instance.CustomConfig {
+				names = append(names, config.Name)
+			}
+			item.CustomConfigs = strings.TrimSpace(strings.Join(names, " "))
+		}
+		item.Cves = hostCveRows(nonEmptyOr(instance.ExistingCves, ""))
+	}
+	if host.UserLvmSize != nil {
+		item.LvmSize = fmt.Sprintf("%d GB", *host.UserLvmSize)
+	}
+	if bytes, ok := parseBytes(host.MemoryBytes); ok {
+		item.MemoryGB = fmt.Sprintf("%d", int(float64(bytes)/(1024*1024*1024)+0.5))
+	}
+
+	// AMT — only populate when SKU is specified (not UNSPECIFIED)
+	if host.AmtSku != nil && *host.AmtSku != infra.AMTSKUUNSPECIFIED {
+		item.AmtEnabled = true
+		item.AmtSku = valueOr(host.AmtSku, "")
+		item.CurrentAmtState = valueOr(host.CurrentAmtState, notAvailable)
+		item.DesiredAmtState = valueOr(host.DesiredAmtState, notAvailable)
+		item.AmtControlMode = valueOr(host.AmtControlMode, notAvailable)
+		item.AmtDnsSuffix = valueOr(host.AmtDnsSuffix, "")
+		item.DesiredKvmState = valueOr(host.DesiredKvmState, notAvailable)
+		item.CurrentKvmState = valueOr(host.CurrentKvmState, notAvailable)
+		item.KvmStatus = valueOr(host.KvmStatus, notAvailable)
+		item.KvmSessionStatus = nonEmptyOr(host.KvmSessionStatus, notAvailable)
+		item.DesiredSolState = valueOr(host.DesiredSolState, notAvailable)
+		item.CurrentSolState = valueOr(host.CurrentSolState, notAvailable)
+		item.SolSessionStatus = nonEmptyOr(host.SolSessionStatus, notAvailable)
+		// Power info only when provisioned
+		if host.CurrentAmtState != nil && *host.CurrentAmtState == infra.AMTSTATEPROVISIONED {
+			item.AmtProvisioned = true
+			item.CurrentPower = valueOr(host.CurrentPowerState, "")
+			item.DesiredPower = valueOr(host.DesiredPowerState, "")
+			item.PowerStatus = valueOr(host.PowerStatus, "")
+			if host.PowerOnTime != nil {
+				item.PowerOnTime = time.Unix(int64(*host.PowerOnTime), 0).UTC().Format(time.RFC3339)
+			}
+		}
+	}
+
+	return item
+}
+
+// The name and first IP address of the interfaces having one, as "<name> <ip>" pairs
+func hostNicIps(nics *[]infra.HostnicResource) string {
+	if nics == nil {
+		return ""
+	}
+	ips := make([]string, 0, len(*nics))
+	for _, nic := range *nics {
+		if nic.Ipaddresses != nil && len(*nic.Ipaddresses) > 0 && nic.DeviceName != nil && (*nic.Ipaddresses)[0].Address != nil {
+			ips = append(ips, *nic.DeviceName+" "+*(*nic.Ipaddresses)[0].Address)
+		}
+	}
+	return strings.Join(ips, "; ")
+}
+
+func hostMetadataRows(metadata *[]infra.MetadataItem) []HostMetadataRow {
+	if metadata == nil {
+		return nil
+	}
+	var rows []HostMetadataRow
+	for _, m := range *metadata {
+		rows = append(rows, HostMetadataRow{Key: m.Key, Value: m.Value})
+	}
+	return rows
+}
+
+// Decodes the JSON list of CVEs of an instance; a list which is not valid JSON has no rows
+func hostCveRows(encoded string) []HostCveRow {
+	var entries []CVEEntry
+	if encoded == "" || json.Unmarshal([]byte(encoded), &entries) != nil {
+		return nil
+	}
+	var rows []HostCveRow
+	for _, cve := range entries {
+		rows = append(rows, HostCveRow{
+			CveId:            cve.CVEID,
+			Priority:         cve.Priority,
+			AffectedPackages: fmt.Sprintf("%v", cve.AffectedPackages),
+		})
+	}
+	return rows
+}
+
+func hostStorageRows(storages *[]infra.HoststorageResource) []HostStorageRow {
+	if storages == nil {
+		return nil
+	}
+	var rows []HostStorageRow
+	for _, s := range *storages {
+		row := HostStorageRow{
+			Wwid:     valueOr(s.Wwid, notAvailable),
+			Capacity: notAvailable,
+			Model:    valueOr(s.Model, notAvailable),
+			Serial:   valueOr(s.Serial, notAvailable),
+			Vendor:   valueOr(s.Vendor, notAvailable),
+		}
+		if bytes, ok := parseBytes(s.CapacityBytes); ok {
+			row.Capacity = fmt.Sprintf("%d GB", bytes/(1024*1024*1024))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func hostGpuRows(gpus *[]infra.HostgpuResource) []HostGpuRow {
+	if gpus == nil {
+		return nil
+	}
+	var rows []HostGpuRow
+	for _, g := range *gpus {
+		row := HostGpuRow{
+			DeviceName:   valueOr(g.DeviceName, notAvailable),
+			Vendor:       valueOr(g.Vendor, notAvailable),
+			Capabilities: notAvailable,
+			PciId:        valueOr(g.PciId, notAvailable),
+		}
+		if g.Capabilities != nil {
+			row.Capabilities = strings.Join(*g.Capabilities, ",")
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func hostUsbRows(usbs *[]infra.HostusbResource) []HostUsbRow {
+	if usbs == nil {
+		return nil
+	}
+	var rows []HostUsbRow
+	for _, u := range *usbs {
+		rows = append(rows, HostUsbRow{
+			Class:     nonEmptyOr(u.Class, notAvailable),
+			Serial:    valueOr(u.Serial, notAvailable),
+			VendorId:  valueOr(u.IdVendor, notAvailable),
+			ProductId: valueOr(u.IdProduct, notAvailable),
+			Bus:       valueOr(u.Bus, notAvailable),
+			Address:   valueOr(u.Addr, notAvailable),
+		})
+	}
+	return rows
+}
+
+func hostNicRows(nics *[]infra.HostnicResource) []HostNicRow {
+	if nics == nil {
+		return nil
+	}
+	var rows []HostNicRow
+	for _, n := range *nics {
+		row := HostNicRow{
+			Name:         valueOr(n.DeviceName, notAvailable),
+			LinkState:    notAvailable,
+			Mtu:          valueOr(n.Mtu, notAvailable),
+			MacAddress:   valueOr(n.MacAddr, notAvailable),
+			PciId:        valueOr(n.PciIdentifier, notAvailable),
+			Sriov:        valueOr(n.SriovEnabled, notAvailable),
+			SriovVFTotal: notAvailable,
+			SriovVFNum:   notAvailable,
+			BmcInterface: valueOr(n.BmcInterface, notAvailable),
+		}
+		if n.LinkState != nil && n.LinkState.Type != nil {
+			switch *n.LinkState.Type {
+			case infra.NETWORKINTERFACELINKSTATEUP:
+				row.LinkState = "UP"
+			case infra.NETWORKINTERFACELINKSTATEDOWN:
+				row.LinkState = "DOWN"
+			default:
+				row.LinkState = "UNSPECIFIED"
+			}
+		}
+		if n.SriovEnabled != nil && *n.SriovEnabled {
+			row.SriovVFTotal = valueOr(n.SriovVfsTotal, notAvailable)
+			row.SriovVFNum = valueOr(n.SriovVfsNum, notAvailable)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Formats the value of an optional field, or returns def when the field is not set
+func valueOr[T any](p *T, def string) string {
+	if p == nil {
+		return def
+	}
+	return fmt.Sprint(*p)
+}
+
+// Returns an optional string field, or def when it is not set or empty
+func nonEmptyOr[T ~string](p *T, def string) string {
+	if p == nil || *p == "" {
+		return def
+	}
+	return string(*p)
+}
+
+// Parses a number of bytes given as a decimal string
+func parseBytes(s *string) (int64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	bytes, err := strconv.ParseInt(*s, 10, 64)
+	return bytes, err == nil
+}
+
+// toJSON is a helper function to format a value into a JSON string
+// Returns "nil" for nil pointers, otherwise returns the JSON representation
+func toJSON(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(jsonBytes)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestHostRows(t *testing.T) {
+	unassigned := infra.HostResource{ResourceId: ptr("host-00000001"), Name: "edge-1"}
+	rows := HostRows([]infra.HostResource{
+		unassigned,
+		{
+			ResourceId: ptr("host-00000002"), Name: "edge-2", HostStatus: ptr("Running"),
+			Site: &infra.SiteResource{Name: ptr("store-a")},
+			Instance: &infra.InstanceResource{
+				ProvisioningStatus: ptr("Provisioned"),
+				Os:                 &infra.OperatingSystemResource{Name: ptr("ubuntu")},
+				OsUpdateAvailable:  ptr("24.04.2"),
+			},
+		},
+	})
+
+	assert.Equal(t, HostRow{
+		ResourceId:         "host-00000001",
+		Name:               "edge-1",
+		HostStatus:         "Not Connected",
+		ProvisioningStatus: "Not Provisioned",
+		OperatingSystem:    "Not Provisioned",
+		Workload:           "Not Assigned",
+		OsUpdateAvailable:  "No update",
+		TrustedCompute:     "Not compatible",
+		host:               unassigned,
+	}, rows[0])
+	assert.Equal(t, "Running", rows[1].HostStatus)
+	assert.Equal(t, "Provisioned", rows[1].ProvisioningStatus)
+	assert.Equal(t, "ubuntu", rows[1].OperatingSystem)
+	assert.Equal(t, "store-a", rows[1].SiteName)
+	assert.Equal(t, "Available", rows[1].OsUpdateAvailable)
+	assert.Equal(t, "edge-2", rows[1].Source().(infra.HostResource).Name)
+}
+
+func TestHostStatus(t *testing.T) {
+	assert.Equal(t, "Not Connected", HostStatus(infra.HostResource{HostStatus: ptr("")}))
+	assert.Equal(t, "error", HostStatus(infra.HostResource{HostStatus: ptr("error")}))
+	assert.Equal(t, "Waiting on node agents", HostStatus(infra.HostResource{
+		HostStatus: ptr("Error"),
+		Instance:   &infra.InstanceResource{InstanceStatusDetail: ptr("7 of 10 components running")},
+	}))
+}
+
+func TestNewHostDetail(t *testing.T) {
+	linkUp := infra.NETWORKINTERFACELINKSTATEUP
+	detail := NewHostDetail(&infra.HostResource{
+		Name:        "edge-1",
+		CpuCores:    ptr(8),
+		MemoryBytes: ptr("17179869184"),
+		UserLvmSize: ptr(20),
+		HostNics: &[]infra.HostnicResource{{
+			DeviceName:   ptr("eth0"),
+			LinkState:    &infra.NetworkInterfaceLinkState{Type: &linkUp},
+			Mtu:          ptr(1500),
+			SriovEnabled: ptr(false),
+			Ipaddresses:  &[]infra.IPAddressResource{{Address: ptr("10.0.0.2/24")}},
+		}},
+		HostStorages: &[]infra.HoststorageResource{{CapacityBytes: ptr("536870912000")}},
+		Instance: &infra.InstanceResource{
+			ExistingCves: ptr(`[{"cve_id":"CVE-2025-1111","priority":"critical","affected_packages":["openssl"]}]`),
+			CustomConfig: &[]infra.CustomConfigResource{{Name: "cloud-init"}, {Name: "proxy"}},
+		},
+		AmtSku: ptr(infra.AMTSKUUNSPECIFIED),
+	})
+
+	assert.Equal(t, "Not Connected", detail.HostStatus)
+	assert.Equal(t, "8", detail.CpuCores)
+	assert.Equal(t, "0", detail.CpuThreads)
+	assert.Equal(t, "16", detail.MemoryGB)
+	assert.Equal(t, "20 GB", detail.LvmSize)
+	assert.Equal(t, "eth0 10.0.0.2/24", detail.NicIps)
+	assert.Equal(t, "cloud-init proxy", detail.CustomConfigs)
+	assert.Equal(t, []HostNicRow{{
+		Name: "eth0", LinkState: "UP", Mtu: "1500", MacAddress: "N/A", PciId: "N/A", Sriov: "false",
+		SriovVFTotal: "N/A", SriovVFNum: "N/A", BmcInterface: "N/A",
+	}}, detail.Nics)
+	assert.Equal(t, []HostStorageRow{{Wwid: "N/A", Capacity: "500 GB", Model: "N/A", Serial: "N/A", Vendor: "N/A"}}, detail.Storage)
+	assert.Equal(t, []HostCveRow{{CveId: "CVE-2025-1111", Priority: "critical", AffectedPackages: "[openssl]"}}, detail.Cves)
+	assert.False(t, detail.AmtEnabled)
+	assert.Empty(t, detail.KvmStatus)
+}
+
+func TestNewHostDetailAmt(t *testing.T) {
+	detail := NewHostDetail(&infra.HostResource{
+		AmtSku:            ptr(infra.AMTSKUAMT),
+		CurrentAmtState:   ptr(infra.AMTSTATEPROVISIONED),
+		KvmSessionStatus:  ptr(""),
+		CurrentPowerState: ptr(infra.POWERSTATEON),
+		PowerOnTime:       ptr(0),
+	})
+
+	assert.True(t, detail.AmtEnabled)
+	assert.True(t, detail.AmtProvisioned)
+	assert.Equal(t, "AMT_SKU_AMT", detail.AmtSku)
+	assert.Equal(t, "N/A", detail.DesiredAmtState)
+	assert.Equal(t, "N/A", detail.KvmSessionStatus)
+	assert.Equal(t, "POWER_STATE_ON", detail.CurrentPower)
+	assert.Equal(t, "1970-01-01T00:00:00Z", detail.PowerOnTime)
+}
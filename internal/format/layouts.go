@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+const (
+	// HostTable is the list layout of hosts in onboarding mode (provisioning disabled)
+	HostTable = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.SerialNumber}}"
+
+	// HostProvisioningTable is the list layout of hosts in provisioning mode
+	HostProvisioningTable = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.ProvisioningStatus}}\t{{.SerialNumber}}\t{{.OperatingSystem}}\t{{.SiteId}}\t{{.SiteName}}\t{{.Workload}}"
+
+	// HostVerboseTable is the verbose list layout of hosts in onboarding mode
+	HostVerboseTable = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.SerialNumber}}\t{{.Uuid}}\t{{.Note}}"
+
+	// HostProvisioningVerboseTable is the verbose list layout of hosts in provisioning mode
+	HostProvisioningVerboseTable = "table{{.ResourceId}}\t{{.Name}}\t{{.HostStatus}}\t{{.ProvisioningStatus}}\t{{.SerialNumber}}\t{{.OperatingSystem}}\t{{.SiteId}}\t{{.SiteName}}\t{{.Workload}}\t{{.Uuid}}\t{{.CpuModel}}\t{{.OsUpdateAvailable}}\t{{.TrustedCompute}}\t{{.Note}}"
+)
+
+// HostTableFormat returns the list layout of the rows of HostRows
+func HostTableFormat(provisioning bool, verbose bool) string {
+	switch {
+	case provisioning && verbose:
+		return HostProvisioningVerboseTable
+	case verbose:
+		return HostVerboseTable
+	case provisioning:
+		return HostProvisioningTable
+	default:
+		return HostTable
+	}
+}
+
+// HostInspectFormat returns the detailed layout of a HostDetail
+func HostInspectFormat(provisioning bool) string {
+	if provisioning {
+		return HostProvisioningInspect
+	}
+	return HostInspect
+}
+
+// HostInspect is the detailed layout of a host in onboarding mode
+const HostInspect = `Host Info:
+  Resource ID:          {{.ResourceId}}
+  Name:                 {{.Name}}
+
+Status:
+  Host Status:          {{.HostStatus}}
+
+Specification:
+  Serial Number:        {{.SerialNumber}}
+  UUID:                 {{.Uuid}}
+  
+AMT Info:{{if .AmtEnabled}}
+  AMT SKU:              {{.AmtSku}}
+  Current State:        {{.CurrentAmtState}}
+  Desired State:        {{.DesiredAmtState}}
+  Control Mode:         {{.AmtControlMode}}
+  DNS Suffix:           {{.AmtDnsSuffix}}
+  KVM Desired State:    {{.DesiredKvmState}}
+  KVM Current State:    {{.CurrentKvmState}}
+  KVM Status:           {{.KvmStatus}}
+  KVM Session Status:   {{.KvmSessionStatus}}
+  SOL Desired State:    {{.DesiredSolState}}
+  SOL Current State:    {{.CurrentSolState}}
+  SOL Session Status:   {{.SolSessionStatus}}{{if .AmtProvisioned}}
+  Current Power:        {{.CurrentPower}}
+  Desired Power:        {{.DesiredPower}}
+  Power Status:         {{.PowerStatus}}
+  Power On Time:        {{.PowerOnTime}}{{else}}
+  AMT not active and/or not supported: No info available{{end}}{{else}}
+  AMT not enabled{{end}}`
+
+// HostProvisioningInspect is the detailed layout of a host in provisioning mode
+const HostProvisioningInspect = `Host Info:
+  Resource ID:          {{.ResourceId}}
+  Name:                 {{.Name}}
+  OS Profile:           {{.OsProfile}}
+  NIC Name and IP:      {{.NicIps}}
+  LVM Size:             {{.LvmSize}}
+
+Status:
+  Host Status:          {{.HostStatus}}
+  Host Status Details:  {{.HostStatusDetails}}
+  Provisioning Status:  {{.ProvisioningStatus}}
+  Update Status:        {{.UpdateStatus}}
+  OS Update Policy:     {{.OsUpdatePolicy}}
+
+Specification:
+  Serial Number:        {{.SerialNumber}}
+  UUID:                 {{.Uuid}}
+  OS:                   {{.OperatingSystem}}
+  BIOS Vendor:          {{.BiosVendor}}
+  BIOS Version:         {{.BiosVersion}}
+  Product Name:         {{.ProductName}}
+
+Customizations:
+  Custom Configs:       {{.CustomConfigs}}{{if .Metadata}}
+
+Metadata:{{range .Metadata}}
+  {{.Key}}: {{.Value}}{{end}}{{end}}
+
+CPU Info:
+  Model:                {{.CpuModel}}
+  Cores:                {{.CpuCores}}
+  Architecture:         {{.CpuArchitecture}}
+  Threads:              {{.CpuThreads}}
+  Sockets:              {{.CpuSockets}}
+
+Memory:
+  Total:                {{.MemoryGB}} GB
+
+Storage:{{if .Storage}}{{range .Storage}}
+  - WWID: {{.Wwid}}, Capacity: {{.Capacity}}, Model: {{.Model}}, Serial: {{.Serial}}, Vendor: {{.Vendor}}{{end}}{{else}}
+  None{{end}}
+
+GPU:{{if .Gpus}}{{range .Gpus}}
+  - Device: {{.DeviceName}}, Vendor: {{.Vendor}}, Capabilities: {{.Capabilities}}, PCI: {{.PciId}}{{end}}{{else}}
+  None{{end}}
+
+USB:{{if .Usbs}}{{range .Usbs}}
+  - Class: {{.Class}}, Serial: {{.Serial}}, Vendor ID: {{.VendorId}}, Product ID: {{.ProductId}}, Bus: {{.Bus}}, Address: {{.Address}}{{end}}{{else}}
+  None{{end}}
+
+Interfaces:{{if .Nics}}{{range .Nics}}
+  - Name: {{.Name}}, Link: {{.LinkState}}, MTU: {{.Mtu}}, MAC: {{.MacAddress}}, PCI: {{.PciId}}, SRIOV: {{.Sriov}}, VF Total: {{.SriovVFTotal}}, VF Num: {{.SriovVFNum}}, BMC: {{.BmcInterface}}{{end}}{{else}}
+  None{{end}}
+
+CVEs:{{if .Cves}}{{range .Cves}}
+  - CVE ID: {{.CveId}}, Priority: {{.Priority}}, Affected: {{.AffectedPackages}}{{end}}{{else}}
+  None{{end}}
+
+AMT Info:{{if .AmtEnabled}}
+  AMT SKU:              {{.AmtSku}}
+  Current State:        {{.CurrentAmtState}}
+  Desired State:        {{.DesiredAmtState}}
+  Control Mode:         {{.AmtControlMode}}
+  DNS Suffix:           {{.AmtDnsSuffix}}
+  KVM Desired State:    {{.DesiredKvmState}}
+  KVM Current State:    {{.CurrentKvmState}}
+  KVM Status:           {{.KvmStatus}}
+  KVM Session Status:   {{.KvmSessionStatus}}
+  SOL Desired State:    {{.DesiredSolState}}
+  SOL Current State:    {{.CurrentSolState}}
+  SOL Session Status:   {{.SolSessionStatus}}{{if .AmtProvisioned}}
+  Current Power:        {{.CurrentPower}}
+  Desired Power:        {{.DesiredPower}}
+  Power Status:         {{.PowerStatus}}
+  Power On Time:        {{.PowerOnTime}}{{else}}
+  AMT not active and/or not supported: No info available{{end}}{{else}}
+  AMT not enabled{{end}}
+`
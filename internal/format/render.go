@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package format holds the presentation of the resources printed by the CLI: the view models resolving the optional
+// fields of the API resources to display values, the layouts showing them, and the renderers writing them as a
+// table, a detailed view, JSON or YAML. The renderers take any io.Writer so that they can be tested on a buffer.
+package format
+
+import (
+	"encoding/json"
+	"io"
+
+	tmpl "github.com/open-edge-platform/cli/pkg/format"
+	"gopkg.in/yaml.v2"
+)
+
+// RenderTable writes rows as a table of a "table..." layout, with the column headers when headers is set and the
+// name column cut to nameLimit characters when it is positive
+func RenderTable(w io.Writer, layout string, headers bool, nameLimit int, rows interface{}) error {
+	return tmpl.Format(layout).Execute(w, headers, nameLimit, rows)
+}
+
+// RenderDetail writes a single item as the detailed view of a layout
+func RenderDetail(w io.Writer, layout string, item interface{}) error {
+	return tmpl.Format(layout).Execute(w, false, -1, item)
+}
+
+// RenderJSON writes v as compact JSON, without a trailing newline
+func RenderJSON(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// RenderYAML writes v as YAML
+func RenderYAML(w io.Writer, v interface{}) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
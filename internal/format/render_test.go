@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-edge-platform/cli/pkg/rest/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTable(t *testing.T) {
+	rows := HostRows([]infra.HostResource{
+		{ResourceId: ptr("host-00000001"), Name: "edge-1", HostStatus: ptr("Running"), SerialNumber: ptr("SN1")},
+	})
+
+	var b bytes.Buffer
+	assert.NoError(t, RenderTable(&b, HostTableFormat(false, false), true, -1, rows))
+	assert.Equal(t, ""+
+		"RESOURCE ID      NAME      HOST STATUS    SERIAL NUMBER\n"+
+		"host-00000001    edge-1    Running        SN1\n", b.String())
+
+	b.Reset()
+	assert.NoError(t, RenderTable(&b, HostTableFormat(false, false), false, -1, rows))
+	assert.Equal(t, "host-00000001    edge-1    Running    SN1\n", b.String())
+}
+
+func TestRenderDetail(t *testing.T) {
+	detail := NewHostDetail(&infra.HostResource{ResourceId: ptr("host-00000001"), Name: "edge-1", Uuid: ptr("u-1")})
+
+	var b bytes.Buffer
+	assert.NoError(t, RenderDetail(&b, HostInspectFormat(false), detail))
+	assert.Contains(t, b.String(), "  Resource ID:          host-00000001\n  Name:                 edge-1\n")
+	assert.Contains(t, b.String(), "  UUID:                 u-1\n")
+	assert.Contains(t, b.String(), "AMT not enabled")
+
+	b.Reset()
+	assert.NoError(t, RenderDetail(&b, HostInspectFormat(true), detail))
+	assert.Contains(t, b.String(), "Storage:\n  None\n")
+}
+
+func TestRenderJSONAndYAML(t *testing.T) {
+	row := HostMetadataRow{Key: "zone", Value: "east"}
+
+	var b bytes.Buffer
+	assert.NoError(t, RenderJSON(&b, row))
+	assert.Equal(t, `{"key":"zone","value":"east"}`, b.String())
+
+	b.Reset()
+	assert.NoError(t, RenderYAML(&b, row))
+	assert.Equal(t, "key: zone\nvalue: east\n", b.String())
+}
@@ -3,6 +3,8 @@
 
 package types // nolint:revive
 
+import "time"
+
 type HostRecord struct {
 	Serial     string
 	UUID       string
@@ -25,6 +27,31 @@ type HostRecord struct {
 	RawRecord          string
 }
 
+// HostRegistration is the outcome of registering the host of a HostRecord
+type HostRegistration struct {
+	Serial    string             `json:"serial"`
+	UUID      string             `json:"uuid"`
+	HostID    string             `json:"hostId,omitempty"`
+	Status    RegistrationStatus `json:"status"`
+	ErrorCode string             `json:"errorCode,omitempty"`
+	Error     string             `json:"error,omitempty"`
+	// Site is the site requested for the host, as given in the CSV file or by --site
+	Site string `json:"site,omitempty"`
+	// ClusterCreated is set when a cluster was deployed on the registered host
+	ClusterCreated bool `json:"clusterCreated,omitempty"`
+	// Resumed is set when the host was registered by a previous run of the import, as recorded by --state-file
+	Resumed bool `json:"resumed,omitempty"`
+	// Duration is the time spent registering the host
+	Duration time.Duration `json:"-"`
+}
+
+type RegistrationStatus string
+
+const (
+	RegistrationRegistered RegistrationStatus = "registered"
+	RegistrationFailed     RegistrationStatus = "failed"
+)
+
 type RecordSecure string
 
 const (
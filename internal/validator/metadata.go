@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+)
+
+const (
+	// Separator of the key=value pairs of a metadata string
+	MetadataPairSeparator = "&"
+
+	// Most metadata pairs accepted in a metadata string or manifest
+	MaxMetadataPairs = 32
+
+	// Length limits of the API; the name is the part of a key after its optional prefix
+	MaxMetadataKeyLength   = 316
+	MaxMetadataNameLength  = 63
+	MaxMetadataValueLength = 63
+)
+
+// Metadata key as accepted by the API: an optional lowercase prefix ending in '/' followed by a name
+// of lowercase alphanumerics, '-', '_' and '.' starting and ending with an alphanumeric
+const METADATAKEYPATTERN = `^([a-z.]+/)?([a-z0-9]|[a-z0-9][a-z0-9-_.]*[a-z0-9])$`
+
+// Metadata value as accepted by the API, empty values included
+const METADATAVALUEPATTERN = `^$|^[a-z0-9]$|^[a-z0-9][a-z0-9._-]*[a-z0-9]$`
+
+// ReservedMetadataPrefixes are the key prefixes set by Kubernetes and the orchestrator itself
+var ReservedMetadataPrefixes = []string{"kubernetes.io/", "k8s.io/", "edge-orchestrator.intel.com/"}
+
+var (
+	metadataKeyRe   = regexp.MustCompile(METADATAKEYPATTERN)
+	metadataValueRe = regexp.MustCompile(METADATAVALUEPATTERN)
+)
+
+// MetadataPair is a key=value pair of a metadata string
+type MetadataPair struct {
+	Key   string
+	Value string
+}
+
+// ParseMetadata parses and validates a metadata string of key=value pairs separated by '&',
+// as given by the --metadata flag or the Metadata column of a host CSV file.
+func ParseMetadata(metadata string) ([]MetadataPair, error) {
+	if metadata == "" {
+		return nil, nil
+	}
+	rawPairs := strings.Split(metadata, MetadataPairSeparator)
+	if len(rawPairs) > MaxMetadataPairs {
+		return nil, metadataError("%d pairs given, at most %d are allowed", len(rawPairs), MaxMetadataPairs)
+	}
+
+	pairs := make([]MetadataPair, 0, len(rawPairs))
+	seen := make(map[string]bool, len(rawPairs))
+	for _, rawPair := range rawPairs {
+		kv := strings.Split(rawPair, "=")
+		if len(kv) != 2 {
+			return nil, metadataError("%q is not a key=value pair", rawPair)
+		}
+		pair := MetadataPair{Key: kv[0], Value: kv[1]}
+		if err := validateMetadataKey(pair.Key); err != nil {
+			return nil, err
+		}
+		if seen[pair.Key] {
+			return nil, metadataError("duplicate key %q", pair.Key)
+		}
+		seen[pair.Key] = true
+		if err := validateMetadataValue(pair.Key, pair.Value); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+// ValidateMetadataKeys validates the keys of metadata given as a map, e.g. in a manifest,
+// with the same rules as the keys of a metadata string.
+func ValidateMetadataKeys(keys []string) error {
+	if len(keys) > MaxMetadataPairs {
+		return metadataError("%d pairs given, at most %d are allowed", len(keys), MaxMetadataPairs)
+	}
+	for _, key := range keys {
+		if err := validateMetadataKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMetadataKey(key string) error {
+	if key == "" {
+		return metadataError("empty key")
+	}
+	if len(key) > MaxMetadataKeyLength {
+		return metadataError("key %q is longer than %d characters", key, MaxMetadataKeyLength)
+	}
+	prefix, name, found := strings.Cut(key, "/")
+	if !found {
+		name = key
+	}
+	if found {
+		for _, reserved := range ReservedMetadataPrefixes {
+			if prefix+"/" == reserved || strings.HasSuffix(prefix+"/", "."+reserved) {
+				return metadataError("prefix of key %q is reserved", key)
+			}
+		}
+	}
+	if !metadataKeyRe.MatchString(key) {
+		return metadataError("key %q must be lowercase alphanumerics, '-', '_' or '.', start and end with an alphanumeric, "+
+			"and may have a lowercase prefix ending in '/'", key)
+	}
+	if len(name) > MaxMetadataNameLength {
+		return metadataError("name of key %q is longer than %d characters", key, MaxMetadataNameLength)
+	}
+	return nil
+}
+
+func validateMetadataValue(key string, value string) error {
+	if len(value) > MaxMetadataValueLength {
+		return metadataError("value of key %q is longer than %d characters", key, MaxMetadataValueLength)
+	}
+	if !metadataValueRe.MatchString(value) {
+		return metadataError("value %q of key %q must be lowercase alphanumerics, '-', '_' or '.', and start and end with an alphanumeric", value, key)
+	}
+	return nil
+}
+
+// All metadata errors start with the message of ErrInvalidMetadata
+func metadataError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", e.NewCustomError(e.ErrInvalidMetadata), fmt.Sprintf(format, args...))
+}
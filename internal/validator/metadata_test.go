@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	e "github.com/open-edge-platform/cli/internal/errors"
+	"github.com/open-edge-platform/cli/internal/types"
+	"github.com/open-edge-platform/cli/internal/validator"
+)
+
+func TestParseMetadata(t *testing.T) {
+	pairs, err := validator.ParseMetadata("cluster-name=test&app.io/app-id=test-app&empty=")
+	assert.NoError(t, err)
+	assert.Equal(t, []validator.MetadataPair{
+		{Key: "cluster-name", Value: "test"},
+		{Key: "app.io/app-id", Value: "test-app"},
+		{Key: "empty", Value: ""},
+	}, pairs)
+
+	pairs, err = validator.ParseMetadata("")
+	assert.NoError(t, err)
+	assert.Empty(t, pairs)
+
+	tooMany := make([]string, validator.MaxMetadataPairs+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("key%d=value", i)
+	}
+
+	tests := []struct {
+		name     string
+		metadata string
+		expected string
+	}{
+		{"missing value", "key1=value1&key2", `Invalid Metadata: "key2" is not a key=value pair`},
+		{"extra separator", "key1=value1=value2", `Invalid Metadata: "key1=value1=value2" is not a key=value pair`},
+		{"empty key", "=value", "Invalid Metadata: empty key"},
+		{"uppercase key", "Key=value", `Invalid Metadata: key "Key" must be lowercase alphanumerics, '-', '_' or '.', start and end with an alphanumeric, and may have a lowercase prefix ending in '/'`},
+		{"key ending with a dash", "key-=value", `Invalid Metadata: key "key-" must be lowercase`},
+		{"long name", strings.Repeat("k", 64) + "=value", "Invalid Metadata: name of key \"" + strings.Repeat("k", 64) + "\" is longer than 63 characters"},
+		{"reserved prefix", "kubernetes.io/arch=amd64", `Invalid Metadata: prefix of key "kubernetes.io/arch" is reserved`},
+		{"reserved subdomain prefix", "node.kubernetes.io/role=edge", `Invalid Metadata: prefix of key "node.kubernetes.io/role" is reserved`},
+		{"duplicate key", "key=a&other=b&key=c", `Invalid Metadata: duplicate key "key"`},
+		{"invalid value", "key=Value", `Invalid Metadata: value "Value" of key "key" must be lowercase`},
+		{"long value", "key=" + strings.Repeat("v", 64), `Invalid Metadata: value of key "key" is longer than 63 characters`},
+		{"too many pairs", strings.Join(tooMany, "&"), "Invalid Metadata: 33 pairs given, at most 32 are allowed"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validator.ParseMetadata(tc.metadata)
+			assert.ErrorContains(t, err, tc.expected)
+			assert.True(t, e.Is(e.ErrInvalidMetadata, err))
+		})
+	}
+}
+
+func TestValidateMetadataKeys(t *testing.T) {
+	assert.NoError(t, validator.ValidateMetadataKeys([]string{"release", "example.com/version"}))
+	assert.EqualError(t, validator.ValidateMetadataKeys([]string{"release", "k8s.io/version"}),
+		`Invalid Metadata: prefix of key "k8s.io/version" is reserved`)
+}
+
+func TestSanitizeEntriesMetadata(t *testing.T) {
+	records, err := validator.SanitizeEntries([]types.HostRecord{
+		{Serial: "ABCD123", Metadata: " key=value "},
+		{Serial: "QWERTY123", Metadata: "key=value&key=other"},
+	}, false)
+	assert.Error(t, err)
+	assert.Equal(t, "key=value", records[0].Metadata)
+	assert.Empty(t, records[0].Error)
+	assert.Equal(t, `Invalid Metadata: duplicate key "key";`, records[1].Error)
+}
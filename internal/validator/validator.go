@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -112,6 +113,16 @@ func SanitizeEntries(entries []types.HostRecord, provisioningSupported bool) ([]
 			}
 		}
 
+		// Check if metadata pairs are valid
+		if record.Metadata != "" {
+			metadata := strings.Trim(record.Metadata, TRIMSET)
+			if _, err := ParseMetadata(metadata); err != nil {
+				errMsg = fmt.Sprintf("%s%s;", errMsg, err.Error())
+			} else {
+				sanitizedRecord.Metadata = metadata
+			}
+		}
+
 		//Check if Cluster Template is valid
 
 		// check if uuid is valid
@@ -170,7 +181,61 @@ func CheckCSV(filename string, globalOverrides types.HostRecord, provisioningSup
 	if err != nil {
 		return nil, err
 	}
+	return checkRecords(filename, content, globalOverrides, provisioningSupported)
+}
+
+// CheckXLSX checks the host records of a sheet of an Excel workbook like CheckCSV, the error file is a CSV file.
+func CheckXLSX(filename string, sheet string, globalOverrides types.HostRecord, provisioningSupported bool) ([]types.HostRecord, error) {
+	fmt.Printf("Checking Excel file: %s\n", filename)
+
+	content, err := files.ReadHostRecordsXLSX(filename, sheet)
+	if err != nil {
+		return nil, err
+	}
+	return checkRecords(strings.TrimSuffix(filename, filepath.Ext(filename))+".csv", content, globalOverrides, provisioningSupported)
+}
+
+// CheckMapped checks the host records of a CSV file, or of a sheet of an Excel workbook, whose columns are named
+// differently, like CheckCSV. The columns are found by header through the column map; it fails when the mandatory
+// columns are neither in the file nor given by the overrides.
+func CheckMapped(filename string, sheet string, columns files.ColumnMap, globalOverrides types.HostRecord, provisioningSupported bool) ([]types.HostRecord, error) {
+	fmt.Printf("Checking file with mapped columns: %s\n", filename)
+
+	content, found, err := files.ReadHostRecordsMapped(filename, sheet, columns)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	if !slices.Contains(found, "Serial") && !slices.Contains(found, "UUID") {
+		missing = append(missing, "Serial or UUID")
+	}
+	if provisioningSupported && !slices.Contains(found, "OSProfile") && globalOverrides.OSProfile == "" {
+		missing = append(missing, "OSProfile")
+	}
+	if provisioningSupported && !slices.Contains(found, "Site") && globalOverrides.Site == "" {
+		missing = append(missing, "Site")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("mandatory columns not mapped: %s", strings.Join(missing, ", "))
+	}
+	return checkRecords(strings.TrimSuffix(filename, filepath.Ext(filename))+".csv", content, globalOverrides, provisioningSupported)
+}
+
+// CheckDiscovery checks the hosts of a discovery manifest of the pre-boot discovery image like CheckCSV, the
+// error file is a CSV file.
+func CheckDiscovery(filename string, globalOverrides types.HostRecord, provisioningSupported bool) ([]types.HostRecord, error) {
+	fmt.Printf("Checking discovery manifest: %s\n", filename)
+
+	content, err := files.ReadHostRecordsDiscovery(filename)
+	if err != nil {
+		return nil, err
+	}
+	return checkRecords(strings.TrimSuffix(filename, filepath.Ext(filename))+".csv", content, globalOverrides, provisioningSupported)
+}
 
+// Applies the overrides to the records read from filename and validates them, writing the erring records to
+// an error file named after filename
+func checkRecords(filename string, content []types.HostRecord, globalOverrides types.HostRecord, provisioningSupported bool) ([]types.HostRecord, error) {
 	//replace content with overrides if not empty
 	for i := range content {
 		recordValue := reflect.ValueOf(&content[i]).Elem()
@@ -597,3 +597,35 @@ func TestCheckCSVOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckMapped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "assets.csv")
+	content := "SN,DeviceGUID,Location,Image\n" +
+		"ABCD123,4c4c4c4c-0000-1111-2222-333333333333,site-c69a3c81,os1\n"
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	columns := files.ColumnMap{"Serial": "SN", "UUID": "DeviceGUID", "Site": "Location"}
+
+	// The OS profile is neither mapped nor given as an override
+	_, err := validator.CheckMapped(path, "", columns, types.HostRecord{}, true)
+	assert.EqualError(t, err, "mandatory columns not mapped: OSProfile")
+
+	// It is not mandatory without provisioning
+	records, err := validator.CheckMapped(path, "", columns, types.HostRecord{}, false)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	records, err = validator.CheckMapped(path, "", columns, types.HostRecord{OSProfile: "profile"}, true)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "profile", records[0].OSProfile)
+
+	columns["OSProfile"] = "Image"
+	records, err = validator.CheckMapped(path, "", columns, types.HostRecord{}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABCD123", records[0].Serial)
+	assert.Equal(t, "os1", records[0].OSProfile)
+	assert.Equal(t, "site-c69a3c81", records[0].Site)
+
+	_, err = validator.CheckMapped(path, "", files.ColumnMap{"Site": "Location", "OSProfile": "Image"}, types.HostRecord{}, true)
+	assert.EqualError(t, err, "mandatory columns not mapped: Serial or UUID")
+}
@@ -6,7 +6,6 @@ package auth
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/atomix/dazl"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/open-edge-platform/cli/pkg/rest/network"
 	"github.com/open-edge-platform/orch-library/go/pkg/openidconnect"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -31,6 +31,13 @@ const (
 	DefaultClientID = "system-client"
 
 	UserName = "username"
+
+	// Configuration of the connection to the orchestrator, shared with the REST clients
+	ProxyField              = "proxy"
+	CACertField             = "ca-cert"
+	InsecureSkipVerifyField = "insecure-skip-verify"
+	ClientCertField         = "client-cert"
+	ClientKeyField          = "client-key"
 )
 
 var log = dazl.GetPackageLogger()
@@ -42,10 +49,15 @@ var KeycloakFactory = newKeycloakClient
 func TLS13ClientOption() openidconnect.ClientOption {
 	return func(c *openidconnect.Client) error {
 		// Create transport based on default transport to preserve proxy settings
-		transport := http.DefaultTransport.(*http.Transport).Clone()
-		transport.TLSClientConfig = &tls.Config{
-			MinVersion: tls.VersionTLS13,
-			MaxVersion: tls.VersionTLS13,
+		transport, err := network.NewTransport(network.Config{
+			Proxy:              viper.GetString(ProxyField),
+			CACert:             viper.GetString(CACertField),
+			InsecureSkipVerify: viper.GetBool(InsecureSkipVerifyField),
+			ClientCert:         viper.GetString(ClientCertField),
+			ClientKey:          viper.GetString(ClientKeyField),
+		})
+		if err != nil {
+			return err
 		}
 
 		c.Client = &http.Client{
@@ -64,6 +76,14 @@ func newKeycloakClient(_ context.Context, endpoint string) (openidconnect.Client
 	return openidconnect.ClientWithResponsesInterface(client), err
 }
 
+type offlineKey struct{}
+
+// WithOffline marks the API calls made with ctx as served from cached responses, for which AddAuthHeader does not
+// fetch an access token
+func WithOffline(ctx context.Context) context.Context {
+	return context.WithValue(ctx, offlineKey{}, true)
+}
+
 // GetAccessToken retrieves the access token from environment variable or by exchanging refresh token
 func GetAccessToken(ctx context.Context) (string, error) {
 	// Short-cut to use an actual access token from an environment variable, rather than refresh token from configuration.
@@ -114,6 +134,10 @@ func GetAccessToken(ctx context.Context) (string, error) {
 }
 
 func AddAuthHeader(ctx context.Context, req *http.Request) error {
+	if offline, _ := ctx.Value(offlineKey{}).(bool); offline {
+		// Calls served from cached responses are not authenticated
+		return nil
+	}
 	accessToken, err := GetAccessToken(ctx)
 	if err != nil {
 		// Return error if we can't get access token (e.g., expired refresh token, Keycloak unreachable)
@@ -83,6 +83,11 @@ func TestAddAuthHeader(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get access token")
 	assert.Equal(t, "", req2.Header.Get("Authorization"))
+
+	// Offline calls need no access token
+	err = AddAuthHeader(WithOffline(context.Background()), req2)
+	assert.NoError(t, err)
+	assert.Equal(t, "", req2.Header.Get("Authorization"))
 }
 
 func TestCheckAuth(t *testing.T) {
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	ansiReset   = "\033[0m"
+	ansiRed     = "\033[31m"
+	ansiBoldRed = "\033[1;31m"
+	ansiGreen   = "\033[32m"
+	ansiYellow  = "\033[33m"
+	ansiCyan    = "\033[36m"
+)
+
+// Style of a cell holding a known status: the color of its text and the icon put in front of it, if any
+type cellStyle struct {
+	color string
+	icon  string
+}
+
+var (
+	styleHealthy     = cellStyle{color: ansiGreen, icon: "✔"}
+	styleInProgress  = cellStyle{color: ansiYellow, icon: "◐"}
+	styleFailed      = cellStyle{color: ansiRed, icon: "✖"}
+	styleMaintenance = cellStyle{color: ansiYellow, icon: "‖"}
+)
+
+// Styles of the cells whose whole value, trimmed and lower cased, is a known status or severity
+var cellStyles = map[string]cellStyle{
+	"running":                     styleHealthy,
+	"provisioned":                 styleHealthy,
+	"healthy":                     styleHealthy,
+	"ready":                       styleHealthy,
+	"succeeded":                   styleHealthy,
+	"completed":                   styleHealthy,
+	"provisioning":                styleInProgress,
+	"onboarding":                  styleInProgress,
+	"booting":                     styleInProgress,
+	"updating":                    styleInProgress,
+	"deleting":                    styleInProgress,
+	"waiting on node agents":      styleInProgress,
+	"error":                       styleFailed,
+	"failed":                      styleFailed,
+	"in maintenance":              styleMaintenance,
+	"schedule_status_maintenance": styleMaintenance,
+	"schedule_status_os_update":   styleMaintenance,
+
+	// CVE severities
+	"critical": {color: ansiBoldRed},
+	"high":     {color: ansiRed},
+	"medium":   {color: ansiYellow},
+	"low":      {color: ansiCyan},
+}
+
+// CVE severities are listed inline in the detailed views, e.g. "CVE ID: CVE-2025-1111, Priority: high, ..."
+var inlineSeverity = regexp.MustCompile(`(?i)(Priority: )(critical|high|medium|low)\b`)
+
+// Pair of the text of a cell as aligned and the same text with its colors
+type coloredCell struct {
+	plain   string
+	colored string
+}
+
+// colorWriter colors the cells of the lines aligned by a table writer.
+//
+// The colors are added once the lines are aligned, as the escape sequences would otherwise count in the width of
+// the cells. The table writer marks each line before aligning it with the cells to color, and the colorWriter
+// finds them in the aligned line, in order, since alignment only adds padding between them.
+type colorWriter struct {
+	out io.Writer
+	// Cells of the marked lines not aligned yet, nil for a line without colors
+	pending [][]coloredCell
+	// Part of the current aligned line written so far
+	line []byte
+}
+
+// Returns the line with an icon in front of its known statuses and queues the colors of its cells
+func (c *colorWriter) mark(line string) string {
+	text, newline := strings.CutSuffix(line, "\n")
+	cells := strings.Split(text, "\t")
+	var colored []coloredCell
+	for i, cell := range cells {
+		plain, withColor := styleCell(cell)
+		cells[i] = plain
+		colored = append(colored, coloredCell{plain: plain, colored: withColor})
+	}
+	if !hasColors(colored) {
+		colored = nil
+	}
+	c.pending = append(c.pending, colored)
+	text = strings.Join(cells, "\t")
+	if newline {
+		text += "\n"
+	}
+	return text
+}
+
+func hasColors(cells []coloredCell) bool {
+	for _, cell := range cells {
+		if cell.plain != cell.colored {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the cell as aligned and the same cell with colors
+func styleCell(cell string) (string, string) {
+	value := strings.TrimSpace(cell)
+	if style, ok := cellStyles[strings.ToLower(value)]; ok && value != "" {
+		start := strings.Index(cell, value)
+		prefix, suffix := cell[:start], cell[start+len(value):]
+		if style.icon != "" {
+			value = style.icon + " " + value
+		}
+		return prefix + value + suffix, prefix + style.color + value + ansiReset + suffix
+	}
+	colored := inlineSeverity.ReplaceAllStringFunc(cell, func(match string) string {
+		parts := inlineSeverity.FindStringSubmatch(match)
+		return parts[1] + cellStyles[strings.ToLower(parts[2])].color + parts[2] + ansiReset
+	})
+	return cell, colored
+}
+
+// Write colors the aligned lines written to it and passes them on
+func (c *colorWriter) Write(p []byte) (int, error) {
+	rest := p
+	for {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			c.line = append(c.line, rest...)
+			return len(p), nil
+		}
+		c.line = append(c.line, rest[:i+1]...)
+		rest = rest[i+1:]
+		if err := c.writeLine(); err != nil {
+			return len(p) - len(rest), err
+		}
+	}
+}
+
+// Flush passes on the last aligned line if it does not end with a newline
+func (c *colorWriter) Flush() error {
+	return c.writeLine()
+}
+
+func (c *colorWriter) writeLine() error {
+	if len(c.line) == 0 {
+		return nil
+	}
+	line := string(c.line)
+	c.line = c.line[:0]
+	var cells []coloredCell
+	if len(c.pending) > 0 {
+		cells = c.pending[0]
+		c.pending = c.pending[1:]
+	}
+	_, err := io.WriteString(c.out, colorLine(line, cells))
+	return err
+}
+
+// Replaces the cells of the aligned line, found in order, with their colored text
+func colorLine(line string, cells []coloredCell) string {
+	if cells == nil {
+		return line
+	}
+	var b strings.Builder
+	rest := line
+	for _, cell := range cells {
+		i := strings.Index(rest, cell.plain)
+		if i < 0 {
+			break
+		}
+		b.WriteString(rest[:i])
+		b.WriteString(cell.colored)
+		rest = rest[i+len(cell.plain):]
+	}
+	b.WriteString(rest)
+	return b.String()
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorTableWriter(t *testing.T) {
+	var b bytes.Buffer
+	w := NewColorTableWriter(&b, 3, 0, 0)
+	fmt.Fprintf(w, "NAME\tHOST STATUS\tSITE\n")
+	fmt.Fprintf(w, "host-1\tRunning\tsite-a\n")
+	fmt.Fprintf(w, "host-2\tError\tsite-b\n")
+	fmt.Fprintf(w, "  - CVE ID: CVE-2025-1111, Priority: critical, Affected: openssl")
+	require.NoError(t, w.Flush())
+
+	// The columns are aligned on the text of the cells, icons included and escape sequences excluded
+	assert.Equal(t, ""+
+		"NAME     HOST STATUS   SITE\n"+
+		"host-1   \033[32m✔ Running\033[0m     site-a\n"+
+		"host-2   \033[31m✖ Error\033[0m       site-b\n"+
+		"  - CVE ID: CVE-2025-1111, Priority: \033[1;31mcritical\033[0m, Affected: openssl", b.String())
+}
+
+func TestStyleCell(t *testing.T) {
+	plain, colored := styleCell("Provisioning ")
+	assert.Equal(t, "◐ Provisioning ", plain)
+	assert.Equal(t, "\033[33m◐ Provisioning\033[0m ", colored)
+
+	plain, colored = styleCell("SCHEDULE_STATUS_MAINTENANCE")
+	assert.Equal(t, "‖ SCHEDULE_STATUS_MAINTENANCE", plain)
+	assert.Equal(t, "\033[33m‖ SCHEDULE_STATUS_MAINTENANCE\033[0m", colored)
+
+	// Severities have no icon
+	plain, colored = styleCell("high")
+	assert.Equal(t, "high", plain)
+	assert.Equal(t, "\033[31mhigh\033[0m", colored)
+
+	// Words of a longer value are left alone
+	plain, colored = styleCell("Provisioning Status: ")
+	assert.Equal(t, "Provisioning Status: ", plain)
+	assert.Equal(t, plain, colored)
+}
@@ -136,16 +136,30 @@ func (f Format) IsTable() bool {
 	return strings.HasPrefix(string(f), "table")
 }
 
+// TableOutput is a writer aligning tab separated cells, either a TableWriter or a tabwriter.Writer
+type TableOutput interface {
+	io.Writer
+	Flush() error
+}
+
+// AsTableOutput returns the table writer of the output, or creates one aligning the cells written to a plain writer
+func AsTableOutput(writer io.Writer) TableOutput {
+	switch w := writer.(type) {
+	case *TableWriter:
+		return w
+	case *tabwriter.Writer:
+		return w
+	default:
+		return tabwriter.NewWriter(writer, 0, 4, 4, ' ', 0)
+	}
+}
+
 func (f Format) Execute(writer io.Writer, withHeaders bool, nameLimit int, data interface{}) error {
-	var tabWriter *tabwriter.Writer
+	var tabWriter TableOutput
 	format := f
 
 	if f.IsTable() {
-		if existingTabWriter, ok := writer.(*tabwriter.Writer); ok {
-			tabWriter = existingTabWriter
-		} else {
-			tabWriter = tabwriter.NewWriter(writer, 0, 4, 4, ' ', 0)
-		}
+		tabWriter = AsTableOutput(writer)
 		format = Format(strings.TrimPrefix(string(f), "table"))
 	}
 
@@ -161,6 +175,7 @@ func (f Format) Execute(writer io.Writer, withHeaders bool, nameLimit int, data
 		"statusIndicator": formatStatusIndicator,
 		"statusMessage":   formatStatusMessage,
 		"nodeCount":       formatNodeCount,
+		"degrees":         formatDegrees,
 	}
 
 	tmpl, err := template.New("output").Funcs(funcmap).Parse(string(format))
@@ -168,14 +183,16 @@ func (f Format) Execute(writer io.Writer, withHeaders bool, nameLimit int, data
 		return err
 	}
 
-	if f.IsTable() && withHeaders {
-		header := GetHeaderString(tmpl, nameLimit)
+	if f.IsTable() {
+		if withHeaders {
+			header := GetHeaderString(tmpl, nameLimit)
 
-		if _, err = tabWriter.Write([]byte(header)); err != nil {
-			return err
-		}
-		if _, err = tabWriter.Write([]byte("\n")); err != nil {
-			return err
+			if _, err = tabWriter.Write([]byte(header)); err != nil {
+				return err
+			}
+			if _, err = tabWriter.Write([]byte("\n")); err != nil {
+				return err
+			}
 		}
 
 		slice := reflect.ValueOf(data)
@@ -238,6 +255,7 @@ func (f Format) HeaderFields(nameLimit int) ([]string, error) {
 		"statusIndicator": formatStatusIndicator,
 		"statusMessage":   formatStatusMessage,
 		"nodeCount":       formatNodeCount,
+		"degrees":         formatDegrees,
 	}
 
 	// Trim table prefix so header text doesn't include the literal "table"
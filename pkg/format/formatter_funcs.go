@@ -20,6 +20,7 @@ package format
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	timestamppb "github.com/golang/protobuf/ptypes/timestamp"
@@ -80,6 +81,14 @@ func formatStringOrNone(v *string) string {
 	return *v
 }
 
+// Renders a coordinate stored in E7 format, i.e. degrees multiplied by 10^7, as decimal degrees.
+func formatDegrees(e7 *int32) string {
+	if e7 == nil {
+		return "<none>"
+	}
+	return strconv.FormatFloat(float64(*e7)/1e7, 'f', -1, 64)
+}
+
 // Formats a time.Time using ISO-8601 format without timezone.
 func formatTimeSimple(t time.Time) string {
 	return t.Format("2006-01-02T15:04:05")
@@ -86,6 +86,23 @@ func TestTableFormat(t *testing.T) {
 	}
 }
 
+func TestTableFormatWithoutHeaders(t *testing.T) {
+	expected := "" +
+		"0x00000    abc    true\n" +
+		"0x00001    abc    false\n"
+	got := &strings.Builder{}
+	format := Format("table{{.Field1}}\t{{.Field2}}\t{{.Field3}}")
+	err := format.Execute(got, false, 1, generateTestData(2))
+	if err != nil {
+		t.Errorf("%s: unexpected error result: %s", t.Name(), err)
+	}
+	if got.String() != expected {
+		t.Logf("RECEIVED:\n%s\n", got.String())
+		t.Logf("EXPECTED:\n%s\n", expected)
+		t.Errorf("%s: expected and received did not match", t.Name())
+	}
+}
+
 func TestNoTableFormat(t *testing.T) {
 	expected := "" +
 		"0x00000,abc,true,0,[a b c d],[[x y z]],abc,[{abc}],{abc}\n" +
@@ -205,3 +222,23 @@ func TestNilSafeTemplateHelpersWithValues(t *testing.T) {
 		t.Errorf("%s: expected and received did not match", t.Name())
 	}
 }
+
+func TestDegreesTemplateHelper(t *testing.T) {
+	type site struct {
+		SiteLat *int32
+		SiteLng *int32
+	}
+	lat, lng := int32(407127281), int32(-740060000)
+	format := Format("{{degrees .SiteLat}}|{{degrees .SiteLng}}")
+	got := &strings.Builder{}
+	if err := format.Execute(got, false, 0, []site{{SiteLat: &lat, SiteLng: &lng}, {}}); err != nil {
+		t.Fatalf("%s: unexpected error result: %s", t.Name(), err)
+	}
+
+	expected := "40.7127281|-74.006\n<none>|<none>\n"
+	if got.String() != expected {
+		t.Logf("RECEIVED:\n%s\n", got.String())
+		t.Logf("EXPECTED:\n%s\n", expected)
+		t.Errorf("%s: expected and received did not match", t.Name())
+	}
+}
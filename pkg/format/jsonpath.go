@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONPath is a template of text and {expressions} selecting fields of the JSON form of a value, e.g.
+// '{.resourceId} {.instance.provisioningStatus}'.
+//
+// The supported subset of JSONPath covers what scripts extract from a single resource: fields (.name or
+// ['name']), array indexes, negative ones counting from the end ([0], [-1]), and wildcards over the
+// elements of an array or the values of an object ([*], .*). A field that is missing, as empty fields are
+// omitted from the JSON form, selects nothing. An expression selecting several values prints them separated by
+// spaces, objects and arrays are printed as JSON.
+type JSONPath struct {
+	parts []jsonPathPart
+}
+
+// Either a literal text or the steps of an expression
+type jsonPathPart struct {
+	text  string
+	steps []jsonPathStep
+}
+
+type jsonPathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseJSONPath parses a JSONPath template; an expression without braces, e.g. .resourceId, is accepted as a
+// template made of that expression only
+func ParseJSONPath(template string) (*JSONPath, error) {
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+	var path JSONPath
+	for rest := template; rest != ""; {
+		open := strings.Index(rest, "{")
+		if open < 0 {
+			path.parts = append(path.parts, jsonPathPart{text: rest})
+			break
+		}
+		if open > 0 {
+			path.parts = append(path.parts, jsonPathPart{text: rest[:open]})
+		}
+		end := strings.Index(rest[open:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed expression %q", rest[open:])
+		}
+		steps, err := parseJSONPathExpression(strings.TrimSpace(rest[open+1 : open+end]))
+		if err != nil {
+			return nil, err
+		}
+		path.parts = append(path.parts, jsonPathPart{steps: steps})
+		rest = rest[open+end+1:]
+	}
+	return &path, nil
+}
+
+func parseJSONPathExpression(expr string) ([]jsonPathStep, error) {
+	in := strings.TrimPrefix(expr, "$")
+	if in == "" || (in[0] != '.' && in[0] != '[') {
+		return nil, fmt.Errorf("invalid expression %q, must start with . or [", expr)
+	}
+	var steps []jsonPathStep
+	for in != "" {
+		switch in[0] {
+		case '.':
+			in = in[1:]
+			// A lone dot selects the value itself
+			if in == "" {
+				break
+			}
+			end := strings.IndexAny(in, ".[")
+			if end < 0 {
+				end = len(in)
+			}
+			name := in[:end]
+			if name == "" {
+				return nil, fmt.Errorf("invalid expression %q, empty field name", expr)
+			}
+			steps = append(steps, jsonPathStep{field: name, wildcard: name == "*"})
+			in = in[end:]
+		case '[':
+			end := strings.Index(in, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid expression %q, unclosed [", expr)
+			}
+			selector := strings.TrimSpace(in[1:end])
+			in = in[end+1:]
+			switch {
+			case selector == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+				steps = append(steps, jsonPathStep{field: selector[1 : len(selector)-1]})
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, fmt.Errorf("invalid expression %q, unsupported selector [%s]", expr, selector)
+				}
+				steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("invalid expression %q at %q", expr, in)
+		}
+	}
+	return steps, nil
+}
+
+// Execute writes the template applied to the JSON form of data
+func (p *JSONPath) Execute(w io.Writer, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	for _, part := range p.parts {
+		if part.steps == nil {
+			out.WriteString(part.text)
+			continue
+		}
+		values, err := selectJSONPath([]interface{}{value}, part.steps)
+		if err != nil {
+			return err
+		}
+		for i, v := range values {
+			if i > 0 {
+				out.WriteString(" ")
+			}
+			text, err := jsonPathText(v)
+			if err != nil {
+				return err
+			}
+			out.WriteString(text)
+		}
+	}
+	_, err = io.WriteString(w, out.String())
+	return err
+}
+
+func selectJSONPath(values []interface{}, steps []jsonPathStep) ([]interface{}, error) {
+	for _, step := range steps {
+		var next []interface{}
+		for _, v := range values {
+			switch {
+			case step.wildcard:
+				switch t := v.(type) {
+				case []interface{}:
+					next = append(next, t...)
+				case map[string]interface{}:
+					keys := make([]string, 0, len(t))
+					for k := range t {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+					for _, k := range keys {
+						next = append(next, t[k])
+					}
+				}
+			case step.isIndex:
+				list, ok := v.([]interface{})
+				if !ok {
+					return nil, fmt.Errorf("[%d] applies to an array, not to %s", step.index, jsonKind(v))
+				}
+				index := step.index
+				if index < 0 {
+					index += len(list)
+				}
+				if index < 0 || index >= len(list) {
+					return nil, fmt.Errorf("index [%d] is out of range, the array has %d elements", step.index, len(list))
+				}
+				next = append(next, list[index])
+			default:
+				object, ok := v.(map[string]interface{})
+				if !ok {
+					// Fields of an absent value are absent too
+					if v == nil {
+						continue
+					}
+					return nil, fmt.Errorf("field %q applies to an object, not to %s", step.field, jsonKind(v))
+				}
+				// Empty fields are omitted from the JSON form, a missing field selects nothing
+				if field, ok := object[step.field]; ok {
+					next = append(next, field)
+				}
+			}
+		}
+		values = next
+	}
+	return values, nil
+}
+
+func jsonPathText(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case json.Number:
+		return t.String(), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	default:
+		encoded, err := json.Marshal(t)
+		return string(encoded), err
+	}
+}
+
+func jsonKind(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return "null"
+	}
+}
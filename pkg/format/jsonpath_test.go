@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonPathNic struct {
+	Name string `json:"name"`
+	MTU  int    `json:"mtu"`
+}
+
+type jsonPathHost struct {
+	ResourceID string            `json:"resourceId"`
+	Serial     *string           `json:"serialNumber,omitempty"`
+	Nics       []jsonPathNic     `json:"hostNics"`
+	Labels     map[string]string `json:"labels"`
+	Enabled    bool              `json:"enabled"`
+}
+
+func TestJSONPath(t *testing.T) {
+	host := jsonPathHost{
+		ResourceID: "host-1234abcd",
+		Nics:       []jsonPathNic{{Name: "eth0", MTU: 1500}, {Name: "eth1", MTU: 9000}},
+		Labels:     map[string]string{"zone": "b", "app": "a"},
+		Enabled:    true,
+	}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"{.resourceId}", "host-1234abcd"},
+		{".resourceId", "host-1234abcd"},
+		{"$.resourceId", "host-1234abcd"},
+		{"id={.resourceId} enabled={.enabled}", "id=host-1234abcd enabled=true"},
+		{"{.hostNics[0].name}", "eth0"},
+		{"{.hostNics[-1].mtu}", "9000"},
+		{"{.hostNics[*].name}", "eth0 eth1"},
+		{"{.labels.*}", "a b"},
+		{"{.labels['zone']}", "b"},
+		{"{.hostNics[1]}", `{"mtu":9000,"name":"eth1"}`},
+		{"{.serialNumber}", ""},
+		{"{.serialNumber.value}", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			path, err := ParseJSONPath(tt.template)
+			require.NoError(t, err)
+			var out strings.Builder
+			require.NoError(t, path.Execute(&out, host))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestJSONPathErrors(t *testing.T) {
+	for template, want := range map[string]string{
+		"{.resourceId":    `unclosed expression "{.resourceId"`,
+		"{resourceId}":    `invalid expression "resourceId", must start with . or [`,
+		"{.hostNics[?x]}": `invalid expression ".hostNics[?x]", unsupported selector [?x]`,
+		"{.a..b}":         `invalid expression ".a..b", empty field name`,
+	} {
+		_, err := ParseJSONPath(template)
+		assert.EqualError(t, err, want, template)
+	}
+
+	host := jsonPathHost{Nics: []jsonPathNic{{Name: "eth0"}}}
+	for template, want := range map[string]string{
+		"{.hostNics[3]}":    "index [3] is out of range, the array has 1 elements",
+		"{.resourceId[0]}":  "[0] applies to an array, not to a string",
+		"{.enabled.status}": `field "status" applies to an object, not to a boolean`,
+	} {
+		path, err := ParseJSONPath(template)
+		require.NoError(t, err)
+		assert.EqualError(t, path.Execute(&strings.Builder{}, host), want, template)
+	}
+}
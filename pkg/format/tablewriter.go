@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+)
+
+// Marks the end of a cut cell
+const cutMarker = "…"
+
+// TableWriter aligns the tab separated cells of the lines written to it in columns as wide as their widest cell.
+//
+// Cells followed by another cell and longer than the maximum width are cut, so that a single long value, e.g.
+// a site name or a status detail, does not push the following columns of every line to the right. The last cell
+// of a line is never cut as nothing follows it, which keeps the values of "Key:\tValue" views whole.
+type TableWriter struct {
+	*tabwriter.Writer
+
+	// Largest number of characters of a cut cell, no cell is cut when zero
+	maxCellWidth int
+	// Part of the current line written so far
+	line []byte
+	// Colors the aligned lines, nil when the output is not colored
+	colors *colorWriter
+}
+
+// NewTableWriter creates a table writer on top of w with the given padding between columns and tabwriter flags;
+// cells are cut to maxCellWidth characters, or kept whole when maxCellWidth is zero
+func NewTableWriter(w io.Writer, padding int, flags uint, maxCellWidth int) *TableWriter {
+	return &TableWriter{
+		Writer:       tabwriter.NewWriter(w, 0, 0, padding, ' ', flags),
+		maxCellWidth: maxCellWidth,
+	}
+}
+
+// NewColorTableWriter creates a table writer like NewTableWriter that also colors the known statuses and CVE
+// severities of the cells, and puts an icon in front of the statuses
+func NewColorTableWriter(w io.Writer, padding int, flags uint, maxCellWidth int) *TableWriter {
+	colors := &colorWriter{out: w}
+	t := NewTableWriter(colors, padding, flags, maxCellWidth)
+	t.colors = colors
+	return t
+}
+
+// Write buffers the written text until the end of each line, cuts the cells of the line and passes it on
+func (t *TableWriter) Write(p []byte) (int, error) {
+	rest := p
+	for {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			t.line = append(t.line, rest...)
+			return len(p), nil
+		}
+		t.line = append(t.line, rest[:i+1]...)
+		rest = rest[i+1:]
+		if err := t.writeLine(); err != nil {
+			return len(p) - len(rest), err
+		}
+	}
+}
+
+// Flush passes on the last line if it does not end with a newline and aligns the lines written so far
+func (t *TableWriter) Flush() error {
+	if err := t.writeLine(); err != nil {
+		return err
+	}
+	if err := t.Writer.Flush(); err != nil {
+		return err
+	}
+	if t.colors != nil {
+		return t.colors.Flush()
+	}
+	return nil
+}
+
+func (t *TableWriter) writeLine() error {
+	if len(t.line) == 0 {
+		return nil
+	}
+	line := string(t.line)
+	t.line = t.line[:0]
+	line = CutCells(line, t.maxCellWidth)
+	if t.colors != nil {
+		line = t.colors.mark(line)
+	}
+	_, err := io.WriteString(t.Writer, line)
+	return err
+}
+
+// CutCells cuts the cells of a tab separated line longer than maxWidth characters, except the last one, to
+// maxWidth characters ending with "…"; the line is returned unchanged when maxWidth is zero
+func CutCells(line string, maxWidth int) string {
+	if maxWidth <= 0 || !strings.Contains(line, "\t") {
+		return line
+	}
+	cells := strings.Split(line, "\t")
+	for i := 0; i < len(cells)-1; i++ {
+		cells[i] = cutCell(cells[i], maxWidth)
+	}
+	return strings.Join(cells, "\t")
+}
+
+func cutCell(cell string, maxWidth int) string {
+	if utf8.RuneCountInString(cell) <= maxWidth {
+		return cell
+	}
+	runes := []rune(cell)
+	if maxWidth <= 1 {
+		return string(runes[:maxWidth])
+	}
+	return strings.TrimRight(string(runes[:maxWidth-1]), " ") + cutMarker
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableWriter(t *testing.T) {
+	var b bytes.Buffer
+	w := NewTableWriter(&b, 3, 0, 12)
+	fmt.Fprintf(w, "NAME\tSITE\tSTATUS\n")
+	fmt.Fprintf(w, "host-1\tStore 42 Downtown Portland\tRunning\n")
+	// The last cell is not cut and the line is passed on when written in pieces
+	fmt.Fprintf(w, "host-2\t")
+	fmt.Fprintf(w, "Store 7\tProvisioning failed: tpm device not found")
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, ""+
+		"NAME     SITE           STATUS\n"+
+		"host-1   Store 42 Do…   Running\n"+
+		"host-2   Store 7        Provisioning failed: tpm device not found", b.String())
+}
+
+func TestTableWriterWide(t *testing.T) {
+	var b bytes.Buffer
+	w := NewTableWriter(&b, 1, tabwriter.Debug, 0)
+	fmt.Fprintf(w, "a\tStore 42 Downtown Portland\tb\n")
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, "a |Store 42 Downtown Portland |b\n", b.String())
+}
+
+func TestCutCells(t *testing.T) {
+	assert.Equal(t, "Edge Micro…\tos-1234abcd", CutCells("Edge Microvisor Toolkit 3.0.20250504\tos-1234abcd", 11))
+	assert.Equal(t, "abcdef\tg", CutCells("abcdef\tg", 6))
+	assert.Equal(t, "Zürich S…\t", CutCells("Zürich Süd Bahnhof\t", 9))
+	// Trailing spaces of a cut cell are dropped before the marker
+	assert.Equal(t, "ab…\tc", CutCells("ab  cd\tc", 4))
+	assert.Equal(t, "no tabs in this line", CutCells("no tabs in this line", 4))
+	assert.Equal(t, "abcdef\tg", CutCells("abcdef\tg", 0))
+}
+
+func TestTableOutput(t *testing.T) {
+	var b bytes.Buffer
+	w := NewTableWriter(&b, 3, 0, 0)
+	assert.Same(t, w, AsTableOutput(w))
+
+	tw := tabwriter.NewWriter(&b, 0, 0, 1, ' ', 0)
+	assert.Same(t, tw, AsTableOutput(tw))
+
+	assert.IsType(t, &tabwriter.Writer{}, AsTableOutput(&b))
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alerting provides primitives to interact with the alerting monitor HTTP API of the orchestrator.
+// This is a manually created client for endpoints not covered by OpenAPI specs.
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestEditorFn is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HTTPRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the alerting monitor API.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.example.com for example. The paths of the API are appended to it.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HTTPRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// NewClient creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HTTPRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// ClientInterface defines the interface for the alerting monitor client
+type ClientInterface interface {
+	// ListProjectAlerts lists the alerts of a project
+	ListProjectAlerts(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses
+type ClientWithResponsesInterface interface {
+	// ListProjectAlertsWithResponse lists the alerts of a project and parses the response
+	ListProjectAlertsWithResponse(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error)
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// ListProjectAlerts request
+func (c *Client) ListProjectAlerts(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectAlertsRequest(c.Server, projectName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListProjectAlertsRequest generates requests for ListProjectAlerts
+func NewListProjectAlertsRequest(server string, projectName string, params *ListAlertsParams) (*http.Request, error) {
+	serverURL, err := url.Parse(strings.TrimSuffix(server, "/"))
+	if err != nil {
+		return nil, err
+	}
+	queryURL := serverURL.JoinPath("v1", "projects", projectName, "alerts")
+
+	if params != nil {
+		query := url.Values{}
+		for name, value := range map[string]*string{
+			"alert":   params.Alert,
+			"host":    params.Host,
+			"cluster": params.Cluster,
+			"app":     params.App,
+		} {
+			if value != nil {
+				query.Set(name, *value)
+			}
+		}
+		for name, value := range map[string]*bool{
+			"active":     params.Active,
+			"suppressed": params.Suppressed,
+		} {
+			if value != nil {
+				query.Set(name, strconv.FormatBool(*value))
+			}
+		}
+		queryURL.RawQuery = query.Encode()
+	}
+
+	return http.NewRequest("GET", queryURL.String(), nil)
+}
+
+// ListProjectAlertsWithResponse request returning *ListAlertsResponse
+func (c *ClientWithResponses) ListProjectAlertsWithResponse(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error) {
+	rsp, err := c.ListProjectAlerts(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAlertsResponse(rsp)
+}
+
+// ParseListAlertsResponse parses an HTTP response from a ListProjectAlerts call
+func ParseListAlertsResponse(rsp *http.Response) (*ListAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	if rsp.StatusCode == http.StatusOK {
+		var dest AlertList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, fmt.Errorf("failed to parse alerts: %w", err)
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// applyEditors applies all request editors to the request
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/rest/alerting/client.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pkg/rest/alerting/client.go -destination=pkg/rest/alerting/mock_client.go -package=alerting
+//
+
+// Package alerting is a generated GoMock package.
+package alerting
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHTTPRequestDoer is a mock of HTTPRequestDoer interface.
+type MockHTTPRequestDoer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHTTPRequestDoerMockRecorder
+	isgomock struct{}
+}
+
+// MockHTTPRequestDoerMockRecorder is the mock recorder for MockHTTPRequestDoer.
+type MockHTTPRequestDoerMockRecorder struct {
+	mock *MockHTTPRequestDoer
+}
+
+// NewMockHTTPRequestDoer creates a new mock instance.
+func NewMockHTTPRequestDoer(ctrl *gomock.Controller) *MockHTTPRequestDoer {
+	mock := &MockHTTPRequestDoer{ctrl: ctrl}
+	mock.recorder = &MockHTTPRequestDoerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHTTPRequestDoer) EXPECT() *MockHTTPRequestDoerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHTTPRequestDoer) Do(req *http.Request) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", req)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHTTPRequestDoerMockRecorder) Do(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHTTPRequestDoer)(nil).Do), req)
+}
+
+// MockClientInterface is a mock of ClientInterface interface.
+type MockClientInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockClientInterfaceMockRecorder is the mock recorder for MockClientInterface.
+type MockClientInterfaceMockRecorder struct {
+	mock *MockClientInterface
+}
+
+// NewMockClientInterface creates a new mock instance.
+func NewMockClientInterface(ctrl *gomock.Controller) *MockClientInterface {
+	mock := &MockClientInterface{ctrl: ctrl}
+	mock.recorder = &MockClientInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientInterface) EXPECT() *MockClientInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ListProjectAlerts mocks base method.
+func (m *MockClientInterface) ListProjectAlerts(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectName, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAlerts", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAlerts indicates an expected call of ListProjectAlerts.
+func (mr *MockClientInterfaceMockRecorder) ListProjectAlerts(ctx, projectName, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectName, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAlerts", reflect.TypeOf((*MockClientInterface)(nil).ListProjectAlerts), varargs...)
+}
+
+// MockClientWithResponsesInterface is a mock of ClientWithResponsesInterface interface.
+type MockClientWithResponsesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientWithResponsesInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockClientWithResponsesInterfaceMockRecorder is the mock recorder for MockClientWithResponsesInterface.
+type MockClientWithResponsesInterfaceMockRecorder struct {
+	mock *MockClientWithResponsesInterface
+}
+
+// NewMockClientWithResponsesInterface creates a new mock instance.
+func NewMockClientWithResponsesInterface(ctrl *gomock.Controller) *MockClientWithResponsesInterface {
+	mock := &MockClientWithResponsesInterface{ctrl: ctrl}
+	mock.recorder = &MockClientWithResponsesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientWithResponsesInterface) EXPECT() *MockClientWithResponsesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ListProjectAlertsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProjectAlertsWithResponse(ctx context.Context, projectName string, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectName, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAlertsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAlertsWithResponse indicates an expected call of ListProjectAlertsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProjectAlertsWithResponse(ctx, projectName, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectName, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAlertsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProjectAlertsWithResponse), varargs...)
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"net/http"
+	"time"
+)
+
+// AlertState is the state of an alert
+type AlertState string
+
+// Defines values for AlertState.
+const (
+	AlertStateActive     AlertState = "active"
+	AlertStateSuppressed AlertState = "suppressed"
+	AlertStateResolved   AlertState = "resolved"
+)
+
+// AlertStatus defines model for the status of an alert
+type AlertStatus struct {
+	State AlertState `json:"state,omitempty"`
+}
+
+// Alert defines model for an alert raised by the alerting monitor
+type Alert struct {
+	// AlertDefinitionId is the ID of the definition the alert was raised for
+	AlertDefinitionId string `json:"alertDefinitionId,omitempty"` //nolint:revive
+
+	// Fingerprint identifies the alert
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Labels identify the alert and the resource it is about, e.g. alert_category, host_uuid and severity
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations describe the alert, e.g. summary and description
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	StartsAt  *time.Time   `json:"startsAt,omitempty"`
+	UpdatedAt *time.Time   `json:"updatedAt,omitempty"`
+	EndsAt    *time.Time   `json:"endsAt,omitempty"`
+	Status    *AlertStatus `json:"status,omitempty"`
+}
+
+// AlertList defines model for the response listing alerts
+type AlertList struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// ListAlertsParams defines parameters for ListProjectAlerts.
+type ListAlertsParams struct {
+	// Alert selects the alerts raised for the alert definition of that name
+	Alert *string
+
+	// Host selects the alerts about the host of that ID
+	Host *string
+
+	// Cluster selects the alerts about the cluster of that ID
+	Cluster *string
+
+	// App selects the alerts about the application of that ID
+	App *string
+
+	// Active selects the active alerts
+	Active *bool
+
+	// Suppressed selects the suppressed alerts
+	Suppressed *bool
+}
+
+// ListAlertsResponse represents the response from ListProjectAlerts
+type ListAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package failover provides an http.RoundTripper decorator that sends REST calls to a warm standby
+// orchestrator when the primary one cannot be reached or keeps failing with server errors.
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Config names the endpoints and which calls may be sent to the fallback.
+type Config struct {
+	// Primary is the API endpoint the calls are addressed to, e.g. https://api.example.com/
+	Primary string
+	// Fallback is the API endpoint calls to Primary are sent to once Primary failed.
+	Fallback string
+	// Writes allows calls which change resources to fail over too; only reads do otherwise, since a
+	// mutation may have been applied by the primary even though its response was lost.
+	Writes bool
+	// Report is called once, when the calls start being served by the fallback, with the reason.
+	Report func(fallback string, reason string)
+}
+
+// Transport sends the calls to the primary endpoint until one of them fails with a network error or a
+// 5xx response; that call and all the following ones are then served by the fallback endpoint.
+type Transport struct {
+	Base   http.RoundTripper
+	Config Config
+
+	mu         sync.Mutex
+	failedOver bool
+}
+
+// NewTransport decorates base with the failover described by cfg.
+func NewTransport(base http.RoundTripper, cfg Config) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, Config: cfg}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.eligible(req) {
+		return t.Base.RoundTrip(req)
+	}
+	if t.usingFallback() {
+		return t.toFallback(req)
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	reason := failureOf(resp, err)
+	if reason == "" {
+		return resp, err
+	}
+	if resp != nil {
+		// Release the connection held by the failed call
+		_ = resp.Body.Close()
+	}
+
+	t.mu.Lock()
+	report := !t.failedOver
+	t.failedOver = true
+	t.mu.Unlock()
+	if report && t.Config.Report != nil {
+		t.Config.Report(t.Config.Fallback, reason)
+	}
+	return t.toFallback(req)
+}
+
+func (t *Transport) usingFallback() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failedOver
+}
+
+// Calls fail over when they are addressed to the primary endpoint, are allowed to and can be sent again
+func (t *Transport) eligible(req *http.Request) bool {
+	if t.Config.Fallback == "" || !strings.HasPrefix(req.URL.String(), endpointPrefix(t.Config.Primary)) {
+		return false
+	}
+	if !t.Config.Writes && !isRead(req) {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func (t *Transport) toFallback(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(endpointPrefix(t.Config.Fallback) +
+		strings.TrimPrefix(req.URL.String(), endpointPrefix(t.Config.Primary)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid fallback endpoint %q: %w", t.Config.Fallback, err)
+	}
+	fallbackReq := req.Clone(req.Context())
+	fallbackReq.URL = target
+	fallbackReq.Host = ""
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		fallbackReq.Body = body
+	}
+	return t.Base.RoundTrip(fallbackReq)
+}
+
+// Endpoints are compared without their trailing slash, the paths of the calls start with one
+func endpointPrefix(endpoint string) string {
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+func isRead(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// Describes why a call to the primary endpoint is failed over, or returns "" if it is not
+func failureOf(resp *http.Response, err error) string {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return ""
+		}
+		return err.Error()
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return resp.Status
+	}
+	return ""
+}
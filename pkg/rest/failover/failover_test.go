@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package failover
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEndpoint answers with status and its name followed by the path and body of the request.
+func newEndpoint(name string, status int, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(name + ":" + r.URL.Path + ":" + string(body)))
+	}))
+}
+
+func call(t *testing.T, client *http.Client, method, url, body string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(data)
+}
+
+func TestFailoverOnServerErrors(t *testing.T) {
+	var primaryCalls, fallbackCalls atomic.Int32
+	primary := newEndpoint("primary", http.StatusInternalServerError, &primaryCalls)
+	defer primary.Close()
+	fallback := newEndpoint("fallback", http.StatusOK, &fallbackCalls)
+	defer fallback.Close()
+
+	var reports []string
+	client := &http.Client{Transport: NewTransport(nil, Config{
+		Primary:  primary.URL + "/",
+		Fallback: fallback.URL,
+		Report:   func(endpoint, reason string) { reports = append(reports, endpoint+" "+reason) },
+	})}
+
+	status, body := call(t, client, http.MethodGet, primary.URL+"/v1/projects/p/compute/hosts?pageSize=10", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "fallback:/v1/projects/p/compute/hosts:", body)
+
+	// Once failed over, the primary is not tried again
+	status, body = call(t, client, http.MethodHead, primary.URL+"/v1/projects/p/compute/sites", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, body)
+	assert.Equal(t, int32(1), primaryCalls.Load())
+	assert.Equal(t, int32(2), fallbackCalls.Load())
+	assert.Equal(t, []string{fallback.URL + " 500 Internal Server Error"}, reports)
+
+	// Mutations are only served by the primary
+	status, body = call(t, client, http.MethodPost, primary.URL+"/v1/projects/p/compute/hosts", "{}")
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Equal(t, "primary:/v1/projects/p/compute/hosts:{}", body)
+}
+
+func TestFailoverWrites(t *testing.T) {
+	var primaryCalls, fallbackCalls atomic.Int32
+	primary := newEndpoint("primary", http.StatusServiceUnavailable, &primaryCalls)
+	defer primary.Close()
+	fallback := newEndpoint("fallback", http.StatusCreated, &fallbackCalls)
+	defer fallback.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, Config{Primary: primary.URL, Fallback: fallback.URL + "/", Writes: true})}
+	status, body := call(t, client, http.MethodPost, primary.URL+"/v1/projects/p/compute/hosts", `{"name":"h"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, `fallback:/v1/projects/p/compute/hosts:{"name":"h"}`, body)
+}
+
+func TestFailoverOnNetworkErrors(t *testing.T) {
+	var fallbackCalls atomic.Int32
+	fallback := newEndpoint("fallback", http.StatusOK, &fallbackCalls)
+	defer fallback.Close()
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	var reasons []string
+	client := &http.Client{Transport: NewTransport(nil, Config{
+		Primary:  unreachable.URL,
+		Fallback: fallback.URL,
+		Report:   func(_, reason string) { reasons = append(reasons, reason) },
+	})}
+	status, body := call(t, client, http.MethodGet, unreachable.URL+"/v1/orgs", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "fallback:/v1/orgs:", body)
+	require.Len(t, reasons, 1)
+	assert.Contains(t, reasons[0], "connection refused")
+}
+
+func TestNoFailover(t *testing.T) {
+	var primaryCalls, otherCalls atomic.Int32
+	primary := newEndpoint("primary", http.StatusNotFound, &primaryCalls)
+	defer primary.Close()
+	other := newEndpoint("other", http.StatusBadGateway, &otherCalls)
+	defer other.Close()
+
+	var reports int
+	client := &http.Client{Transport: NewTransport(nil, Config{
+		Primary:  primary.URL,
+		Fallback: "http://fallback.invalid",
+		Report:   func(_, _ string) { reports++ },
+	})}
+
+	// Client errors are answers of a healthy primary
+	status, _ := call(t, client, http.MethodGet, primary.URL+"/v1/orgs", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// Calls to other endpoints are left alone
+	status, _ = call(t, client, http.MethodGet, other.URL+"/v1/orgs", "")
+	assert.Equal(t, http.StatusBadGateway, status)
+	assert.Zero(t, reports)
+
+	// Without a fallback nothing fails over
+	client = &http.Client{Transport: NewTransport(nil, Config{Primary: other.URL})}
+	status, _ = call(t, client, http.MethodGet, other.URL+"/v1/orgs", "")
+	assert.Equal(t, http.StatusBadGateway, status)
+}
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package infra
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI definition the client and the types of the package are generated from
+//
+//go:embed amc-infra-core-edge-infrastructure-manager-openapi-all.yaml
+var OpenAPISpec []byte
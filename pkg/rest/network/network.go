@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package network builds the base http.Transport of the REST and Keycloak clients, reaching the
+// orchestrator through an optional proxy, trusting an optional private certificate authority and
+// authenticating with an optional client certificate.
+package network
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// Config selects how the orchestrator is reached.
+type Config struct {
+	// Proxy is the URL of the proxy all requests go through; when empty the HTTPS_PROXY, HTTP_PROXY
+	// and NO_PROXY environment variables apply.
+	Proxy string
+	// CACert is the path of a PEM file with certificate authorities trusted in addition to the system ones.
+	CACert string
+	// InsecureSkipVerify disables the verification of the server certificates.
+	InsecureSkipVerify bool
+	// ClientCert and ClientKey are the paths of the PEM files of the certificate and private key presented
+	// to the servers requesting one, for mutual TLS; both or none are set.
+	ClientCert string
+	ClientKey  string
+}
+
+// NewTransport returns a transport based on http.DefaultTransport, limited to TLS 1.3, configured by cfg.
+func NewTransport(cfg Config) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		MaxVersion:         tls.VersionTLS13,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in only, e.g. for test deployments with self-signed certificates
+	}
+
+	if cfg.Proxy != "" {
+		proxyURL, err := ParseProxy(cfg.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if cfg.CACert != "" {
+		pool, err := certPool(cfg.CACert)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, errors.New("a client certificate and its private key must be given together")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate: %w", err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	return transport, nil
+}
+
+// ClientCertificateRejected reports whether err comes from a server which ended the TLS handshake because it
+// requires a client certificate and was given none, or does not accept the one it was given.
+func ClientCertificateRejected(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "remote error" || opErr.Err == nil {
+		return false
+	}
+	switch opErr.Err.Error() {
+	case "tls: certificate required", "tls: bad certificate", "tls: unknown certificate authority",
+		"tls: certificate expired", "tls: certificate revoked", "tls: unsupported certificate":
+		return true
+	}
+	return false
+}
+
+// ParseProxy parses a proxy URL; the scheme must be http, https or socks5.
+func ParseProxy(proxy string) (*url.URL, error) {
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", proxy, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", proxy)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", proxy)
+	}
+	return proxyURL, nil
+}
+
+// Returns the system certificate pool with the certificates of the PEM file added
+func certPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CA certificate: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", path)
+	}
+	return pool, nil
+}
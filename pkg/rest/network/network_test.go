@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, cfg Config, url string) (*http.Response, error) {
+	transport, err := NewTransport(cfg)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestTransportCACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	_, err := get(t, Config{}, srv.URL)
+	assert.Error(t, err, "the test server certificate is not trusted by default")
+
+	caCert := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+	resp, err := get(t, Config{CACert: caCert}, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = get(t, Config{InsecureSkipVerify: true}, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestTransportInvalidCACert(t *testing.T) {
+	_, err := NewTransport(Config{CACert: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.ErrorContains(t, err, "cannot read CA certificate")
+
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0600))
+	_, err = NewTransport(Config{CACert: notPEM})
+	assert.ErrorContains(t, err, "no PEM certificate found")
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	resp, err := get(t, Config{Proxy: proxy.URL}, "http://api.orch.example/v1/projects")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "http://api.orch.example/v1/projects", proxied)
+}
+
+func TestParseProxy(t *testing.T) {
+	for _, proxy := range []string{"http://proxy.example.com:3128", "https://proxy.example.com", "socks5://127.0.0.1:1080"} {
+		_, err := ParseProxy(proxy)
+		assert.NoError(t, err, proxy)
+	}
+	for proxy, msg := range map[string]string{
+		"proxy.example.com:3128":  "scheme must be http, https or socks5",
+		"ftp://proxy.example.com": "scheme must be http, https or socks5",
+		"http://":                 "missing host",
+		"http://proxy\x7f":        "invalid proxy URL",
+	} {
+		_, err := ParseProxy(proxy)
+		assert.ErrorContains(t, err, msg, proxy)
+	}
+}
+
+// Writes a self-signed client certificate and its key to PEM files
+func writeClientCert(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "orch-cli"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return cert, certFile, keyFile
+}
+
+func TestTransportClientCert(t *testing.T) {
+	dir := t.TempDir()
+	cert, certFile, keyFile := writeClientCert(t, dir)
+	_, otherCertFile, otherKeyFile := writeClientCert(t, t.TempDir())
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	_, err := get(t, Config{InsecureSkipVerify: true}, srv.URL)
+	require.Error(t, err)
+	assert.True(t, ClientCertificateRejected(err), err.Error())
+
+	resp, err := get(t, Config{InsecureSkipVerify: true, ClientCert: certFile, ClientKey: keyFile}, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = get(t, Config{InsecureSkipVerify: true, ClientCert: otherCertFile, ClientKey: otherKeyFile}, srv.URL)
+	require.Error(t, err)
+	assert.True(t, ClientCertificateRejected(err), err.Error())
+
+	// Errors not caused by the client certificate
+	assert.False(t, ClientCertificateRejected(os.ErrNotExist))
+	_, err = get(t, Config{}, srv.URL)
+	assert.False(t, ClientCertificateRejected(err), "the server certificate is not trusted")
+}
+
+func TestTransportInvalidClientCert(t *testing.T) {
+	dir := t.TempDir()
+	_, certFile, keyFile := writeClientCert(t, dir)
+
+	_, err := NewTransport(Config{ClientCert: certFile})
+	assert.EqualError(t, err, "a client certificate and its private key must be given together")
+	_, err = NewTransport(Config{ClientKey: keyFile})
+	assert.EqualError(t, err, "a client certificate and its private key must be given together")
+
+	_, err = NewTransport(Config{ClientCert: certFile, ClientKey: certFile})
+	assert.ErrorContains(t, err, "cannot load client certificate")
+	_, err = NewTransport(Config{ClientCert: filepath.Join(dir, "missing.pem"), ClientKey: keyFile})
+	assert.ErrorContains(t, err, "cannot load client certificate")
+}
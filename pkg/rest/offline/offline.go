@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package offline provides an http.RoundTripper decorator that keeps the last successful response of every
+// read on disk, and serves the reads from those responses instead of the network when working offline. Responses
+// carrying secrets are left out with Config.Skip or a "Cache-Control: no-store" header, and Config.MaxAge and
+// Config.MaxEntries bound what stays on disk.
+package offline
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedAtHeader is set on the responses served from the cache to the time they were received.
+const CachedAtHeader = "X-Orch-Cli-Cached-At"
+
+// Config sets where the responses are kept and whether the calls are served from them.
+type Config struct {
+	// Dir is the directory of the cached responses; responses are neither cached nor served without it.
+	Dir string
+	// Offline serves the reads from the cached responses and blocks the other calls.
+	Offline bool
+	// Report is called, once per path, when a read is served from a response cached at the given time.
+	Report func(path string, cachedAt time.Time)
+	// Skip reports the reads whose responses must never be written to disk, like those carrying credentials.
+	Skip func(req *http.Request) bool
+	// MaxAge is how long a response is kept; older responses are neither served nor kept. Zero keeps them forever.
+	MaxAge time.Duration
+	// MaxEntries is the number of responses kept, the oldest are removed beyond it. Zero keeps them all.
+	MaxEntries int
+}
+
+// NotCachedError is returned offline for a read whose response was never cached.
+type NotCachedError struct {
+	Method string
+	URL    string
+}
+
+func (e *NotCachedError) Error() string {
+	return fmt.Sprintf("offline: no cached response for %s %s, run the command once while connected", e.Method, e.URL)
+}
+
+// SkippedError is returned offline for a read whose responses are never cached.
+type SkippedError struct {
+	Method string
+	URL    string
+}
+
+func (e *SkippedError) Error() string {
+	return fmt.Sprintf("offline: responses of %s %s are never cached, it needs a connection to the orchestrator", e.Method, e.URL)
+}
+
+// WriteBlockedError is returned offline for a call which would change resources.
+type WriteBlockedError struct {
+	Method string
+	Path   string
+}
+
+func (e *WriteBlockedError) Error() string {
+	return fmt.Sprintf("offline: %s %s blocked, changes need a connection to the orchestrator", e.Method, e.Path)
+}
+
+// entry is a cached response, stored as a JSON file named after its URL
+type entry struct {
+	URL         string    `json:"url"`
+	CachedAt    time.Time `json:"cachedAt"`
+	ContentType string    `json:"contentType,omitempty"`
+	Body        []byte    `json:"body"`
+}
+
+// Transport caches the 200 responses of the GET calls of Base while online, and serves them offline.
+type Transport struct {
+	Base   http.RoundTripper
+	Config Config
+
+	mu       sync.Mutex
+	reported map[string]bool
+}
+
+// NewTransport decorates base with the cache described by cfg.
+func NewTransport(base http.RoundTripper, cfg Config) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, Config: cfg, reported: map[string]bool{}}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Config.Offline {
+		return t.serve(req)
+	}
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || t.Config.Dir == "" {
+		return resp, err
+	}
+	if t.skip(req) || noStore(resp) {
+		// A response cached before the read was skipped is removed, so that no secret is left behind
+		_ = os.Remove(t.path(req))
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	// The response is still returned when it cannot be cached, the cache only serves offline reads
+	_ = t.store(req, entry{
+		URL:         req.URL.String(),
+		CachedAt:    time.Now().UTC(),
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        body,
+	})
+	t.prune()
+	return resp, nil
+}
+
+func (t *Transport) serve(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil, &WriteBlockedError{Method: req.Method, Path: req.URL.Path}
+	}
+	if t.skip(req) {
+		return nil, &SkippedError{Method: req.Method, URL: req.URL.String()}
+	}
+	cached, err := t.load(req)
+	if err != nil {
+		return nil, err
+	}
+	t.report(req.URL.Path, cached.CachedAt)
+
+	header := http.Header{}
+	if cached.ContentType != "" {
+		header.Set("Content-Type", cached.ContentType)
+	}
+	header.Set(CachedAtHeader, cached.CachedAt.Format(time.RFC3339))
+	body := cached.Body
+	if req.Method == http.MethodHead {
+		body = nil
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *Transport) skip(req *http.Request) bool {
+	return t.Config.Skip != nil && t.Config.Skip(req)
+}
+
+// Reports whether the server asked for the response not to be kept
+func noStore(resp *http.Response) bool {
+	for _, directive := range strings.Split(resp.Header.Get("Cache-Control"), ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether a response cached at the given time is older than MaxAge
+func (t *Transport) expired(cachedAt time.Time) bool {
+	return t.Config.MaxAge > 0 && time.Since(cachedAt) > t.Config.MaxAge
+}
+
+func (t *Transport) report(path string, cachedAt time.Time) {
+	if t.Config.Report == nil {
+		return
+	}
+	t.mu.Lock()
+	first := !t.reported[path]
+	t.reported[path] = true
+	t.mu.Unlock()
+	if first {
+		t.Config.Report(path, cachedAt)
+	}
+}
+
+// The file of the response of a call is named after its URL, HEAD calls are served from the GET responses
+func (t *Transport) path(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.URL.String()))
+	return filepath.Join(t.Config.Dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (t *Transport) load(req *http.Request) (*entry, error) {
+	if t.Config.Dir == "" {
+		return nil, &NotCachedError{Method: req.Method, URL: req.URL.String()}
+	}
+	data, err := os.ReadFile(t.path(req))
+	if os.IsNotExist(err) {
+		return nil, &NotCachedError{Method: req.Method, URL: req.URL.String()}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cached entry
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, fmt.Errorf("offline: invalid cached response %s: %w", t.path(req), err)
+	}
+	if t.expired(cached.CachedAt) {
+		_ = os.Remove(t.path(req))
+		return nil, &NotCachedError{Method: req.Method, URL: req.URL.String()}
+	}
+	return &cached, nil
+}
+
+// Writes the response through a temporary file so that a concurrent read never sees part of it
+func (t *Transport) store(req *http.Request, cached entry) error {
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.Config.Dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(t.Config.Dir, ".response-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), t.path(req))
+}
+
+// Removes the responses older than MaxAge, then the oldest ones beyond MaxEntries. The files are dated by their
+// modification time, which is when they were cached since each one is written anew.
+func (t *Transport) prune() {
+	if t.Config.MaxAge <= 0 && t.Config.MaxEntries <= 0 {
+		return
+	}
+	dirEntries, err := os.ReadDir(t.Config.Dir)
+	if err != nil {
+		return
+	}
+	type cachedFile struct {
+		path    string
+		modTime time.Time
+	}
+	var files []cachedFile
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || filepath.Ext(dirEntry.Name()) != ".json" {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(t.Config.Dir, dirEntry.Name())
+		if t.expired(info.ModTime()) {
+			_ = os.Remove(path)
+			continue
+		}
+		files = append(files, cachedFile{path: path, modTime: info.ModTime()})
+	}
+	if t.Config.MaxEntries <= 0 || len(files) <= t.Config.MaxEntries {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.After(files[j].modTime) })
+	for _, file := range files[t.Config.MaxEntries:] {
+		_ = os.Remove(file.path)
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package offline
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEndpoint answers with status and the path and query of the request.
+func newEndpoint(status int, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"path":"` + r.URL.Path + `","query":"` + r.URL.RawQuery + `"}`))
+	}))
+}
+
+func call(client *http.Client, method, url string) (*http.Response, string, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(""))
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return resp, string(data), err
+}
+
+func TestServeCachedReads(t *testing.T) {
+	var calls atomic.Int32
+	server := newEndpoint(http.StatusOK, &calls)
+	defer server.Close()
+	dir := t.TempDir()
+
+	online := &http.Client{Transport: NewTransport(nil, Config{Dir: dir})}
+	_, body, err := call(online, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?pageSize=20")
+	require.NoError(t, err)
+	assert.Equal(t, `{"path":"/v1/projects/p/compute/hosts","query":"pageSize=20"}`, body)
+
+	var reports []string
+	offline := &http.Client{Transport: NewTransport(nil, Config{
+		Dir:     dir,
+		Offline: true,
+		Report:  func(path string, cachedAt time.Time) { reports = append(reports, path) },
+	})}
+	for range 2 {
+		resp, cached, err := call(offline, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?pageSize=20")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, body, cached)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		cachedAt, err := time.Parse(time.RFC3339, resp.Header.Get(CachedAtHeader))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), cachedAt, time.Minute)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+	// Each path is reported once
+	assert.Equal(t, []string{"/v1/projects/p/compute/hosts"}, reports)
+
+	// Other parameters were never fetched
+	_, _, err = call(offline, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?pageSize=50")
+	var notCached *NotCachedError
+	require.True(t, errors.As(err, &notCached))
+	assert.Contains(t, err.Error(), "no cached response for GET "+server.URL+"/v1/projects/p/compute/hosts?pageSize=50")
+}
+
+func TestBlockWritesOffline(t *testing.T) {
+	var calls atomic.Int32
+	server := newEndpoint(http.StatusOK, &calls)
+	defer server.Close()
+
+	offline := &http.Client{Transport: NewTransport(nil, Config{Dir: t.TempDir(), Offline: true})}
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		_, _, err := call(offline, method, server.URL+"/v1/projects/p/compute/hosts/host-1")
+		var blocked *WriteBlockedError
+		require.True(t, errors.As(err, &blocked), method)
+		assert.Equal(t, method, blocked.Method)
+		assert.Equal(t, "/v1/projects/p/compute/hosts/host-1", blocked.Path)
+	}
+	assert.Zero(t, calls.Load())
+}
+
+func TestCacheOnlySuccessfulReads(t *testing.T) {
+	var calls atomic.Int32
+	failing := newEndpoint(http.StatusNotFound, &calls)
+	defer failing.Close()
+	dir := t.TempDir()
+
+	online := &http.Client{Transport: NewTransport(nil, Config{Dir: dir})}
+	resp, _, err := call(online, http.MethodGet, failing.URL+"/v1/orgs")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	_, _, err = call(online, http.MethodPost, failing.URL+"/v1/orgs")
+	require.NoError(t, err)
+
+	var notCached *NotCachedError
+	offline := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, Offline: true})}
+	_, _, err = call(offline, http.MethodGet, failing.URL+"/v1/orgs")
+	assert.True(t, errors.As(err, &notCached))
+
+	// Without a directory nothing is served
+	offline = &http.Client{Transport: NewTransport(nil, Config{Offline: true})}
+	_, _, err = call(offline, http.MethodGet, failing.URL+"/v1/orgs")
+	assert.True(t, errors.As(err, &notCached))
+}
+
+func TestSkipSecretReads(t *testing.T) {
+	var calls atomic.Int32
+	server := newEndpoint(http.StatusOK, &calls)
+	defer server.Close()
+	noStore := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "private, no-store")
+		_, _ = w.Write([]byte(`{"token":"secret"}`))
+	}))
+	defer noStore.Close()
+	dir := t.TempDir()
+	skip := func(req *http.Request) bool { return strings.HasSuffix(req.URL.Path, "/kubeconfigs") }
+
+	// A response cached before the read was skipped is removed on the next read
+	_, _, err := call(&http.Client{Transport: NewTransport(nil, Config{Dir: dir})}, http.MethodGet,
+		server.URL+"/v2/projects/p/clusters/c/kubeconfigs")
+	require.NoError(t, err)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	online := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, Skip: skip})}
+	_, body, err := call(online, http.MethodGet, server.URL+"/v2/projects/p/clusters/c/kubeconfigs")
+	require.NoError(t, err)
+	assert.Contains(t, body, "/kubeconfigs")
+	_, body, err = call(online, http.MethodGet, noStore.URL+"/v1/token")
+	require.NoError(t, err)
+	assert.Equal(t, `{"token":"secret"}`, body)
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	offline := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, Offline: true, Skip: skip})}
+	_, _, err = call(offline, http.MethodGet, server.URL+"/v2/projects/p/clusters/c/kubeconfigs")
+	var skipped *SkippedError
+	require.True(t, errors.As(err, &skipped))
+	assert.Contains(t, err.Error(), "are never cached")
+	_, _, err = call(offline, http.MethodGet, noStore.URL+"/v1/token")
+	var notCached *NotCachedError
+	assert.True(t, errors.As(err, &notCached))
+}
+
+func TestBoundCache(t *testing.T) {
+	var calls atomic.Int32
+	server := newEndpoint(http.StatusOK, &calls)
+	defer server.Close()
+	dir := t.TempDir()
+
+	online := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, MaxAge: time.Hour, MaxEntries: 2})}
+	for _, page := range []string{"1", "2", "3"} {
+		_, _, err := call(online, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?page="+page)
+		require.NoError(t, err)
+		// Tell the files apart by their modification time
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for i, file := range files {
+			info, err := file.Info()
+			require.NoError(t, err)
+			modTime := info.ModTime().Add(-time.Duration(len(files)-i) * time.Second)
+			require.NoError(t, os.Chtimes(filepath.Join(dir, file.Name()), modTime, modTime))
+		}
+	}
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	offline := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, Offline: true, MaxAge: time.Hour})}
+	_, _, err = call(offline, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?page=3")
+	require.NoError(t, err)
+
+	// Responses older than MaxAge are neither served nor kept
+	expired := &http.Client{Transport: NewTransport(nil, Config{Dir: dir, Offline: true, MaxAge: time.Nanosecond})}
+	_, _, err = call(expired, http.MethodGet, server.URL+"/v1/projects/p/compute/hosts?page=3")
+	var notCached *NotCachedError
+	require.True(t, errors.As(err, &notCached))
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	online = &http.Client{Transport: NewTransport(nil, Config{Dir: dir, MaxAge: time.Nanosecond})}
+	_, _, err = call(online, http.MethodGet, server.URL+"/v1/orgs")
+	require.NoError(t, err)
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy provides an http.RoundTripper decorator that evaluates the mutating REST calls
+// against organisation policies written in Rego or CUE before they are sent, and refuses the ones
+// the policies deny.
+//
+// Rego policies are evaluated with the opa binary and must define the set data.orchcli.deny, each
+// element being the reason of a denial. CUE policies are evaluated with the cue binary: the input is
+// vetted against them and every validation error is a reason of a denial.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// Query evaluated against the Rego policies
+	RegoQuery = "data.orchcli.deny"
+
+	opaBinary = "opa"
+	cueBinary = "cue"
+)
+
+// Input is the document the policies are evaluated against.
+type Input struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Project is the project the request applies to, if any.
+	Project string `json:"project,omitempty"`
+	// Resource is the path below the project, e.g. "compute/hosts/host-1234abcd".
+	Resource string `json:"resource,omitempty"`
+	// Body is the decoded JSON request body, if any.
+	Body interface{} `json:"body,omitempty"`
+}
+
+// Decision is the outcome of evaluating an input.
+type Decision struct {
+	// Policies are the policy files that were evaluated.
+	Policies []string
+	// Reasons are the denials reported by the policies; the input is allowed when there are none.
+	Reasons []string
+}
+
+// Allowed reports whether no policy denied the input.
+func (d Decision) Allowed() bool {
+	return len(d.Reasons) == 0
+}
+
+// DeniedError is returned for requests denied by a policy.
+type DeniedError struct {
+	Method   string
+	Resource string
+	Reasons  []string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("%s %s denied by policy: %s", e.Method, e.Resource, strings.Join(e.Reasons, "; "))
+}
+
+// runFunc runs a binary with the given standard input and returns its standard output and error.
+type runFunc func(ctx context.Context, name string, args []string, stdin []byte) ([]byte, []byte, error)
+
+// Engine evaluates inputs against the Rego and CUE policies of a directory.
+type Engine struct {
+	Dir  string
+	rego []string
+	cue  []string
+
+	// run executes the policy binaries; replaceable in tests.
+	run runFunc
+}
+
+// Load finds the .rego and .cue policies in dir and its subdirectories.
+func Load(dir string) (*Engine, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("invalid policy directory: %s is not a directory", dir)
+	}
+
+	engine := &Engine{Dir: dir, run: runBinary}
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch filepath.Ext(path) {
+		case ".rego":
+			engine.rego = append(engine.rego, path)
+		case ".cue":
+			engine.cue = append(engine.cue, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy directory: %w", err)
+	}
+	if len(engine.rego) == 0 && len(engine.cue) == 0 {
+		return nil, fmt.Errorf("no .rego or .cue policies found in %s", dir)
+	}
+	return engine, nil
+}
+
+// Policies returns the policy files of the engine.
+func (e *Engine) Policies() []string {
+	return append(append([]string(nil), e.rego...), e.cue...)
+}
+
+// Evaluate evaluates the input against all the policies of the engine.
+func (e *Engine) Evaluate(ctx context.Context, input Input) (Decision, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	decision := Decision{Policies: e.Policies()}
+	if len(e.rego) > 0 {
+		reasons, err := e.evaluateRego(ctx, data)
+		if err != nil {
+			return Decision{}, err
+		}
+		decision.Reasons = append(decision.Reasons, reasons...)
+	}
+	if len(e.cue) > 0 {
+		reasons, err := e.evaluateCUE(ctx, data)
+		if err != nil {
+			return Decision{}, err
+		}
+		decision.Reasons = append(decision.Reasons, reasons...)
+	}
+	return decision, nil
+}
+
+// Queries the deny set with opa; an undefined set allows the input.
+func (e *Engine) evaluateRego(ctx context.Context, input []byte) ([]string, error) {
+	args := []string{"eval", "--format", "json", "--stdin-input"}
+	for _, file := range e.rego {
+		args = append(args, "--data", file)
+	}
+	args = append(args, RegoQuery)
+
+	stdout, stderr, err := e.run(ctx, opaBinary, args, input)
+	if err != nil {
+		return nil, binaryError(opaBinary, err, stderr)
+	}
+
+	var output struct {
+		Result []struct {
+			Expressions []struct {
+				Value json.RawMessage `json:"value"`
+			} `json:"expressions"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(stdout, &output); err != nil {
+		return nil, fmt.Errorf("unexpected output of %s: %w", opaBinary, err)
+	}
+
+	var reasons []string
+	for _, result := range output.Result {
+		for _, expression := range result.Expressions {
+			denials, err := denyReasons(expression.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a set of strings: %w", RegoQuery, err)
+			}
+			reasons = append(reasons, denials...)
+		}
+	}
+	return reasons, nil
+}
+
+// A deny set is a JSON array once evaluated; partial object rules map reasons to true
+func denyReasons(value json.RawMessage) ([]string, error) {
+	var list []string
+	if err := json.Unmarshal(value, &list); err == nil {
+		return list, nil
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(value, &object); err != nil {
+		return nil, err
+	}
+	reasons := make([]string, 0, len(object))
+	for reason := range object {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	return reasons, nil
+}
+
+// Vets the input against the CUE policies; each validation error is a denial.
+func (e *Engine) evaluateCUE(ctx context.Context, input []byte) ([]string, error) {
+	file, err := os.CreateTemp("", "orch-cli-policy-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(input); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	args := append([]string{"vet"}, e.cue...)
+	args = append(args, file.Name())
+	_, stderr, err := e.run(ctx, cueBinary, args, nil)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		var reasons []string
+		for _, line := range strings.Split(string(stderr), "\n") {
+			// Lines indented by cue point at the source positions of the error above them
+			if strings.TrimSpace(line) != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+				reasons = append(reasons, strings.TrimSpace(line))
+			}
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, exitErr.Error())
+		}
+		return reasons, nil
+	}
+	if err != nil {
+		return nil, binaryError(cueBinary, err, stderr)
+	}
+	return nil, nil
+}
+
+func binaryError(name string, err error, stderr []byte) error {
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("policy evaluation needs the %s binary on the PATH: %w", name, err)
+	}
+	if msg := strings.TrimSpace(string(stderr)); msg != "" {
+		return fmt.Errorf("%s failed: %s", name, msg)
+	}
+	return fmt.Errorf("%s failed: %w", name, err)
+}
+
+func runBinary(ctx context.Context, name string, args []string, stdin []byte) ([]byte, []byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+// Transport evaluates POST, PUT, PATCH and DELETE requests against the policies of an engine
+// and only sends the ones they allow.
+type Transport struct {
+	Base   http.RoundTripper
+	Engine *Engine
+	// Explain receives a description of every decision; nil disables it.
+	Explain io.Writer
+}
+
+// NewTransport decorates base with the evaluation of the policies of engine.
+func NewTransport(base http.RoundTripper, engine *Engine, explain io.Writer) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, Engine: engine, Explain: explain}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutation(req.Method) {
+		return t.Base.RoundTrip(req)
+	}
+
+	body, req, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	input := NewInput(req.Method, req.URL.Path, body)
+	decision, err := t.Engine.Evaluate(req.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("policy evaluation failed: %w", err)
+	}
+	if t.Explain != nil {
+		explain(t.Explain, input, decision)
+	}
+	if !decision.Allowed() {
+		resource := input.Resource
+		if resource == "" {
+			resource = input.Path
+		}
+		return nil, &DeniedError{Method: input.Method, Resource: resource, Reasons: decision.Reasons}
+	}
+	return t.Base.RoundTrip(req)
+}
+
+// NewInput builds the policy input of a request; bodies that are not JSON are left out.
+func NewInput(method string, path string, body []byte) Input {
+	input := Input{Method: method, Path: path}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "projects" {
+			input.Project = segments[i+1]
+			input.Resource = strings.Join(segments[i+2:], "/")
+			break
+		}
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		var decoded interface{}
+		if err := json.Unmarshal(body, &decoded); err == nil {
+			input.Body = decoded
+		}
+	}
+	return input
+}
+
+func explain(w io.Writer, input Input, decision Decision) {
+	fmt.Fprintf(w, "policy: %s %s\n", input.Method, input.Path)
+	fmt.Fprintf(w, "policy: evaluated %s\n", strings.Join(decision.Policies, ", "))
+	if decision.Allowed() {
+		fmt.Fprintf(w, "policy: allowed\n")
+		return
+	}
+	for _, reason := range decision.Reasons {
+		fmt.Fprintf(w, "policy: denied: %s\n", reason)
+	}
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Returns the request body and a request whose body can still be sent.
+func readBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+	if req.GetBody != nil {
+		copyBody, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer copyBody.Close()
+		body, err := io.ReadAll(copyBody)
+		return body, req, err
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, clone, nil
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Denies the creation of hosts without secure boot, as a Rego policy evaluated by a fake opa would
+func fakeOPA(_ context.Context, name string, args []string, stdin []byte) ([]byte, []byte, error) {
+	if name != opaBinary || args[len(args)-1] != RegoQuery {
+		return nil, []byte("unexpected command"), errors.New("exit status 1")
+	}
+	var input Input
+	if err := json.Unmarshal(stdin, &input); err != nil {
+		return nil, nil, err
+	}
+	var denials []string
+	if body, ok := input.Body.(map[string]interface{}); ok && input.Method == http.MethodPost &&
+		strings.HasPrefix(input.Resource, "compute/hosts") && body["secureBoot"] != true {
+		denials = append(denials, "hosts must be created with secure boot")
+	}
+	out, _ := json.Marshal(map[string]interface{}{
+		"result": []interface{}{map[string]interface{}{
+			"expressions": []interface{}{map[string]interface{}{"value": denials}},
+		}},
+	})
+	return out, nil, nil
+}
+
+func newTestEngine(t *testing.T, files ...string) *Engine {
+	dir := t.TempDir()
+	for _, file := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte("package orchcli\n"), 0o600))
+	}
+	engine, err := Load(dir)
+	require.NoError(t, err)
+	engine.run = fakeOPA
+	return engine
+}
+
+func newEchoServer(calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("ok:"), body...))
+	}))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	_, err := Load(dir)
+	assert.EqualError(t, err, "no .rego or .cue policies found in "+dir)
+
+	_, err = Load(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "invalid policy directory")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sites"), 0o700))
+	for _, file := range []string{"hosts.rego", "sites/sites.cue", "README.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), nil, 0o600))
+	}
+	engine, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "hosts.rego"), filepath.Join(dir, "sites", "sites.cue")}, engine.Policies())
+}
+
+func TestNewInput(t *testing.T) {
+	input := NewInput(http.MethodPost, "/v1/projects/itep/compute/hosts/register", []byte(`{"name":"edge-1"}`))
+	assert.Equal(t, "itep", input.Project)
+	assert.Equal(t, "compute/hosts/register", input.Resource)
+	assert.Equal(t, map[string]interface{}{"name": "edge-1"}, input.Body)
+
+	input = NewInput(http.MethodDelete, "/v1/orgs/acme", []byte("not json"))
+	assert.Empty(t, input.Project)
+	assert.Empty(t, input.Resource)
+	assert.Nil(t, input.Body)
+}
+
+func TestTransportDeniesMutation(t *testing.T) {
+	var calls atomic.Int32
+	srv := newEchoServer(&calls)
+	defer srv.Close()
+
+	var explained bytes.Buffer
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport, newTestEngine(t, "hosts.rego"), &explained)}
+
+	_, err := client.Post(srv.URL+"/v1/projects/itep/compute/hosts", "application/json", strings.NewReader(`{"name":"edge-1"}`))
+	var denied *DeniedError
+	require.ErrorAs(t, err, &denied)
+	assert.Equal(t, "POST compute/hosts denied by policy: hosts must be created with secure boot", denied.Error())
+	assert.Equal(t, int32(0), calls.Load(), "denied requests must not be sent")
+	assert.Contains(t, explained.String(), "policy: POST /v1/projects/itep/compute/hosts\n")
+	assert.Contains(t, explained.String(), "policy: denied: hosts must be created with secure boot\n")
+}
+
+func TestTransportAllowsMutation(t *testing.T) {
+	var calls atomic.Int32
+	srv := newEchoServer(&calls)
+	defer srv.Close()
+
+	var explained bytes.Buffer
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport, newTestEngine(t, "hosts.rego"), &explained)}
+
+	resp, err := client.Post(srv.URL+"/v1/projects/itep/compute/hosts", "application/json", strings.NewReader(`{"secureBoot":true}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, `ok:{"secureBoot":true}`, string(body), "the body must still be sent after evaluation")
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Contains(t, explained.String(), "policy: allowed\n")
+}
+
+func TestTransportSkipsReads(t *testing.T) {
+	var calls atomic.Int32
+	srv := newEchoServer(&calls)
+	defer srv.Close()
+
+	engine := newTestEngine(t, "hosts.rego")
+	engine.run = func(context.Context, string, []string, []byte) ([]byte, []byte, error) {
+		t.Fatal("reads must not be evaluated")
+		return nil, nil, nil
+	}
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport, engine, nil)}
+
+	resp, err := client.Get(srv.URL + "/v1/projects/itep/compute/hosts")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestEvaluateCUE(t *testing.T) {
+	engine := newTestEngine(t, "sites.cue")
+	engine.run = func(_ context.Context, name string, args []string, _ []byte) ([]byte, []byte, error) {
+		assert.Equal(t, cueBinary, name)
+		assert.Equal(t, "vet", args[0])
+		stderr := "body.siteLat: invalid value 0 (out of bound >0):\n    ./sites.cue:4:12\n"
+		return nil, []byte(stderr), &exec.ExitError{}
+	}
+
+	decision, err := engine.Evaluate(context.Background(), NewInput(http.MethodPost, "/v1/projects/itep/regions/r/sites", []byte(`{"siteLat":0}`)))
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed())
+	assert.Equal(t, []string{"body.siteLat: invalid value 0 (out of bound >0):"}, decision.Reasons)
+}
+
+func TestEvaluateMissingBinary(t *testing.T) {
+	engine := newTestEngine(t, "hosts.rego")
+	engine.run = func(context.Context, string, []string, []byte) ([]byte, []byte, error) {
+		return nil, nil, exec.ErrNotFound
+	}
+
+	_, err := engine.Evaluate(context.Background(), Input{Method: http.MethodPost})
+	assert.ErrorContains(t, err, "policy evaluation needs the opa binary on the PATH")
+}
+
+func TestDenyReasons(t *testing.T) {
+	reasons, err := denyReasons(json.RawMessage(`{"b":true,"a":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, reasons)
+
+	_, err = denyReasons(json.RawMessage(`true`))
+	assert.Error(t, err)
+}
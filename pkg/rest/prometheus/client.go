@@ -5,9 +5,11 @@ package prometheus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -122,3 +124,53 @@ func ExecuteGET(ctx context.Context, client promapi.Client, path string, orgID s
 	}
 	return body, nil
 }
+
+// Sample is a value of an instant vector with the labels of its series
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// QueryVector runs an instant PromQL query evaluated now and decodes the samples of the resulting vector
+func QueryVector(ctx context.Context, client promapi.Client, query string, orgID string, timeout time.Duration) ([]Sample, error) {
+	body, err := ExecuteQueryAt(ctx, client, query, 0, orgID, timeout)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Status string `json:"status"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Metric map[string]string `json:"metric"`
+				Value  []interface{}     `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse Prometheus response: %w", err)
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("prometheus returned non-success status: %s", resp.Status)
+	}
+	if resp.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("unsupported prometheus result type %q, expected vector", resp.Data.ResultType)
+	}
+	samples := make([]Sample, 0, len(resp.Data.Result))
+	for _, item := range resp.Data.Result {
+		// A sample is a [timestamp, "value"] pair
+		if len(item.Value) < 2 {
+			continue
+		}
+		text, ok := item.Value[1].(string)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample value %q: %w", text, err)
+		}
+		samples = append(samples, Sample{Labels: item.Metric, Value: value})
+	}
+	return samples, nil
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retry provides an http.RoundTripper decorator that retries idempotent REST calls
+// which failed because of transient conditions, using exponential backoff with full jitter.
+package retry
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultBaseDelay  = 250 * time.Millisecond
+	DefaultMaxDelay   = 10 * time.Second
+)
+
+// Config controls how often and how long the transport waits between attempts.
+type Config struct {
+	// MaxRetries is the number of additional attempts after the first one; 0 disables retries.
+	MaxRetries int
+	// BaseDelay is the upper bound of the delay before the first retry; it doubles with each attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including delays requested via Retry-After.
+	MaxDelay time.Duration
+}
+
+// Transport retries idempotent requests on 429, 502, 503 and network errors.
+type Transport struct {
+	Base   http.RoundTripper
+	Config Config
+
+	// sleep waits for the given duration or until the context is done; replaceable in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewTransport decorates base with retries according to cfg.
+func NewTransport(base http.RoundTripper, cfg Config) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultMaxDelay
+	}
+	return &Transport{Base: base, Config: cfg, sleep: sleepContext}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Config.MaxRetries <= 0 || !isIdempotent(req) {
+		return t.Base.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(attemptReq)
+		if attempt >= t.Config.MaxRetries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt, resp)
+		if resp != nil {
+			// Release the connection held by the failed attempt
+			_ = resp.Body.Close()
+		}
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Computes the delay before the next attempt: the server's Retry-After if present,
+// otherwise a random duration up to BaseDelay*2^attempt; both capped by MaxDelay.
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(after, t.Config.MaxDelay)
+		}
+	}
+	ceiling := t.Config.BaseDelay << attempt
+	if ceiling <= 0 || ceiling > t.Config.MaxDelay {
+		ceiling = t.Config.MaxDelay
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1)) //nolint:gosec // jitter does not need a secure source
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// Requests are only replayed when doing so cannot cause a second side effect,
+// and when the body can be produced again.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return isTransientError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// Network failures are transient unless retrying cannot possibly help.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false
+	}
+	var certErr *tls.CertificateVerificationError
+	return !errors.As(err, &certErr)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
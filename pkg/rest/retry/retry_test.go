@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer fails the first `failures` requests with the given status code.
+func newFlakyServer(failures int32, status int, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if n <= failures {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write(append([]byte("ok:"), body...))
+	}))
+}
+
+func newTestTransport(retries int, delays *[]time.Duration) *Transport {
+	t := NewTransport(http.DefaultTransport, Config{MaxRetries: retries, MaxDelay: 500 * time.Millisecond})
+	t.sleep = func(_ context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return nil
+	}
+	return t
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(2, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	var delays []time.Duration
+	client := &http.Client{Transport: newTestTransport(3, &delays)}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok:payload", string(body))
+	assert.Equal(t, int32(3), calls.Load())
+	// Retry-After of 1s is capped by the configured maximum delay
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}, delays)
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(10, http.StatusTooManyRequests, &calls)
+	defer server.Close()
+
+	var delays []time.Duration
+	client := &http.Client{Transport: newTestTransport(2, &delays)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestNoRetry(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		status  int
+		retries int
+	}{
+		{name: "post is not idempotent", method: http.MethodPost, status: http.StatusServiceUnavailable, retries: 3},
+		{name: "internal server error", method: http.MethodGet, status: http.StatusInternalServerError, retries: 3},
+		{name: "retries disabled", method: http.MethodGet, status: http.StatusServiceUnavailable, retries: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := newFlakyServer(1, tt.status, &calls)
+			defer server.Close()
+
+			var delays []time.Duration
+			client := &http.Client{Transport: newTestTransport(tt.retries, &delays)}
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load())
+			assert.Empty(t, delays)
+		})
+	}
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	// Grab a free port and close the listener so connections are refused
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	var delays []time.Duration
+	client := &http.Client{Transport: newTestTransport(2, &delays)}
+
+	_, err := client.Get(url)
+	assert.Error(t, err)
+	assert.Len(t, delays, 2)
+	for i, d := range delays {
+		assert.LessOrEqual(t, d, DefaultBaseDelay<<i)
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(10, http.StatusBadGateway, &calls)
+	defer server.Close()
+
+	transport := NewTransport(http.DefaultTransport, Config{MaxRetries: 5, BaseDelay: time.Hour, MaxDelay: time.Hour})
+	client := &http.Client{Transport: transport}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls.Load())
+}
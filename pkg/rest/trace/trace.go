@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package trace provides an http.RoundTripper decorator that records every REST call, with its
+// response status and latency, as a JSON line, e.g. to attach to a support ticket.
+//
+// Credentials are never recorded: the Authorization header is reduced to its scheme and the values
+// of query parameters that may carry secrets are replaced.
+package trace
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces the credentials in the records.
+const Redacted = "REDACTED"
+
+// Query parameters whose values are redacted
+var sensitiveQueryParameters = []string{"token", "access_token", "refresh_token", "password", "secret", "key"}
+
+// Record is the JSON line written for each call.
+type Record struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	URL    string    `json:"url"`
+	// Project is the project the call applies to, if any.
+	Project string `json:"project,omitempty"`
+	// Auth is the scheme of the Authorization header followed by Redacted, e.g. "Bearer REDACTED".
+	Auth      string  `json:"auth,omitempty"`
+	Status    int     `json:"status,omitempty"`
+	LatencyMs float64 `json:"latencyMs"`
+	// Error is set when no response was received.
+	Error string `json:"error,omitempty"`
+}
+
+// Transport records the calls made through its base transport to a writer.
+type Transport struct {
+	Base http.RoundTripper
+
+	mu  sync.Mutex
+	out io.Writer
+	now func() time.Time
+}
+
+// NewTransport decorates base with the recording of its calls to out.
+func NewTransport(base http.RoundTripper, out io.Writer) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, out: out, now: time.Now}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.now()
+	resp, err := t.Base.RoundTrip(req)
+
+	record := Record{
+		Time:      start.UTC(),
+		Method:    req.Method,
+		URL:       redactURL(req.URL),
+		Project:   projectOf(req.URL.Path),
+		Auth:      redactAuth(req.Header.Get("Authorization")),
+		LatencyMs: float64(t.now().Sub(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = resp.StatusCode
+	}
+	t.write(record)
+	return resp, err
+}
+
+// Failing to trace a call does not fail the call
+func (t *Transport) write(record Record) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.out.Write(append(line, '\n'))
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	query := redacted.Query()
+	changed := false
+	for name := range query {
+		for _, sensitive := range sensitiveQueryParameters {
+			if strings.EqualFold(name, sensitive) {
+				query[name] = []string{Redacted}
+				changed = true
+			}
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+func redactAuth(header string) string {
+	if header == "" {
+		return ""
+	}
+	scheme, _, found := strings.Cut(header, " ")
+	if !found {
+		return Redacted
+	}
+	return scheme + " " + Redacted
+}
+
+// Projects are the path segment following "projects", as in /v1/projects/<project>/compute/hosts
+func projectOf(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "projects" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,649 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package selector evaluates boolean expressions over the JSON representation of API resources,
+// to select list items client-side on fields the server-side filter cannot reach.
+//
+// The syntax is a subset of CEL:
+//
+//	cpuCores >= 16 && hostStatus == "Running"
+//	instance.os.name =~ "^Ubuntu" || !has(instance)
+//	size(instance.existingCves) > 0 && "critical" in instance.existingCves.priority
+//
+// Fields are the JSON names of the resource, nested ones separated by '.'; a field of a list is the
+// list of that field of its elements. Missing fields are null, and only == and != match null. Strings
+// holding numbers compare as numbers with numbers, as the API renders 64-bit integers as strings.
+package selector
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Selector is a parsed expression.
+type Selector struct {
+	source string
+	root   node
+}
+
+// Parse parses an expression.
+func Parse(source string) (*Selector, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+	}
+	return &Selector{source: source, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (s *Selector) String() string {
+	return s.source
+}
+
+// Match reports whether the JSON representation of item satisfies the expression.
+func (s *Selector) Match(item interface{}) (bool, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false, err
+	}
+	var view interface{}
+	if err := json.Unmarshal(data, &view); err != nil {
+		return false, err
+	}
+	return s.MatchView(view)
+}
+
+// MatchView reports whether a decoded JSON value, e.g. a map[string]interface{}, satisfies the expression.
+func (s *Selector) MatchView(view interface{}) (bool, error) {
+	value, err := s.root.eval(view)
+	if err != nil {
+		return false, err
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q is not a condition, it evaluates to %s", s.source, describe(value))
+	}
+	return result, nil
+}
+
+// Lexer
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return strconv.Quote(t.value.(string))
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// Longest operators first
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "<", ">", "!", "(", ")", "[", "]", ",", ".", "-"}
+
+func lex(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := rune(source[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			var sb strings.Builder
+			for ; end < len(source) && rune(source[end]) != c; end++ {
+				if source[end] == '\\' && end+1 < len(source) {
+					end++
+					switch source[end] {
+					case 'n':
+						sb.WriteByte('\n')
+					case 't':
+						sb.WriteByte('\t')
+					default:
+						sb.WriteByte(source[end])
+					}
+					continue
+				}
+				sb.WriteByte(source[end])
+			}
+			if end >= len(source) {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: source[i : end+1], value: sb.String(), pos: i})
+			i = end + 1
+		case unicode.IsDigit(c):
+			end := i
+			for end < len(source) && (unicode.IsDigit(rune(source[end])) || source[end] == '.') {
+				end++
+			}
+			number, err := strconv.ParseFloat(source[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", source[i:end], i+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[i:end], value: number, pos: i})
+			i = end
+		case unicode.IsLetter(c) || c == '_':
+			end := i
+			for end < len(source) && (unicode.IsLetter(rune(source[end])) || unicode.IsDigit(rune(source[end])) || source[end] == '_') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[i:end], pos: i})
+			i = end
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+// Parser
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) accept(kind tokenKind, text string) bool {
+	if tok := p.peek(); tok.kind == kind && tok.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(tokenOperator, text) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, found %s", text, tok.pos+1, tok)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokenOperator, "||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokenOperator, "&&") {
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	op := ""
+	switch {
+	case tok.kind == tokenOperator && (tok.text == "==" || tok.text == "!=" || tok.text == "<" || tok.text == "<=" ||
+		tok.text == ">" || tok.text == ">=" || tok.text == "=~"):
+		op = tok.text
+	case tok.kind == tokenIdent && tok.text == "in":
+		op = tok.text
+	default:
+		return left, nil
+	}
+	p.next()
+	right, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if op == "=~" {
+		pattern, ok := right.(literalNode)
+		if !ok {
+			return nil, fmt.Errorf("the right operand of =~ at position %d must be a string", tok.pos+1)
+		}
+		source, ok := pattern.value.(string)
+		if !ok {
+			return nil, fmt.Errorf("the right operand of =~ at position %d must be a string", tok.pos+1)
+		}
+		re, err := regexp.Compile(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", source, err)
+		}
+		return matchNode{operand: left, re: re}, nil
+	}
+	return comparisonNode{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.accept(tokenOperator, "!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	if p.accept(tokenOperator, "-") {
+		tok := p.next()
+		if tok.kind != tokenNumber {
+			return nil, fmt.Errorf("expected a number at position %d, found %s", tok.pos+1, tok)
+		}
+		return literalNode{value: -tok.value.(float64)}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenString, tokenNumber:
+		return literalNode{value: tok.value}, nil
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "null":
+			return literalNode{value: nil}, nil
+		}
+		if p.accept(tokenOperator, "(") {
+			return p.parseCall(tok)
+		}
+		path := []string{tok.text}
+		for p.accept(tokenOperator, ".") {
+			field := p.next()
+			if field.kind != tokenIdent {
+				return nil, fmt.Errorf("expected a field name at position %d, found %s", field.pos+1, field)
+			}
+			path = append(path, field.text)
+		}
+		return fieldNode{path: path}, nil
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return inner, p.expect(")")
+		case "[":
+			var items []node
+			for !p.accept(tokenOperator, "]") {
+				if len(items) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				item, err := p.parseUnary()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return listNode{items: items}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos+1)
+	}
+	argument, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if name.text == "has" {
+		if _, ok := argument.(fieldNode); !ok {
+			return nil, fmt.Errorf("the argument of has at position %d must be a field", name.pos+1)
+		}
+	}
+	return callNode{name: name.text, fn: fn, argument: argument}, nil
+}
+
+// Functions of a single argument
+var functions = map[string]func(interface{}) (interface{}, error){
+	"has": func(value interface{}) (interface{}, error) {
+		return value != nil, nil
+	},
+	"size": func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case nil:
+			return float64(0), nil
+		case string:
+			return float64(len(v)), nil
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		}
+		return nil, fmt.Errorf("size of %s is undefined", describe(value))
+	},
+	"lower": func(value interface{}) (interface{}, error) {
+		if s, ok := value.(string); ok {
+			return strings.ToLower(s), nil
+		}
+		return value, nil
+	},
+}
+
+// Evaluation
+
+type node interface {
+	eval(view interface{}) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(interface{}) (interface{}, error) {
+	return n.value, nil
+}
+
+type listNode struct {
+	items []node
+}
+
+func (n listNode) eval(view interface{}) (interface{}, error) {
+	values := make([]interface{}, 0, len(n.items))
+	for _, item := range n.items {
+		value, err := item.eval(view)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+type fieldNode struct {
+	path []string
+}
+
+func (n fieldNode) eval(view interface{}) (interface{}, error) {
+	value := view
+	for _, name := range n.path {
+		value = lookup(value, name)
+	}
+	return value, nil
+}
+
+// Looks a field up by its exact name, then ignoring case; fields of lists are looked up in every element
+func lookup(value interface{}, name string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if field, ok := v[name]; ok {
+			return field
+		}
+		for key, field := range v {
+			if strings.EqualFold(key, name) {
+				return field
+			}
+		}
+	case []interface{}:
+		fields := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			if field := lookup(element, name); field != nil {
+				fields = append(fields, field)
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
+type callNode struct {
+	name     string
+	fn       func(interface{}) (interface{}, error)
+	argument node
+}
+
+func (n callNode) eval(view interface{}) (interface{}, error) {
+	value, err := n.argument.eval(view)
+	if err != nil {
+		return nil, err
+	}
+	return n.fn(value)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(view interface{}) (interface{}, error) {
+	value, err := evalBool(n.operand, view)
+	if err != nil {
+		return nil, err
+	}
+	return !value, nil
+}
+
+type logicalNode struct {
+	or          bool
+	left, right node
+}
+
+func (n logicalNode) eval(view interface{}) (interface{}, error) {
+	left, err := evalBool(n.left, view)
+	if err != nil {
+		return nil, err
+	}
+	if left == n.or {
+		return left, nil
+	}
+	return evalBool(n.right, view)
+}
+
+func evalBool(n node, view interface{}) (bool, error) {
+	value, err := n.eval(view)
+	if err != nil {
+		return false, err
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a condition, found %s", describe(value))
+	}
+	return result, nil
+}
+
+type matchNode struct {
+	operand node
+	re      *regexp.Regexp
+}
+
+func (n matchNode) eval(view interface{}) (interface{}, error) {
+	value, err := n.operand.eval(view)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := value.(string)
+	return ok && n.re.MatchString(s), nil
+}
+
+type comparisonNode struct {
+	op          string
+	left, right node
+}
+
+func (n comparisonNode) eval(view interface{}) (interface{}, error) {
+	left, err := n.left.eval(view)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(view)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "in":
+		list, ok := right.([]interface{})
+		if !ok {
+			if right == nil {
+				return false, nil
+			}
+			return nil, fmt.Errorf("the right operand of in must be a list, found %s", describe(right))
+		}
+		for _, element := range list {
+			if equal(left, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	cmp, ok := compare(left, right)
+	if !ok {
+		return false, nil
+	}
+	switch n.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func equal(left, right interface{}) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	if cmp, ok := compare(left, right); ok {
+		return cmp == 0
+	}
+	if l, ok := left.(bool); ok {
+		r, ok := right.(bool)
+		return ok && l == r
+	}
+	return false
+}
+
+// Orders numbers, and strings holding numbers, numerically and other strings lexically
+func compare(left, right interface{}) (int, bool) {
+	l, lok := asNumber(left)
+	r, rok := asNumber(right)
+	_, lnum := left.(float64)
+	_, rnum := right.(float64)
+	if lok && rok && (lnum || rnum) {
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		}
+		return 0, true
+	}
+	ls, lok := left.(string)
+	rs, rok := right.(string)
+	if lok && rok {
+		return strings.Compare(ls, rs), true
+	}
+	return 0, false
+}
+
+func asNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func describe(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []interface{}:
+		return "a list"
+	}
+	return "an object"
+}
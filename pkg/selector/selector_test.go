@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testInstance struct {
+	Name          string              `json:"name"`
+	Os            map[string]string   `json:"os,omitempty"`
+	ExistingCves  []map[string]string `json:"existingCves,omitempty"`
+	ProvisionedAt *string             `json:"provisionedAt,omitempty"`
+}
+
+type testHost struct {
+	Name        string        `json:"name"`
+	CPUCores    int           `json:"cpuCores"`
+	HostStatus  string        `json:"hostStatus"`
+	MemoryBytes string        `json:"memoryBytes"`
+	Instance    *testInstance `json:"instance,omitempty"`
+}
+
+var host = testHost{
+	Name:        "edge-host-001",
+	CPUCores:    16,
+	HostStatus:  "Running",
+	MemoryBytes: "17179869184",
+	Instance: &testInstance{
+		Name: "instance-1",
+		Os:   map[string]string{"name": "Ubuntu 22.04"},
+		ExistingCves: []map[string]string{
+			{"cve_id": "CVE-2024-0001", "priority": "low"},
+			{"cve_id": "CVE-2024-0002", "priority": "critical"},
+		},
+	},
+}
+
+func TestMatch(t *testing.T) {
+	for expression, expected := range map[string]bool{
+		`cpuCores >= 16 && hostStatus == "Running"`: true,
+		`cpuCores > 16 || hostStatus != 'Running'`:  false,
+		`!(cpuCores < 8)`:                                 true,
+		`instance.os.name =~ "^Ubuntu"`:                   true,
+		`instance.os.name =~ "^Debian"`:                   false,
+		`memoryBytes > 8000000000`:                        true,
+		`memoryBytes == "17179869184"`:                    true,
+		`size(instance.existingCves) == 2`:                true,
+		`"critical" in instance.existingCves.priority`:    true,
+		`"CVE-2024-9999" in instance.existingCves.cve_id`: false,
+		`hostStatus in ["Running", "Error"]`:              true,
+		`has(instance.provisionedAt)`:                     false,
+		`instance.provisionedAt == null`:                  true,
+		`instance.missing > 3`:                            false,
+		`lower(HOSTSTATUS) == "running"`:                  true,
+		`cpuCores >= -1 && true`:                          true,
+	} {
+		sel, err := Parse(expression)
+		require.NoError(t, err, expression)
+		match, err := sel.Match(host)
+		require.NoError(t, err, expression)
+		assert.Equal(t, expected, match, expression)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for expression, message := range map[string]string{
+		`cpuCores >=`:           "unexpected end of expression at position 12",
+		`(cpuCores > 1`:         `expected ")" at position 14, found end of expression`,
+		`name == "edge`:         "unterminated string at position 9",
+		`name # 1`:              `unexpected character '#' at position 6`,
+		`upper(name) == "A"`:    `unknown function "upper" at position 1`,
+		`name =~ hostStatus`:    "the right operand of =~ at position 6 must be a string",
+		`name =~ "("`:           "invalid regular expression",
+		`has(size(name))`:       "the argument of has at position 1 must be a field",
+		`cpuCores > 1 cpuCores`: `unexpected "cpuCores" at position 14`,
+	} {
+		_, err := Parse(expression)
+		require.Error(t, err, expression)
+		assert.Contains(t, err.Error(), message, expression)
+	}
+}
+
+func TestMatchErrors(t *testing.T) {
+	sel, err := Parse(`cpuCores`)
+	require.NoError(t, err)
+	_, err = sel.Match(host)
+	assert.EqualError(t, err, `expression "cpuCores" is not a condition, it evaluates to a number`)
+
+	sel, err = Parse(`name && true`)
+	require.NoError(t, err)
+	_, err = sel.Match(host)
+	assert.EqualError(t, err, "expected a condition, found a string")
+
+	sel, err = Parse(`"a" in name`)
+	require.NoError(t, err)
+	_, err = sel.Match(host)
+	assert.EqualError(t, err, "the right operand of in must be a list, found a string")
+}